@@ -31,9 +31,11 @@ import (
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
 	"github.com/gardener/gardener/pkg/openapi"
 	"github.com/gardener/gardener/pkg/version"
+	cloudprofilecapabilities "github.com/gardener/gardener/plugin/pkg/cloudprofile/capabilities"
 	controllerregistrationresources "github.com/gardener/gardener/plugin/pkg/controllerregistration/resources"
 	"github.com/gardener/gardener/plugin/pkg/global/deletionconfirmation"
 	"github.com/gardener/gardener/plugin/pkg/global/resourcereferencemanager"
+	shootcostestimator "github.com/gardener/gardener/plugin/pkg/shoot/costestimator"
 	shootdnshostedzone "github.com/gardener/gardener/plugin/pkg/shoot/dnshostedzone"
 	shootquotavalidator "github.com/gardener/gardener/plugin/pkg/shoot/quotavalidator"
 	shootseedmanager "github.com/gardener/gardener/plugin/pkg/shoot/seedmanager"
@@ -122,22 +124,27 @@ func (o *Options) complete() error {
 	resourcereferencemanager.Register(o.Recommended.Admission.Plugins)
 	deletionconfirmation.Register(o.Recommended.Admission.Plugins)
 	shootquotavalidator.Register(o.Recommended.Admission.Plugins)
+	shootcostestimator.Register(o.Recommended.Admission.Plugins)
 	shootseedmanager.Register(o.Recommended.Admission.Plugins)
 	shootdnshostedzone.Register(o.Recommended.Admission.Plugins)
 	shootvalidator.Register(o.Recommended.Admission.Plugins)
 	controllerregistrationresources.Register(o.Recommended.Admission.Plugins)
+	cloudprofilecapabilities.Register(o.Recommended.Admission.Plugins)
 
 	allOrderedPlugins := []string{
 		resourcereferencemanager.PluginName,
 		shootdnshostedzone.PluginName,
 		shootquotavalidator.PluginName,
+		shootcostestimator.PluginName,
 		shootseedmanager.PluginName,
 		shootvalidator.PluginName,
 		controllerregistrationresources.PluginName,
+		cloudprofilecapabilities.PluginName,
 		deletionconfirmation.PluginName,
 	}
 
 	o.Recommended.Admission.RecommendedPluginOrder = append(o.Recommended.Admission.RecommendedPluginOrder, allOrderedPlugins...)
+	o.Recommended.Admission.DefaultOffPlugins.Insert(shootcostestimator.PluginName)
 
 	return nil
 }
@@ -23,6 +23,7 @@ import (
 	"os"
 	"strings"
 
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	coreclientset "github.com/gardener/gardener/pkg/client/core/clientset/versioned"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
@@ -31,6 +32,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	configvalidation "github.com/gardener/gardener/pkg/controllermanager/apis/config/validation"
 	"github.com/gardener/gardener/pkg/controllermanager/controller"
 	"github.com/gardener/gardener/pkg/controllermanager/features"
 	"github.com/gardener/gardener/pkg/controllermanager/server"
@@ -91,6 +93,9 @@ func NewOptions() (*Options, error) {
 	if err := gardenv1beta1.AddToScheme(scheme.Scheme); err != nil {
 		return nil, err
 	}
+	if err := gardencorev1alpha1.AddToScheme(scheme.Scheme); err != nil {
+		return nil, err
+	}
 
 	return o, nil
 }
@@ -159,6 +164,10 @@ func (o *Options) run(ctx context.Context, cancel context.CancelFunc) error {
 		o.config = c
 	}
 
+	if errs := configvalidation.ValidateControllerManagerConfiguration(o.config); len(errs) > 0 {
+		return fmt.Errorf("invalid Gardener controller manager configuration: %v", errs.ToAggregate())
+	}
+
 	// Add feature flags
 	if err := features.FeatureGate.SetFromMap(o.config.FeatureGates); err != nil {
 		return err
@@ -224,8 +233,15 @@ type Gardener struct {
 	Logger                 *logrus.Logger
 	Recorder               record.EventRecorder
 	LeaderElection         *leaderelection.LeaderElectionConfig
+	// GlobalLeaderElection is used for the controllers acting on the whole Garden cluster if only a subset of the
+	// Seeds is managed, i.e., if multiple controller managers run side by side.
+	GlobalLeaderElection *leaderelection.LeaderElectionConfig
 }
 
+// globalControllersLockObjectName is the name of the lock object shared by all controller managers for running the
+// controllers acting on the whole Garden cluster.
+const globalControllersLockObjectName = "gardener-controller-manager-global"
+
 // NewGardener is the main entry point of instantiating a new Gardener controller manager.
 func NewGardener(cfg *config.ControllerManagerConfiguration) (*Gardener, error) {
 	if cfg == nil {
@@ -287,14 +303,26 @@ func NewGardener(cfg *config.ControllerManagerConfiguration) (*Gardener, error)
 
 	// Set up leader election if enabled and prepare event recorder.
 	var (
-		leaderElectionConfig *leaderelection.LeaderElectionConfig
-		recorder             = createRecorder(k8sGardenClient.Kubernetes())
+		leaderElectionConfig       *leaderelection.LeaderElectionConfig
+		globalLeaderElectionConfig *leaderelection.LeaderElectionConfig
+		recorder                   = createRecorder(k8sGardenClient.Kubernetes())
 	)
 	if cfg.LeaderElection.LeaderElect {
 		leaderElectionConfig, err = makeLeaderElectionConfig(cfg.LeaderElection, k8sGardenClientLeaderElection.Kubernetes(), recorder)
 		if err != nil {
 			return nil, err
 		}
+
+		// Controller managers managing a subset of the Seeds use their own locks, hence, the controllers acting on the
+		// whole Garden cluster need an additional lock shared by all of them.
+		if cfg.SeedSelector != nil {
+			globalLeaderElectionCfg := cfg.LeaderElection
+			globalLeaderElectionCfg.LockObjectName = globalControllersLockObjectName
+			globalLeaderElectionConfig, err = makeLeaderElectionConfig(globalLeaderElectionCfg, k8sGardenClientLeaderElection.Kubernetes(), recorder)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	identity, gardenerNamespace, err := determineGardenerIdentity()
@@ -313,11 +341,17 @@ func NewGardener(cfg *config.ControllerManagerConfiguration) (*Gardener, error)
 		K8sGardenCoreInformers: gardencoreinformers.NewSharedInformerFactory(k8sGardenClient.GardenCore(), 0),
 		KubeInformerFactory:    kubeinformers.NewSharedInformerFactory(k8sGardenClient.Kubernetes(), 0),
 		LeaderElection:         leaderElectionConfig,
+		GlobalLeaderElection:   globalLeaderElectionConfig,
 	}, nil
 }
 
 // Run runs the Gardener. This should never exit.
 func (g *Gardener) Run(ctx context.Context, cancel context.CancelFunc) error {
+	// The indexers must be added before the HTTP server starts the first informers.
+	if err := controller.AddIndexers(g.K8sGardenInformers, g.K8sGardenCoreInformers); err != nil {
+		return fmt.Errorf("couldn't add informer indexers: %v", err)
+	}
+
 	leaderElectionCtx, leaderElectionCancel := context.WithCancel(context.Background())
 
 	// Prepare a reusable run function.
@@ -366,6 +400,7 @@ func (g *Gardener) startControllers(ctx context.Context) {
 		g.Identity,
 		g.GardenerNamespace,
 		g.Recorder,
+		g.GlobalLeaderElection,
 	).Run(ctx)
 }
 
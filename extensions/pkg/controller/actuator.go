@@ -0,0 +1,57 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package controller contains helpers for implementing controllers for Gardener's extension resources.
+package controller
+
+import (
+	"context"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Object is an extension resource.
+type Object = extensionsv1alpha1.Object
+
+// Actuator acts upon extension resources of a certain kind.
+type Actuator interface {
+	// Reconcile reconciles the given extension resource.
+	Reconcile(ctx context.Context, obj Object) error
+	// Delete deletes the given extension resource and cleans up all resources that were created for it.
+	Delete(ctx context.Context, obj Object) error
+	// ForceDelete deletes the given extension resource without cleaning up the resources that were created for
+	// it on the cloud provider level. It must only release what is required to remove the extension resource.
+	ForceDelete(ctx context.Context, obj Object) error
+}
+
+// Act calls the operation of the given actuator that is appropriate for the current state of the given extension
+// resource, i.e., Reconcile if it is not being deleted, ForceDelete if it is being deleted and annotated with the
+// force-delete operation, and Delete otherwise.
+func Act(ctx context.Context, actuator Actuator, obj Object) error {
+	if obj.GetDeletionTimestamp() == nil {
+		return actuator.Reconcile(ctx, obj)
+	}
+	if IsForceDeletion(obj) {
+		return actuator.ForceDelete(ctx, obj)
+	}
+	return actuator.Delete(ctx, obj)
+}
+
+// IsForceDeletion checks whether the given extension resource is being deleted and annotated with the force-delete
+// operation.
+func IsForceDeletion(obj metav1.Object) bool {
+	return obj.GetDeletionTimestamp() != nil && obj.GetAnnotations()[extensionsv1alpha1.OperationAnnotation] == extensionsv1alpha1.OperationForceDelete
+}
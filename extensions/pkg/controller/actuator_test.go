@@ -0,0 +1,89 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller_test
+
+import (
+	"context"
+
+	. "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type recordingActuator struct {
+	calls []string
+	err   error
+}
+
+func (r *recordingActuator) Reconcile(_ context.Context, _ Object) error {
+	r.calls = append(r.calls, "reconcile")
+	return r.err
+}
+
+func (r *recordingActuator) Delete(_ context.Context, _ Object) error {
+	r.calls = append(r.calls, "delete")
+	return nil
+}
+
+func (r *recordingActuator) ForceDelete(_ context.Context, _ Object) error {
+	r.calls = append(r.calls, "force-delete")
+	return nil
+}
+
+var _ = Describe("Actuator", func() {
+	var (
+		now      = metav1.Now()
+		actuator *recordingActuator
+		obj      *extensionsv1alpha1.OperatingSystemConfig
+	)
+
+	BeforeEach(func() {
+		actuator = &recordingActuator{}
+		obj = &extensionsv1alpha1.OperatingSystemConfig{}
+	})
+
+	Describe("#Act", func() {
+		It("should reconcile the object if it is not being deleted", func() {
+			Expect(Act(context.TODO(), actuator, obj)).To(Succeed())
+			Expect(actuator.calls).To(Equal([]string{"reconcile"}))
+		})
+
+		It("should delete the object if it is being deleted", func() {
+			obj.DeletionTimestamp = &now
+
+			Expect(Act(context.TODO(), actuator, obj)).To(Succeed())
+			Expect(actuator.calls).To(Equal([]string{"delete"}))
+		})
+
+		It("should force-delete the object if it is being deleted and annotated accordingly", func() {
+			obj.DeletionTimestamp = &now
+			obj.Annotations = map[string]string{extensionsv1alpha1.OperationAnnotation: extensionsv1alpha1.OperationForceDelete}
+
+			Expect(Act(context.TODO(), actuator, obj)).To(Succeed())
+			Expect(actuator.calls).To(Equal([]string{"force-delete"}))
+		})
+	})
+
+	Describe("#IsForceDeletion", func() {
+		It("should return false if the object is only annotated", func() {
+			obj.Annotations = map[string]string{extensionsv1alpha1.OperationAnnotation: extensionsv1alpha1.OperationForceDelete}
+
+			Expect(IsForceDeletion(obj)).To(BeFalse())
+		})
+	})
+})
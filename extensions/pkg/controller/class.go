@@ -0,0 +1,82 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// ClassOf returns the extension class of the given extension resource. Resources without a class are treated as if
+// they had the shoot class.
+func ClassOf(obj Object) extensionsv1alpha1.ExtensionClass {
+	if class := obj.GetExtensionSpec().Class; class != nil {
+		return *class
+	}
+	return extensionsv1alpha1.ExtensionClassShoot
+}
+
+// IsResponsible checks whether the given extension resource has one of the given extension classes. If no classes
+// are given, only resources of the shoot class are considered.
+func IsResponsible(obj Object, classes ...extensionsv1alpha1.ExtensionClass) bool {
+	if len(classes) == 0 {
+		classes = []extensionsv1alpha1.ExtensionClass{extensionsv1alpha1.ExtensionClassShoot}
+	}
+
+	class := ClassOf(obj)
+	for _, c := range classes {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+type classFilteredActuator struct {
+	actuator Actuator
+	classes  []extensionsv1alpha1.ExtensionClass
+}
+
+// NewClassFilteredActuator returns an Actuator that only passes extension resources to the given Actuator if they
+// have one of the given extension classes (see IsResponsible). Resources of other classes are left to the controllers
+// responsible for them and ignored.
+func NewClassFilteredActuator(actuator Actuator, classes ...extensionsv1alpha1.ExtensionClass) Actuator {
+	return &classFilteredActuator{actuator, classes}
+}
+
+// Reconcile implements Actuator.
+func (a *classFilteredActuator) Reconcile(ctx context.Context, obj Object) error {
+	if !IsResponsible(obj, a.classes...) {
+		return nil
+	}
+	return a.actuator.Reconcile(ctx, obj)
+}
+
+// Delete implements Actuator.
+func (a *classFilteredActuator) Delete(ctx context.Context, obj Object) error {
+	if !IsResponsible(obj, a.classes...) {
+		return nil
+	}
+	return a.actuator.Delete(ctx, obj)
+}
+
+// ForceDelete implements Actuator.
+func (a *classFilteredActuator) ForceDelete(ctx context.Context, obj Object) error {
+	if !IsResponsible(obj, a.classes...) {
+		return nil
+	}
+	return a.actuator.ForceDelete(ctx, obj)
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller_test
+
+import (
+	"context"
+
+	. "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Class", func() {
+	var (
+		seedClass = extensionsv1alpha1.ExtensionClassSeed
+		obj       *extensionsv1alpha1.OperatingSystemConfig
+	)
+
+	BeforeEach(func() {
+		obj = &extensionsv1alpha1.OperatingSystemConfig{}
+	})
+
+	Describe("#ClassOf", func() {
+		It("should default to the shoot class", func() {
+			Expect(ClassOf(obj)).To(Equal(extensionsv1alpha1.ExtensionClassShoot))
+		})
+
+		It("should return the class of the object", func() {
+			obj.Spec.Class = &seedClass
+
+			Expect(ClassOf(obj)).To(Equal(extensionsv1alpha1.ExtensionClassSeed))
+		})
+	})
+
+	Describe("#IsResponsible", func() {
+		It("should only consider the shoot class if no classes are given", func() {
+			Expect(IsResponsible(obj)).To(BeTrue())
+
+			obj.Spec.Class = &seedClass
+			Expect(IsResponsible(obj)).To(BeFalse())
+		})
+
+		It("should consider the given classes", func() {
+			obj.Spec.Class = &seedClass
+
+			Expect(IsResponsible(obj, extensionsv1alpha1.ExtensionClassGarden, extensionsv1alpha1.ExtensionClassSeed)).To(BeTrue())
+			Expect(IsResponsible(obj, extensionsv1alpha1.ExtensionClassShoot)).To(BeFalse())
+		})
+	})
+
+	Describe("#NewClassFilteredActuator", func() {
+		var (
+			now      = metav1.Now()
+			actuator *recordingActuator
+		)
+
+		BeforeEach(func() {
+			actuator = &recordingActuator{}
+		})
+
+		It("should pass resources of the given classes to the actuator", func() {
+			obj.Spec.Class = &seedClass
+			filtered := NewClassFilteredActuator(actuator, extensionsv1alpha1.ExtensionClassSeed)
+
+			Expect(filtered.Reconcile(context.TODO(), obj)).To(Succeed())
+			Expect(filtered.Delete(context.TODO(), obj)).To(Succeed())
+			Expect(filtered.ForceDelete(context.TODO(), obj)).To(Succeed())
+			Expect(actuator.calls).To(Equal([]string{"reconcile", "delete", "force-delete"}))
+		})
+
+		It("should ignore resources of other classes", func() {
+			obj.Spec.Class = &seedClass
+			obj.DeletionTimestamp = &now
+			filtered := NewClassFilteredActuator(actuator)
+
+			Expect(Act(context.TODO(), filtered, obj)).To(Succeed())
+			Expect(filtered.Reconcile(context.TODO(), obj)).To(Succeed())
+			Expect(actuator.calls).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,35 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CredentialsChecksum returns the checksum of the credentials Gardener provides for the given extension resource.
+// It returns an empty string if the resource is not annotated with a checksum.
+func CredentialsChecksum(obj metav1.Object) string {
+	return obj.GetAnnotations()[extensionsv1alpha1.CredentialsChecksumAnnotation]
+}
+
+// CredentialsRotated checks whether the credentials Gardener provides for an extension resource have been rotated
+// between the given old and new versions of the resource. Controllers can use it in their update event handlers to
+// reload their clients proactively, as the rotation does not change the generation of the resource.
+func CredentialsRotated(oldObj, newObj metav1.Object) bool {
+	oldChecksum := CredentialsChecksum(oldObj)
+	return len(oldChecksum) > 0 && oldChecksum != CredentialsChecksum(newObj)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller_test
+
+import (
+	. "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Credentials", func() {
+	newObj := func(checksum string) *extensionsv1alpha1.OperatingSystemConfig {
+		obj := &extensionsv1alpha1.OperatingSystemConfig{}
+		if len(checksum) > 0 {
+			obj.Annotations = map[string]string{extensionsv1alpha1.CredentialsChecksumAnnotation: checksum}
+		}
+		return obj
+	}
+
+	Describe("#CredentialsChecksum", func() {
+		It("should return the checksum of the annotation", func() {
+			Expect(CredentialsChecksum(newObj("foo"))).To(Equal("foo"))
+		})
+
+		It("should return an empty string if the object is not annotated", func() {
+			Expect(CredentialsChecksum(&metav1.ObjectMeta{})).To(BeEmpty())
+		})
+	})
+
+	Describe("#CredentialsRotated", func() {
+		It("should detect a changed checksum", func() {
+			Expect(CredentialsRotated(newObj("foo"), newObj("bar"))).To(BeTrue())
+		})
+
+		It("should not detect a rotation if the checksum is unchanged", func() {
+			Expect(CredentialsRotated(newObj("foo"), newObj("foo"))).To(BeFalse())
+		})
+
+		It("should not detect a rotation if the checksum is added", func() {
+			Expect(CredentialsRotated(newObj(""), newObj("foo"))).To(BeFalse())
+		})
+	})
+})
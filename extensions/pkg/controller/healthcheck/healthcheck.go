@@ -0,0 +1,201 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package healthcheck provides health checks for extensions whose results are aggregated into conditions. Every
+// check can declare a severity, a failure threshold, and a grace period so that short-lived failures (e.g. during
+// rollouts) do not make the conditions flap between healthy and unhealthy.
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Severity describes the impact of a failing health check on the aggregated condition.
+type Severity string
+
+const (
+	// SeverityCritical indicates that a failing check makes the aggregated condition unhealthy.
+	SeverityCritical Severity = "Critical"
+	// SeverityWarning indicates that a failing check is only reported but keeps the aggregated condition healthy.
+	SeverityWarning Severity = "Warning"
+
+	// ReasonSucceeded is the reason of conditions whose checks have all succeeded.
+	ReasonSucceeded = "HealthCheckSucceeded"
+	// ReasonFailed is the reason of conditions with at least one failed critical check.
+	ReasonFailed = "HealthCheckFailed"
+	// ReasonWarning is the reason of conditions with failed checks of severity Warning only.
+	ReasonWarning = "HealthCheckWarning"
+	// ReasonProgressing is the reason of conditions with failing checks that have not exceeded their failure
+	// threshold or grace period yet.
+	ReasonProgressing = "HealthCheckProgressing"
+)
+
+// Now returns the current time.
+var Now = time.Now
+
+// Check is a single health check.
+type Check struct {
+	// Name is the unique name of the check.
+	Name string
+	// ConditionType is the type of the condition the result of the check is aggregated into.
+	ConditionType gardencorev1alpha1.ConditionType
+	// Severity is the severity of the check. Defaults to SeverityCritical.
+	Severity Severity
+	// FailureThreshold is the number of consecutive failures after which the check is considered failed.
+	// Defaults to 1.
+	FailureThreshold int
+	// GracePeriod is the duration a check must be failing before it is considered failed.
+	GracePeriod time.Duration
+	// Fn is the function performing the check. It returns an error if the check fails.
+	Fn func(ctx context.Context) error
+}
+
+type checkState struct {
+	consecutiveFailures int
+	failingSince        time.Time
+}
+
+// Checker executes health checks and aggregates their results into conditions. It remembers the results of
+// previous executions in order to apply the failure thresholds and grace periods of the checks.
+type Checker struct {
+	checks []Check
+
+	lock   sync.Mutex
+	states map[string]*checkState
+}
+
+// NewChecker returns a new Checker for the given checks.
+func NewChecker(checks ...Check) (*Checker, error) {
+	names := make(map[string]struct{}, len(checks))
+	for _, check := range checks {
+		if len(check.Name) == 0 {
+			return nil, fmt.Errorf("health check must have a name")
+		}
+		if _, ok := names[check.Name]; ok {
+			return nil, fmt.Errorf("health check %q is defined twice", check.Name)
+		}
+		if check.Fn == nil {
+			return nil, fmt.Errorf("health check %q has no function", check.Name)
+		}
+		names[check.Name] = struct{}{}
+	}
+
+	return &Checker{checks: checks, states: make(map[string]*checkState, len(checks))}, nil
+}
+
+type result struct {
+	failed      []string
+	warnings    []string
+	progressing []string
+}
+
+// Check executes all checks and returns the given conditions updated with the aggregated results. Conditions
+// of types no check is defined for are returned unchanged.
+func (c *Checker) Check(ctx context.Context, conditions []gardencorev1alpha1.Condition) []gardencorev1alpha1.Condition {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	var (
+		now     = Now()
+		results = make(map[gardencorev1alpha1.ConditionType]*result)
+	)
+
+	for _, check := range c.checks {
+		res, ok := results[check.ConditionType]
+		if !ok {
+			res = &result{}
+			results[check.ConditionType] = res
+		}
+
+		state, ok := c.states[check.Name]
+		if !ok {
+			state = &checkState{}
+			c.states[check.Name] = state
+		}
+
+		err := check.Fn(ctx)
+		if err == nil {
+			state.consecutiveFailures = 0
+			state.failingSince = time.Time{}
+			continue
+		}
+
+		if state.consecutiveFailures == 0 {
+			state.failingSince = now
+		}
+		state.consecutiveFailures++
+
+		message := fmt.Sprintf("%s: %v", check.Name, err)
+		switch {
+		case state.consecutiveFailures < failureThreshold(check) || now.Sub(state.failingSince) < check.GracePeriod:
+			res.progressing = append(res.progressing, message)
+		case check.Severity == SeverityWarning:
+			res.warnings = append(res.warnings, message)
+		default:
+			res.failed = append(res.failed, message)
+		}
+	}
+
+	var updated []gardencorev1alpha1.Condition
+	for _, conditionType := range sortedConditionTypes(results) {
+		condition := helper.GetCondition(conditions, conditionType)
+		if condition == nil {
+			initialized := helper.InitCondition(conditionType)
+			condition = &initialized
+		}
+		updated = append(updated, aggregate(*condition, results[conditionType]))
+	}
+
+	return helper.MergeConditions(conditions, updated...)
+}
+
+func aggregate(condition gardencorev1alpha1.Condition, res *result) gardencorev1alpha1.Condition {
+	switch {
+	case len(res.failed) > 0:
+		return helper.UpdatedCondition(condition, corev1.ConditionFalse, ReasonFailed, strings.Join(res.failed, "; "))
+	case len(res.progressing) > 0:
+		// Failing checks that have not exceeded their threshold or grace period do not change the status of the
+		// condition.
+		return helper.UpdatedCondition(condition, condition.Status, ReasonProgressing, strings.Join(res.progressing, "; "))
+	case len(res.warnings) > 0:
+		return helper.UpdatedCondition(condition, corev1.ConditionTrue, ReasonWarning, strings.Join(res.warnings, "; "))
+	}
+	return helper.UpdatedCondition(condition, corev1.ConditionTrue, ReasonSucceeded, "All health checks succeeded.")
+}
+
+func failureThreshold(check Check) int {
+	if check.FailureThreshold < 1 {
+		return 1
+	}
+	return check.FailureThreshold
+}
+
+func sortedConditionTypes(results map[gardencorev1alpha1.ConditionType]*result) []gardencorev1alpha1.ConditionType {
+	types := make([]gardencorev1alpha1.ConditionType, 0, len(results))
+	for conditionType := range results {
+		types = append(types, conditionType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("HealthCheck", func() {
+	const conditionType gardencorev1alpha1.ConditionType = "ControlPlaneHealthy"
+
+	var (
+		ctx     = context.TODO()
+		now     time.Time
+		oldNow  func() time.Time
+		failing error
+
+		check = func(ctx context.Context) error { return failing }
+	)
+
+	BeforeEach(func() {
+		failing = nil
+		now = time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+		oldNow = Now
+		Now = func() time.Time { return now }
+	})
+
+	AfterEach(func() {
+		Now = oldNow
+	})
+
+	Describe("#NewChecker", func() {
+		It("should fail for duplicate names", func() {
+			_, err := NewChecker(Check{Name: "foo", Fn: check}, Check{Name: "foo", Fn: check})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#Check", func() {
+		It("should report succeeded checks", func() {
+			checker, err := NewChecker(Check{Name: "foo", ConditionType: conditionType, Fn: check})
+			Expect(err).NotTo(HaveOccurred())
+
+			conditions := checker.Check(ctx, nil)
+			Expect(conditions).To(HaveLen(1))
+			Expect(conditions[0].Type).To(Equal(conditionType))
+			Expect(conditions[0].Status).To(Equal(corev1.ConditionTrue))
+			Expect(conditions[0].Reason).To(Equal(ReasonSucceeded))
+		})
+
+		It("should keep the status until the failure threshold is exceeded", func() {
+			checker, err := NewChecker(Check{Name: "foo", ConditionType: conditionType, FailureThreshold: 2, Fn: check})
+			Expect(err).NotTo(HaveOccurred())
+
+			conditions := checker.Check(ctx, nil)
+			failing = errors.New("not ready")
+
+			conditions = checker.Check(ctx, conditions)
+			Expect(conditions[0].Status).To(Equal(corev1.ConditionTrue))
+			Expect(conditions[0].Reason).To(Equal(ReasonProgressing))
+			Expect(conditions[0].Message).To(Equal("foo: not ready"))
+
+			conditions = checker.Check(ctx, conditions)
+			Expect(conditions[0].Status).To(Equal(corev1.ConditionFalse))
+			Expect(conditions[0].Reason).To(Equal(ReasonFailed))
+		})
+
+		It("should keep the status until the grace period is exceeded", func() {
+			checker, err := NewChecker(Check{Name: "foo", ConditionType: conditionType, GracePeriod: time.Minute, Fn: check})
+			Expect(err).NotTo(HaveOccurred())
+
+			conditions := checker.Check(ctx, nil)
+			failing = errors.New("not ready")
+
+			conditions = checker.Check(ctx, conditions)
+			Expect(conditions[0].Status).To(Equal(corev1.ConditionTrue))
+			Expect(conditions[0].Reason).To(Equal(ReasonProgressing))
+
+			now = now.Add(time.Minute)
+			conditions = checker.Check(ctx, conditions)
+			Expect(conditions[0].Status).To(Equal(corev1.ConditionFalse))
+		})
+
+		It("should reset the state once the check succeeds again", func() {
+			checker, err := NewChecker(Check{Name: "foo", ConditionType: conditionType, FailureThreshold: 2, Fn: check})
+			Expect(err).NotTo(HaveOccurred())
+
+			failing = errors.New("not ready")
+			conditions := checker.Check(ctx, nil)
+			failing = nil
+			conditions = checker.Check(ctx, conditions)
+			failing = errors.New("not ready")
+			conditions = checker.Check(ctx, conditions)
+
+			Expect(conditions[0].Status).To(Equal(corev1.ConditionTrue))
+			Expect(conditions[0].Reason).To(Equal(ReasonProgressing))
+		})
+
+		It("should keep the condition healthy for failed warnings", func() {
+			checker, err := NewChecker(
+				Check{Name: "foo", ConditionType: conditionType, Fn: check},
+				Check{Name: "bar", ConditionType: conditionType, Severity: SeverityWarning, Fn: func(ctx context.Context) error { return errors.New("degraded") }},
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			conditions := checker.Check(ctx, nil)
+			Expect(conditions[0].Status).To(Equal(corev1.ConditionTrue))
+			Expect(conditions[0].Reason).To(Equal(ReasonWarning))
+			Expect(conditions[0].Message).To(Equal("bar: degraded"))
+		})
+
+		It("should not touch conditions without checks", func() {
+			checker, err := NewChecker(Check{Name: "foo", ConditionType: conditionType, Fn: check})
+			Expect(err).NotTo(HaveOccurred())
+
+			other := gardencorev1alpha1.Condition{Type: "Other", Status: corev1.ConditionFalse}
+			conditions := checker.Check(ctx, []gardencorev1alpha1.Condition{other})
+			Expect(conditions).To(HaveLen(2))
+			Expect(conditions[0]).To(Equal(other))
+		})
+	})
+})
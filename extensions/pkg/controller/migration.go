@@ -0,0 +1,182 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// StateStore persists the state of extension resources outside of the seed cluster so that it survives the
+// migration of a shoot's control plane to another seed.
+type StateStore interface {
+	// Store persists the given state of the given extension resource.
+	Store(ctx context.Context, obj Object, state string) error
+	// Load returns the persisted state of the given extension resource. It returns an empty string if no
+	// state has been persisted.
+	Load(ctx context.Context, obj Object) (string, error)
+}
+
+// IsMigration checks whether the given extension resource is annotated with the migrate operation.
+func IsMigration(obj Object) bool {
+	return obj.GetAnnotations()[extensionsv1alpha1.OperationAnnotation] == extensionsv1alpha1.OperationMigrate
+}
+
+// IsRestoration checks whether the given extension resource is annotated with the restore operation.
+func IsRestoration(obj Object) bool {
+	return obj.GetAnnotations()[extensionsv1alpha1.OperationAnnotation] == extensionsv1alpha1.OperationRestore
+}
+
+type migrationActuator struct {
+	actuator Actuator
+	store    StateStore
+	client   client.Client
+}
+
+// NewMigrationActuator returns an Actuator that implements the migrate and restore operations on top of the given
+// Actuator:
+// - Resources annotated with the migrate operation get their state persisted in the given StateStore. Neither the
+//   reconciliation nor the deletion of such resources calls the cloud provider, i.e., they are deleted with the
+//   ForceDelete function of the given Actuator.
+// - Resources annotated with the restore operation get their state loaded from the given StateStore before they
+//   are reconciled by the given Actuator. Once the reconciliation succeeded, the restored state and the restore
+//   operation are recorded in the status of the resource and the annotation is removed with the given client, so
+//   that later reconciliations do not overwrite the live state again.
+func NewMigrationActuator(actuator Actuator, store StateStore, c client.Client) Actuator {
+	return &migrationActuator{actuator, store, c}
+}
+
+// Reconcile implements Actuator.
+func (a *migrationActuator) Reconcile(ctx context.Context, obj Object) error {
+	if IsMigration(obj) {
+		return a.store.Store(ctx, obj, obj.GetExtensionStatus().State)
+	}
+
+	if !IsRestoration(obj) {
+		return a.actuator.Reconcile(ctx, obj)
+	}
+
+	state, err := a.store.Load(ctx, obj)
+	if err != nil {
+		return err
+	}
+	obj.GetExtensionStatus().State = state
+
+	if err := a.actuator.Reconcile(ctx, obj); err != nil {
+		return err
+	}
+	return a.finishRestoration(ctx, obj)
+}
+
+// finishRestoration persists the status of the given restored extension resource and removes the restore
+// operation annotation afterwards.
+func (a *migrationActuator) finishRestoration(ctx context.Context, obj Object) error {
+	status := obj.GetExtensionStatus()
+	status.LastOperation = &extensionsv1alpha1.LastOperation{
+		Description:    "The state has been restored successfully.",
+		LastUpdateTime: metav1.Now(),
+		Progress:       100,
+		State:          extensionsv1alpha1.LastOperationStateSucceeded,
+		Type:           extensionsv1alpha1.LastOperationTypeRestore,
+	}
+	if err := a.client.Status().Update(ctx, obj); err != nil {
+		return err
+	}
+
+	annotations := obj.GetAnnotations()
+	delete(annotations, extensionsv1alpha1.OperationAnnotation)
+	obj.SetAnnotations(annotations)
+	return a.client.Update(ctx, obj)
+}
+
+// Delete implements Actuator.
+func (a *migrationActuator) Delete(ctx context.Context, obj Object) error {
+	if IsMigration(obj) {
+		if err := a.store.Store(ctx, obj, obj.GetExtensionStatus().State); err != nil {
+			return err
+		}
+		return a.actuator.ForceDelete(ctx, obj)
+	}
+	return a.actuator.Delete(ctx, obj)
+}
+
+// ForceDelete implements Actuator.
+func (a *migrationActuator) ForceDelete(ctx context.Context, obj Object) error {
+	return a.actuator.ForceDelete(ctx, obj)
+}
+
+const stateDataKey = "state"
+
+type secretStateStore struct {
+	client    client.Client
+	scheme    *runtime.Scheme
+	namespace string
+}
+
+// NewSecretStateStore returns a StateStore that persists the state of every extension resource in a dedicated
+// secret in the given namespace. The scheme is used to determine the kind of the extension resources.
+func NewSecretStateStore(c client.Client, scheme *runtime.Scheme, namespace string) StateStore {
+	return &secretStateStore{c, scheme, namespace}
+}
+
+func (s *secretStateStore) secretName(obj Object) (string, error) {
+	gvk, err := apiutil.GVKForObject(obj, s.scheme)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s-%s-%s", strings.ToLower(gvk.Kind), obj.GetNamespace(), obj.GetName()), nil
+}
+
+// Store implements StateStore.
+func (s *secretStateStore) Store(ctx context.Context, obj Object, state string) error {
+	name, err := s.secretName(obj)
+	if err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{ObjectMeta: kutil.ObjectMeta(s.namespace, name)}
+	return kutil.CreateOrUpdate(ctx, s.client, secret, func() error {
+		secret.Data = map[string][]byte{stateDataKey: []byte(state)}
+		return nil
+	})
+}
+
+// Load implements StateStore.
+func (s *secretStateStore) Load(ctx context.Context, obj Object) (string, error) {
+	name, err := s.secretName(obj)
+	if err != nil {
+		return "", err
+	}
+
+	secret := &corev1.Secret{}
+	if err := s.client.Get(ctx, kutil.Key(s.namespace, name), secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return string(secret.Data[stateDataKey]), nil
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Migration", func() {
+	var (
+		ctx      = context.TODO()
+		now      = metav1.Now()
+		scheme   *runtime.Scheme
+		c        client.Client
+		store    StateStore
+		actuator *recordingActuator
+		obj      *extensionsv1alpha1.OperatingSystemConfig
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		c = fake.NewFakeClientWithScheme(scheme)
+		store = NewSecretStateStore(c, scheme, "garden")
+		actuator = &recordingActuator{}
+		obj = &extensionsv1alpha1.OperatingSystemConfig{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "osc"},
+		}
+	})
+
+	Describe("SecretStateStore", func() {
+		It("should return an empty state if nothing has been stored", func() {
+			Expect(store.Load(ctx, obj)).To(BeEmpty())
+		})
+
+		It("should store and load the state", func() {
+			Expect(store.Store(ctx, obj, "foo")).To(Succeed())
+			Expect(store.Store(ctx, obj, "bar")).To(Succeed())
+			Expect(store.Load(ctx, obj)).To(Equal("bar"))
+		})
+	})
+
+	Describe("MigrationActuator", func() {
+		It("should delegate the reconciliation if no operation is requested", func() {
+			Expect(NewMigrationActuator(actuator, store, c).Reconcile(ctx, obj)).To(Succeed())
+			Expect(actuator.calls).To(Equal([]string{"reconcile"}))
+		})
+
+		It("should only persist the state when migrating", func() {
+			obj.Annotations = map[string]string{extensionsv1alpha1.OperationAnnotation: extensionsv1alpha1.OperationMigrate}
+			obj.Status.State = "foo"
+
+			Expect(NewMigrationActuator(actuator, store, c).Reconcile(ctx, obj)).To(Succeed())
+			Expect(actuator.calls).To(BeEmpty())
+			Expect(store.Load(ctx, obj)).To(Equal("foo"))
+		})
+
+		It("should persist the state and skip the cloud provider cleanup when deleting a migrated object", func() {
+			obj.Annotations = map[string]string{extensionsv1alpha1.OperationAnnotation: extensionsv1alpha1.OperationMigrate}
+			obj.DeletionTimestamp = &now
+			obj.Status.State = "foo"
+
+			Expect(NewMigrationActuator(actuator, store, c).Delete(ctx, obj)).To(Succeed())
+			Expect(actuator.calls).To(Equal([]string{"force-delete"}))
+			Expect(store.Load(ctx, obj)).To(Equal("foo"))
+		})
+
+		It("should restore the state before reconciling and finish the restoration afterwards", func() {
+			Expect(store.Store(ctx, obj, "foo")).To(Succeed())
+			obj.Annotations = map[string]string{extensionsv1alpha1.OperationAnnotation: extensionsv1alpha1.OperationRestore}
+			Expect(c.Create(ctx, obj)).To(Succeed())
+
+			Expect(NewMigrationActuator(actuator, store, c).Reconcile(ctx, obj)).To(Succeed())
+			Expect(actuator.calls).To(Equal([]string{"reconcile"}))
+
+			stored := &extensionsv1alpha1.OperatingSystemConfig{}
+			Expect(c.Get(ctx, kutil.Key(obj.Namespace, obj.Name), stored)).To(Succeed())
+			Expect(stored.Annotations).NotTo(HaveKey(extensionsv1alpha1.OperationAnnotation))
+			Expect(stored.Status.State).To(Equal("foo"))
+			Expect(stored.Status.LastOperation).NotTo(BeNil())
+			Expect(stored.Status.LastOperation.Type).To(Equal(extensionsv1alpha1.LastOperationTypeRestore))
+			Expect(stored.Status.LastOperation.State).To(Equal(extensionsv1alpha1.LastOperationStateSucceeded))
+		})
+
+		It("should not restore the state again in later reconciliations", func() {
+			Expect(store.Store(ctx, obj, "foo")).To(Succeed())
+			obj.Annotations = map[string]string{extensionsv1alpha1.OperationAnnotation: extensionsv1alpha1.OperationRestore}
+			Expect(c.Create(ctx, obj)).To(Succeed())
+			migrationActuator := NewMigrationActuator(actuator, store, c)
+
+			Expect(migrationActuator.Reconcile(ctx, obj)).To(Succeed())
+
+			stored := &extensionsv1alpha1.OperatingSystemConfig{}
+			Expect(c.Get(ctx, kutil.Key(obj.Namespace, obj.Name), stored)).To(Succeed())
+			stored.Status.State = "bar"
+
+			Expect(migrationActuator.Reconcile(ctx, stored)).To(Succeed())
+			Expect(actuator.calls).To(Equal([]string{"reconcile", "reconcile"}))
+			Expect(stored.Status.State).To(Equal("bar"))
+		})
+
+		It("should keep the restore operation if the reconciliation fails", func() {
+			Expect(store.Store(ctx, obj, "foo")).To(Succeed())
+			obj.Annotations = map[string]string{extensionsv1alpha1.OperationAnnotation: extensionsv1alpha1.OperationRestore}
+			Expect(c.Create(ctx, obj)).To(Succeed())
+			actuator.err = errors.New("err")
+
+			Expect(NewMigrationActuator(actuator, store, c).Reconcile(ctx, obj)).To(MatchError("err"))
+
+			stored := &extensionsv1alpha1.OperatingSystemConfig{}
+			Expect(c.Get(ctx, kutil.Key(obj.Namespace, obj.Name), stored)).To(Succeed())
+			Expect(stored.Annotations).To(HaveKeyWithValue(extensionsv1alpha1.OperationAnnotation, extensionsv1alpha1.OperationRestore))
+		})
+	})
+})
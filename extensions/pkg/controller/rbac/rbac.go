@@ -0,0 +1,162 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rbac derives the minimal RBAC rules an extension needs from the types its controllers watch and manage.
+package rbac
+
+import (
+	"sort"
+	"strings"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+var (
+	// ReadVerbs are the verbs required to watch a type.
+	ReadVerbs = []string{"get", "list", "watch"}
+	// WriteVerbs are the verbs required to manage a type, in addition to the ReadVerbs.
+	WriteVerbs = []string{"create", "update", "patch", "delete"}
+)
+
+// Access describes how a controller accesses objects of a certain type.
+type Access struct {
+	// Object is an instance of the accessed type.
+	Object runtime.Object
+	// Verbs are the verbs the controller needs.
+	Verbs []string
+	// Subresources are the subresources the controller accesses with the same verbs, e.g. "status".
+	// +optional
+	Subresources []string
+}
+
+// Watch returns the Access of a controller that watches objects of the given type.
+func Watch(obj runtime.Object) Access {
+	return Access{Object: obj, Verbs: ReadVerbs}
+}
+
+// Manage returns the Access of a controller that watches and manages objects of the given type, including
+// the given subresources.
+func Manage(obj runtime.Object, subresources ...string) Access {
+	return Access{Object: obj, Verbs: append(append([]string{}, ReadVerbs...), WriteVerbs...), Subresources: subresources}
+}
+
+// Rules derives the minimal policy rules for the given accesses. The scheme is used to determine the API group
+// and the resource of the accessed types. Resources of the same API group requiring the same verbs are merged
+// into one rule, and the rules are sorted so that their rendering is stable.
+func Rules(scheme *runtime.Scheme, accesses ...Access) ([]rbacv1.PolicyRule, error) {
+	type ruleKey struct {
+		group string
+		verbs string
+	}
+
+	resourcesByRule := make(map[ruleKey]sets.String)
+	verbsByResource := make(map[string]sets.String)
+
+	for _, access := range accesses {
+		gvk, err := apiutil.GVKForObject(access.Object, scheme)
+		if err != nil {
+			return nil, err
+		}
+		gvr, _ := meta.UnsafeGuessKindToResource(gvk)
+
+		resources := []string{gvr.Resource}
+		for _, subresource := range access.Subresources {
+			resources = append(resources, gvr.Resource+"/"+subresource)
+		}
+
+		for _, resource := range resources {
+			id := gvr.Group + "/" + resource
+			if _, ok := verbsByResource[id]; !ok {
+				verbsByResource[id] = sets.NewString()
+			}
+			verbsByResource[id].Insert(access.Verbs...)
+		}
+	}
+
+	for id, verbs := range verbsByResource {
+		var (
+			index    = strings.Index(id, "/")
+			group    = id[:index]
+			resource = id[index+1:]
+			key      = ruleKey{group, strings.Join(verbs.List(), ",")}
+		)
+
+		if _, ok := resourcesByRule[key]; !ok {
+			resourcesByRule[key] = sets.NewString()
+		}
+		resourcesByRule[key].Insert(resource)
+	}
+
+	rules := make([]rbacv1.PolicyRule, 0, len(resourcesByRule))
+	for key, resources := range resourcesByRule {
+		rules = append(rules, rbacv1.PolicyRule{
+			APIGroups: []string{key.group},
+			Resources: resources.List(),
+			Verbs:     strings.Split(key.verbs, ","),
+		})
+	}
+
+	sort.Slice(rules, func(i, j int) bool {
+		if rules[i].APIGroups[0] != rules[j].APIGroups[0] {
+			return rules[i].APIGroups[0] < rules[j].APIGroups[0]
+		}
+		return rules[i].Resources[0] < rules[j].Resources[0]
+	})
+
+	return rules, nil
+}
+
+// ClusterRole returns a ClusterRole with the given name and rules.
+func ClusterRole(name string, rules []rbacv1.PolicyRule) *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+			Kind:       "ClusterRole",
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Rules:      rules,
+	}
+}
+
+// ChartValues returns the given rules in a format that can be passed as values to the Helm chart of a
+// ControllerRegistration, i.e., as `rbac.rules`.
+func ChartValues(rules []rbacv1.PolicyRule) map[string]interface{} {
+	values := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		values = append(values, map[string]interface{}{
+			"apiGroups": toInterfaceSlice(rule.APIGroups),
+			"resources": toInterfaceSlice(rule.Resources),
+			"verbs":     toInterfaceSlice(rule.Verbs),
+		})
+	}
+
+	return map[string]interface{}{
+		"rbac": map[string]interface{}{
+			"rules": values,
+		},
+	}
+}
+
+func toInterfaceSlice(in []string) []interface{} {
+	out := make([]interface{}, 0, len(in))
+	for _, s := range in {
+		out = append(out, s)
+	}
+	return out
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rbac_test
+
+import (
+	. "github.com/gardener/gardener/extensions/pkg/controller/rbac"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+var _ = Describe("RBAC", func() {
+	var s *runtime.Scheme
+
+	BeforeEach(func() {
+		s = runtime.NewScheme()
+		Expect(scheme.AddToScheme(s)).To(Succeed())
+		Expect(extensionsv1alpha1.AddToScheme(s)).To(Succeed())
+	})
+
+	Describe("#Rules", func() {
+		It("should derive merged and sorted rules from the accesses", func() {
+			rules, err := Rules(s,
+				Manage(&extensionsv1alpha1.OperatingSystemConfig{}, "status"),
+				Watch(&corev1.Secret{}),
+				Watch(&corev1.ConfigMap{}),
+			)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rules).To(Equal([]rbacv1.PolicyRule{
+				{
+					APIGroups: []string{""},
+					Resources: []string{"configmaps", "secrets"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
+				{
+					APIGroups: []string{extensionsv1alpha1.SchemeGroupVersion.Group},
+					Resources: []string{"operatingsystemconfigs", "operatingsystemconfigs/status"},
+					Verbs:     []string{"create", "delete", "get", "list", "patch", "update", "watch"},
+				},
+			}))
+		})
+
+		It("should merge the verbs of multiple accesses to the same type", func() {
+			rules, err := Rules(s,
+				Watch(&corev1.Secret{}),
+				Access{Object: &corev1.Secret{}, Verbs: []string{"create"}},
+			)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rules).To(Equal([]rbacv1.PolicyRule{
+				{
+					APIGroups: []string{""},
+					Resources: []string{"secrets"},
+					Verbs:     []string{"create", "get", "list", "watch"},
+				},
+			}))
+		})
+
+		It("should fail for types that are not registered in the scheme", func() {
+			_, err := Rules(runtime.NewScheme(), Watch(&corev1.Secret{}))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#ChartValues", func() {
+		It("should render the rules as chart values", func() {
+			values := ChartValues([]rbacv1.PolicyRule{{
+				APIGroups: []string{""},
+				Resources: []string{"secrets"},
+				Verbs:     []string{"get"},
+			}})
+
+			Expect(values).To(Equal(map[string]interface{}{
+				"rbac": map[string]interface{}{
+					"rules": []interface{}{
+						map[string]interface{}{
+							"apiGroups": []interface{}{""},
+							"resources": []interface{}{"secrets"},
+							"verbs":     []interface{}{"get"},
+						},
+					},
+				},
+			}))
+		})
+	})
+})
@@ -0,0 +1,64 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// IsReconcileRequested checks whether the given extension resource is annotated with the reconcile operation.
+func IsReconcileRequested(obj Object) bool {
+	return obj.GetAnnotations()[extensionsv1alpha1.OperationAnnotation] == extensionsv1alpha1.OperationReconcile
+}
+
+type reconcileOperationActuator struct {
+	actuator Actuator
+	client   client.Client
+}
+
+// NewReconcileOperationActuator returns an Actuator that removes the reconcile operation annotation with the given
+// client before it passes extension resources annotated with it to the given Actuator. This way, Gardener observes
+// that the requested reconciliation has been started and may request another one later. Controllers wrapping their
+// actuator must also watch changes of the annotations, not only of the generation of the resources.
+func NewReconcileOperationActuator(actuator Actuator, c client.Client) Actuator {
+	return &reconcileOperationActuator{actuator, c}
+}
+
+// Reconcile implements Actuator.
+func (a *reconcileOperationActuator) Reconcile(ctx context.Context, obj Object) error {
+	if IsReconcileRequested(obj) {
+		annotations := obj.GetAnnotations()
+		delete(annotations, extensionsv1alpha1.OperationAnnotation)
+		obj.SetAnnotations(annotations)
+		if err := a.client.Update(ctx, obj); err != nil {
+			return err
+		}
+	}
+	return a.actuator.Reconcile(ctx, obj)
+}
+
+// Delete implements Actuator.
+func (a *reconcileOperationActuator) Delete(ctx context.Context, obj Object) error {
+	return a.actuator.Delete(ctx, obj)
+}
+
+// ForceDelete implements Actuator.
+func (a *reconcileOperationActuator) ForceDelete(ctx context.Context, obj Object) error {
+	return a.actuator.ForceDelete(ctx, obj)
+}
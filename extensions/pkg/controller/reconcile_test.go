@@ -0,0 +1,80 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller_test
+
+import (
+	"context"
+
+	. "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("ReconcileOperationActuator", func() {
+	var (
+		ctx      = context.TODO()
+		c        client.Client
+		actuator *recordingActuator
+		obj      *extensionsv1alpha1.OperatingSystemConfig
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		c = fake.NewFakeClientWithScheme(scheme)
+		actuator = &recordingActuator{}
+		obj = &extensionsv1alpha1.OperatingSystemConfig{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "osc"},
+		}
+	})
+
+	It("should delegate the reconciliation if no operation is requested", func() {
+		Expect(c.Create(ctx, obj)).To(Succeed())
+
+		Expect(NewReconcileOperationActuator(actuator, c).Reconcile(ctx, obj)).To(Succeed())
+		Expect(actuator.calls).To(Equal([]string{"reconcile"}))
+	})
+
+	It("should remove the reconcile operation and reconcile", func() {
+		obj.Annotations = map[string]string{extensionsv1alpha1.OperationAnnotation: extensionsv1alpha1.OperationReconcile}
+		Expect(c.Create(ctx, obj)).To(Succeed())
+
+		Expect(NewReconcileOperationActuator(actuator, c).Reconcile(ctx, obj)).To(Succeed())
+		Expect(actuator.calls).To(Equal([]string{"reconcile"}))
+
+		stored := &extensionsv1alpha1.OperatingSystemConfig{}
+		Expect(c.Get(ctx, kutil.Key(obj.Namespace, obj.Name), stored)).To(Succeed())
+		Expect(stored.Annotations).NotTo(HaveKey(extensionsv1alpha1.OperationAnnotation))
+	})
+
+	It("should keep other operations", func() {
+		obj.Annotations = map[string]string{extensionsv1alpha1.OperationAnnotation: extensionsv1alpha1.OperationRestore}
+		Expect(c.Create(ctx, obj)).To(Succeed())
+
+		Expect(NewReconcileOperationActuator(actuator, c).Reconcile(ctx, obj)).To(Succeed())
+
+		stored := &extensionsv1alpha1.OperatingSystemConfig{}
+		Expect(c.Get(ctx, kutil.Key(obj.Namespace, obj.Name), stored)).To(Succeed())
+		Expect(stored.Annotations).To(HaveKeyWithValue(extensionsv1alpha1.OperationAnnotation, extensionsv1alpha1.OperationRestore))
+	})
+})
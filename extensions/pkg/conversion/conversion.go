@@ -0,0 +1,150 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package conversion contains helpers for extensions that evolve their provider configuration APIs across versions,
+// i.e., for decoding provider configuration and for serving conversion webhooks for their custom resources.
+package conversion
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Converter converts objects between the versions of the API groups registered in its scheme. Each API group must
+// register an internal version together with the conversion functions from and to all of its external versions
+// (usually generated by conversion-gen), i.e., objects are always converted via the internal version.
+type Converter struct {
+	scheme *runtime.Scheme
+	codecs serializer.CodecFactory
+}
+
+// NewConverter returns a new Converter for the given scheme.
+func NewConverter(scheme *runtime.Scheme) *Converter {
+	return &Converter{scheme, serializer.NewCodecFactory(scheme)}
+}
+
+// Decode decodes the given data, which may be of any registered version, into the internal version.
+func (c *Converter) Decode(data []byte) (runtime.Object, error) {
+	obj, _, err := c.codecs.UniversalDecoder().Decode(data, nil, nil)
+	return obj, err
+}
+
+// DecodeInto decodes the given data, which may be of any registered version, into the given object. The
+// object may be of any registered version, too.
+func (c *Converter) DecodeInto(data []byte, into runtime.Object) error {
+	obj, err := c.Decode(data)
+	if err != nil {
+		return err
+	}
+	return c.scheme.Convert(obj, into, nil)
+}
+
+// DecodeProviderConfig decodes the given provider configuration into the given object. It returns an error if no
+// provider configuration is given.
+func (c *Converter) DecodeProviderConfig(config *gardencorev1alpha1.ProviderConfig, into runtime.Object) error {
+	if config == nil {
+		return fmt.Errorf("no provider config given")
+	}
+
+	data := config.Raw
+	if data == nil && config.Object != nil {
+		var err error
+		if data, err = json.Marshal(config.Object); err != nil {
+			return err
+		}
+	}
+
+	return c.DecodeInto(data, into)
+}
+
+// Convert converts the given data, which may be of any registered version, into the given version.
+func (c *Converter) Convert(data []byte, version schema.GroupVersion) ([]byte, error) {
+	obj, err := c.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	return runtime.Encode(c.codecs.LegacyCodec(version), obj)
+}
+
+// Review performs the conversion requested by the given ConversionReview and returns the response.
+func (c *Converter) Review(review *apiextensionsv1beta1.ConversionReview) *apiextensionsv1beta1.ConversionResponse {
+	if review.Request == nil {
+		return failure("", fmt.Errorf("conversion review contains no request"))
+	}
+
+	version, err := schema.ParseGroupVersion(review.Request.DesiredAPIVersion)
+	if err != nil {
+		return failure(review.Request.UID, err)
+	}
+
+	converted := make([]runtime.RawExtension, 0, len(review.Request.Objects))
+	for _, obj := range review.Request.Objects {
+		data, err := c.Convert(obj.Raw, version)
+		if err != nil {
+			return failure(review.Request.UID, err)
+		}
+		converted = append(converted, runtime.RawExtension{Raw: data})
+	}
+
+	return &apiextensionsv1beta1.ConversionResponse{
+		UID:              review.Request.UID,
+		ConvertedObjects: converted,
+		Result:           metav1.Status{Status: metav1.StatusSuccess},
+	}
+}
+
+// ServeHTTP implements http.Handler. It serves the ConversionReview requests of the kube-apiserver and can be
+// registered as conversion webhook for the custom resources of an extension.
+func (c *Converter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	review := &apiextensionsv1beta1.ConversionReview{}
+	if err := json.Unmarshal(body, review); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	review.Response = c.Review(review)
+	review.Request = nil
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(review); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func failure(uid types.UID, err error) *apiextensionsv1beta1.ConversionResponse {
+	return &apiextensionsv1beta1.ConversionResponse{
+		UID: uid,
+		Result: metav1.Status{
+			Status:  metav1.StatusFailure,
+			Message: err.Error(),
+		},
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversion_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/gardener/gardener/extensions/pkg/conversion"
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	group    = "test.extensions.gardener.cloud"
+	internal = schema.GroupVersion{Group: group, Version: runtime.APIVersionInternal}
+	v1       = schema.GroupVersion{Group: group, Version: "v1"}
+	v2       = schema.GroupVersion{Group: group, Version: "v2"}
+)
+
+// config is the internal version, configV1 stores the value as `value`, configV2 as `data`.
+type config struct {
+	metav1.TypeMeta `json:",inline"`
+	Value           string
+}
+
+type configV1 struct {
+	metav1.TypeMeta `json:",inline"`
+	Value           string `json:"value"`
+}
+
+type configV2 struct {
+	metav1.TypeMeta `json:",inline"`
+	Data            string `json:"data"`
+}
+
+func (c *config) DeepCopyObject() runtime.Object   { out := *c; return &out }
+func (c *configV1) DeepCopyObject() runtime.Object { out := *c; return &out }
+func (c *configV2) DeepCopyObject() runtime.Object { out := *c; return &out }
+
+func newScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypeWithName(internal.WithKind("Config"), &config{})
+	scheme.AddKnownTypeWithName(v1.WithKind("Config"), &configV1{})
+	scheme.AddKnownTypeWithName(v2.WithKind("Config"), &configV2{})
+
+	Expect(scheme.AddConversionFunc((*configV1)(nil), (*config)(nil), func(a, b interface{}, _ conversion.Scope) error {
+		b.(*config).Value = a.(*configV1).Value
+		return nil
+	})).To(Succeed())
+	Expect(scheme.AddConversionFunc((*config)(nil), (*configV1)(nil), func(a, b interface{}, _ conversion.Scope) error {
+		b.(*configV1).Value = a.(*config).Value
+		return nil
+	})).To(Succeed())
+	Expect(scheme.AddConversionFunc((*configV2)(nil), (*config)(nil), func(a, b interface{}, _ conversion.Scope) error {
+		b.(*config).Value = a.(*configV2).Data
+		return nil
+	})).To(Succeed())
+	Expect(scheme.AddConversionFunc((*config)(nil), (*configV2)(nil), func(a, b interface{}, _ conversion.Scope) error {
+		b.(*configV2).Data = a.(*config).Value
+		return nil
+	})).To(Succeed())
+
+	return scheme
+}
+
+var _ = Describe("Converter", func() {
+	var (
+		converter *Converter
+		dataV1    = []byte(`{"apiVersion":"test.extensions.gardener.cloud/v1","kind":"Config","value":"foo"}`)
+	)
+
+	BeforeEach(func() {
+		converter = NewConverter(newScheme())
+	})
+
+	Describe("#Convert", func() {
+		It("should convert between versions", func() {
+			data, err := converter.Convert(dataV1, v2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(MatchJSON(`{"apiVersion":"test.extensions.gardener.cloud/v2","kind":"Config","data":"foo"}`))
+
+			data, err = converter.Convert(data, v1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(MatchJSON(dataV1))
+		})
+
+		It("should fail for unknown kinds", func() {
+			_, err := converter.Convert([]byte(`{"apiVersion":"test.extensions.gardener.cloud/v1","kind":"Unknown"}`), v2)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#DecodeProviderConfig", func() {
+		It("should decode the provider config into the requested version", func() {
+			out := &configV2{}
+			Expect(converter.DecodeProviderConfig(&gardencorev1alpha1.ProviderConfig{RawExtension: runtime.RawExtension{Raw: dataV1}}, out)).To(Succeed())
+			Expect(out.Data).To(Equal("foo"))
+		})
+
+		It("should fail if no provider config is given", func() {
+			Expect(converter.DecodeProviderConfig(nil, &configV2{})).NotTo(Succeed())
+		})
+	})
+
+	Describe("#ServeHTTP", func() {
+		It("should serve conversion reviews", func() {
+			body, err := json.Marshal(&apiextensionsv1beta1.ConversionReview{
+				Request: &apiextensionsv1beta1.ConversionRequest{
+					UID:               "uid",
+					DesiredAPIVersion: v2.String(),
+					Objects:           []runtime.RawExtension{{Raw: dataV1}},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			recorder := httptest.NewRecorder()
+			converter.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/convert", bytes.NewReader(body)))
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+
+			review := &apiextensionsv1beta1.ConversionReview{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), review)).To(Succeed())
+			Expect(review.Response.UID).To(BeEquivalentTo("uid"))
+			Expect(review.Response.Result.Status).To(Equal(metav1.StatusSuccess))
+			Expect(review.Response.ConvertedObjects).To(HaveLen(1))
+			Expect(review.Response.ConvertedObjects[0].Raw).To(MatchJSON(`{"apiVersion":"test.extensions.gardener.cloud/v2","kind":"Config","data":"foo"}`))
+		})
+
+		It("should report conversion failures in the response", func() {
+			review := &apiextensionsv1beta1.ConversionReview{
+				Request: &apiextensionsv1beta1.ConversionRequest{
+					UID:               "uid",
+					DesiredAPIVersion: v2.String(),
+					Objects:           []runtime.RawExtension{{Raw: []byte(`{}`)}},
+				},
+			}
+
+			response := converter.Review(review)
+			Expect(response.UID).To(BeEquivalentTo("uid"))
+			Expect(response.Result.Status).To(Equal(metav1.StatusFailure))
+		})
+	})
+})
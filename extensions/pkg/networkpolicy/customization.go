@@ -0,0 +1,161 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	"github.com/ghodss/yaml"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// CustomizationsNamespace is the namespace in the seed cluster containing the ConfigMap with the customizations.
+	CustomizationsNamespace = "garden"
+	// CustomizationsConfigMapName is the name of the ConfigMap in the seed cluster containing the customizations.
+	CustomizationsConfigMapName = "extension-networkpolicy-customizations"
+	// CustomizationsDataKey is the key in the data of the ConfigMap containing the customizations as YAML list.
+	CustomizationsDataKey = "customizations.yaml"
+)
+
+// knownTargets are the targets which can be customized.
+var knownTargets = map[Target]bool{
+	TargetDNS:             true,
+	TargetShootAPIServer:  true,
+	TargetGardenAPIServer: true,
+	TargetPublicInternet:  true,
+}
+
+// Customization extends or overrides the generated NetworkPolicy of a target. It allows seed administrators to adapt
+// the policies to their seed, e.g. if the kube-apiserver is reached via another port or network, without disabling
+// them entirely.
+type Customization struct {
+	// Target is the target whose NetworkPolicy is customized.
+	Target Target `json:"target"`
+	// Egress replaces the generated egress rules of the target if it is set.
+	// +optional
+	Egress []networkingv1.NetworkPolicyEgressRule `json:"egress,omitempty"`
+	// AdditionalPorts are added to all egress rules of the target which are restricted to ports.
+	// +optional
+	AdditionalPorts []networkingv1.NetworkPolicyPort `json:"additionalPorts,omitempty"`
+	// AdditionalCIDRs are networks which may be reached in addition. If the target is restricted to ports, only these
+	// ports may be reached in the additional networks.
+	// +optional
+	AdditionalCIDRs []string `json:"additionalCIDRs,omitempty"`
+}
+
+// LoadCustomizations reads the customizations from the ConfigMap CustomizationsConfigMapName in the namespace
+// CustomizationsNamespace of the seed cluster. It returns no customizations if the ConfigMap does not exist.
+func LoadCustomizations(ctx context.Context, c client.Client) ([]Customization, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := c.Get(ctx, kutil.Key(CustomizationsNamespace, CustomizationsConfigMapName), configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var customizations []Customization
+	if err := yaml.Unmarshal([]byte(configMap.Data[CustomizationsDataKey]), &customizations); err != nil {
+		return nil, fmt.Errorf("could not decode network policy customizations in config map %s/%s: %v", CustomizationsNamespace, CustomizationsConfigMapName, err)
+	}
+	if err := ValidateCustomizations(customizations); err != nil {
+		return nil, fmt.Errorf("invalid network policy customizations in config map %s/%s: %v", CustomizationsNamespace, CustomizationsConfigMapName, err)
+	}
+	return customizations, nil
+}
+
+// ValidateCustomizations validates the given customizations. Every known target may be customized at most once.
+func ValidateCustomizations(customizations []Customization) error {
+	targets := make(map[Target]bool, len(customizations))
+	for _, customization := range customizations {
+		if !knownTargets[customization.Target] {
+			return fmt.Errorf("unknown network policy target %q", customization.Target)
+		}
+		if targets[customization.Target] {
+			return fmt.Errorf("network policy target %q is customized more than once", customization.Target)
+		}
+		targets[customization.Target] = true
+
+		for _, cidr := range customization.AdditionalCIDRs {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				return fmt.Errorf("invalid additional CIDR %q for network policy target %q: %v", cidr, customization.Target, err)
+			}
+		}
+		for _, port := range customization.AdditionalPorts {
+			if port.Port == nil {
+				return fmt.Errorf("additional port for network policy target %q must specify a port", customization.Target)
+			}
+		}
+	}
+	return nil
+}
+
+// apply returns the given egress rules of the target customized with the first matching customization.
+func apply(target Target, egress []networkingv1.NetworkPolicyEgressRule, customizations []Customization) []networkingv1.NetworkPolicyEgressRule {
+	for _, customization := range customizations {
+		if customization.Target == target {
+			return customization.apply(egress)
+		}
+	}
+	return egress
+}
+
+func (c Customization) apply(egress []networkingv1.NetworkPolicyEgressRule) []networkingv1.NetworkPolicyEgressRule {
+	if len(c.Egress) > 0 {
+		egress = c.Egress
+	}
+
+	out := make([]networkingv1.NetworkPolicyEgressRule, 0, len(egress)+1)
+	for _, rule := range egress {
+		rule = *rule.DeepCopy()
+		if len(rule.Ports) > 0 {
+			rule.Ports = append(rule.Ports, c.AdditionalPorts...)
+		}
+		out = append(out, rule)
+	}
+
+	if len(c.AdditionalCIDRs) > 0 {
+		rule := networkingv1.NetworkPolicyEgressRule{Ports: ports(out)}
+		for _, cidr := range c.AdditionalCIDRs {
+			rule.To = append(rule.To, networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: cidr}})
+		}
+		out = append(out, rule)
+	}
+
+	return out
+}
+
+// ports returns the ports of all given egress rules. It returns no ports (i.e., all ports) if any rule is not
+// restricted to ports.
+func ports(egress []networkingv1.NetworkPolicyEgressRule) []networkingv1.NetworkPolicyPort {
+	var out []networkingv1.NetworkPolicyPort
+	for _, rule := range egress {
+		if len(rule.Ports) == 0 {
+			return nil
+		}
+		for _, port := range rule.Ports {
+			out = append(out, *port.DeepCopy())
+		}
+	}
+	return out
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package networkpolicy allows extensions to declare which components they need to reach from the seed cluster.
+// It generates the matching NetworkPolicies and the labels the extension's pods must carry, so that extensions
+// do not need to ship hand-written policies depending on Gardener's label scheme.
+package networkpolicy
+
+import (
+	"context"
+	"fmt"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Target is a component or network an extension needs to reach from the seed cluster.
+type Target string
+
+const (
+	// TargetDNS is the cluster internal DNS of the seed cluster.
+	TargetDNS Target = "dns"
+	// TargetShootAPIServer is the kube-apiserver of the shoot whose control plane runs in the same namespace.
+	TargetShootAPIServer Target = "shoot-apiserver"
+	// TargetGardenAPIServer is the kube-apiserver of the garden cluster. It is reached via the public internet on
+	// port 443.
+	TargetGardenAPIServer Target = "garden-apiserver"
+	// TargetPublicInternet is every network other than the seed networks and the cloud's metadata service.
+	TargetPublicInternet Target = "public-internet"
+
+	// LabelPrefix is the prefix of the labels that allow pods to reach a target.
+	LabelPrefix = "networking.gardener.cloud/to-"
+	// LabelValueAllowed is the value of the labels that allow pods to reach a target.
+	LabelValueAllowed = "allowed"
+
+	cloudMetadataServiceCIDR = "169.254.169.254/32"
+)
+
+// Label returns the label key pods must carry in order to be allowed to reach the given target.
+func Label(target Target) string {
+	return LabelPrefix + string(target)
+}
+
+// Labels returns the labels pods must carry in order to be allowed to reach all of the given targets.
+func Labels(targets ...Target) map[string]string {
+	labels := make(map[string]string, len(targets))
+	for _, target := range targets {
+		labels[Label(target)] = LabelValueAllowed
+	}
+	return labels
+}
+
+// Name returns the name of the NetworkPolicy allowing traffic to the given target.
+func Name(target Target) string {
+	return fmt.Sprintf("allow-to-%s", target)
+}
+
+// NetworkPolicy returns the NetworkPolicy in the given namespace that allows egress traffic from all pods labelled
+// with the Label of the given target to the target. The egress rules are adapted by the given customizations of the
+// target (see Customization).
+func NetworkPolicy(namespace string, target Target, seedNetworks gardenv1beta1.SeedNetworks, customizations ...Customization) (*networkingv1.NetworkPolicy, error) {
+	egress, err := egressRules(target, seedNetworks)
+	if err != nil {
+		return nil, err
+	}
+	egress = apply(target, egress, customizations)
+
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: kutil.ObjectMeta(namespace, Name(target)),
+		Spec:       spec(target, egress),
+	}, nil
+}
+
+// Ensure creates or updates the NetworkPolicies in the given namespace that allow traffic to the given targets. The
+// policies are customized as configured by the seed administrators (see LoadCustomizations).
+func Ensure(ctx context.Context, c client.Client, namespace string, seedNetworks gardenv1beta1.SeedNetworks, targets ...Target) error {
+	customizations, err := LoadCustomizations(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	for _, target := range targets {
+		egress, err := egressRules(target, seedNetworks)
+		if err != nil {
+			return err
+		}
+		egress = apply(target, egress, customizations)
+
+		networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: kutil.ObjectMeta(namespace, Name(target))}
+		if err := kutil.CreateOrUpdate(ctx, c, networkPolicy, func() error {
+			networkPolicy.Spec = spec(target, egress)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func spec(target Target, egress []networkingv1.NetworkPolicyEgressRule) networkingv1.NetworkPolicySpec {
+	return networkingv1.NetworkPolicySpec{
+		PodSelector: metav1.LabelSelector{MatchLabels: Labels(target)},
+		Egress:      egress,
+		PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+	}
+}
+
+func egressRules(target Target, seedNetworks gardenv1beta1.SeedNetworks) ([]networkingv1.NetworkPolicyEgressRule, error) {
+	switch target {
+	case TargetDNS:
+		return []networkingv1.NetworkPolicyEgressRule{{
+			Ports: []networkingv1.NetworkPolicyPort{
+				port(corev1.ProtocolUDP, 53),
+				port(corev1.ProtocolTCP, 53),
+				port(corev1.ProtocolUDP, 8053),
+				port(corev1.ProtocolTCP, 8053),
+			},
+			To: []networkingv1.NetworkPolicyPeer{{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"role": "kube-system"}},
+			}},
+		}}, nil
+
+	case TargetShootAPIServer:
+		return []networkingv1.NetworkPolicyEgressRule{{
+			Ports: []networkingv1.NetworkPolicyPort{port(corev1.ProtocolTCP, 443)},
+			To: []networkingv1.NetworkPolicyPeer{{
+				PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "kubernetes", "role": "apiserver"}},
+			}},
+		}}, nil
+
+	case TargetGardenAPIServer:
+		return []networkingv1.NetworkPolicyEgressRule{{
+			Ports: []networkingv1.NetworkPolicyPort{port(corev1.ProtocolTCP, 443)},
+			To:    []networkingv1.NetworkPolicyPeer{publicInternet(seedNetworks)},
+		}}, nil
+
+	case TargetPublicInternet:
+		return []networkingv1.NetworkPolicyEgressRule{{
+			To: []networkingv1.NetworkPolicyPeer{publicInternet(seedNetworks)},
+		}}, nil
+	}
+
+	return nil, fmt.Errorf("unknown network policy target %q", target)
+}
+
+func publicInternet(seedNetworks gardenv1beta1.SeedNetworks) networkingv1.NetworkPolicyPeer {
+	return networkingv1.NetworkPolicyPeer{
+		IPBlock: &networkingv1.IPBlock{
+			CIDR: "0.0.0.0/0",
+			Except: []string{
+				string(seedNetworks.Pods),
+				string(seedNetworks.Nodes),
+				string(seedNetworks.Services),
+				cloudMetadataServiceCIDR,
+			},
+		},
+	}
+}
+
+func port(protocol corev1.Protocol, port int) networkingv1.NetworkPolicyPort {
+	p := intstr.FromInt(port)
+	return networkingv1.NetworkPolicyPort{Protocol: &protocol, Port: &p}
+}
@@ -0,0 +1,186 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy_test
+
+import (
+	"context"
+
+	. "github.com/gardener/gardener/extensions/pkg/networkpolicy"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("NetworkPolicy", func() {
+	var (
+		namespace    = "shoot--foo--bar"
+		seedNetworks = gardenv1beta1.SeedNetworks{Pods: "10.0.0.0/16", Nodes: "10.1.0.0/16", Services: "10.2.0.0/16"}
+	)
+
+	Describe("#Labels", func() {
+		It("should return the labels for all targets", func() {
+			Expect(Labels(TargetShootAPIServer, TargetDNS)).To(Equal(map[string]string{
+				"networking.gardener.cloud/to-shoot-apiserver": "allowed",
+				"networking.gardener.cloud/to-dns":             "allowed",
+			}))
+		})
+	})
+
+	Describe("#NetworkPolicy", func() {
+		It("should select the pods with the target's label", func() {
+			networkPolicy, err := NetworkPolicy(namespace, TargetShootAPIServer, seedNetworks)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(networkPolicy.Name).To(Equal("allow-to-shoot-apiserver"))
+			Expect(networkPolicy.Namespace).To(Equal(namespace))
+			Expect(networkPolicy.Spec.PodSelector.MatchLabels).To(Equal(Labels(TargetShootAPIServer)))
+			Expect(networkPolicy.Spec.PolicyTypes).To(ConsistOf(networkingv1.PolicyTypeEgress))
+		})
+
+		It("should exclude the seed networks from the public internet", func() {
+			networkPolicy, err := NetworkPolicy(namespace, TargetPublicInternet, seedNetworks)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(networkPolicy.Spec.Egress).To(HaveLen(1))
+			Expect(networkPolicy.Spec.Egress[0].Ports).To(BeEmpty())
+			Expect(networkPolicy.Spec.Egress[0].To[0].IPBlock.Except).To(ConsistOf("10.0.0.0/16", "10.1.0.0/16", "10.2.0.0/16", "169.254.169.254/32"))
+		})
+
+		It("should fail for unknown targets", func() {
+			_, err := NetworkPolicy(namespace, Target("foo"), seedNetworks)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should add the additional ports and CIDRs of the target's customization", func() {
+			networkPolicy, err := NetworkPolicy(namespace, TargetShootAPIServer, seedNetworks,
+				Customization{Target: TargetDNS, AdditionalCIDRs: []string{"192.168.0.0/24"}},
+				Customization{Target: TargetShootAPIServer, AdditionalPorts: []networkingv1.NetworkPolicyPort{port(corev1.ProtocolTCP, 8443)}, AdditionalCIDRs: []string{"10.250.0.0/16"}},
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(networkPolicy.Spec.Egress).To(HaveLen(2))
+			Expect(networkPolicy.Spec.Egress[0].Ports).To(Equal([]networkingv1.NetworkPolicyPort{port(corev1.ProtocolTCP, 443), port(corev1.ProtocolTCP, 8443)}))
+			Expect(networkPolicy.Spec.Egress[0].To[0].PodSelector).NotTo(BeNil())
+			Expect(networkPolicy.Spec.Egress[1].Ports).To(Equal(networkPolicy.Spec.Egress[0].Ports))
+			Expect(networkPolicy.Spec.Egress[1].To).To(Equal([]networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "10.250.0.0/16"}}}))
+		})
+
+		It("should not restrict the ports of additional CIDRs if the target is not restricted to ports", func() {
+			networkPolicy, err := NetworkPolicy(namespace, TargetPublicInternet, seedNetworks,
+				Customization{Target: TargetPublicInternet, AdditionalPorts: []networkingv1.NetworkPolicyPort{port(corev1.ProtocolTCP, 8443)}, AdditionalCIDRs: []string{"10.250.0.0/16"}},
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(networkPolicy.Spec.Egress).To(HaveLen(2))
+			Expect(networkPolicy.Spec.Egress[0].Ports).To(BeEmpty())
+			Expect(networkPolicy.Spec.Egress[1].Ports).To(BeEmpty())
+		})
+
+		It("should replace the generated egress rules with the ones of the customization", func() {
+			egress := []networkingv1.NetworkPolicyEgressRule{{
+				Ports: []networkingv1.NetworkPolicyPort{port(corev1.ProtocolTCP, 443)},
+				To:    []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "1.2.3.4/32"}}},
+			}}
+
+			networkPolicy, err := NetworkPolicy(namespace, TargetGardenAPIServer, seedNetworks, Customization{Target: TargetGardenAPIServer, Egress: egress})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(networkPolicy.Spec.Egress).To(Equal(egress))
+		})
+	})
+
+	Describe("#ValidateCustomizations", func() {
+		It("should accept valid customizations", func() {
+			Expect(ValidateCustomizations([]Customization{
+				{Target: TargetShootAPIServer, AdditionalPorts: []networkingv1.NetworkPolicyPort{port(corev1.ProtocolTCP, 8443)}, AdditionalCIDRs: []string{"10.250.0.0/16"}},
+				{Target: TargetDNS},
+			})).To(Succeed())
+		})
+
+		It("should reject unknown targets", func() {
+			Expect(ValidateCustomizations([]Customization{{Target: "foo"}})).NotTo(Succeed())
+		})
+
+		It("should reject targets which are customized more than once", func() {
+			Expect(ValidateCustomizations([]Customization{{Target: TargetDNS}, {Target: TargetDNS}})).NotTo(Succeed())
+		})
+
+		It("should reject invalid CIDRs and ports", func() {
+			Expect(ValidateCustomizations([]Customization{{Target: TargetDNS, AdditionalCIDRs: []string{"10.250.0.0"}}})).NotTo(Succeed())
+			Expect(ValidateCustomizations([]Customization{{Target: TargetDNS, AdditionalPorts: []networkingv1.NetworkPolicyPort{{}}}})).NotTo(Succeed())
+		})
+	})
+
+	Describe("#Ensure", func() {
+		It("should create the network policies for all targets", func() {
+			var (
+				ctx = context.TODO()
+				c   = fake.NewFakeClient()
+			)
+
+			Expect(Ensure(ctx, c, namespace, seedNetworks, TargetDNS, TargetGardenAPIServer)).To(Succeed())
+
+			networkPolicy := &networkingv1.NetworkPolicy{}
+			Expect(c.Get(ctx, kutil.Key(namespace, "allow-to-dns"), networkPolicy)).To(Succeed())
+			Expect(c.Get(ctx, kutil.Key(namespace, "allow-to-garden-apiserver"), networkPolicy)).To(Succeed())
+			Expect(networkPolicy.Spec.Egress[0].Ports).To(HaveLen(1))
+		})
+
+		It("should apply the customizations of the seed administrators", func() {
+			var (
+				ctx       = context.TODO()
+				configMap = &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Namespace: CustomizationsNamespace, Name: CustomizationsConfigMapName},
+					Data: map[string]string{CustomizationsDataKey: `- target: shoot-apiserver
+  additionalPorts:
+  - protocol: TCP
+    port: 8443
+`},
+				}
+				c = fake.NewFakeClient(configMap)
+			)
+
+			Expect(Ensure(ctx, c, namespace, seedNetworks, TargetShootAPIServer)).To(Succeed())
+
+			networkPolicy := &networkingv1.NetworkPolicy{}
+			Expect(c.Get(ctx, kutil.Key(namespace, "allow-to-shoot-apiserver"), networkPolicy)).To(Succeed())
+			Expect(networkPolicy.Spec.Egress[0].Ports).To(Equal([]networkingv1.NetworkPolicyPort{port(corev1.ProtocolTCP, 443), port(corev1.ProtocolTCP, 8443)}))
+		})
+
+		It("should fail if the customizations are invalid", func() {
+			var (
+				ctx       = context.TODO()
+				configMap = &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Namespace: CustomizationsNamespace, Name: CustomizationsConfigMapName},
+					Data:       map[string]string{CustomizationsDataKey: "- target: foo\n"},
+				}
+				c = fake.NewFakeClient(configMap)
+			)
+
+			Expect(Ensure(ctx, c, namespace, seedNetworks, TargetShootAPIServer)).NotTo(Succeed())
+		})
+	})
+})
+
+func port(protocol corev1.Protocol, port int) networkingv1.NetworkPolicyPort {
+	p := intstr.FromInt(port)
+	return networkingv1.NetworkPolicyPort{Protocol: &protocol, Port: &p}
+}
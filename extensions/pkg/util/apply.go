@@ -0,0 +1,92 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package util contains helpers for extensions to ship their resources into seed and shoot clusters.
+package util
+
+import (
+	"context"
+	"time"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ManagerPrefix is the prefix of the manager names of extensions.
+	ManagerPrefix = "gardener-extension-"
+	// ManagedByLabel is the label carrying the manager name of an object that was applied by an extension.
+	ManagedByLabel = "extensions.gardener.cloud/managed-by"
+)
+
+// Manager returns the name an extension with the given name uses to identify itself as the manager of the resources
+// it applies.
+func Manager(extensionName string) string {
+	return ManagerPrefix + extensionName
+}
+
+type managedByReader struct {
+	reader  kubernetes.UnstructuredReader
+	manager string
+}
+
+// Read implements kubernetes.UnstructuredReader.
+func (m *managedByReader) Read() (*unstructured.Unstructured, error) {
+	obj, err := m.reader.Read()
+	if err != nil || obj == nil {
+		return obj, err
+	}
+
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels[ManagedByLabel] = m.manager
+	obj.SetLabels(labels)
+	return obj, nil
+}
+
+// ApplyManifest applies the given manifests with the given applier and labels all applied objects with the
+// ManagedByLabel carrying the given manager name. The objects are applied client-side, i.e., the label only records
+// which extension applied an object, it does not detect conflicting changes of other managers.
+func ApplyManifest(ctx context.Context, applier kubernetes.ApplierInterface, manager string, manifest []byte) error {
+	reader := &managedByReader{kubernetes.NewManifestReader(manifest), manager}
+	return applier.ApplyManifest(ctx, reader, kubernetes.DefaultApplierOptions)
+}
+
+// WaitUntil reads the given object every <interval> and checks it with the given function until the check succeeds
+// or the <timeout> is reached. In the latter case, it returns the last error of the check.
+func WaitUntil(ctx context.Context, c client.Client, obj runtime.Object, interval, timeout time.Duration, check func(runtime.Object) error) error {
+	key, err := client.ObjectKeyFromObject(obj)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return utils.RetryUntil(ctx, interval, func() (ok, severe bool, err error) {
+		if err := c.Get(ctx, key, obj); err != nil {
+			return false, false, err
+		}
+		if err := check(obj); err != nil {
+			return false, false, err
+		}
+		return true, false, nil
+	})
+}
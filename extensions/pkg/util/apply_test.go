@@ -0,0 +1,102 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/gardener/gardener/extensions/pkg/util"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type recordingApplier struct {
+	objects []*unstructured.Unstructured
+}
+
+func (r *recordingApplier) ApplyManifest(_ context.Context, reader kubernetes.UnstructuredReader, _ kubernetes.ApplierOptions) error {
+	for obj, err := reader.Read(); err == nil; obj, err = reader.Read() {
+		r.objects = append(r.objects, obj)
+	}
+	return nil
+}
+
+var _ = Describe("Apply", func() {
+	var ctx = context.TODO()
+
+	Describe("#Manager", func() {
+		It("should prefix the extension name", func() {
+			Expect(Manager("os-coreos")).To(Equal("gardener-extension-os-coreos"))
+		})
+	})
+
+	Describe("#ApplyManifest", func() {
+		It("should label all objects with the manager", func() {
+			applier := &recordingApplier{}
+			manifest := []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: foo
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: bar
+  labels:
+    foo: bar
+`)
+
+			Expect(ApplyManifest(ctx, applier, "gardener-extension-foo", manifest)).To(Succeed())
+			Expect(applier.objects).To(HaveLen(2))
+			Expect(applier.objects[0].GetLabels()).To(Equal(map[string]string{ManagedByLabel: "gardener-extension-foo"}))
+			Expect(applier.objects[1].GetLabels()).To(Equal(map[string]string{ManagedByLabel: "gardener-extension-foo", "foo": "bar"}))
+		})
+	})
+
+	Describe("#WaitUntil", func() {
+		var configMap *corev1.ConfigMap
+
+		BeforeEach(func() {
+			configMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "bar"}}
+		})
+
+		It("should succeed once the check succeeds", func() {
+			c := fake.NewFakeClient(configMap.DeepCopy())
+
+			Expect(WaitUntil(ctx, c, configMap, time.Millisecond, time.Second, func(runtime.Object) error { return nil })).To(Succeed())
+		})
+
+		It("should time out with the last error of the check", func() {
+			var (
+				c   = fake.NewFakeClient(configMap.DeepCopy())
+				err = errors.New("not ready")
+			)
+
+			waitErr := WaitUntil(ctx, c, configMap, time.Millisecond, 10*time.Millisecond, func(runtime.Object) error { return err })
+			Expect(utils.IsTimedOut(waitErr)).To(BeTrue())
+			Expect(utils.LastErrorOfTimedOutWithError(waitErr)).To(Equal(err))
+		})
+	})
+})
@@ -0,0 +1,99 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// InjectCABundle configures the given <caBundle> in all webhooks of the given webhook configurations. The webhook
+// configurations are only updated if their CA bundle differs.
+func InjectCABundle(ctx context.Context, c client.Client, caBundle []byte, webhookConfigs ...runtime.Object) error {
+	for _, obj := range webhookConfigs {
+		key, err := client.ObjectKeyFromObject(obj)
+		if err != nil {
+			return err
+		}
+		if err := c.Get(ctx, key, obj); err != nil {
+			return err
+		}
+
+		var changed bool
+		switch config := obj.(type) {
+		case *admissionregistrationv1beta1.MutatingWebhookConfiguration:
+			for i := range config.Webhooks {
+				changed = setCABundle(&config.Webhooks[i].ClientConfig, caBundle) || changed
+			}
+		case *admissionregistrationv1beta1.ValidatingWebhookConfiguration:
+			for i := range config.Webhooks {
+				changed = setCABundle(&config.Webhooks[i].ClientConfig, caBundle) || changed
+			}
+		default:
+			return fmt.Errorf("unsupported webhook configuration type %T", obj)
+		}
+
+		if changed {
+			if err := c.Update(ctx, obj); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func setCABundle(clientConfig *admissionregistrationv1beta1.WebhookClientConfig, caBundle []byte) bool {
+	if bytes.Equal(clientConfig.CABundle, caBundle) {
+		return false
+	}
+	clientConfig.CABundle = caBundle
+	return true
+}
+
+// Run reconciles the certificates described by the <config> until the <ctx> is cancelled. Each time, the CA bundle is
+// injected into the given webhook configurations before the <onCertificates> function is called with the certificates
+// the webhook server must serve. This ensures that the webhook configurations always trust the serving certificate.
+// Errors are reported and retried after the given <retryPeriod>.
+func Run(ctx context.Context, c client.Client, config CertificateConfig, retryPeriod time.Duration, onCertificates func(*Certificates) error, webhookConfigs ...runtime.Object) {
+	for {
+		requeueAfter := retryPeriod
+
+		certificates, err := Reconcile(ctx, c, config)
+		if err == nil {
+			err = InjectCABundle(ctx, c, certificates.CABundle, webhookConfigs...)
+		}
+		if err == nil {
+			err = onCertificates(certificates)
+		}
+		if err != nil {
+			utilruntime.HandleError(fmt.Errorf("could not reconcile webhook certificates in secret %s/%s: %v", config.Namespace, config.Name, err))
+		} else {
+			requeueAfter = certificates.RequeueAfter
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(requeueAfter):
+		}
+	}
+}
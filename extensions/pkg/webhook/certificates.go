@@ -0,0 +1,329 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webhook contains helpers for extensions serving admission webhooks in the seed clusters.
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils/secrets"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DataKeyCABundle is the key in the secret data holding the CA bundle the webhook configurations must trust.
+	DataKeyCABundle = "bundle.crt"
+	// DataKeyOldCertificateCA is the key in the secret data holding the previous CA certificate during a rotation.
+	DataKeyOldCertificateCA = "ca-old.crt"
+
+	// AnnotationCARotationPhase is the annotation of the secret holding the current phase of the CA rotation.
+	AnnotationCARotationPhase = "webhook.extensions.gardener.cloud/ca-rotation-phase"
+	// AnnotationCARotationPhaseStarted is the annotation of the secret holding the time the current phase of the CA
+	// rotation has started.
+	AnnotationCARotationPhaseStarted = "webhook.extensions.gardener.cloud/ca-rotation-phase-started"
+	// AnnotationRotateCA is the annotation operators can put on the secret in order to rotate the CA immediately.
+	AnnotationRotateCA = "webhook.extensions.gardener.cloud/rotate-ca"
+)
+
+// CARotationPhase is the phase of a CA rotation.
+type CARotationPhase string
+
+const (
+	// CARotationPhasePreparing is the phase in which both the old and the new CA are trusted, and the serving
+	// certificate is still signed by the old CA. It lasts until the new CA bundle has been propagated.
+	CARotationPhasePreparing CARotationPhase = "Preparing"
+	// CARotationPhaseFinalizing is the phase in which both the old and the new CA are trusted, and the serving
+	// certificate is signed by the new CA. It lasts until the new serving certificate is used by all webhook servers.
+	CARotationPhaseFinalizing CARotationPhase = "Finalizing"
+)
+
+// Now returns the current time. It can be overwritten in tests.
+var Now = time.Now
+
+// CertificateConfig is the configuration of the CA and the serving certificate of a webhook server.
+type CertificateConfig struct {
+	// Name is the name of the secret holding the CA, the CA bundle, and the serving certificate.
+	Name string
+	// Namespace is the namespace of the secret, i.e., the namespace of the extension in the seed.
+	Namespace string
+	// CommonName is the common name of the serving certificate.
+	CommonName string
+	// DNSNames are the DNS names of the serving certificate, typically the names of the webhook service.
+	DNSNames []string
+	// CAValidity is the validity of the CA (secrets.DefaultCertificateValidity if it is zero).
+	CAValidity time.Duration
+	// ServerValidity is the validity of the serving certificate (secrets.DefaultCertificateValidity if it is zero).
+	ServerValidity time.Duration
+	// RenewalThreshold is the remaining validity below which the CA is rotated and the serving certificate is renewed.
+	RenewalThreshold time.Duration
+	// PropagationPeriod is the duration of each phase of a CA rotation. It must be long enough for all webhook
+	// configurations to be updated and for all webhook servers to load the new serving certificate.
+	PropagationPeriod time.Duration
+}
+
+// Certificates are the CA bundle and the serving certificate of a webhook server.
+type Certificates struct {
+	// CABundle is the bundle of CA certificates which must be configured in the webhook configurations.
+	CABundle []byte
+	// ServerCertificate is the PEM-encoded serving certificate.
+	ServerCertificate []byte
+	// ServerPrivateKey is the PEM-encoded private key of the serving certificate.
+	ServerPrivateKey []byte
+	// Phase is the phase of the CA rotation. It is empty if no rotation is in progress.
+	Phase CARotationPhase
+	// RequeueAfter is the duration after which Reconcile must be called again in order to continue the CA rotation or
+	// to renew the certificates in time.
+	RequeueAfter time.Duration
+}
+
+// Reconcile ensures the CA and the serving certificate of a webhook server in the secret described by the <config>.
+// The CA is rotated without downtime if its validity falls below the renewal threshold or if the secret is annotated
+// with AnnotationRotateCA:
+//
+// 1. A new CA is created and added to the CA bundle, the serving certificate is still signed by the old CA (Preparing).
+// 2. After the propagation period, the serving certificate is signed by the new CA (Finalizing).
+// 3. After another propagation period, the old CA is removed from the CA bundle.
+//
+// Callers must configure the returned CA bundle in the webhook configurations before they serve the returned serving
+// certificate, see Run.
+func Reconcile(ctx context.Context, c client.Client, config CertificateConfig) (*Certificates, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: config.Namespace, Name: config.Name}, secret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+
+		secret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: config.Namespace, Name: config.Name}}
+		if err := generate(config, secret); err != nil {
+			return nil, err
+		}
+		if err := c.Create(ctx, secret); err != nil {
+			return nil, err
+		}
+		return certificates(config, secret)
+	}
+
+	updated := secret.DeepCopy()
+	if err := rotate(config, updated); err != nil {
+		return nil, err
+	}
+	if !apiequality.Semantic.DeepEqual(secret.Data, updated.Data) || !apiequality.Semantic.DeepEqual(secret.Annotations, updated.Annotations) {
+		if err := c.Update(ctx, updated); err != nil {
+			return nil, err
+		}
+	}
+
+	return certificates(config, updated)
+}
+
+// generate creates a new CA and serving certificate in the given <secret>.
+func generate(config CertificateConfig, secret *corev1.Secret) error {
+	ca, err := generateCA(config)
+	if err != nil {
+		return err
+	}
+	server, err := generateServerCertificate(config, ca)
+	if err != nil {
+		return err
+	}
+
+	secret.Data = server.SecretData()
+	secret.Data[secrets.DataKeyPrivateKeyCA] = ca.PrivateKeyPEM
+	secret.Data[DataKeyCABundle] = ca.CertificatePEM
+	return nil
+}
+
+// rotate advances the CA rotation and renews the serving certificate if required.
+func rotate(config CertificateConfig, secret *corev1.Secret) error {
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+
+	ca, err := loadCA(secret.Data[secrets.DataKeyCertificateCA], secret.Data[secrets.DataKeyPrivateKeyCA])
+	if err != nil {
+		return fmt.Errorf("could not load CA from secret %s/%s: %v", secret.Namespace, secret.Name, err)
+	}
+
+	var (
+		now          = Now()
+		phase        = CARotationPhase(secret.Annotations[AnnotationCARotationPhase])
+		phaseStarted time.Time
+	)
+	if value, ok := secret.Annotations[AnnotationCARotationPhaseStarted]; ok {
+		if phaseStarted, err = time.Parse(time.RFC3339, value); err != nil {
+			return fmt.Errorf("could not parse start of CA rotation phase of secret %s/%s: %v", secret.Namespace, secret.Name, err)
+		}
+	}
+	phaseCompleted := !now.Before(phaseStarted.Add(config.PropagationPeriod))
+
+	switch {
+	case phase == CARotationPhasePreparing && phaseCompleted:
+		server, err := generateServerCertificate(config, ca)
+		if err != nil {
+			return err
+		}
+		setServerCertificate(secret, server)
+		setPhase(secret, CARotationPhaseFinalizing, now)
+
+	case phase == CARotationPhaseFinalizing && phaseCompleted:
+		delete(secret.Data, DataKeyOldCertificateCA)
+		secret.Data[DataKeyCABundle] = ca.CertificatePEM
+		setPhase(secret, "", now)
+
+	case len(phase) == 0 && (secret.Annotations[AnnotationRotateCA] == "true" || mustRenew(config, ca.Certificate.NotAfter, now)):
+		newCA, err := generateCA(config)
+		if err != nil {
+			return err
+		}
+		delete(secret.Annotations, AnnotationRotateCA)
+		secret.Data[DataKeyOldCertificateCA] = ca.CertificatePEM
+		secret.Data[secrets.DataKeyCertificateCA] = newCA.CertificatePEM
+		secret.Data[secrets.DataKeyPrivateKeyCA] = newCA.PrivateKeyPEM
+		secret.Data[DataKeyCABundle] = append(append([]byte{}, ca.CertificatePEM...), newCA.CertificatePEM...)
+		setPhase(secret, CARotationPhasePreparing, now)
+
+	case len(phase) == 0:
+		server, err := secrets.LoadCertificate("", secret.Data[secrets.DataKeyPrivateKey], secret.Data[secrets.DataKeyCertificate])
+		if err != nil || mustRenew(config, server.(*secrets.Certificate).Certificate.NotAfter, now) || !sameDNSNames(config.DNSNames, server.(*secrets.Certificate).Certificate.DNSNames) {
+			newServer, err := generateServerCertificate(config, ca)
+			if err != nil {
+				return err
+			}
+			setServerCertificate(secret, newServer)
+		}
+	}
+
+	return nil
+}
+
+func setPhase(secret *corev1.Secret, phase CARotationPhase, now time.Time) {
+	if len(phase) == 0 {
+		delete(secret.Annotations, AnnotationCARotationPhase)
+		delete(secret.Annotations, AnnotationCARotationPhaseStarted)
+		return
+	}
+	secret.Annotations[AnnotationCARotationPhase] = string(phase)
+	secret.Annotations[AnnotationCARotationPhaseStarted] = now.UTC().Format(time.RFC3339)
+}
+
+func setServerCertificate(secret *corev1.Secret, server *secrets.Certificate) {
+	secret.Data[secrets.DataKeyCertificate] = server.CertificatePEM
+	secret.Data[secrets.DataKeyPrivateKey] = server.PrivateKeyPEM
+}
+
+func mustRenew(config CertificateConfig, notAfter, now time.Time) bool {
+	return notAfter.Sub(now) < config.RenewalThreshold
+}
+
+func sameDNSNames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func generateCA(config CertificateConfig) (*secrets.Certificate, error) {
+	ca, err := (&secrets.CertificateSecretConfig{
+		Name:       config.Name,
+		CommonName: config.Name,
+		CertType:   secrets.CACert,
+		Validity:   config.CAValidity,
+	}).Generate()
+	if err != nil {
+		return nil, err
+	}
+	return ca.(*secrets.Certificate), nil
+}
+
+func generateServerCertificate(config CertificateConfig, ca *secrets.Certificate) (*secrets.Certificate, error) {
+	server, err := (&secrets.CertificateSecretConfig{
+		Name:       config.Name,
+		CommonName: config.CommonName,
+		DNSNames:   config.DNSNames,
+		CertType:   secrets.ServerCert,
+		SigningCA:  ca,
+		Validity:   config.ServerValidity,
+	}).Generate()
+	if err != nil {
+		return nil, err
+	}
+	return server.(*secrets.Certificate), nil
+}
+
+func loadCA(certificatePEM, privateKeyPEM []byte) (*secrets.Certificate, error) {
+	ca, err := secrets.LoadCertificate("", privateKeyPEM, certificatePEM)
+	if err != nil {
+		return nil, err
+	}
+	return ca.(*secrets.Certificate), nil
+}
+
+// certificates returns the Certificates stored in the given <secret>.
+func certificates(config CertificateConfig, secret *corev1.Secret) (*Certificates, error) {
+	ca, err := loadCA(secret.Data[secrets.DataKeyCertificateCA], secret.Data[secrets.DataKeyPrivateKeyCA])
+	if err != nil {
+		return nil, err
+	}
+	server, err := secrets.LoadCertificate("", secret.Data[secrets.DataKeyPrivateKey], secret.Data[secrets.DataKeyCertificate])
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		now   = Now()
+		phase = CARotationPhase(secret.Annotations[AnnotationCARotationPhase])
+		// The certificates are renewed once their remaining validity falls below the threshold.
+		requeueAfter = minDuration(
+			ca.Certificate.NotAfter.Add(-config.RenewalThreshold).Sub(now),
+			server.(*secrets.Certificate).Certificate.NotAfter.Add(-config.RenewalThreshold).Sub(now),
+		)
+	)
+	if len(phase) > 0 {
+		requeueAfter = config.PropagationPeriod
+		if started, err := time.Parse(time.RFC3339, secret.Annotations[AnnotationCARotationPhaseStarted]); err == nil {
+			requeueAfter = started.Add(config.PropagationPeriod).Sub(now)
+		}
+	}
+	if requeueAfter < 0 {
+		requeueAfter = 0
+	}
+
+	return &Certificates{
+		CABundle:          secret.Data[DataKeyCABundle],
+		ServerCertificate: secret.Data[secrets.DataKeyCertificate],
+		ServerPrivateKey:  secret.Data[secrets.DataKeyPrivateKey],
+		Phase:             phase,
+		RequeueAfter:      requeueAfter,
+	}, nil
+}
+
+func minDuration(a, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/gardener/gardener/extensions/pkg/webhook"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Certificates", func() {
+	var (
+		ctx = context.TODO()
+
+		c      client.Client
+		now    time.Time
+		config CertificateConfig
+	)
+
+	BeforeEach(func() {
+		c = fake.NewFakeClient()
+		now = time.Now().Truncate(time.Second)
+		Now = func() time.Time { return now }
+
+		config = CertificateConfig{
+			Name:              "webhook-certs",
+			Namespace:         "extension-foo",
+			CommonName:        "gardener-extension-foo",
+			DNSNames:          []string{"gardener-extension-foo", "gardener-extension-foo.extension-foo.svc"},
+			CAValidity:        30 * 24 * time.Hour,
+			ServerValidity:    30 * 24 * time.Hour,
+			RenewalThreshold:  7 * 24 * time.Hour,
+			PropagationPeriod: time.Hour,
+		}
+	})
+
+	AfterEach(func() {
+		Now = time.Now
+	})
+
+	getSecret := func() *corev1.Secret {
+		secret := &corev1.Secret{}
+		ExpectWithOffset(1, c.Get(ctx, client.ObjectKey{Namespace: config.Namespace, Name: config.Name}, secret)).To(Succeed())
+		return secret
+	}
+
+	expectSignedBy := func(certificates *Certificates, caPEM []byte) {
+		server, err := utils.DecodeCertificate(certificates.ServerCertificate)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		ca, err := utils.DecodeCertificate(caPEM)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		ExpectWithOffset(1, server.CheckSignatureFrom(ca)).To(Succeed())
+	}
+
+	Describe("#Reconcile", func() {
+		It("should generate the CA and the serving certificate", func() {
+			certificates, err := Reconcile(ctx, c, config)
+			Expect(err).NotTo(HaveOccurred())
+
+			secret := getSecret()
+			Expect(certificates.CABundle).To(Equal(secret.Data[secrets.DataKeyCertificateCA]))
+			Expect(certificates.Phase).To(BeEmpty())
+			Expect(certificates.RequeueAfter).To(BeNumerically("~", 23*24*time.Hour, time.Minute))
+			expectSignedBy(certificates, secret.Data[secrets.DataKeyCertificateCA])
+
+			server, err := utils.DecodeCertificate(certificates.ServerCertificate)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(server.DNSNames).To(Equal(config.DNSNames))
+		})
+
+		It("should not change the certificates if they are still valid", func() {
+			first, err := Reconcile(ctx, c, config)
+			Expect(err).NotTo(HaveOccurred())
+
+			second, err := Reconcile(ctx, c, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(second.CABundle).To(Equal(first.CABundle))
+			Expect(second.ServerCertificate).To(Equal(first.ServerCertificate))
+		})
+
+		It("should renew the serving certificate if the DNS names change", func() {
+			first, err := Reconcile(ctx, c, config)
+			Expect(err).NotTo(HaveOccurred())
+
+			config.DNSNames = append(config.DNSNames, "gardener-extension-foo.extension-foo.svc.cluster.local")
+			second, err := Reconcile(ctx, c, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(second.CABundle).To(Equal(first.CABundle))
+			Expect(second.ServerCertificate).NotTo(Equal(first.ServerCertificate))
+			expectSignedBy(second, first.CABundle)
+		})
+
+		It("should rotate the CA with a dual-CA phase if it is triggered", func() {
+			initial, err := Reconcile(ctx, c, config)
+			Expect(err).NotTo(HaveOccurred())
+			oldCA := initial.CABundle
+
+			secret := getSecret()
+			metav1.SetMetaDataAnnotation(&secret.ObjectMeta, AnnotationRotateCA, "true")
+			Expect(c.Update(ctx, secret)).To(Succeed())
+
+			By("preparing")
+			preparing, err := Reconcile(ctx, c, config)
+			Expect(err).NotTo(HaveOccurred())
+			newCA := getSecret().Data[secrets.DataKeyCertificateCA]
+			Expect(newCA).NotTo(Equal(oldCA))
+			Expect(preparing.Phase).To(Equal(CARotationPhasePreparing))
+			Expect(preparing.CABundle).To(Equal(append(append([]byte{}, oldCA...), newCA...)))
+			Expect(preparing.ServerCertificate).To(Equal(initial.ServerCertificate))
+			Expect(preparing.RequeueAfter).To(Equal(config.PropagationPeriod))
+			Expect(getSecret().Annotations).NotTo(HaveKey(AnnotationRotateCA))
+
+			By("waiting for the propagation of the CA bundle")
+			now = now.Add(30 * time.Minute)
+			waiting, err := Reconcile(ctx, c, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(waiting.Phase).To(Equal(CARotationPhasePreparing))
+			Expect(waiting.RequeueAfter).To(Equal(30 * time.Minute))
+
+			By("finalizing")
+			now = now.Add(30 * time.Minute)
+			finalizing, err := Reconcile(ctx, c, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(finalizing.Phase).To(Equal(CARotationPhaseFinalizing))
+			Expect(finalizing.CABundle).To(Equal(preparing.CABundle))
+			expectSignedBy(finalizing, newCA)
+
+			By("completing")
+			now = now.Add(time.Hour)
+			completed, err := Reconcile(ctx, c, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(completed.Phase).To(BeEmpty())
+			Expect(completed.CABundle).To(Equal(newCA))
+			Expect(completed.ServerCertificate).To(Equal(finalizing.ServerCertificate))
+			Expect(getSecret().Data).NotTo(HaveKey(DataKeyOldCertificateCA))
+		})
+
+		It("should rotate the CA if it is about to expire", func() {
+			_, err := Reconcile(ctx, c, config)
+			Expect(err).NotTo(HaveOccurred())
+
+			now = now.Add(24 * 24 * time.Hour)
+			certificates, err := Reconcile(ctx, c, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(certificates.Phase).To(Equal(CARotationPhasePreparing))
+		})
+	})
+
+	Describe("#InjectCABundle", func() {
+		It("should inject the CA bundle into all webhooks", func() {
+			var (
+				mutating = &admissionregistrationv1beta1.MutatingWebhookConfiguration{
+					ObjectMeta: metav1.ObjectMeta{Name: "gardener-extension-foo"},
+					Webhooks:   []admissionregistrationv1beta1.Webhook{{Name: "a"}, {Name: "b"}},
+				}
+				validating = &admissionregistrationv1beta1.ValidatingWebhookConfiguration{
+					ObjectMeta: metav1.ObjectMeta{Name: "gardener-extension-foo"},
+					Webhooks:   []admissionregistrationv1beta1.Webhook{{Name: "c"}},
+				}
+				caBundle = []byte("bundle")
+			)
+			c = fake.NewFakeClient(mutating.DeepCopy(), validating.DeepCopy())
+
+			Expect(InjectCABundle(ctx, c, caBundle, mutating, validating)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKey{Name: mutating.Name}, mutating)).To(Succeed())
+			Expect(mutating.Webhooks[0].ClientConfig.CABundle).To(Equal(caBundle))
+			Expect(mutating.Webhooks[1].ClientConfig.CABundle).To(Equal(caBundle))
+			Expect(c.Get(ctx, client.ObjectKey{Name: validating.Name}, validating)).To(Succeed())
+			Expect(validating.Webhooks[0].ClientConfig.CABundle).To(Equal(caBundle))
+		})
+
+		It("should fail for unsupported objects", func() {
+			secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "bar"}}
+			c = fake.NewFakeClient(secret.DeepCopy())
+
+			Expect(InjectCABundle(ctx, c, []byte("bundle"), secret)).NotTo(Succeed())
+		})
+	})
+})
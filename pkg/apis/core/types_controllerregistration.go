@@ -66,4 +66,16 @@ type ControllerDeployment struct {
 	Type string
 	// ProviderConfig contains type-specific configuration.
 	ProviderConfig *ProviderConfig
+	// Strategy configures how changes of this registration are rolled out to the seeds.
+	Strategy *ControllerDeploymentStrategy
+}
+
+// ControllerDeploymentStrategy configures how changes of a ControllerRegistration are rolled out to the seeds.
+type ControllerDeploymentStrategy struct {
+	// MaxUnavailable is the maximum number of seeds to which a change is rolled out at the same time. A seed counts as
+	// unavailable until the change has been installed successfully to it.
+	MaxUnavailable *int32
+	// OrderLabel is the key of a label of the seeds whose values determine the order in which the change is rolled
+	// out to them.
+	OrderLabel *string
 }
@@ -69,4 +69,21 @@ type ControllerDeployment struct {
 	// ProviderConfig contains type-specific configuration.
 	// +optional
 	ProviderConfig *ProviderConfig `json:"providerConfig,omitempty"`
+	// Strategy configures how changes of this registration are rolled out to the seeds. If not set, they are rolled
+	// out to all seeds at once.
+	// +optional
+	Strategy *ControllerDeploymentStrategy `json:"strategy,omitempty"`
+}
+
+// ControllerDeploymentStrategy configures how changes of a ControllerRegistration are rolled out to the seeds.
+type ControllerDeploymentStrategy struct {
+	// MaxUnavailable is the maximum number of seeds to which a change is rolled out at the same time. A seed counts as
+	// unavailable until the change has been installed successfully to it. If not set, changes are rolled out to all
+	// seeds at once.
+	// +optional
+	MaxUnavailable *int32 `json:"maxUnavailable,omitempty"`
+	// OrderLabel is the key of a label of the seeds whose values determine the order in which the change is rolled
+	// out to them (ascending, seeds without the label come last). Seeds with the same value are ordered by name.
+	// +optional
+	OrderLabel *string `json:"orderLabel,omitempty"`
 }
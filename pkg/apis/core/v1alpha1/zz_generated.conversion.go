@@ -40,6 +40,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControllerDeploymentStrategy)(nil), (*core.ControllerDeploymentStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ControllerDeploymentStrategy_To_core_ControllerDeploymentStrategy(a.(*ControllerDeploymentStrategy), b.(*core.ControllerDeploymentStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControllerDeploymentStrategy)(nil), (*ControllerDeploymentStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControllerDeploymentStrategy_To_v1alpha1_ControllerDeploymentStrategy(a.(*core.ControllerDeploymentStrategy), b.(*ControllerDeploymentStrategy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerInstallation)(nil), (*core.ControllerInstallation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControllerInstallation_To_core_ControllerInstallation(a.(*ControllerInstallation), b.(*core.ControllerInstallation), scope)
 	}); err != nil {
@@ -166,6 +176,7 @@ func Convert_core_Condition_To_v1alpha1_Condition(in *core.Condition, out *Condi
 func autoConvert_v1alpha1_ControllerDeployment_To_core_ControllerDeployment(in *ControllerDeployment, out *core.ControllerDeployment, s conversion.Scope) error {
 	out.Type = in.Type
 	out.ProviderConfig = (*core.ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
+	out.Strategy = (*core.ControllerDeploymentStrategy)(unsafe.Pointer(in.Strategy))
 	return nil
 }
 
@@ -177,6 +188,7 @@ func Convert_v1alpha1_ControllerDeployment_To_core_ControllerDeployment(in *Cont
 func autoConvert_core_ControllerDeployment_To_v1alpha1_ControllerDeployment(in *core.ControllerDeployment, out *ControllerDeployment, s conversion.Scope) error {
 	out.Type = in.Type
 	out.ProviderConfig = (*ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
+	out.Strategy = (*ControllerDeploymentStrategy)(unsafe.Pointer(in.Strategy))
 	return nil
 }
 
@@ -185,6 +197,28 @@ func Convert_core_ControllerDeployment_To_v1alpha1_ControllerDeployment(in *core
 	return autoConvert_core_ControllerDeployment_To_v1alpha1_ControllerDeployment(in, out, s)
 }
 
+func autoConvert_v1alpha1_ControllerDeploymentStrategy_To_core_ControllerDeploymentStrategy(in *ControllerDeploymentStrategy, out *core.ControllerDeploymentStrategy, s conversion.Scope) error {
+	out.MaxUnavailable = (*int32)(unsafe.Pointer(in.MaxUnavailable))
+	out.OrderLabel = (*string)(unsafe.Pointer(in.OrderLabel))
+	return nil
+}
+
+// Convert_v1alpha1_ControllerDeploymentStrategy_To_core_ControllerDeploymentStrategy is an autogenerated conversion function.
+func Convert_v1alpha1_ControllerDeploymentStrategy_To_core_ControllerDeploymentStrategy(in *ControllerDeploymentStrategy, out *core.ControllerDeploymentStrategy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControllerDeploymentStrategy_To_core_ControllerDeploymentStrategy(in, out, s)
+}
+
+func autoConvert_core_ControllerDeploymentStrategy_To_v1alpha1_ControllerDeploymentStrategy(in *core.ControllerDeploymentStrategy, out *ControllerDeploymentStrategy, s conversion.Scope) error {
+	out.MaxUnavailable = (*int32)(unsafe.Pointer(in.MaxUnavailable))
+	out.OrderLabel = (*string)(unsafe.Pointer(in.OrderLabel))
+	return nil
+}
+
+// Convert_core_ControllerDeploymentStrategy_To_v1alpha1_ControllerDeploymentStrategy is an autogenerated conversion function.
+func Convert_core_ControllerDeploymentStrategy_To_v1alpha1_ControllerDeploymentStrategy(in *core.ControllerDeploymentStrategy, out *ControllerDeploymentStrategy, s conversion.Scope) error {
+	return autoConvert_core_ControllerDeploymentStrategy_To_v1alpha1_ControllerDeploymentStrategy(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControllerInstallation_To_core_ControllerInstallation(in *ControllerInstallation, out *core.ControllerInstallation, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_ControllerInstallationSpec_To_core_ControllerInstallationSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -20,6 +20,7 @@ import (
 	"github.com/gardener/gardener/pkg/apis/core"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -58,6 +59,23 @@ func ValidateControllerRegistrationSpec(spec *core.ControllerRegistrationSpec, f
 		resources[resource.Kind] = resource.Type
 	}
 
+	if spec.Deployment != nil && spec.Deployment.Strategy != nil {
+		allErrs = append(allErrs, validateControllerDeploymentStrategy(spec.Deployment.Strategy, fldPath.Child("deployment", "strategy"))...)
+	}
+
+	return allErrs
+}
+
+func validateControllerDeploymentStrategy(strategy *core.ControllerDeploymentStrategy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if strategy.MaxUnavailable != nil && *strategy.MaxUnavailable < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), *strategy.MaxUnavailable, "must be at least 1"))
+	}
+	if strategy.OrderLabel != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelName(*strategy.OrderLabel, fldPath.Child("orderLabel"))...)
+	}
+
 	return allErrs
 }
 
@@ -89,6 +89,51 @@ var _ = Describe("validation", func() {
 
 			Expect(errorList).To(BeEmpty())
 		})
+
+		It("should allow a valid deployment strategy", func() {
+			var (
+				maxUnavailable int32 = 2
+				orderLabel           = "seed.gardener.cloud/rollout-wave"
+			)
+			controllerRegistration.Spec.Deployment = &core.ControllerDeployment{
+				Type: "helm",
+				Strategy: &core.ControllerDeploymentStrategy{
+					MaxUnavailable: &maxUnavailable,
+					OrderLabel:     &orderLabel,
+				},
+			}
+
+			errorList := ValidateControllerRegistration(controllerRegistration)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid an invalid deployment strategy", func() {
+			var (
+				maxUnavailable int32 = 0
+				orderLabel           = "-invalid"
+			)
+			controllerRegistration.Spec.Deployment = &core.ControllerDeployment{
+				Type: "helm",
+				Strategy: &core.ControllerDeploymentStrategy{
+					MaxUnavailable: &maxUnavailable,
+					OrderLabel:     &orderLabel,
+				},
+			}
+
+			errorList := ValidateControllerRegistration(controllerRegistration)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.deployment.strategy.maxUnavailable"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.deployment.strategy.orderLabel"),
+				})),
+			))
+		})
 	})
 
 	Describe("#ValidateControllerRegistrationUpdate", func() {
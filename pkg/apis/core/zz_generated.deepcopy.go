@@ -50,6 +50,11 @@ func (in *ControllerDeployment) DeepCopyInto(out *ControllerDeployment) {
 		*out = new(ProviderConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(ControllerDeploymentStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -63,6 +68,32 @@ func (in *ControllerDeployment) DeepCopy() *ControllerDeployment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerDeploymentStrategy) DeepCopyInto(out *ControllerDeploymentStrategy) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(int32)
+		**out = **in
+	}
+	if in.OrderLabel != nil {
+		in, out := &in.OrderLabel, &out.OrderLabel
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerDeploymentStrategy.
+func (in *ControllerDeploymentStrategy) DeepCopy() *ControllerDeploymentStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerDeploymentStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerInstallation) DeepCopyInto(out *ControllerInstallation) {
 	*out = *in
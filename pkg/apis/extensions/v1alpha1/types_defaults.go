@@ -15,9 +15,36 @@
 package v1alpha1
 
 import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	// OperationAnnotation is an annotation on extension resources indicating that an operation shall be performed.
+	OperationAnnotation = "gardener.cloud/operation"
+	// OperationForceDelete is a value for the OperationAnnotation indicating that the extension resource shall be
+	// deleted forcefully, i.e., extension controllers must not clean up the resources they created on the cloud
+	// provider level but only release the extension resource itself.
+	OperationForceDelete = "force-delete"
+	// OperationMigrate is a value for the OperationAnnotation indicating that the extension resource is about to be
+	// moved to another seed cluster. Extension controllers must persist the state of the resource and must not
+	// perform any calls to the cloud provider.
+	OperationMigrate = "migrate"
+	// OperationRestore is a value for the OperationAnnotation indicating that the extension resource has been moved
+	// to another seed cluster. Extension controllers must restore the previously persisted state before reconciling.
+	OperationRestore = "restore"
+	// OperationReconcile is a value for the OperationAnnotation indicating that the extension resource shall be
+	// reconciled again although its specification did not change. Extension controllers must remove the annotation
+	// once they have started the reconciliation.
+	OperationReconcile = "reconcile"
+
+	// CredentialsChecksumAnnotation is an annotation on extension resources containing a checksum of the credentials
+	// Gardener provides to extensions (e.g., the CA of the shoot and the access tokens for the shoot and the cloud
+	// provider). It changes whenever one of the credentials is rotated so that extensions can reload their clients.
+	CredentialsChecksumAnnotation = "extensions.gardener.cloud/credentials-checksum"
+)
+
 // ErrorCode is a string alias.
 type ErrorCode string
 
@@ -49,6 +76,10 @@ const (
 	LastOperationTypeReconcile LastOperationType = "Reconcile"
 	// LastOperationTypeDelete indicates a 'delete' operation.
 	LastOperationTypeDelete LastOperationType = "Delete"
+	// LastOperationTypeMigrate indicates a 'migrate' operation.
+	LastOperationTypeMigrate LastOperationType = "Migrate"
+	// LastOperationTypeRestore indicates a 'restore' operation.
+	LastOperationTypeRestore LastOperationType = "Restore"
 )
 
 // LastOperationState is a string alias.
@@ -84,10 +115,27 @@ type LastOperation struct {
 	Type LastOperationType `json:"type"`
 }
 
+// ExtensionClass is a string alias.
+type ExtensionClass string
+
+const (
+	// ExtensionClassGarden indicates that the extension resource belongs to the garden cluster.
+	ExtensionClassGarden ExtensionClass = "garden"
+	// ExtensionClassSeed indicates that the extension resource belongs to the seed cluster.
+	ExtensionClassSeed ExtensionClass = "seed"
+	// ExtensionClassShoot indicates that the extension resource belongs to a shoot cluster.
+	ExtensionClassShoot ExtensionClass = "shoot"
+)
+
 // DefaultSpec contains common status fields for every extension resource.
 type DefaultSpec struct {
 	// Type contains the instance of the resource's kind.
 	Type string `json:"type"`
+	// Class holds the extension class used to control the responsibility for multiple controllers of the same
+	// kind and type, e.g. one handling resources of the garden cluster and another one handling resources of
+	// shoot clusters. If not set then the resource is treated as if it had the "shoot" class.
+	// +optional
+	Class *ExtensionClass `json:"class,omitempty"`
 }
 
 // DefaultStatus contains common status fields for every extension resource.
@@ -102,4 +150,8 @@ type DefaultStatus struct {
 	// LastError holds information about the last occurred error during an operation.
 	// +optional
 	LastError *LastError `json:"lastError,omitempty"`
+	// Conditions represents the latest available observations of the resource's current state, e.g. the
+	// results of the health checks of the responsible extension controller.
+	// +optional
+	Conditions []gardencorev1alpha1.Condition `json:"conditions,omitempty"`
 }
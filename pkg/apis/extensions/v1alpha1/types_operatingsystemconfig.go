@@ -34,6 +34,18 @@ type OperatingSystemConfig struct {
 	Status OperatingSystemConfigStatus `json:"status"`
 }
 
+var _ Object = (*OperatingSystemConfig)(nil)
+
+// GetExtensionSpec implements Object.
+func (o *OperatingSystemConfig) GetExtensionSpec() *DefaultSpec {
+	return &o.Spec.DefaultSpec
+}
+
+// GetExtensionStatus implements Object.
+func (o *OperatingSystemConfig) GetExtensionStatus() *DefaultStatus {
+	return &o.Status.DefaultStatus
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // OperatingSystemConfigList is a list of OperatingSystemConfig resources
@@ -68,8 +80,39 @@ type OperatingSystemConfigSpec struct {
 	// Files is a list of files that should get written to the host's file system.
 	// +optional
 	Files []File `json:"files,omitempty"`
+	// NodeBootstrap describes how the kubelet obtains its initial credentials for joining the shoot cluster.
+	// If not set, a bootstrap token is used.
+	// +optional
+	NodeBootstrap *NodeBootstrap `json:"nodeBootstrap,omitempty"`
 }
 
+// NodeBootstrap describes how the kubelet obtains its initial credentials for joining the shoot cluster.
+type NodeBootstrap struct {
+	// Mechanism is the mechanism used for obtaining the initial kubelet credentials.
+	Mechanism NodeBootstrapMechanism `json:"mechanism"`
+	// SecretRef is a reference to a secret in the same namespace containing the credentials required by
+	// the mechanism, e.g. the pre-shared machine certificate and key in the `tls.crt` and `tls.key` data
+	// keys for the MachineCertificate mechanism.
+	// +optional
+	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
+}
+
+// NodeBootstrapMechanism is a string alias.
+type NodeBootstrapMechanism string
+
+const (
+	// NodeBootstrapMechanismBootstrapToken describes that the kubelet uses a bootstrap token that is part of
+	// the user-data.
+	NodeBootstrapMechanismBootstrapToken NodeBootstrapMechanism = "BootstrapToken"
+	// NodeBootstrapMechanismProviderIdentity describes that the kubelet authenticates with an identity issued
+	// by the infrastructure provider to the machine (e.g. an instance identity document). The extension
+	// controller must not write any credentials into the user-data.
+	NodeBootstrapMechanismProviderIdentity NodeBootstrapMechanism = "ProviderIdentity"
+	// NodeBootstrapMechanismMachineCertificate describes that the kubelet authenticates with a pre-shared
+	// machine certificate referenced by the secret in .spec.nodeBootstrap.secretRef.
+	NodeBootstrapMechanismMachineCertificate NodeBootstrapMechanism = "MachineCertificate"
+)
+
 // Unit is a unit for the operating system configuration (usually, a systemd unit).
 type Unit struct {
 	// Name is the name of a unit.
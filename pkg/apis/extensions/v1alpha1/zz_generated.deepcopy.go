@@ -21,6 +21,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -44,6 +46,11 @@ func (in *CloudConfig) DeepCopy() *CloudConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DefaultSpec) DeepCopyInto(out *DefaultSpec) {
 	*out = *in
+	if in.Class != nil {
+		in, out := &in.Class, &out.Class
+		*out = new(ExtensionClass)
+		**out = **in
+	}
 	return
 }
 
@@ -70,6 +77,13 @@ func (in *DefaultStatus) DeepCopyInto(out *DefaultStatus) {
 		*out = new(LastError)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -217,6 +231,27 @@ func (in *LastOperation) DeepCopy() *LastOperation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeBootstrap) DeepCopyInto(out *NodeBootstrap) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeBootstrap.
+func (in *NodeBootstrap) DeepCopy() *NodeBootstrap {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeBootstrap)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperatingSystemConfig) DeepCopyInto(out *OperatingSystemConfig) {
 	*out = *in
@@ -281,7 +316,7 @@ func (in *OperatingSystemConfigList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperatingSystemConfigSpec) DeepCopyInto(out *OperatingSystemConfigSpec) {
 	*out = *in
-	out.DefaultSpec = in.DefaultSpec
+	in.DefaultSpec.DeepCopyInto(&out.DefaultSpec)
 	if in.ReloadConfigFilePath != nil {
 		in, out := &in.ReloadConfigFilePath, &out.ReloadConfigFilePath
 		*out = new(string)
@@ -301,6 +336,11 @@ func (in *OperatingSystemConfigSpec) DeepCopyInto(out *OperatingSystemConfigSpec
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NodeBootstrap != nil {
+		in, out := &in.NodeBootstrap, &out.NodeBootstrap
+		*out = new(NodeBootstrap)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
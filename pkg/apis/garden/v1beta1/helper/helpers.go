@@ -21,7 +21,9 @@ import (
 	"strings"
 
 	"strconv"
+	"time"
 
+	"github.com/Masterminds/semver"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/utils"
@@ -150,6 +152,22 @@ func GetShootCloudProviderWorkers(cloudProvider gardenv1beta1.CloudProvider, sho
 	return workers
 }
 
+// GetShootZones returns the availability zones used by the shoot, however, it requires the cloudprovider as input. It
+// returns nil for cloud providers which do not support zones.
+func GetShootZones(cloudProvider gardenv1beta1.CloudProvider, shoot *gardenv1beta1.Shoot) []string {
+	switch cloudProvider {
+	case gardenv1beta1.CloudProviderAWS:
+		return shoot.Spec.Cloud.AWS.Zones
+	case gardenv1beta1.CloudProviderGCP:
+		return shoot.Spec.Cloud.GCP.Zones
+	case gardenv1beta1.CloudProviderOpenStack:
+		return shoot.Spec.Cloud.OpenStack.Zones
+	case gardenv1beta1.CloudProviderAlicloud:
+		return shoot.Spec.Cloud.Alicloud.Zones
+	}
+	return nil
+}
+
 // GetMachineImageNameFromShoot returns the machine image name used in a shoot manifest, however, it requires the cloudprovider as input.
 func GetMachineImageNameFromShoot(cloudProvider gardenv1beta1.CloudProvider, shoot *gardenv1beta1.Shoot) gardenv1beta1.MachineImageName {
 	switch cloudProvider {
@@ -315,6 +333,42 @@ func GetCondition(conditions []gardenv1beta1.Condition, conditionType gardenv1be
 	return nil
 }
 
+// GetCredentialsRotation returns the rotation of the given class of <credentials> out of the list of <rotations>.
+// In case the rotation could not be found, it returns nil.
+func GetCredentialsRotation(rotations []gardenv1beta1.CredentialsRotation, credentials string) *gardenv1beta1.CredentialsRotation {
+	for _, rotation := range rotations {
+		if rotation.Credentials == credentials {
+			r := rotation
+			return &r
+		}
+	}
+	return nil
+}
+
+// SetCredentialsRotation replaces the rotation of the same class of credentials as <rotation> in the list of
+// <rotations>, or appends it if there is none yet.
+func SetCredentialsRotation(rotations []gardenv1beta1.CredentialsRotation, rotation gardenv1beta1.CredentialsRotation) []gardenv1beta1.CredentialsRotation {
+	for i := range rotations {
+		if rotations[i].Credentials == rotation.Credentials {
+			rotations[i] = rotation
+			return rotations
+		}
+	}
+	return append(rotations, rotation)
+}
+
+// SetCondition replaces the condition of the same type as <condition> in the list of <conditions>, or appends it if
+// there is none yet.
+func SetCondition(conditions []gardenv1beta1.Condition, condition gardenv1beta1.Condition) []gardenv1beta1.Condition {
+	for i := range conditions {
+		if conditions[i].Type == condition.Type {
+			conditions[i] = condition
+			return conditions
+		}
+	}
+	return append(conditions, condition)
+}
+
 // ConditionsNeedUpdate returns true if the <existingConditions> must be updated based on <newConditions>.
 func ConditionsNeedUpdate(existingConditions, newConditions []gardenv1beta1.Condition) bool {
 	return existingConditions == nil || !apiequality.Semantic.DeepEqual(newConditions, existingConditions)
@@ -405,49 +459,136 @@ func UpdateMachineImage(cloudProvider gardenv1beta1.CloudProvider, machineImage
 	return nil
 }
 
+// GetMachineImageFromShoot returns the machine image used in a shoot manifest, however, it requires the cloudprovider as input.
+func GetMachineImageFromShoot(cloudProvider gardenv1beta1.CloudProvider, shoot *gardenv1beta1.Shoot) interface{} {
+	switch cloudProvider {
+	case gardenv1beta1.CloudProviderAWS:
+		return shoot.Spec.Cloud.AWS.MachineImage
+	case gardenv1beta1.CloudProviderAzure:
+		return shoot.Spec.Cloud.Azure.MachineImage
+	case gardenv1beta1.CloudProviderGCP:
+		return shoot.Spec.Cloud.GCP.MachineImage
+	case gardenv1beta1.CloudProviderOpenStack:
+		return shoot.Spec.Cloud.OpenStack.MachineImage
+	case gardenv1beta1.CloudProviderAlicloud:
+		return shoot.Spec.Cloud.Alicloud.MachineImage
+	}
+	return nil
+}
+
+// GetMachineImageID returns the technical identifier of the given <machineImage>, i.e., the AMI (AWS), the version
+// (Azure), the image (GCP and OpenStack), or the ID (Alicloud). It returns an empty string for unknown images.
+func GetMachineImageID(machineImage interface{}) string {
+	switch image := machineImage.(type) {
+	case *gardenv1beta1.AWSMachineImage:
+		if image != nil {
+			return image.AMI
+		}
+	case *gardenv1beta1.AzureMachineImage:
+		if image != nil {
+			return image.Version
+		}
+	case *gardenv1beta1.GCPMachineImage:
+		if image != nil {
+			return image.Image
+		}
+	case *gardenv1beta1.OpenStackMachineImage:
+		if image != nil {
+			return image.Image
+		}
+	case *gardenv1beta1.AlicloudMachineImage:
+		if image != nil {
+			return image.ID
+		}
+	}
+	return ""
+}
+
+// GetMachineImageVulnerabilities returns the vulnerabilities reported in the status of the <cloudProfile> for the
+// machine image with the given <name> and technical <id>.
+func GetMachineImageVulnerabilities(cloudProfile gardenv1beta1.CloudProfile, name gardenv1beta1.MachineImageName, id string) []gardenv1beta1.MachineImageVulnerability {
+	var vulnerabilities []gardenv1beta1.MachineImageVulnerability
+
+	for _, image := range cloudProfile.Status.MachineImages {
+		if machineImageToString(image.Name) != machineImageToString(name) {
+			continue
+		}
+		if len(image.Image) == 0 || image.Image == id {
+			vulnerabilities = append(vulnerabilities, image.Vulnerabilities...)
+		}
+	}
+
+	return vulnerabilities
+}
+
+var vulnerabilitySeverityRanks = map[gardenv1beta1.VulnerabilitySeverity]int{
+	gardenv1beta1.VulnerabilitySeverityLow:      1,
+	gardenv1beta1.VulnerabilitySeverityMedium:   2,
+	gardenv1beta1.VulnerabilitySeverityHigh:     3,
+	gardenv1beta1.VulnerabilitySeverityCritical: 4,
+}
+
+// HasVulnerabilityWithSeverity returns true if at least one of the given <vulnerabilities> has a severity which is
+// equal to or higher than the given <severity>.
+func HasVulnerabilityWithSeverity(vulnerabilities []gardenv1beta1.MachineImageVulnerability, severity gardenv1beta1.VulnerabilitySeverity) bool {
+	minimum, ok := vulnerabilitySeverityRanks[severity]
+	if !ok {
+		return false
+	}
+
+	for _, vulnerability := range vulnerabilities {
+		if vulnerabilitySeverityRanks[vulnerability.Severity] >= minimum {
+			return true
+		}
+	}
+	return false
+}
+
 func machineImageToString(name gardenv1beta1.MachineImageName) string {
 	return strings.ToLower(string(name))
 }
 
-// DetermineLatestKubernetesVersion finds the latest Kubernetes patch version in the <cloudProfile> compared
-// to the given <currentVersion>. In case it does not find a newer patch version, it returns false. Otherwise,
-// true and the found version will be returned.
-func DetermineLatestKubernetesVersion(cloudProfile gardenv1beta1.CloudProfile, currentVersion string) (bool, string, error) {
-	cloudProvider, err := DetermineCloudProviderInProfile(cloudProfile.Spec)
+// GetKubernetesVersionsFromCloudProfile returns the Kubernetes versions offered in the <cloudProfile>.
+func GetKubernetesVersionsFromCloudProfile(cloudProfile gardenv1beta1.CloudProfile) ([]string, error) {
+	constraints, err := GetKubernetesConstraintsFromCloudProfile(cloudProfile)
 	if err != nil {
-		return false, "", err
+		return nil, err
 	}
+	return constraints.Versions, nil
+}
 
-	var (
-		versions      = []string{}
-		newerVersions = []string{}
-	)
+// GetKubernetesConstraintsFromCloudProfile returns the Kubernetes constraints of the given <cloudProfile>.
+func GetKubernetesConstraintsFromCloudProfile(cloudProfile gardenv1beta1.CloudProfile) (gardenv1beta1.KubernetesConstraints, error) {
+	cloudProvider, err := DetermineCloudProviderInProfile(cloudProfile.Spec)
+	if err != nil {
+		return gardenv1beta1.KubernetesConstraints{}, err
+	}
 
 	switch cloudProvider {
 	case gardenv1beta1.CloudProviderAWS:
-		for _, version := range cloudProfile.Spec.AWS.Constraints.Kubernetes.Versions {
-			versions = append(versions, version)
-		}
+		return cloudProfile.Spec.AWS.Constraints.Kubernetes, nil
 	case gardenv1beta1.CloudProviderAzure:
-		for _, version := range cloudProfile.Spec.Azure.Constraints.Kubernetes.Versions {
-			versions = append(versions, version)
-		}
+		return cloudProfile.Spec.Azure.Constraints.Kubernetes, nil
 	case gardenv1beta1.CloudProviderGCP:
-		for _, version := range cloudProfile.Spec.GCP.Constraints.Kubernetes.Versions {
-			versions = append(versions, version)
-		}
+		return cloudProfile.Spec.GCP.Constraints.Kubernetes, nil
 	case gardenv1beta1.CloudProviderOpenStack:
-		for _, version := range cloudProfile.Spec.OpenStack.Constraints.Kubernetes.Versions {
-			versions = append(versions, version)
-		}
+		return cloudProfile.Spec.OpenStack.Constraints.Kubernetes, nil
 	case gardenv1beta1.CloudProviderAlicloud:
-		for _, version := range cloudProfile.Spec.Alicloud.Constraints.Kubernetes.Versions {
-			versions = append(versions, version)
-		}
-	default:
-		return false, "", fmt.Errorf("unknown cloud provider %s", cloudProvider)
+		return cloudProfile.Spec.Alicloud.Constraints.Kubernetes, nil
 	}
+	return gardenv1beta1.KubernetesConstraints{}, fmt.Errorf("unknown cloud provider %s", cloudProvider)
+}
 
+// DetermineLatestKubernetesVersion finds the latest Kubernetes patch version in the <cloudProfile> compared
+// to the given <currentVersion>. In case it does not find a newer patch version, it returns false. Otherwise,
+// true and the found version will be returned.
+func DetermineLatestKubernetesVersion(cloudProfile gardenv1beta1.CloudProfile, currentVersion string) (bool, string, error) {
+	versions, err := GetKubernetesVersionsFromCloudProfile(cloudProfile)
+	if err != nil {
+		return false, "", err
+	}
+
+	newerVersions := []string{}
 	for _, version := range versions {
 		ok, err := utils.CompareVersions(version, "~", currentVersion)
 		if err != nil {
@@ -466,6 +607,62 @@ func DetermineLatestKubernetesVersion(cloudProfile gardenv1beta1.CloudProfile, c
 	return false, "", nil
 }
 
+// DetermineNextMinorKubernetesVersion finds the latest Kubernetes version of the minor version following the one of
+// the given <currentVersion> in the <cloudProfile>. In case the next minor version is not offered, it returns false.
+// Otherwise, true and the found version will be returned.
+func DetermineNextMinorKubernetesVersion(cloudProfile gardenv1beta1.CloudProfile, currentVersion string) (bool, string, error) {
+	versions, err := GetKubernetesVersionsFromCloudProfile(cloudProfile)
+	if err != nil {
+		return false, "", err
+	}
+
+	current, err := semver.NewVersion(currentVersion)
+	if err != nil {
+		return false, "", err
+	}
+
+	var latest *semver.Version
+	for _, version := range versions {
+		v, err := semver.NewVersion(version)
+		if err != nil {
+			return false, "", err
+		}
+		if v.Major() != current.Major() || v.Minor() != current.Minor()+1 {
+			continue
+		}
+		if latest == nil || v.GreaterThan(latest) {
+			latest = v
+		}
+	}
+
+	if latest == nil {
+		return false, "", nil
+	}
+	return true, latest.Original(), nil
+}
+
+// IsKubernetesMinorVersionExpired returns true if the minor version of the given <version> has expired according to
+// the <cloudProfile> at the given time <now>.
+func IsKubernetesMinorVersionExpired(cloudProfile gardenv1beta1.CloudProfile, version string, now time.Time) (bool, error) {
+	constraints, err := GetKubernetesConstraintsFromCloudProfile(cloudProfile)
+	if err != nil {
+		return false, err
+	}
+
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return false, err
+	}
+
+	minorVersion := fmt.Sprintf("%d.%d", v.Major(), v.Minor())
+	for _, expiration := range constraints.MinorVersionExpirations {
+		if expiration.Version == minorVersion {
+			return !now.Before(expiration.ExpirationDate.Time), nil
+		}
+	}
+	return false, nil
+}
+
 type ShootedSeed struct {
 	Protected         *bool
 	Visible           *bool
@@ -360,6 +360,112 @@ var _ = Describe("helper", func() {
 		})
 	})
 
+	Describe("#GetCredentialsRotation", func() {
+		It("should return the found rotation", func() {
+			rotation := gardenv1beta1.CredentialsRotation{Credentials: "ssh-keypair", Phase: gardenv1beta1.CredentialsRotationPhaseRotating}
+
+			Expect(GetCredentialsRotation([]gardenv1beta1.CredentialsRotation{rotation}, "ssh-keypair")).To(Equal(&rotation))
+		})
+
+		It("should return nil because the rotation could not be found", func() {
+			Expect(GetCredentialsRotation(nil, "ssh-keypair")).To(BeNil())
+		})
+	})
+
+	Describe("#SetCondition", func() {
+		var (
+			apiServer = gardenv1beta1.Condition{Type: gardenv1beta1.ShootAPIServerAvailable, Status: gardenv1beta1.ConditionTrue}
+			compliant = gardenv1beta1.Condition{Type: gardenv1beta1.ShootCompliant, Status: gardenv1beta1.ConditionTrue}
+		)
+
+		It("should append the condition", func() {
+			Expect(SetCondition([]gardenv1beta1.Condition{apiServer}, compliant)).To(Equal([]gardenv1beta1.Condition{apiServer, compliant}))
+		})
+
+		It("should replace the condition of the same type", func() {
+			notCompliant := compliant
+			notCompliant.Status = gardenv1beta1.ConditionFalse
+
+			Expect(SetCondition([]gardenv1beta1.Condition{compliant, apiServer}, notCompliant)).To(Equal([]gardenv1beta1.Condition{notCompliant, apiServer}))
+		})
+	})
+
+	Describe("#SetCredentialsRotation", func() {
+		var (
+			sshKeypair    = gardenv1beta1.CredentialsRotation{Credentials: "ssh-keypair", Phase: gardenv1beta1.CredentialsRotationPhaseRotating}
+			observability = gardenv1beta1.CredentialsRotation{Credentials: "observability-credentials", Phase: gardenv1beta1.CredentialsRotationPhaseCompleted}
+		)
+
+		It("should append the rotation", func() {
+			Expect(SetCredentialsRotation([]gardenv1beta1.CredentialsRotation{observability}, sshKeypair)).To(Equal([]gardenv1beta1.CredentialsRotation{observability, sshKeypair}))
+		})
+
+		It("should replace the rotation of the same credentials", func() {
+			completed := sshKeypair
+			completed.Phase = gardenv1beta1.CredentialsRotationPhaseCompleted
+
+			Expect(SetCredentialsRotation([]gardenv1beta1.CredentialsRotation{sshKeypair, observability}, completed)).To(Equal([]gardenv1beta1.CredentialsRotation{completed, observability}))
+		})
+	})
+
+	DescribeTable("#GetMachineImageID",
+		func(machineImage interface{}, expected string) {
+			Expect(GetMachineImageID(machineImage)).To(Equal(expected))
+		},
+		Entry("aws", &gardenv1beta1.AWSMachineImage{Name: "coreos", AMI: "ami-123"}, "ami-123"),
+		Entry("azure", &gardenv1beta1.AzureMachineImage{Name: "coreos", Version: "1967.6.0"}, "1967.6.0"),
+		Entry("gcp", &gardenv1beta1.GCPMachineImage{Name: "coreos", Image: "projects/coreos-cloud/global/images/coreos-stable"}, "projects/coreos-cloud/global/images/coreos-stable"),
+		Entry("openstack", &gardenv1beta1.OpenStackMachineImage{Name: "coreos", Image: "coreos-1967.6.0"}, "coreos-1967.6.0"),
+		Entry("alicloud", &gardenv1beta1.AlicloudMachineImage{Name: "coreos-alicloud", ID: "coreos_1745_7_0_64_30G_alibase_20180705.vhd"}, "coreos_1745_7_0_64_30G_alibase_20180705.vhd"),
+		Entry("nil image", (*gardenv1beta1.AWSMachineImage)(nil), ""),
+		Entry("unknown image", nil, ""),
+	)
+
+	Describe("#GetMachineImageVulnerabilities", func() {
+		var (
+			cve1 = gardenv1beta1.MachineImageVulnerability{ID: "CVE-2019-0001", Severity: gardenv1beta1.VulnerabilitySeverityLow}
+			cve2 = gardenv1beta1.MachineImageVulnerability{ID: "CVE-2019-0002", Severity: gardenv1beta1.VulnerabilitySeverityCritical}
+			cve3 = gardenv1beta1.MachineImageVulnerability{ID: "CVE-2019-0003", Severity: gardenv1beta1.VulnerabilitySeverityHigh}
+
+			cloudProfile = gardenv1beta1.CloudProfile{
+				Status: gardenv1beta1.CloudProfileStatus{
+					MachineImages: []gardenv1beta1.MachineImageStatus{
+						{Name: "coreos", Vulnerabilities: []gardenv1beta1.MachineImageVulnerability{cve1}},
+						{Name: "coreos", Image: "ami-123", Vulnerabilities: []gardenv1beta1.MachineImageVulnerability{cve2}},
+						{Name: "ubuntu", Image: "ami-123", Vulnerabilities: []gardenv1beta1.MachineImageVulnerability{cve3}},
+					},
+				},
+			}
+		)
+
+		It("should return the vulnerabilities of all matching entries", func() {
+			Expect(GetMachineImageVulnerabilities(cloudProfile, "CoreOS", "ami-123")).To(ConsistOf(cve1, cve2))
+		})
+
+		It("should only return the vulnerabilities reported for all images with the name", func() {
+			Expect(GetMachineImageVulnerabilities(cloudProfile, "coreos", "ami-456")).To(ConsistOf(cve1))
+		})
+
+		It("should return nothing for unknown images", func() {
+			Expect(GetMachineImageVulnerabilities(cloudProfile, "suse", "ami-123")).To(BeEmpty())
+		})
+	})
+
+	DescribeTable("#HasVulnerabilityWithSeverity",
+		func(severities []gardenv1beta1.VulnerabilitySeverity, minimum gardenv1beta1.VulnerabilitySeverity, expected bool) {
+			var vulnerabilities []gardenv1beta1.MachineImageVulnerability
+			for _, severity := range severities {
+				vulnerabilities = append(vulnerabilities, gardenv1beta1.MachineImageVulnerability{ID: "CVE", Severity: severity})
+			}
+			Expect(HasVulnerabilityWithSeverity(vulnerabilities, minimum)).To(Equal(expected))
+		},
+		Entry("no vulnerabilities", nil, gardenv1beta1.VulnerabilitySeverityLow, false),
+		Entry("lower severity", []gardenv1beta1.VulnerabilitySeverity{gardenv1beta1.VulnerabilitySeverityLow, gardenv1beta1.VulnerabilitySeverityHigh}, gardenv1beta1.VulnerabilitySeverityCritical, false),
+		Entry("same severity", []gardenv1beta1.VulnerabilitySeverity{gardenv1beta1.VulnerabilitySeverityLow, gardenv1beta1.VulnerabilitySeverityHigh}, gardenv1beta1.VulnerabilitySeverityHigh, true),
+		Entry("higher severity", []gardenv1beta1.VulnerabilitySeverity{gardenv1beta1.VulnerabilitySeverityCritical}, gardenv1beta1.VulnerabilitySeverityMedium, true),
+		Entry("unknown minimum severity", []gardenv1beta1.VulnerabilitySeverity{gardenv1beta1.VulnerabilitySeverityCritical}, gardenv1beta1.VulnerabilitySeverity("Severe"), false),
+	)
+
 	Describe("#ReadShootedSeed", func() {
 		var (
 			shoot                    *gardenv1beta1.Shoot
@@ -43,6 +43,9 @@ type CloudProfile struct {
 	// Spec defines the cloud environment properties.
 	// +optional
 	Spec CloudProfileSpec `json:"spec,omitempty"`
+	// Status contains information about the vulnerabilities of the offered machine images.
+	// +optional
+	Status CloudProfileStatus `json:"status,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -81,8 +84,69 @@ type CloudProfileSpec struct {
 	// CABundle is a certificate bundle which will be installed onto every host machine of the Shoot cluster.
 	// +optional
 	CABundle *string `json:"caBundle,omitempty"`
+	// RegionAffinity groups the regions of the cloud profile into geographical or legal boundaries (e.g. EU, US). The
+	// control plane of a Shoot in a region of a group is never placed in a Seed outside of this group.
+	// +optional
+	RegionAffinity []RegionAffinityGroup `json:"regionAffinity,omitempty"`
 }
 
+// RegionAffinityGroup is a group of regions which Shoot control planes must not leave.
+type RegionAffinityGroup struct {
+	// Name is the name of the group.
+	Name string `json:"name"`
+	// Regions are the names of the regions belonging to the group.
+	Regions []string `json:"regions"`
+}
+
+// CloudProfileStatus holds the most recently observed status of the cloud profile.
+type CloudProfileStatus struct {
+	// MachineImages contains the advisories reported for the machine images offered in the CloudProfile. It is
+	// populated by external vulnerability scanners via the status subresource.
+	// +optional
+	MachineImages []MachineImageStatus `json:"machineImages,omitempty"`
+}
+
+// MachineImageStatus contains the advisories reported for a technical machine image.
+type MachineImageStatus struct {
+	// Name is the name of the image.
+	Name MachineImageName `json:"name"`
+	// Image is the technical identifier of the image, i.e., the AMI (AWS), the version (Azure), the image (GCP and
+	// OpenStack), or the ID (Alicloud). If not present, the advisories apply to all images with the given name.
+	// +optional
+	Image string `json:"image,omitempty"`
+	// Vulnerabilities is a list of known vulnerabilities of the image.
+	// +optional
+	Vulnerabilities []MachineImageVulnerability `json:"vulnerabilities,omitempty"`
+}
+
+// MachineImageVulnerability contains information about a known vulnerability of a machine image.
+type MachineImageVulnerability struct {
+	// ID is the identifier of the vulnerability or advisory, e.g. "CVE-2019-5736".
+	ID string `json:"id"`
+	// Severity is the severity of the vulnerability.
+	Severity VulnerabilitySeverity `json:"severity"`
+	// Description is a human-readable summary of the vulnerability.
+	// +optional
+	Description *string `json:"description,omitempty"`
+	// URL is a link to further information about the vulnerability.
+	// +optional
+	URL *string `json:"url,omitempty"`
+}
+
+// VulnerabilitySeverity is a string alias.
+type VulnerabilitySeverity string
+
+const (
+	// VulnerabilitySeverityLow is a constant for vulnerabilities with low severity.
+	VulnerabilitySeverityLow VulnerabilitySeverity = "Low"
+	// VulnerabilitySeverityMedium is a constant for vulnerabilities with medium severity.
+	VulnerabilitySeverityMedium VulnerabilitySeverity = "Medium"
+	// VulnerabilitySeverityHigh is a constant for vulnerabilities with high severity.
+	VulnerabilitySeverityHigh VulnerabilitySeverity = "High"
+	// VulnerabilitySeverityCritical is a constant for vulnerabilities with critical severity.
+	VulnerabilitySeverityCritical VulnerabilitySeverity = "Critical"
+)
+
 // AWSProfile defines certain constraints and definitions for the AWS cloud.
 type AWSProfile struct {
 	// Constraints is an object containing constraints for certain values in the Shoot specification.
@@ -325,6 +389,18 @@ type DNSProviderConstraint struct {
 type KubernetesConstraints struct {
 	// Versions is the list of allowed Kubernetes versions for Shoot clusters (e.g., 1.13.1).
 	Versions []string `json:"versions"`
+	// MinorVersionExpirations is a list of minor Kubernetes versions (e.g., 1.10) together with the date after which
+	// they expire. Shoots using an expired minor version are updated to the next minor version during their maintenance.
+	// +optional
+	MinorVersionExpirations []KubernetesMinorVersionExpiration `json:"minorVersionExpirations,omitempty"`
+}
+
+// KubernetesMinorVersionExpiration contains the expiration date of a minor Kubernetes version.
+type KubernetesMinorVersionExpiration struct {
+	// Version is the minor Kubernetes version (e.g., 1.10).
+	Version string `json:"version"`
+	// ExpirationDate is the date after which the minor Kubernetes version expires.
+	ExpirationDate metav1.Time `json:"expirationDate"`
 }
 
 // MachineType contains certain properties of a machine type.
@@ -340,6 +416,9 @@ type MachineType struct {
 	GPU resource.Quantity `json:"gpu"`
 	// Memory is the amount of memory for this machine type.
 	Memory resource.Quantity `json:"memory"`
+	// Price is the price of running one machine of this type for one hour. It is used for cost estimations.
+	// +optional
+	Price *resource.Quantity `json:"price,omitempty"`
 }
 
 // OpenStackMachineType contains certain properties of a machine type in OpenStack
@@ -360,6 +439,9 @@ type VolumeType struct {
 	Usable *bool `json:"usable,omitempty"`
 	// Class is the class of the volume type.
 	Class string `json:"class"`
+	// Price is the price of one GiB of storage of this type for one month. It is used for cost estimations.
+	// +optional
+	Price *resource.Quantity `json:"price,omitempty"`
 }
 
 // Zone contains certain properties of an availability zone.
@@ -435,13 +517,26 @@ type ProjectSpec struct {
 	// +optional
 	Purpose *string `json:"purpose,omitempty"`
 	// Members is a list of subjects representing a user name, an email address, or any other identifier of a user
-	// that should be part of this project.
+	// that should be part of this project. Members with an expiration timestamp are removed once it has passed.
 	// +optional
-	Members []rbacv1.Subject `json:"members,omitempty"`
+	Members []ProjectMember `json:"members,omitempty"`
 	// Namespace is the name of the namespace that has been created for the Project object.
 	// A nil value means that Gardener will determine the name of the namespace.
 	// +optional
 	Namespace *string `json:"namespace,omitempty"`
+	// Quotas is a list of references to Quota objects of scope 'project' which limit the resources of all
+	// Shoots of the project.
+	// +optional
+	Quotas []corev1.ObjectReference `json:"quotas,omitempty"`
+}
+
+// ProjectMember is a member of a project.
+type ProjectMember struct {
+	// Subject is a subject representing a user name, an email address, or any other identifier of a user.
+	rbacv1.Subject `json:",inline"`
+	// ExpirationTimestamp is the time after which the member is removed from the project.
+	// +optional
+	ExpirationTimestamp *metav1.Time `json:"expirationTimestamp,omitempty"`
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -451,6 +546,27 @@ type ProjectStatus struct {
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// Phase is the current phase of the project.
 	Phase ProjectPhase `json:"phase,omitempty"`
+	// Quota contains the limits of the quotas referenced by the project and the resources used by its Shoots.
+	// +optional
+	Quota *ProjectQuotaStatus `json:"quota,omitempty"`
+	// LastActivityTimestamp is the time of the last observed activity in the project, e.g. a change of its
+	// members or of the secrets in its namespace.
+	// +optional
+	LastActivityTimestamp *metav1.Time `json:"lastActivityTimestamp,omitempty"`
+	// StaleSinceTimestamp is the time since when the project has been stale, i.e. it has had no Shoots and
+	// no activity for the configured period.
+	// +optional
+	StaleSinceTimestamp *metav1.Time `json:"staleSinceTimestamp,omitempty"`
+}
+
+// ProjectQuotaStatus contains the limits of the quotas referenced by a project and the resources used by its Shoots.
+type ProjectQuotaStatus struct {
+	// Hard is the most restrictive limit of all referenced quotas for each metric.
+	// +optional
+	Hard corev1.ResourceList `json:"hard,omitempty"`
+	// Used is the amount of resources of the metrics in Hard which are allocated by the Shoots of the project.
+	// +optional
+	Used corev1.ResourceList `json:"used,omitempty"`
 }
 
 // ProjectPhase is a label for the condition of a project at the current time.
@@ -527,6 +643,41 @@ type SeedStatus struct {
 	// Conditions represents the latest available observations of a Seed's current state.
 	// +optional
 	Conditions []Condition `json:"conditions,omitempty"`
+	// Extensions contains the names, versions, and health of the extensions installed on the Seed.
+	// +optional
+	Extensions []SeedExtension `json:"extensions,omitempty"`
+	// Utilization summarizes the Shoots scheduled to the Seed and the resources of the Seed cluster.
+	// +optional
+	Utilization *SeedUtilization `json:"utilization,omitempty"`
+}
+
+// SeedExtension contains information about an extension installed on a Seed.
+type SeedExtension struct {
+	// Name is the name of the ControllerRegistration of the extension.
+	Name string `json:"name"`
+	// Version is the version of the chart the extension has been installed with.
+	// +optional
+	Version string `json:"version,omitempty"`
+	// Healthy indicates whether the installation of the extension is valid and has succeeded.
+	Healthy bool `json:"healthy"`
+	// LastHeartbeatTime is the last time the installation of the extension has been reconciled.
+	// +optional
+	LastHeartbeatTime *metav1.Time `json:"lastHeartbeatTime,omitempty"`
+}
+
+// SeedUtilization summarizes the Shoots scheduled to a Seed and the resources of the Seed cluster.
+type SeedUtilization struct {
+	// Shoots is the number of Shoots scheduled to the Seed.
+	Shoots int `json:"shoots"`
+	// ShootsByPurpose maps the purposes of the Shoots scheduled to the Seed to their number.
+	// +optional
+	ShootsByPurpose map[string]int `json:"shootsByPurpose,omitempty"`
+	// Allocatable is the sum of the allocatable resources of the nodes of the Seed cluster.
+	// +optional
+	Allocatable corev1.ResourceList `json:"allocatable,omitempty"`
+	// Requested is the sum of the resources requested by the pods running in the Seed cluster.
+	// +optional
+	Requested corev1.ResourceList `json:"requested,omitempty"`
 }
 
 // SeedCloud defines the cloud profile and the region this Seed cluster belongs to.
@@ -581,12 +732,35 @@ type QuotaSpec struct {
 	// ClusterLifetimeDays is the lifetime of a Shoot cluster in days before it will be terminated automatically.
 	// +optional
 	ClusterLifetimeDays *int `json:"clusterLifetimeDays,omitempty"`
+	// MaxClusterLifetimeExtensionDays is the number of days by which the lifetime of a Shoot cluster can be extended
+	// in total via lifetime extension requests. Extension requests are denied if it is not set.
+	// +optional
+	MaxClusterLifetimeExtensionDays *int `json:"maxClusterLifetimeExtensionDays,omitempty"`
 	// Metrics is a list of resources which will be put under constraints.
 	Metrics corev1.ResourceList `json:"metrics"`
 	// Scope is the scope of the Quota object, either 'project' or 'secret'.
 	Scope QuotaScope `json:"scope"`
 }
 
+const (
+	// QuotaMetricCPU is the constraint for the amount of CPUs
+	QuotaMetricCPU corev1.ResourceName = corev1.ResourceCPU
+	// QuotaMetricGPU is the constraint for the amount of GPUs (e.g. from Nvidia)
+	QuotaMetricGPU corev1.ResourceName = "gpu"
+	// QuotaMetricMemory is the constraint for the amount of memory
+	QuotaMetricMemory corev1.ResourceName = corev1.ResourceMemory
+	// QuotaMetricStorageStandard is the constraint for the size of a standard disk
+	QuotaMetricStorageStandard corev1.ResourceName = corev1.ResourceStorage + ".standard"
+	// QuotaMetricStoragePremium is the constraint for the size of a premium disk (e.g. SSD)
+	QuotaMetricStoragePremium corev1.ResourceName = corev1.ResourceStorage + ".premium"
+	// QuotaMetricLoadbalancer is the constraint for the amount of loadbalancers
+	QuotaMetricLoadbalancer corev1.ResourceName = "loadbalancer"
+	// QuotaMetricShoots is the constraint for the amount of Shoots
+	QuotaMetricShoots corev1.ResourceName = "shoots"
+	// QuotaMetricWorkers is the constraint for the maximum amount of worker nodes
+	QuotaMetricWorkers corev1.ResourceName = "workers"
+)
+
 // QuotaScope is a string alias.
 type QuotaScope string
 
@@ -715,6 +889,22 @@ type ShootStatus struct {
 	// UID is a unique identifier for the Shoot cluster to avoid portability between Kubernetes clusters.
 	// It is used to compute unique hashes.
 	UID types.UID `json:"uid"`
+	// CredentialsRotations holds information about the rotations of single classes of Shoot credentials which
+	// have been requested via the operation annotation.
+	// +optional
+	CredentialsRotations []CredentialsRotation `json:"credentialsRotations,omitempty"`
+	// Plan contains the changes which a reconciliation of the Shoot would make. It is only computed on request via
+	// the operation annotation.
+	// +optional
+	Plan *ShootPlan `json:"plan,omitempty"`
+	// MaintenancePlan contains the changes which the next maintenance of the Shoot would make. It is only computed on
+	// request via the operation annotation.
+	// +optional
+	MaintenancePlan *ShootPlan `json:"maintenancePlan,omitempty"`
+	// HibernationStatistics contains statistics about the hibernation of the Shoot in the current and the previous
+	// months.
+	// +optional
+	HibernationStatistics *HibernationStatistics `json:"hibernationStatistics,omitempty"`
 }
 
 ///////////////////////////////
@@ -1209,6 +1399,13 @@ type HibernationSchedule struct {
 	// End is a Cron spec at which time a Shoot will be woken up.
 	// +optional
 	End *string `json:"end,omitempty"`
+	// Location is the time zone (e.g., `Europe/Berlin`) in which the Cron specs are evaluated. Defaults to UTC.
+	// +optional
+	Location *string `json:"location,omitempty"`
+	// ExcludeDates are dates (`YYYY-MM-DD` in the given location, e.g., public holidays) on which the Shoot is neither
+	// hibernated nor woken up by this schedule.
+	// +optional
+	ExcludeDates []string `json:"excludeDates,omitempty"`
 }
 
 // Kubernetes contains the version and configuration variables for the Shoot control plane.
@@ -1441,14 +1638,40 @@ type Maintenance struct {
 	// TimeWindow contains information about the time window for maintenance operations.
 	// +optional
 	TimeWindow *MaintenanceTimeWindow `json:"timeWindow,omitempty"`
+	// Operations is a list of additional operations which are executed in the given order when the Shoot is
+	// maintained, e.g. "restart-control-plane" or "rotate-ssh-keypair".
+	// +optional
+	Operations []string `json:"operations,omitempty"`
 }
 
 // MaintenanceAutoUpdate contains information about which constraints should be automatically updated.
 type MaintenanceAutoUpdate struct {
 	// KubernetesVersion indicates whether the patch Kubernetes version may be automatically updated.
 	KubernetesVersion bool `json:"kubernetesVersion"`
+	// MachineImageVulnerabilitySeverity is the minimum severity of a vulnerability of the currently used machine
+	// image which causes the machine image to be updated immediately, i.e., outside of the maintenance time window,
+	// as soon as the CloudProfile offers another image. If not present, machine images are only updated within the
+	// maintenance time window.
+	// +optional
+	MachineImageVulnerabilitySeverity *VulnerabilitySeverity `json:"machineImageVulnerabilitySeverity,omitempty"`
+	// KubernetesVersionPolicy defines which Kubernetes version updates are applied if KubernetesVersion is true.
+	// Defaults to "patch".
+	// +optional
+	KubernetesVersionPolicy *KubernetesVersionPolicy `json:"kubernetesVersionPolicy,omitempty"`
 }
 
+// KubernetesVersionPolicy is a string alias.
+type KubernetesVersionPolicy string
+
+const (
+	// KubernetesVersionPolicyPatch is a constant for updating the Kubernetes version to the latest patch version of
+	// its minor version.
+	KubernetesVersionPolicyPatch KubernetesVersionPolicy = "patch"
+	// KubernetesVersionPolicyMinor is a constant for updating the Kubernetes version to the latest patch version of
+	// the next minor version.
+	KubernetesVersionPolicyMinor KubernetesVersionPolicy = "minor"
+)
+
 // MaintenanceTimeWindow contains information about the time window for maintenance operations.
 type MaintenanceTimeWindow struct {
 	// Begin is the beginning of the time window in the format HHMMSS+ZONE, e.g. "220000+0100".
@@ -1525,6 +1748,106 @@ const (
 	ShootLastOperationStateAborted ShootLastOperationState = "Aborted"
 )
 
+// CredentialsRotation contains information about the rotation of a single class of Shoot credentials.
+type CredentialsRotation struct {
+	// Credentials is the class of the rotated credentials, i.e. "observability-credentials", "ssh-keypair", or the
+	// name of a certificate authority.
+	Credentials string `json:"credentials"`
+	// Phase is the phase of the rotation, one of Rotating, Completed.
+	Phase CredentialsRotationPhase `json:"phase"`
+	// LastInitiationTime is the most recent time when the rotation was initiated.
+	// +optional
+	LastInitiationTime *metav1.Time `json:"lastInitiationTime,omitempty"`
+	// LastCompletionTime is the most recent time when the rotation was successfully completed.
+	// +optional
+	LastCompletionTime *metav1.Time `json:"lastCompletionTime,omitempty"`
+}
+
+// CredentialsRotationPhase is a string alias.
+type CredentialsRotationPhase string
+
+const (
+	// CredentialsRotationPhaseRotating indicates that the credentials are being rotated.
+	CredentialsRotationPhaseRotating CredentialsRotationPhase = "Rotating"
+	// CredentialsRotationPhaseCompleted indicates that the rotation of the credentials has been completed.
+	CredentialsRotationPhaseCompleted CredentialsRotationPhase = "Completed"
+)
+
+// HibernationStatistics contains statistics about the hibernation of a Shoot.
+type HibernationStatistics struct {
+	// HibernatedSince is the time since which the hibernation of the Shoot has not been accounted in the statistics
+	// of the months yet. It is only set while the Shoot is hibernated.
+	// +optional
+	HibernatedSince *metav1.Time `json:"hibernatedSince,omitempty"`
+	// Months are the statistics of the current and the previous months, ordered from the oldest to the newest.
+	// +optional
+	Months []HibernationMonthStatistics `json:"months,omitempty"`
+}
+
+// HibernationMonthStatistics contains statistics about the hibernation of a Shoot in a month.
+type HibernationMonthStatistics struct {
+	// Month is the month (`YYYY-MM` in UTC).
+	Month string `json:"month"`
+	// HibernatedSeconds is the number of seconds the Shoot has been hibernated in the month.
+	HibernatedSeconds int64 `json:"hibernatedSeconds"`
+	// HibernatedNodeSeconds is the sum of the hibernated seconds multiplied by the number of nodes which would have
+	// been running otherwise (the minimum number of nodes of all worker pools at that time).
+	HibernatedNodeSeconds int64 `json:"hibernatedNodeSeconds"`
+	// HibernatedHours is the number of hours the Shoot has been hibernated in the month.
+	HibernatedHours string `json:"hibernatedHours"`
+	// EstimatedSavings is the estimated cost which has been saved by the hibernation in the month, based on the
+	// hibernated node seconds and the price per node and hour configured for the controller manager.
+	// +optional
+	EstimatedSavings *string `json:"estimatedSavings,omitempty"`
+}
+
+// ShootPlan contains the changes which a reconciliation or a maintenance of the Shoot would make, computed without
+// applying them.
+type ShootPlan struct {
+	// ComputedTime is the time at which the plan has been computed.
+	ComputedTime metav1.Time `json:"computedTime"`
+	// Generation is the generation of the Shoot for which the plan has been computed.
+	Generation int64 `json:"generation"`
+	// Changes are the changes which a reconciliation or a maintenance would make.
+	// +optional
+	Changes []PlannedChange `json:"changes,omitempty"`
+}
+
+// PlannedChange is a change which a reconciliation or a maintenance of the Shoot would make.
+type PlannedChange struct {
+	// Type is the type of the change.
+	Type PlannedChangeType `json:"type"`
+	// Target is the name of the affected object, e.g. the name of a deployment or a secret.
+	Target string `json:"target"`
+	// Current is the current value of the target (e.g., the deployed image), if any.
+	// +optional
+	Current string `json:"current,omitempty"`
+	// Desired is the value of the target after the reconciliation, if any.
+	// +optional
+	Desired string `json:"desired,omitempty"`
+	// Message is a human readable message describing the change.
+	Message string `json:"message"`
+}
+
+// PlannedChangeType is a string alias.
+type PlannedChangeType string
+
+const (
+	// PlannedChangeSpecification indicates that the specification of the Shoot has been changed since the last
+	// reconciliation.
+	PlannedChangeSpecification PlannedChangeType = "Specification"
+	// PlannedChangeComponentVersion indicates that a control plane component would be rolled out with another image.
+	PlannedChangeComponentVersion PlannedChangeType = "ComponentVersion"
+	// PlannedChangeSecretRotation indicates that a secret would be generated again.
+	PlannedChangeSecretRotation PlannedChangeType = "SecretRotation"
+	// PlannedChangeKubernetesVersion indicates that the Kubernetes version of the Shoot would be updated.
+	PlannedChangeKubernetesVersion PlannedChangeType = "KubernetesVersion"
+	// PlannedChangeMachineImage indicates that the machine image of the Shoot would be updated.
+	PlannedChangeMachineImage PlannedChangeType = "MachineImage"
+	// PlannedChangeMaintenanceOperation indicates that a maintenance operation would be triggered.
+	PlannedChangeMaintenanceOperation PlannedChangeType = "MaintenanceOperation"
+)
+
 // LastError indicates the last occurred error for an operation on a Shoot cluster.
 type LastError struct {
 	// A human readable message indicating details about the last error.
@@ -1566,6 +1889,10 @@ const (
 	ShootEventMaintenanceDone = "MaintenanceDone"
 	// ShootEventMaintenanceError indicates that a maintenance operation has failed.
 	ShootEventMaintenanceError = "MaintenanceError"
+	// ShootEventLifetimeExtended indicates that the lifetime of the Shoot has been extended.
+	ShootEventLifetimeExtended = "LifetimeExtended"
+	// ShootEventLifetimeExtensionDenied indicates that a lifetime extension request for the Shoot has been denied.
+	ShootEventLifetimeExtensionDenied = "LifetimeExtensionDenied"
 
 	// ProjectEventNamespaceReconcileFailed indicates that the namespace reconciliation has failed.
 	ProjectEventNamespaceReconcileFailed = "NamespaceReconcileFailed"
@@ -1575,6 +1902,23 @@ const (
 	ProjectEventNamespaceDeletionFailed = "NamespaceDeletionFailed"
 	// ProjectEventNamespaceMarkedForDeletion indicates that the namespace has been successfully marked for deletion.
 	ProjectEventNamespaceMarkedForDeletion = "NamespaceMarkedForDeletion"
+	// ProjectEventStale indicates that the project has been marked as stale.
+	ProjectEventStale = "Stale"
+	// ProjectEventStaleDeletion indicates that the stale project is deleted.
+	ProjectEventStaleDeletion = "StaleDeletion"
+	// ProjectEventMemberExpired indicates that an expired member has been removed from the project.
+	ProjectEventMemberExpired = "MemberExpired"
+
+	// SeedEventShootNamespacesReadopted indicates that the state of the Shoots has been rebuilt from their namespaces in the Seed cluster.
+	SeedEventShootNamespacesReadopted = "ShootNamespacesReadopted"
+	// SeedEventShootNamespacesReadoptionError indicates that the re-adoption of the shoot namespaces in the Seed cluster has failed.
+	SeedEventShootNamespacesReadoptionError = "ShootNamespacesReadoptionError"
+	// SeedEventOrphanedShootNamespaces indicates that the Seed cluster contains shoot namespaces which do not belong to any Shoot.
+	SeedEventOrphanedShootNamespaces = "OrphanedShootNamespaces"
+	// SeedEventCordoned indicates that the Seed has been cordoned because too many of its Shoots failed.
+	SeedEventCordoned = "Cordoned"
+	// SeedEventUncordoned indicates that the Seed has been uncordoned because its Shoots recovered.
+	SeedEventUncordoned = "Uncordoned"
 )
 
 const (
@@ -1638,9 +1982,15 @@ const (
 	ShootAlertsInactive ConditionType = "AlertsInactive"
 	// ShootAPIServerAvailable is a constant for a condition type indicating that the Shoot clusters API server is available.
 	ShootAPIServerAvailable ConditionType = "APIServerAvailable"
+	// ShootCompliant is a constant for a condition type indicating whether the Shoot satisfies the compliance profile
+	// of the Garden administrators.
+	ShootCompliant ConditionType = "Compliant"
 
 	// ConditionCheckError is a constant for indicating that a condition could not be checked.
 	ConditionCheckError = "ConditionCheckError"
+	// ConditionStale is a constant for indicating that a condition has not been updated by its controller within the
+	// staleness threshold, i.e., the controller is probably not running anymore.
+	ConditionStale = "ConditionStale"
 )
 
 ////////////////////////////////////////////////////
@@ -10,6 +10,7 @@ import (
 	garden "github.com/gardener/gardener/pkg/apis/garden"
 	v1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -443,6 +444,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CloudProfileStatus)(nil), (*garden.CloudProfileStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CloudProfileStatus_To_garden_CloudProfileStatus(a.(*CloudProfileStatus), b.(*garden.CloudProfileStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.CloudProfileStatus)(nil), (*CloudProfileStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_CloudProfileStatus_To_v1beta1_CloudProfileStatus(a.(*garden.CloudProfileStatus), b.(*CloudProfileStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ClusterAutoscaler)(nil), (*garden.ClusterAutoscaler)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ClusterAutoscaler_To_garden_ClusterAutoscaler(a.(*ClusterAutoscaler), b.(*garden.ClusterAutoscaler), scope)
 	}); err != nil {
@@ -463,6 +474,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CredentialsRotation)(nil), (*garden.CredentialsRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CredentialsRotation_To_garden_CredentialsRotation(a.(*CredentialsRotation), b.(*garden.CredentialsRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.CredentialsRotation)(nil), (*CredentialsRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_CredentialsRotation_To_v1beta1_CredentialsRotation(a.(*garden.CredentialsRotation), b.(*CredentialsRotation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DNS)(nil), (*garden.DNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_DNS_To_garden_DNS(a.(*DNS), b.(*garden.DNS), scope)
 	}); err != nil {
@@ -593,6 +614,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HibernationMonthStatistics)(nil), (*garden.HibernationMonthStatistics)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HibernationMonthStatistics_To_garden_HibernationMonthStatistics(a.(*HibernationMonthStatistics), b.(*garden.HibernationMonthStatistics), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.HibernationMonthStatistics)(nil), (*HibernationMonthStatistics)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_HibernationMonthStatistics_To_v1beta1_HibernationMonthStatistics(a.(*garden.HibernationMonthStatistics), b.(*HibernationMonthStatistics), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HibernationSchedule)(nil), (*garden.HibernationSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_HibernationSchedule_To_garden_HibernationSchedule(a.(*HibernationSchedule), b.(*garden.HibernationSchedule), scope)
 	}); err != nil {
@@ -603,6 +634,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HibernationStatistics)(nil), (*garden.HibernationStatistics)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HibernationStatistics_To_garden_HibernationStatistics(a.(*HibernationStatistics), b.(*garden.HibernationStatistics), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.HibernationStatistics)(nil), (*HibernationStatistics)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_HibernationStatistics_To_v1beta1_HibernationStatistics(a.(*garden.HibernationStatistics), b.(*HibernationStatistics), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HorizontalPodAutoscalerConfig)(nil), (*garden.HorizontalPodAutoscalerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_HorizontalPodAutoscalerConfig_To_garden_HorizontalPodAutoscalerConfig(a.(*HorizontalPodAutoscalerConfig), b.(*garden.HorizontalPodAutoscalerConfig), scope)
 	}); err != nil {
@@ -743,6 +784,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KubernetesMinorVersionExpiration)(nil), (*garden.KubernetesMinorVersionExpiration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KubernetesMinorVersionExpiration_To_garden_KubernetesMinorVersionExpiration(a.(*KubernetesMinorVersionExpiration), b.(*garden.KubernetesMinorVersionExpiration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.KubernetesMinorVersionExpiration)(nil), (*KubernetesMinorVersionExpiration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_KubernetesMinorVersionExpiration_To_v1beta1_KubernetesMinorVersionExpiration(a.(*garden.KubernetesMinorVersionExpiration), b.(*KubernetesMinorVersionExpiration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LastError)(nil), (*garden.LastError)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_LastError_To_garden_LastError(a.(*LastError), b.(*garden.LastError), scope)
 	}); err != nil {
@@ -803,6 +854,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineImageStatus)(nil), (*garden.MachineImageStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineImageStatus_To_garden_MachineImageStatus(a.(*MachineImageStatus), b.(*garden.MachineImageStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.MachineImageStatus)(nil), (*MachineImageStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_MachineImageStatus_To_v1beta1_MachineImageStatus(a.(*garden.MachineImageStatus), b.(*MachineImageStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineImageVulnerability)(nil), (*garden.MachineImageVulnerability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineImageVulnerability_To_garden_MachineImageVulnerability(a.(*MachineImageVulnerability), b.(*garden.MachineImageVulnerability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.MachineImageVulnerability)(nil), (*MachineImageVulnerability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_MachineImageVulnerability_To_v1beta1_MachineImageVulnerability(a.(*garden.MachineImageVulnerability), b.(*MachineImageVulnerability), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineType)(nil), (*garden.MachineType)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MachineType_To_garden_MachineType(a.(*MachineType), b.(*garden.MachineType), scope)
 	}); err != nil {
@@ -973,6 +1044,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PlannedChange)(nil), (*garden.PlannedChange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PlannedChange_To_garden_PlannedChange(a.(*PlannedChange), b.(*garden.PlannedChange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.PlannedChange)(nil), (*PlannedChange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_PlannedChange_To_v1beta1_PlannedChange(a.(*garden.PlannedChange), b.(*PlannedChange), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Project)(nil), (*garden.Project)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Project_To_garden_Project(a.(*Project), b.(*garden.Project), scope)
 	}); err != nil {
@@ -993,6 +1074,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectMember)(nil), (*garden.ProjectMember)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectMember_To_garden_ProjectMember(a.(*ProjectMember), b.(*garden.ProjectMember), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ProjectMember)(nil), (*ProjectMember)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ProjectMember_To_v1beta1_ProjectMember(a.(*garden.ProjectMember), b.(*ProjectMember), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectQuotaStatus)(nil), (*garden.ProjectQuotaStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectQuotaStatus_To_garden_ProjectQuotaStatus(a.(*ProjectQuotaStatus), b.(*garden.ProjectQuotaStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ProjectQuotaStatus)(nil), (*ProjectQuotaStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ProjectQuotaStatus_To_v1beta1_ProjectQuotaStatus(a.(*garden.ProjectQuotaStatus), b.(*ProjectQuotaStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectSpec)(nil), (*garden.ProjectSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ProjectSpec_To_garden_ProjectSpec(a.(*ProjectSpec), b.(*garden.ProjectSpec), scope)
 	}); err != nil {
@@ -1043,6 +1144,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RegionAffinityGroup)(nil), (*garden.RegionAffinityGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RegionAffinityGroup_To_garden_RegionAffinityGroup(a.(*RegionAffinityGroup), b.(*garden.RegionAffinityGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.RegionAffinityGroup)(nil), (*RegionAffinityGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_RegionAffinityGroup_To_v1beta1_RegionAffinityGroup(a.(*garden.RegionAffinityGroup), b.(*RegionAffinityGroup), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SecretBinding)(nil), (*garden.SecretBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SecretBinding_To_garden_SecretBinding(a.(*SecretBinding), b.(*garden.SecretBinding), scope)
 	}); err != nil {
@@ -1083,6 +1194,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedExtension)(nil), (*garden.SeedExtension)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedExtension_To_garden_SeedExtension(a.(*SeedExtension), b.(*garden.SeedExtension), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SeedExtension)(nil), (*SeedExtension)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SeedExtension_To_v1beta1_SeedExtension(a.(*garden.SeedExtension), b.(*SeedExtension), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedList)(nil), (*garden.SeedList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedList_To_garden_SeedList(a.(*SeedList), b.(*garden.SeedList), scope)
 	}); err != nil {
@@ -1123,6 +1244,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedUtilization)(nil), (*garden.SeedUtilization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedUtilization_To_garden_SeedUtilization(a.(*SeedUtilization), b.(*garden.SeedUtilization), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SeedUtilization)(nil), (*SeedUtilization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SeedUtilization_To_v1beta1_SeedUtilization(a.(*garden.SeedUtilization), b.(*SeedUtilization), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Shoot)(nil), (*garden.Shoot)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Shoot_To_garden_Shoot(a.(*Shoot), b.(*garden.Shoot), scope)
 	}); err != nil {
@@ -1143,6 +1274,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootPlan)(nil), (*garden.ShootPlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootPlan_To_garden_ShootPlan(a.(*ShootPlan), b.(*garden.ShootPlan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ShootPlan)(nil), (*ShootPlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootPlan_To_v1beta1_ShootPlan(a.(*garden.ShootPlan), b.(*ShootPlan), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootSpec)(nil), (*garden.ShootSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootSpec_To_garden_ShootSpec(a.(*ShootSpec), b.(*garden.ShootSpec), scope)
 	}); err != nil {
@@ -2399,6 +2540,9 @@ func autoConvert_v1beta1_CloudProfile_To_garden_CloudProfile(in *CloudProfile, o
 	if err := Convert_v1beta1_CloudProfileSpec_To_garden_CloudProfileSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_v1beta1_CloudProfileStatus_To_garden_CloudProfileStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2412,6 +2556,9 @@ func autoConvert_garden_CloudProfile_To_v1beta1_CloudProfile(in *garden.CloudPro
 	if err := Convert_garden_CloudProfileSpec_To_v1beta1_CloudProfileSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_garden_CloudProfileStatus_To_v1beta1_CloudProfileStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2450,6 +2597,7 @@ func autoConvert_v1beta1_CloudProfileSpec_To_garden_CloudProfileSpec(in *CloudPr
 	out.Alicloud = (*garden.AlicloudProfile)(unsafe.Pointer(in.Alicloud))
 	out.Local = (*garden.LocalProfile)(unsafe.Pointer(in.Local))
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
+	out.RegionAffinity = *(*[]garden.RegionAffinityGroup)(unsafe.Pointer(&in.RegionAffinity))
 	return nil
 }
 
@@ -2466,6 +2614,7 @@ func autoConvert_garden_CloudProfileSpec_To_v1beta1_CloudProfileSpec(in *garden.
 	out.Alicloud = (*AlicloudProfile)(unsafe.Pointer(in.Alicloud))
 	out.Local = (*LocalProfile)(unsafe.Pointer(in.Local))
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
+	out.RegionAffinity = *(*[]RegionAffinityGroup)(unsafe.Pointer(&in.RegionAffinity))
 	return nil
 }
 
@@ -2474,6 +2623,26 @@ func Convert_garden_CloudProfileSpec_To_v1beta1_CloudProfileSpec(in *garden.Clou
 	return autoConvert_garden_CloudProfileSpec_To_v1beta1_CloudProfileSpec(in, out, s)
 }
 
+func autoConvert_v1beta1_CloudProfileStatus_To_garden_CloudProfileStatus(in *CloudProfileStatus, out *garden.CloudProfileStatus, s conversion.Scope) error {
+	out.MachineImages = *(*[]garden.MachineImageStatus)(unsafe.Pointer(&in.MachineImages))
+	return nil
+}
+
+// Convert_v1beta1_CloudProfileStatus_To_garden_CloudProfileStatus is an autogenerated conversion function.
+func Convert_v1beta1_CloudProfileStatus_To_garden_CloudProfileStatus(in *CloudProfileStatus, out *garden.CloudProfileStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_CloudProfileStatus_To_garden_CloudProfileStatus(in, out, s)
+}
+
+func autoConvert_garden_CloudProfileStatus_To_v1beta1_CloudProfileStatus(in *garden.CloudProfileStatus, out *CloudProfileStatus, s conversion.Scope) error {
+	out.MachineImages = *(*[]MachineImageStatus)(unsafe.Pointer(&in.MachineImages))
+	return nil
+}
+
+// Convert_garden_CloudProfileStatus_To_v1beta1_CloudProfileStatus is an autogenerated conversion function.
+func Convert_garden_CloudProfileStatus_To_v1beta1_CloudProfileStatus(in *garden.CloudProfileStatus, out *CloudProfileStatus, s conversion.Scope) error {
+	return autoConvert_garden_CloudProfileStatus_To_v1beta1_CloudProfileStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_ClusterAutoscaler_To_garden_ClusterAutoscaler(in *ClusterAutoscaler, out *garden.ClusterAutoscaler, s conversion.Scope) error {
 	if err := Convert_v1beta1_Addon_To_garden_Addon(&in.Addon, &out.Addon, s); err != nil {
 		return err
@@ -2528,6 +2697,32 @@ func Convert_garden_Condition_To_v1beta1_Condition(in *garden.Condition, out *Co
 	return autoConvert_garden_Condition_To_v1beta1_Condition(in, out, s)
 }
 
+func autoConvert_v1beta1_CredentialsRotation_To_garden_CredentialsRotation(in *CredentialsRotation, out *garden.CredentialsRotation, s conversion.Scope) error {
+	out.Credentials = in.Credentials
+	out.Phase = garden.CredentialsRotationPhase(in.Phase)
+	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	return nil
+}
+
+// Convert_v1beta1_CredentialsRotation_To_garden_CredentialsRotation is an autogenerated conversion function.
+func Convert_v1beta1_CredentialsRotation_To_garden_CredentialsRotation(in *CredentialsRotation, out *garden.CredentialsRotation, s conversion.Scope) error {
+	return autoConvert_v1beta1_CredentialsRotation_To_garden_CredentialsRotation(in, out, s)
+}
+
+func autoConvert_garden_CredentialsRotation_To_v1beta1_CredentialsRotation(in *garden.CredentialsRotation, out *CredentialsRotation, s conversion.Scope) error {
+	out.Credentials = in.Credentials
+	out.Phase = CredentialsRotationPhase(in.Phase)
+	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	return nil
+}
+
+// Convert_garden_CredentialsRotation_To_v1beta1_CredentialsRotation is an autogenerated conversion function.
+func Convert_garden_CredentialsRotation_To_v1beta1_CredentialsRotation(in *garden.CredentialsRotation, out *CredentialsRotation, s conversion.Scope) error {
+	return autoConvert_garden_CredentialsRotation_To_v1beta1_CredentialsRotation(in, out, s)
+}
+
 func autoConvert_v1beta1_DNS_To_garden_DNS(in *DNS, out *garden.DNS, s conversion.Scope) error {
 	out.Provider = garden.DNSProvider(in.Provider)
 	out.HostedZoneID = (*string)(unsafe.Pointer(in.HostedZoneID))
@@ -2876,9 +3071,39 @@ func Convert_garden_Hibernation_To_v1beta1_Hibernation(in *garden.Hibernation, o
 	return autoConvert_garden_Hibernation_To_v1beta1_Hibernation(in, out, s)
 }
 
+func autoConvert_v1beta1_HibernationMonthStatistics_To_garden_HibernationMonthStatistics(in *HibernationMonthStatistics, out *garden.HibernationMonthStatistics, s conversion.Scope) error {
+	out.Month = in.Month
+	out.HibernatedSeconds = in.HibernatedSeconds
+	out.HibernatedNodeSeconds = in.HibernatedNodeSeconds
+	out.HibernatedHours = in.HibernatedHours
+	out.EstimatedSavings = (*string)(unsafe.Pointer(in.EstimatedSavings))
+	return nil
+}
+
+// Convert_v1beta1_HibernationMonthStatistics_To_garden_HibernationMonthStatistics is an autogenerated conversion function.
+func Convert_v1beta1_HibernationMonthStatistics_To_garden_HibernationMonthStatistics(in *HibernationMonthStatistics, out *garden.HibernationMonthStatistics, s conversion.Scope) error {
+	return autoConvert_v1beta1_HibernationMonthStatistics_To_garden_HibernationMonthStatistics(in, out, s)
+}
+
+func autoConvert_garden_HibernationMonthStatistics_To_v1beta1_HibernationMonthStatistics(in *garden.HibernationMonthStatistics, out *HibernationMonthStatistics, s conversion.Scope) error {
+	out.Month = in.Month
+	out.HibernatedSeconds = in.HibernatedSeconds
+	out.HibernatedNodeSeconds = in.HibernatedNodeSeconds
+	out.HibernatedHours = in.HibernatedHours
+	out.EstimatedSavings = (*string)(unsafe.Pointer(in.EstimatedSavings))
+	return nil
+}
+
+// Convert_garden_HibernationMonthStatistics_To_v1beta1_HibernationMonthStatistics is an autogenerated conversion function.
+func Convert_garden_HibernationMonthStatistics_To_v1beta1_HibernationMonthStatistics(in *garden.HibernationMonthStatistics, out *HibernationMonthStatistics, s conversion.Scope) error {
+	return autoConvert_garden_HibernationMonthStatistics_To_v1beta1_HibernationMonthStatistics(in, out, s)
+}
+
 func autoConvert_v1beta1_HibernationSchedule_To_garden_HibernationSchedule(in *HibernationSchedule, out *garden.HibernationSchedule, s conversion.Scope) error {
 	out.Start = (*string)(unsafe.Pointer(in.Start))
 	out.End = (*string)(unsafe.Pointer(in.End))
+	out.Location = (*string)(unsafe.Pointer(in.Location))
+	out.ExcludeDates = *(*[]string)(unsafe.Pointer(&in.ExcludeDates))
 	return nil
 }
 
@@ -2890,6 +3115,8 @@ func Convert_v1beta1_HibernationSchedule_To_garden_HibernationSchedule(in *Hiber
 func autoConvert_garden_HibernationSchedule_To_v1beta1_HibernationSchedule(in *garden.HibernationSchedule, out *HibernationSchedule, s conversion.Scope) error {
 	out.Start = (*string)(unsafe.Pointer(in.Start))
 	out.End = (*string)(unsafe.Pointer(in.End))
+	out.Location = (*string)(unsafe.Pointer(in.Location))
+	out.ExcludeDates = *(*[]string)(unsafe.Pointer(&in.ExcludeDates))
 	return nil
 }
 
@@ -2898,6 +3125,28 @@ func Convert_garden_HibernationSchedule_To_v1beta1_HibernationSchedule(in *garde
 	return autoConvert_garden_HibernationSchedule_To_v1beta1_HibernationSchedule(in, out, s)
 }
 
+func autoConvert_v1beta1_HibernationStatistics_To_garden_HibernationStatistics(in *HibernationStatistics, out *garden.HibernationStatistics, s conversion.Scope) error {
+	out.HibernatedSince = (*metav1.Time)(unsafe.Pointer(in.HibernatedSince))
+	out.Months = *(*[]garden.HibernationMonthStatistics)(unsafe.Pointer(&in.Months))
+	return nil
+}
+
+// Convert_v1beta1_HibernationStatistics_To_garden_HibernationStatistics is an autogenerated conversion function.
+func Convert_v1beta1_HibernationStatistics_To_garden_HibernationStatistics(in *HibernationStatistics, out *garden.HibernationStatistics, s conversion.Scope) error {
+	return autoConvert_v1beta1_HibernationStatistics_To_garden_HibernationStatistics(in, out, s)
+}
+
+func autoConvert_garden_HibernationStatistics_To_v1beta1_HibernationStatistics(in *garden.HibernationStatistics, out *HibernationStatistics, s conversion.Scope) error {
+	out.HibernatedSince = (*metav1.Time)(unsafe.Pointer(in.HibernatedSince))
+	out.Months = *(*[]HibernationMonthStatistics)(unsafe.Pointer(&in.Months))
+	return nil
+}
+
+// Convert_garden_HibernationStatistics_To_v1beta1_HibernationStatistics is an autogenerated conversion function.
+func Convert_garden_HibernationStatistics_To_v1beta1_HibernationStatistics(in *garden.HibernationStatistics, out *HibernationStatistics, s conversion.Scope) error {
+	return autoConvert_garden_HibernationStatistics_To_v1beta1_HibernationStatistics(in, out, s)
+}
+
 func autoConvert_v1beta1_HorizontalPodAutoscalerConfig_To_garden_HorizontalPodAutoscalerConfig(in *HorizontalPodAutoscalerConfig, out *garden.HorizontalPodAutoscalerConfig, s conversion.Scope) error {
 	out.DownscaleDelay = (*metav1.Duration)(unsafe.Pointer(in.DownscaleDelay))
 	out.SyncPeriod = (*metav1.Duration)(unsafe.Pointer(in.SyncPeriod))
@@ -3216,6 +3465,7 @@ func Convert_garden_KubernetesConfig_To_v1beta1_KubernetesConfig(in *garden.Kube
 
 func autoConvert_v1beta1_KubernetesConstraints_To_garden_KubernetesConstraints(in *KubernetesConstraints, out *garden.KubernetesConstraints, s conversion.Scope) error {
 	out.Versions = *(*[]string)(unsafe.Pointer(&in.Versions))
+	out.MinorVersionExpirations = *(*[]garden.KubernetesMinorVersionExpiration)(unsafe.Pointer(&in.MinorVersionExpirations))
 	return nil
 }
 
@@ -3226,6 +3476,7 @@ func Convert_v1beta1_KubernetesConstraints_To_garden_KubernetesConstraints(in *K
 
 func autoConvert_garden_KubernetesConstraints_To_v1beta1_KubernetesConstraints(in *garden.KubernetesConstraints, out *KubernetesConstraints, s conversion.Scope) error {
 	out.Versions = *(*[]string)(unsafe.Pointer(&in.Versions))
+	out.MinorVersionExpirations = *(*[]KubernetesMinorVersionExpiration)(unsafe.Pointer(&in.MinorVersionExpirations))
 	return nil
 }
 
@@ -3258,6 +3509,28 @@ func Convert_garden_KubernetesDashboard_To_v1beta1_KubernetesDashboard(in *garde
 	return autoConvert_garden_KubernetesDashboard_To_v1beta1_KubernetesDashboard(in, out, s)
 }
 
+func autoConvert_v1beta1_KubernetesMinorVersionExpiration_To_garden_KubernetesMinorVersionExpiration(in *KubernetesMinorVersionExpiration, out *garden.KubernetesMinorVersionExpiration, s conversion.Scope) error {
+	out.Version = in.Version
+	out.ExpirationDate = in.ExpirationDate
+	return nil
+}
+
+// Convert_v1beta1_KubernetesMinorVersionExpiration_To_garden_KubernetesMinorVersionExpiration is an autogenerated conversion function.
+func Convert_v1beta1_KubernetesMinorVersionExpiration_To_garden_KubernetesMinorVersionExpiration(in *KubernetesMinorVersionExpiration, out *garden.KubernetesMinorVersionExpiration, s conversion.Scope) error {
+	return autoConvert_v1beta1_KubernetesMinorVersionExpiration_To_garden_KubernetesMinorVersionExpiration(in, out, s)
+}
+
+func autoConvert_garden_KubernetesMinorVersionExpiration_To_v1beta1_KubernetesMinorVersionExpiration(in *garden.KubernetesMinorVersionExpiration, out *KubernetesMinorVersionExpiration, s conversion.Scope) error {
+	out.Version = in.Version
+	out.ExpirationDate = in.ExpirationDate
+	return nil
+}
+
+// Convert_garden_KubernetesMinorVersionExpiration_To_v1beta1_KubernetesMinorVersionExpiration is an autogenerated conversion function.
+func Convert_garden_KubernetesMinorVersionExpiration_To_v1beta1_KubernetesMinorVersionExpiration(in *garden.KubernetesMinorVersionExpiration, out *KubernetesMinorVersionExpiration, s conversion.Scope) error {
+	return autoConvert_garden_KubernetesMinorVersionExpiration_To_v1beta1_KubernetesMinorVersionExpiration(in, out, s)
+}
+
 func autoConvert_v1beta1_LastError_To_garden_LastError(in *LastError, out *garden.LastError, s conversion.Scope) error {
 	out.Description = in.Description
 	out.Codes = *(*[]garden.ErrorCode)(unsafe.Pointer(&in.Codes))
@@ -3404,12 +3677,63 @@ func Convert_garden_LocalProfile_To_v1beta1_LocalProfile(in *garden.LocalProfile
 	return autoConvert_garden_LocalProfile_To_v1beta1_LocalProfile(in, out, s)
 }
 
+func autoConvert_v1beta1_MachineImageStatus_To_garden_MachineImageStatus(in *MachineImageStatus, out *garden.MachineImageStatus, s conversion.Scope) error {
+	out.Name = garden.MachineImageName(in.Name)
+	out.Image = in.Image
+	out.Vulnerabilities = *(*[]garden.MachineImageVulnerability)(unsafe.Pointer(&in.Vulnerabilities))
+	return nil
+}
+
+// Convert_v1beta1_MachineImageStatus_To_garden_MachineImageStatus is an autogenerated conversion function.
+func Convert_v1beta1_MachineImageStatus_To_garden_MachineImageStatus(in *MachineImageStatus, out *garden.MachineImageStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineImageStatus_To_garden_MachineImageStatus(in, out, s)
+}
+
+func autoConvert_garden_MachineImageStatus_To_v1beta1_MachineImageStatus(in *garden.MachineImageStatus, out *MachineImageStatus, s conversion.Scope) error {
+	out.Name = MachineImageName(in.Name)
+	out.Image = in.Image
+	out.Vulnerabilities = *(*[]MachineImageVulnerability)(unsafe.Pointer(&in.Vulnerabilities))
+	return nil
+}
+
+// Convert_garden_MachineImageStatus_To_v1beta1_MachineImageStatus is an autogenerated conversion function.
+func Convert_garden_MachineImageStatus_To_v1beta1_MachineImageStatus(in *garden.MachineImageStatus, out *MachineImageStatus, s conversion.Scope) error {
+	return autoConvert_garden_MachineImageStatus_To_v1beta1_MachineImageStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineImageVulnerability_To_garden_MachineImageVulnerability(in *MachineImageVulnerability, out *garden.MachineImageVulnerability, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Severity = garden.VulnerabilitySeverity(in.Severity)
+	out.Description = (*string)(unsafe.Pointer(in.Description))
+	out.URL = (*string)(unsafe.Pointer(in.URL))
+	return nil
+}
+
+// Convert_v1beta1_MachineImageVulnerability_To_garden_MachineImageVulnerability is an autogenerated conversion function.
+func Convert_v1beta1_MachineImageVulnerability_To_garden_MachineImageVulnerability(in *MachineImageVulnerability, out *garden.MachineImageVulnerability, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineImageVulnerability_To_garden_MachineImageVulnerability(in, out, s)
+}
+
+func autoConvert_garden_MachineImageVulnerability_To_v1beta1_MachineImageVulnerability(in *garden.MachineImageVulnerability, out *MachineImageVulnerability, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Severity = VulnerabilitySeverity(in.Severity)
+	out.Description = (*string)(unsafe.Pointer(in.Description))
+	out.URL = (*string)(unsafe.Pointer(in.URL))
+	return nil
+}
+
+// Convert_garden_MachineImageVulnerability_To_v1beta1_MachineImageVulnerability is an autogenerated conversion function.
+func Convert_garden_MachineImageVulnerability_To_v1beta1_MachineImageVulnerability(in *garden.MachineImageVulnerability, out *MachineImageVulnerability, s conversion.Scope) error {
+	return autoConvert_garden_MachineImageVulnerability_To_v1beta1_MachineImageVulnerability(in, out, s)
+}
+
 func autoConvert_v1beta1_MachineType_To_garden_MachineType(in *MachineType, out *garden.MachineType, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
 	out.CPU = in.CPU
 	out.GPU = in.GPU
 	out.Memory = in.Memory
+	out.Price = (*resource.Quantity)(unsafe.Pointer(in.Price))
 	return nil
 }
 
@@ -3424,6 +3748,7 @@ func autoConvert_garden_MachineType_To_v1beta1_MachineType(in *garden.MachineTyp
 	out.CPU = in.CPU
 	out.GPU = in.GPU
 	out.Memory = in.Memory
+	out.Price = (*resource.Quantity)(unsafe.Pointer(in.Price))
 	return nil
 }
 
@@ -3435,6 +3760,7 @@ func Convert_garden_MachineType_To_v1beta1_MachineType(in *garden.MachineType, o
 func autoConvert_v1beta1_Maintenance_To_garden_Maintenance(in *Maintenance, out *garden.Maintenance, s conversion.Scope) error {
 	out.AutoUpdate = (*garden.MaintenanceAutoUpdate)(unsafe.Pointer(in.AutoUpdate))
 	out.TimeWindow = (*garden.MaintenanceTimeWindow)(unsafe.Pointer(in.TimeWindow))
+	out.Operations = *(*[]string)(unsafe.Pointer(&in.Operations))
 	return nil
 }
 
@@ -3446,6 +3772,7 @@ func Convert_v1beta1_Maintenance_To_garden_Maintenance(in *Maintenance, out *gar
 func autoConvert_garden_Maintenance_To_v1beta1_Maintenance(in *garden.Maintenance, out *Maintenance, s conversion.Scope) error {
 	out.AutoUpdate = (*MaintenanceAutoUpdate)(unsafe.Pointer(in.AutoUpdate))
 	out.TimeWindow = (*MaintenanceTimeWindow)(unsafe.Pointer(in.TimeWindow))
+	out.Operations = *(*[]string)(unsafe.Pointer(&in.Operations))
 	return nil
 }
 
@@ -3456,6 +3783,8 @@ func Convert_garden_Maintenance_To_v1beta1_Maintenance(in *garden.Maintenance, o
 
 func autoConvert_v1beta1_MaintenanceAutoUpdate_To_garden_MaintenanceAutoUpdate(in *MaintenanceAutoUpdate, out *garden.MaintenanceAutoUpdate, s conversion.Scope) error {
 	out.KubernetesVersion = in.KubernetesVersion
+	out.MachineImageVulnerabilitySeverity = (*garden.VulnerabilitySeverity)(unsafe.Pointer(in.MachineImageVulnerabilitySeverity))
+	out.KubernetesVersionPolicy = (*garden.KubernetesVersionPolicy)(unsafe.Pointer(in.KubernetesVersionPolicy))
 	return nil
 }
 
@@ -3466,6 +3795,8 @@ func Convert_v1beta1_MaintenanceAutoUpdate_To_garden_MaintenanceAutoUpdate(in *M
 
 func autoConvert_garden_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in *garden.MaintenanceAutoUpdate, out *MaintenanceAutoUpdate, s conversion.Scope) error {
 	out.KubernetesVersion = in.KubernetesVersion
+	out.MachineImageVulnerabilitySeverity = (*VulnerabilitySeverity)(unsafe.Pointer(in.MachineImageVulnerabilitySeverity))
+	out.KubernetesVersionPolicy = (*KubernetesVersionPolicy)(unsafe.Pointer(in.KubernetesVersionPolicy))
 	return nil
 }
 
@@ -3866,6 +4197,34 @@ func Convert_garden_OpenStackWorker_To_v1beta1_OpenStackWorker(in *garden.OpenSt
 	return autoConvert_garden_OpenStackWorker_To_v1beta1_OpenStackWorker(in, out, s)
 }
 
+func autoConvert_v1beta1_PlannedChange_To_garden_PlannedChange(in *PlannedChange, out *garden.PlannedChange, s conversion.Scope) error {
+	out.Type = garden.PlannedChangeType(in.Type)
+	out.Target = in.Target
+	out.Current = in.Current
+	out.Desired = in.Desired
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1beta1_PlannedChange_To_garden_PlannedChange is an autogenerated conversion function.
+func Convert_v1beta1_PlannedChange_To_garden_PlannedChange(in *PlannedChange, out *garden.PlannedChange, s conversion.Scope) error {
+	return autoConvert_v1beta1_PlannedChange_To_garden_PlannedChange(in, out, s)
+}
+
+func autoConvert_garden_PlannedChange_To_v1beta1_PlannedChange(in *garden.PlannedChange, out *PlannedChange, s conversion.Scope) error {
+	out.Type = PlannedChangeType(in.Type)
+	out.Target = in.Target
+	out.Current = in.Current
+	out.Desired = in.Desired
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_garden_PlannedChange_To_v1beta1_PlannedChange is an autogenerated conversion function.
+func Convert_garden_PlannedChange_To_v1beta1_PlannedChange(in *garden.PlannedChange, out *PlannedChange, s conversion.Scope) error {
+	return autoConvert_garden_PlannedChange_To_v1beta1_PlannedChange(in, out, s)
+}
+
 func autoConvert_v1beta1_Project_To_garden_Project(in *Project, out *garden.Project, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_ProjectSpec_To_garden_ProjectSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -3920,13 +4279,58 @@ func Convert_garden_ProjectList_To_v1beta1_ProjectList(in *garden.ProjectList, o
 	return autoConvert_garden_ProjectList_To_v1beta1_ProjectList(in, out, s)
 }
 
+func autoConvert_v1beta1_ProjectMember_To_garden_ProjectMember(in *ProjectMember, out *garden.ProjectMember, s conversion.Scope) error {
+	out.Subject = in.Subject
+	out.ExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	return nil
+}
+
+// Convert_v1beta1_ProjectMember_To_garden_ProjectMember is an autogenerated conversion function.
+func Convert_v1beta1_ProjectMember_To_garden_ProjectMember(in *ProjectMember, out *garden.ProjectMember, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectMember_To_garden_ProjectMember(in, out, s)
+}
+
+func autoConvert_garden_ProjectMember_To_v1beta1_ProjectMember(in *garden.ProjectMember, out *ProjectMember, s conversion.Scope) error {
+	out.Subject = in.Subject
+	out.ExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	return nil
+}
+
+// Convert_garden_ProjectMember_To_v1beta1_ProjectMember is an autogenerated conversion function.
+func Convert_garden_ProjectMember_To_v1beta1_ProjectMember(in *garden.ProjectMember, out *ProjectMember, s conversion.Scope) error {
+	return autoConvert_garden_ProjectMember_To_v1beta1_ProjectMember(in, out, s)
+}
+
+func autoConvert_v1beta1_ProjectQuotaStatus_To_garden_ProjectQuotaStatus(in *ProjectQuotaStatus, out *garden.ProjectQuotaStatus, s conversion.Scope) error {
+	out.Hard = *(*v1.ResourceList)(unsafe.Pointer(&in.Hard))
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	return nil
+}
+
+// Convert_v1beta1_ProjectQuotaStatus_To_garden_ProjectQuotaStatus is an autogenerated conversion function.
+func Convert_v1beta1_ProjectQuotaStatus_To_garden_ProjectQuotaStatus(in *ProjectQuotaStatus, out *garden.ProjectQuotaStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectQuotaStatus_To_garden_ProjectQuotaStatus(in, out, s)
+}
+
+func autoConvert_garden_ProjectQuotaStatus_To_v1beta1_ProjectQuotaStatus(in *garden.ProjectQuotaStatus, out *ProjectQuotaStatus, s conversion.Scope) error {
+	out.Hard = *(*v1.ResourceList)(unsafe.Pointer(&in.Hard))
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	return nil
+}
+
+// Convert_garden_ProjectQuotaStatus_To_v1beta1_ProjectQuotaStatus is an autogenerated conversion function.
+func Convert_garden_ProjectQuotaStatus_To_v1beta1_ProjectQuotaStatus(in *garden.ProjectQuotaStatus, out *ProjectQuotaStatus, s conversion.Scope) error {
+	return autoConvert_garden_ProjectQuotaStatus_To_v1beta1_ProjectQuotaStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_ProjectSpec_To_garden_ProjectSpec(in *ProjectSpec, out *garden.ProjectSpec, s conversion.Scope) error {
 	out.CreatedBy = (*rbacv1.Subject)(unsafe.Pointer(in.CreatedBy))
 	out.Description = (*string)(unsafe.Pointer(in.Description))
 	out.Owner = (*rbacv1.Subject)(unsafe.Pointer(in.Owner))
 	out.Purpose = (*string)(unsafe.Pointer(in.Purpose))
-	out.Members = *(*[]rbacv1.Subject)(unsafe.Pointer(&in.Members))
+	out.Members = *(*[]garden.ProjectMember)(unsafe.Pointer(&in.Members))
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	out.Quotas = *(*[]v1.ObjectReference)(unsafe.Pointer(&in.Quotas))
 	return nil
 }
 
@@ -3940,8 +4344,9 @@ func autoConvert_garden_ProjectSpec_To_v1beta1_ProjectSpec(in *garden.ProjectSpe
 	out.Description = (*string)(unsafe.Pointer(in.Description))
 	out.Owner = (*rbacv1.Subject)(unsafe.Pointer(in.Owner))
 	out.Purpose = (*string)(unsafe.Pointer(in.Purpose))
-	out.Members = *(*[]rbacv1.Subject)(unsafe.Pointer(&in.Members))
+	out.Members = *(*[]ProjectMember)(unsafe.Pointer(&in.Members))
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	out.Quotas = *(*[]v1.ObjectReference)(unsafe.Pointer(&in.Quotas))
 	return nil
 }
 
@@ -3953,6 +4358,9 @@ func Convert_garden_ProjectSpec_To_v1beta1_ProjectSpec(in *garden.ProjectSpec, o
 func autoConvert_v1beta1_ProjectStatus_To_garden_ProjectStatus(in *ProjectStatus, out *garden.ProjectStatus, s conversion.Scope) error {
 	out.ObservedGeneration = in.ObservedGeneration
 	out.Phase = garden.ProjectPhase(in.Phase)
+	out.Quota = (*garden.ProjectQuotaStatus)(unsafe.Pointer(in.Quota))
+	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
 	return nil
 }
 
@@ -3964,6 +4372,9 @@ func Convert_v1beta1_ProjectStatus_To_garden_ProjectStatus(in *ProjectStatus, ou
 func autoConvert_garden_ProjectStatus_To_v1beta1_ProjectStatus(in *garden.ProjectStatus, out *ProjectStatus, s conversion.Scope) error {
 	out.ObservedGeneration = in.ObservedGeneration
 	out.Phase = ProjectPhase(in.Phase)
+	out.Quota = (*ProjectQuotaStatus)(unsafe.Pointer(in.Quota))
+	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
 	return nil
 }
 
@@ -4022,6 +4433,7 @@ func Convert_garden_QuotaList_To_v1beta1_QuotaList(in *garden.QuotaList, out *Qu
 
 func autoConvert_v1beta1_QuotaSpec_To_garden_QuotaSpec(in *QuotaSpec, out *garden.QuotaSpec, s conversion.Scope) error {
 	out.ClusterLifetimeDays = (*int)(unsafe.Pointer(in.ClusterLifetimeDays))
+	out.MaxClusterLifetimeExtensionDays = (*int)(unsafe.Pointer(in.MaxClusterLifetimeExtensionDays))
 	out.Metrics = *(*v1.ResourceList)(unsafe.Pointer(&in.Metrics))
 	out.Scope = garden.QuotaScope(in.Scope)
 	return nil
@@ -4034,6 +4446,7 @@ func Convert_v1beta1_QuotaSpec_To_garden_QuotaSpec(in *QuotaSpec, out *garden.Qu
 
 func autoConvert_garden_QuotaSpec_To_v1beta1_QuotaSpec(in *garden.QuotaSpec, out *QuotaSpec, s conversion.Scope) error {
 	out.ClusterLifetimeDays = (*int)(unsafe.Pointer(in.ClusterLifetimeDays))
+	out.MaxClusterLifetimeExtensionDays = (*int)(unsafe.Pointer(in.MaxClusterLifetimeExtensionDays))
 	out.Metrics = *(*v1.ResourceList)(unsafe.Pointer(&in.Metrics))
 	out.Scope = QuotaScope(in.Scope)
 	return nil
@@ -4044,6 +4457,28 @@ func Convert_garden_QuotaSpec_To_v1beta1_QuotaSpec(in *garden.QuotaSpec, out *Qu
 	return autoConvert_garden_QuotaSpec_To_v1beta1_QuotaSpec(in, out, s)
 }
 
+func autoConvert_v1beta1_RegionAffinityGroup_To_garden_RegionAffinityGroup(in *RegionAffinityGroup, out *garden.RegionAffinityGroup, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Regions = *(*[]string)(unsafe.Pointer(&in.Regions))
+	return nil
+}
+
+// Convert_v1beta1_RegionAffinityGroup_To_garden_RegionAffinityGroup is an autogenerated conversion function.
+func Convert_v1beta1_RegionAffinityGroup_To_garden_RegionAffinityGroup(in *RegionAffinityGroup, out *garden.RegionAffinityGroup, s conversion.Scope) error {
+	return autoConvert_v1beta1_RegionAffinityGroup_To_garden_RegionAffinityGroup(in, out, s)
+}
+
+func autoConvert_garden_RegionAffinityGroup_To_v1beta1_RegionAffinityGroup(in *garden.RegionAffinityGroup, out *RegionAffinityGroup, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Regions = *(*[]string)(unsafe.Pointer(&in.Regions))
+	return nil
+}
+
+// Convert_garden_RegionAffinityGroup_To_v1beta1_RegionAffinityGroup is an autogenerated conversion function.
+func Convert_garden_RegionAffinityGroup_To_v1beta1_RegionAffinityGroup(in *garden.RegionAffinityGroup, out *RegionAffinityGroup, s conversion.Scope) error {
+	return autoConvert_garden_RegionAffinityGroup_To_v1beta1_RegionAffinityGroup(in, out, s)
+}
+
 func autoConvert_v1beta1_SecretBinding_To_garden_SecretBinding(in *SecretBinding, out *garden.SecretBinding, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.SecretRef = in.SecretRef
@@ -4144,6 +4579,32 @@ func Convert_garden_SeedCloud_To_v1beta1_SeedCloud(in *garden.SeedCloud, out *Se
 	return autoConvert_garden_SeedCloud_To_v1beta1_SeedCloud(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedExtension_To_garden_SeedExtension(in *SeedExtension, out *garden.SeedExtension, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	out.Healthy = in.Healthy
+	out.LastHeartbeatTime = (*metav1.Time)(unsafe.Pointer(in.LastHeartbeatTime))
+	return nil
+}
+
+// Convert_v1beta1_SeedExtension_To_garden_SeedExtension is an autogenerated conversion function.
+func Convert_v1beta1_SeedExtension_To_garden_SeedExtension(in *SeedExtension, out *garden.SeedExtension, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedExtension_To_garden_SeedExtension(in, out, s)
+}
+
+func autoConvert_garden_SeedExtension_To_v1beta1_SeedExtension(in *garden.SeedExtension, out *SeedExtension, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	out.Healthy = in.Healthy
+	out.LastHeartbeatTime = (*metav1.Time)(unsafe.Pointer(in.LastHeartbeatTime))
+	return nil
+}
+
+// Convert_garden_SeedExtension_To_v1beta1_SeedExtension is an autogenerated conversion function.
+func Convert_garden_SeedExtension_To_v1beta1_SeedExtension(in *garden.SeedExtension, out *SeedExtension, s conversion.Scope) error {
+	return autoConvert_garden_SeedExtension_To_v1beta1_SeedExtension(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedList_To_garden_SeedList(in *SeedList, out *garden.SeedList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]garden.Seed)(unsafe.Pointer(&in.Items))
@@ -4230,6 +4691,8 @@ func Convert_garden_SeedSpec_To_v1beta1_SeedSpec(in *garden.SeedSpec, out *SeedS
 
 func autoConvert_v1beta1_SeedStatus_To_garden_SeedStatus(in *SeedStatus, out *garden.SeedStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]garden.Condition)(unsafe.Pointer(&in.Conditions))
+	out.Extensions = *(*[]garden.SeedExtension)(unsafe.Pointer(&in.Extensions))
+	out.Utilization = (*garden.SeedUtilization)(unsafe.Pointer(in.Utilization))
 	return nil
 }
 
@@ -4240,6 +4703,8 @@ func Convert_v1beta1_SeedStatus_To_garden_SeedStatus(in *SeedStatus, out *garden
 
 func autoConvert_garden_SeedStatus_To_v1beta1_SeedStatus(in *garden.SeedStatus, out *SeedStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]Condition)(unsafe.Pointer(&in.Conditions))
+	out.Extensions = *(*[]SeedExtension)(unsafe.Pointer(&in.Extensions))
+	out.Utilization = (*SeedUtilization)(unsafe.Pointer(in.Utilization))
 	return nil
 }
 
@@ -4248,6 +4713,32 @@ func Convert_garden_SeedStatus_To_v1beta1_SeedStatus(in *garden.SeedStatus, out
 	return autoConvert_garden_SeedStatus_To_v1beta1_SeedStatus(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedUtilization_To_garden_SeedUtilization(in *SeedUtilization, out *garden.SeedUtilization, s conversion.Scope) error {
+	out.Shoots = in.Shoots
+	out.ShootsByPurpose = *(*map[string]int)(unsafe.Pointer(&in.ShootsByPurpose))
+	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.Requested = *(*v1.ResourceList)(unsafe.Pointer(&in.Requested))
+	return nil
+}
+
+// Convert_v1beta1_SeedUtilization_To_garden_SeedUtilization is an autogenerated conversion function.
+func Convert_v1beta1_SeedUtilization_To_garden_SeedUtilization(in *SeedUtilization, out *garden.SeedUtilization, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedUtilization_To_garden_SeedUtilization(in, out, s)
+}
+
+func autoConvert_garden_SeedUtilization_To_v1beta1_SeedUtilization(in *garden.SeedUtilization, out *SeedUtilization, s conversion.Scope) error {
+	out.Shoots = in.Shoots
+	out.ShootsByPurpose = *(*map[string]int)(unsafe.Pointer(&in.ShootsByPurpose))
+	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.Requested = *(*v1.ResourceList)(unsafe.Pointer(&in.Requested))
+	return nil
+}
+
+// Convert_garden_SeedUtilization_To_v1beta1_SeedUtilization is an autogenerated conversion function.
+func Convert_garden_SeedUtilization_To_v1beta1_SeedUtilization(in *garden.SeedUtilization, out *SeedUtilization, s conversion.Scope) error {
+	return autoConvert_garden_SeedUtilization_To_v1beta1_SeedUtilization(in, out, s)
+}
+
 func autoConvert_v1beta1_Shoot_To_garden_Shoot(in *Shoot, out *garden.Shoot, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_ShootSpec_To_garden_ShootSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -4322,6 +4813,30 @@ func Convert_garden_ShootList_To_v1beta1_ShootList(in *garden.ShootList, out *Sh
 	return autoConvert_garden_ShootList_To_v1beta1_ShootList(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootPlan_To_garden_ShootPlan(in *ShootPlan, out *garden.ShootPlan, s conversion.Scope) error {
+	out.ComputedTime = in.ComputedTime
+	out.Generation = in.Generation
+	out.Changes = *(*[]garden.PlannedChange)(unsafe.Pointer(&in.Changes))
+	return nil
+}
+
+// Convert_v1beta1_ShootPlan_To_garden_ShootPlan is an autogenerated conversion function.
+func Convert_v1beta1_ShootPlan_To_garden_ShootPlan(in *ShootPlan, out *garden.ShootPlan, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootPlan_To_garden_ShootPlan(in, out, s)
+}
+
+func autoConvert_garden_ShootPlan_To_v1beta1_ShootPlan(in *garden.ShootPlan, out *ShootPlan, s conversion.Scope) error {
+	out.ComputedTime = in.ComputedTime
+	out.Generation = in.Generation
+	out.Changes = *(*[]PlannedChange)(unsafe.Pointer(&in.Changes))
+	return nil
+}
+
+// Convert_garden_ShootPlan_To_v1beta1_ShootPlan is an autogenerated conversion function.
+func Convert_garden_ShootPlan_To_v1beta1_ShootPlan(in *garden.ShootPlan, out *ShootPlan, s conversion.Scope) error {
+	return autoConvert_garden_ShootPlan_To_v1beta1_ShootPlan(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootSpec_To_garden_ShootSpec(in *ShootSpec, out *garden.ShootSpec, s conversion.Scope) error {
 	out.Addons = (*garden.Addons)(unsafe.Pointer(in.Addons))
 	out.Backup = (*garden.Backup)(unsafe.Pointer(in.Backup))
@@ -4378,6 +4893,10 @@ func autoConvert_v1beta1_ShootStatus_To_garden_ShootStatus(in *ShootStatus, out
 	out.Seed = in.Seed
 	out.TechnicalID = in.TechnicalID
 	out.UID = types.UID(in.UID)
+	out.CredentialsRotations = *(*[]garden.CredentialsRotation)(unsafe.Pointer(&in.CredentialsRotations))
+	out.Plan = (*garden.ShootPlan)(unsafe.Pointer(in.Plan))
+	out.MaintenancePlan = (*garden.ShootPlan)(unsafe.Pointer(in.MaintenancePlan))
+	out.HibernationStatistics = (*garden.HibernationStatistics)(unsafe.Pointer(in.HibernationStatistics))
 	return nil
 }
 
@@ -4398,6 +4917,10 @@ func autoConvert_garden_ShootStatus_To_v1beta1_ShootStatus(in *garden.ShootStatu
 	out.Seed = in.Seed
 	out.TechnicalID = in.TechnicalID
 	out.UID = types.UID(in.UID)
+	out.CredentialsRotations = *(*[]CredentialsRotation)(unsafe.Pointer(&in.CredentialsRotations))
+	out.Plan = (*ShootPlan)(unsafe.Pointer(in.Plan))
+	out.MaintenancePlan = (*ShootPlan)(unsafe.Pointer(in.MaintenancePlan))
+	out.HibernationStatistics = (*HibernationStatistics)(unsafe.Pointer(in.HibernationStatistics))
 	return nil
 }
 
@@ -4410,6 +4933,7 @@ func autoConvert_v1beta1_VolumeType_To_garden_VolumeType(in *VolumeType, out *ga
 	out.Name = in.Name
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
 	out.Class = in.Class
+	out.Price = (*resource.Quantity)(unsafe.Pointer(in.Price))
 	return nil
 }
 
@@ -4422,6 +4946,7 @@ func autoConvert_garden_VolumeType_To_v1beta1_VolumeType(in *garden.VolumeType,
 	out.Name = in.Name
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
 	out.Class = in.Class
+	out.Price = (*resource.Quantity)(unsafe.Pointer(in.Price))
 	return nil
 }
 
@@ -28,7 +28,9 @@ import (
 	"github.com/gardener/gardener/pkg/apis/garden/helper"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/secrets"
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
+	"github.com/gardener/gardener/pkg/utils/validation/compatibility"
 	"github.com/robfig/cron"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -41,7 +43,11 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
-var availableDNS sets.String
+var (
+	availableDNS                       sets.String
+	availableVulnerabilitySeverities   sets.String
+	availableKubernetesVersionPolicies sets.String
+)
 
 func init() {
 	availableDNS = sets.NewString(
@@ -51,6 +57,18 @@ func init() {
 		string(garden.DNSAlicloud),
 		string(garden.DNSOpenstackDesignate),
 	)
+
+	availableVulnerabilitySeverities = sets.NewString(
+		string(garden.VulnerabilitySeverityLow),
+		string(garden.VulnerabilitySeverityMedium),
+		string(garden.VulnerabilitySeverityHigh),
+		string(garden.VulnerabilitySeverityCritical),
+	)
+
+	availableKubernetesVersionPolicies = sets.NewString(
+		string(garden.KubernetesVersionPolicyPatch),
+		string(garden.KubernetesVersionPolicyMinor),
+	)
 }
 
 // ValidateName is a helper function for validating that a name is a DNS sub domain.
@@ -109,6 +127,7 @@ func ValidateCloudProfile(cloudProfile *garden.CloudProfile) field.ErrorList {
 
 	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&cloudProfile.ObjectMeta, false, ValidateName, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, ValidateCloudProfileSpec(&cloudProfile.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, ValidateCloudProfileStatus(&cloudProfile.Status, field.NewPath("status"))...)
 
 	return allErrs
 }
@@ -123,6 +142,63 @@ func ValidateCloudProfileUpdate(newProfile, oldProfile *garden.CloudProfile) fie
 	return allErrs
 }
 
+// ValidateCloudProfileStatusUpdate validates the status field of a CloudProfile object.
+func ValidateCloudProfileStatusUpdate(newProfile, oldProfile *garden.CloudProfile) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newProfile.ObjectMeta, &oldProfile.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateCloudProfileStatus(&newProfile.Status, field.NewPath("status"))...)
+
+	return allErrs
+}
+
+// ValidateCloudProfileStatus validates the status of a CloudProfile object.
+func ValidateCloudProfileStatus(status *garden.CloudProfileStatus, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	seenImages := sets.NewString()
+	for i, image := range status.MachineImages {
+		idxPath := fldPath.Child("machineImages").Index(i)
+
+		if len(image.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a machine image name"))
+		}
+
+		key := fmt.Sprintf("%s/%s", image.Name, image.Image)
+		if seenImages.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, key))
+		}
+		seenImages.Insert(key)
+
+		seenIDs := sets.NewString()
+		for j, vulnerability := range image.Vulnerabilities {
+			vulnerabilityPath := idxPath.Child("vulnerabilities").Index(j)
+
+			if len(vulnerability.ID) == 0 {
+				allErrs = append(allErrs, field.Required(vulnerabilityPath.Child("id"), "must provide an id"))
+			}
+			if seenIDs.Has(vulnerability.ID) {
+				allErrs = append(allErrs, field.Duplicate(vulnerabilityPath.Child("id"), vulnerability.ID))
+			}
+			seenIDs.Insert(vulnerability.ID)
+
+			allErrs = append(allErrs, validateVulnerabilitySeverity(vulnerability.Severity, vulnerabilityPath.Child("severity"))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateVulnerabilitySeverity(severity garden.VulnerabilitySeverity, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !availableVulnerabilitySeverities.Has(string(severity)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath, severity, availableVulnerabilitySeverities.List()))
+	}
+
+	return allErrs
+}
+
 // ValidateCloudProfileSpec validates the specification of a CloudProfile object.
 func ValidateCloudProfileSpec(spec *garden.CloudProfileSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -223,6 +299,42 @@ func ValidateCloudProfileSpec(spec *garden.CloudProfileSpec, fldPath *field.Path
 		}
 	}
 
+	allErrs = append(allErrs, validateRegionAffinity(spec.RegionAffinity, fldPath.Child("regionAffinity"))...)
+
+	return allErrs
+}
+
+func validateRegionAffinity(groups []garden.RegionAffinityGroup, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var (
+		names   = sets.NewString()
+		regions = sets.NewString()
+	)
+	for i, group := range groups {
+		idxPath := fldPath.Index(i)
+
+		if len(group.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if names.Has(group.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), group.Name))
+		}
+		names.Insert(group.Name)
+
+		if len(group.Regions) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("regions"), "must provide at least one region"))
+		}
+		for j, region := range group.Regions {
+			regionPath := idxPath.Child("regions").Index(j)
+			if len(region) == 0 {
+				allErrs = append(allErrs, field.Required(regionPath, "region name cannot be empty"))
+			} else if regions.Has(region) {
+				allErrs = append(allErrs, field.Invalid(regionPath, region, "region must not belong to more than one group"))
+			}
+			regions.Insert(region)
+		}
+	}
+
 	return allErrs
 }
 
@@ -258,6 +370,21 @@ func validateKubernetesConstraints(kubernetes garden.KubernetesConstraints, fldP
 		}
 	}
 
+	var (
+		minorRegex    = regexp.MustCompile(`^[0-9]+\.[0-9]+$`)
+		minorVersions = sets.NewString()
+	)
+	for i, expiration := range kubernetes.MinorVersionExpirations {
+		idxPath := fldPath.Child("minorVersionExpirations").Index(i).Child("version")
+		if !minorRegex.MatchString(expiration.Version) {
+			allErrs = append(allErrs, field.Invalid(idxPath, expiration.Version, fmt.Sprintf("minor Kubernetes versions must match the regex %s", minorRegex)))
+		}
+		if minorVersions.Has(expiration.Version) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, expiration.Version))
+		}
+		minorVersions.Insert(expiration.Version)
+	}
+
 	return allErrs
 }
 
@@ -290,6 +417,10 @@ func validateMachineTypeConstraints(machineTypes []garden.MachineType, fldPath *
 		allErrs = append(allErrs, validateResourceQuantityValue("cpu", machineType.CPU, cpuPath)...)
 		allErrs = append(allErrs, validateResourceQuantityValue("gpu", machineType.GPU, gpuPath)...)
 		allErrs = append(allErrs, validateResourceQuantityValue("memory", machineType.Memory, memoryPath)...)
+
+		if machineType.Price != nil {
+			allErrs = append(allErrs, validateResourceQuantityValue("price", *machineType.Price, idxPath.Child("price"))...)
+		}
 	}
 
 	return allErrs
@@ -504,6 +635,10 @@ func validateVolumeTypeConstraints(volumeTypes []garden.VolumeType, fldPath *fie
 		if len(volumeType.Class) == 0 {
 			allErrs = append(allErrs, field.Required(classPath, "must provide a class"))
 		}
+
+		if volumeType.Price != nil {
+			allErrs = append(allErrs, validateResourceQuantityValue("price", *volumeType.Price, idxPath.Child("price"))...)
+		}
 	}
 
 	return allErrs
@@ -636,7 +771,12 @@ func ValidateProjectSpec(projectSpec *garden.ProjectSpec, fldPath *field.Path) f
 	allErrs := field.ErrorList{}
 
 	for i, member := range projectSpec.Members {
-		allErrs = append(allErrs, ValidateSubject(member, fldPath.Child("members").Index(i))...)
+		idxPath := fldPath.Child("members").Index(i)
+		allErrs = append(allErrs, ValidateSubject(member.Subject, idxPath)...)
+
+		if member.ExpirationTimestamp != nil && projectSpec.Owner != nil && member.Subject == *projectSpec.Owner {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("expirationTimestamp"), "the owner of the project cannot expire"))
+		}
 	}
 	if createdBy := projectSpec.CreatedBy; createdBy != nil {
 		allErrs = append(allErrs, ValidateSubject(*createdBy, fldPath.Child("createdBy"))...)
@@ -650,6 +790,17 @@ func ValidateProjectSpec(projectSpec *garden.ProjectSpec, fldPath *field.Path) f
 	if purpose := projectSpec.Description; purpose != nil && len(*purpose) == 0 {
 		allErrs = append(allErrs, field.Required(fldPath.Child("purpose"), "must provide a purpose when key is present"))
 	}
+	quotas := make(map[string]bool, len(projectSpec.Quotas))
+	for i, quota := range projectSpec.Quotas {
+		idxPath := fldPath.Child("quotas").Index(i)
+		allErrs = append(allErrs, validateObjectReference(quota, idxPath)...)
+
+		key := quota.Namespace + "/" + quota.Name
+		if quotas[key] {
+			allErrs = append(allErrs, field.Duplicate(idxPath, key))
+		}
+		quotas[key] = true
+	}
 
 	return allErrs
 }
@@ -762,6 +913,7 @@ func ValidateSeedSpec(seedSpec *garden.SeedSpec, fldPath *field.Path) field.Erro
 		cidrvalidation.NewCIDR(seedSpec.Networks.Services, networksPath.Child("services")),
 	}
 	allErrs = append(allErrs, validateCIDRParse(networks...)...)
+	allErrs = append(allErrs, cidrvalidation.ValidateSameIPFamily(networks...)...)
 	allErrs = append(allErrs, validateCIDROVerlap(networks, networks, false)...)
 
 	return allErrs
@@ -831,6 +983,10 @@ func ValidateQuotaSpec(quotaSpec *garden.QuotaSpec, fldPath *field.Path) field.E
 		allErrs = append(allErrs, validateResourceQuantityValue(string(k), v, keyPath)...)
 	}
 
+	if days := quotaSpec.MaxClusterLifetimeExtensionDays; days != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*days), fldPath.Child("maxClusterLifetimeExtensionDays"))...)
+	}
+
 	return allErrs
 }
 
@@ -842,7 +998,9 @@ func isValidQuotaMetric(metric corev1.ResourceName) bool {
 		garden.QuotaMetricMemory,
 		garden.QuotaMetricStorageStandard,
 		garden.QuotaMetricStoragePremium,
-		garden.QuotaMetricLoadbalancer:
+		garden.QuotaMetricLoadbalancer,
+		garden.QuotaMetricShoots,
+		garden.QuotaMetricWorkers:
 		return true
 	}
 	return false
@@ -950,22 +1108,105 @@ func validateSecretReferenceOptionalNamespace(ref corev1.SecretReference, fldPat
 
 // ValidateShoot validates a Shoot object.
 func ValidateShoot(shoot *garden.Shoot) field.ErrorList {
+	return validateShoot(shoot, nil)
+}
+
+// validateShoot validates a Shoot object. On updates, <oldShoot> is the Shoot before the update and annotations which
+// have not been changed are not validated again, so that Shoots which have been created before a validation was
+// introduced can still be updated (e.g., for removing their finalizers).
+func validateShoot(shoot, oldShoot *garden.Shoot) field.ErrorList {
 	allErrs := field.ErrorList{}
 
+	var oldAnnotations map[string]string
+	if oldShoot != nil {
+		oldAnnotations = oldShoot.Annotations
+	}
+
 	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&shoot.ObjectMeta, true, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, validateNameConsecutiveHyphens(shoot.Name, field.NewPath("metadata", "name"))...)
+	allErrs = append(allErrs, ValidateShootAnnotations(shoot.Annotations, oldAnnotations, field.NewPath("metadata", "annotations"))...)
 	allErrs = append(allErrs, ValidateShootSpec(&shoot.Spec, field.NewPath("spec"))...)
 
 	return allErrs
 }
 
+// ValidateShootAnnotations validates the annotations of a Shoot object. Annotations whose values equal the ones in
+// <oldAnnotations> are not validated (pass nil on creation).
+func ValidateShootAnnotations(annotations, oldAnnotations map[string]string, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		changed = func(key string) (string, bool) {
+			value, ok := annotations[key]
+			if !ok {
+				return "", false
+			}
+			oldValue, oldOK := oldAnnotations[key]
+			return value, !oldOK || value != oldValue
+		}
+	)
+
+	if _, ok := changed(common.ShootOperation); ok {
+		if credentials, ok := common.CredentialsToRotate(annotations); ok && !common.RotatableShootCredentials.Has(credentials) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Key(common.ShootOperation), annotations[common.ShootOperation], rotateOperations()))
+		}
+	}
+
+	if value, ok := changed(common.ShootCertificateKeyAlgorithm); ok {
+		if _, err := secrets.ParseKeyAlgorithm(value); err != nil || len(value) == 0 {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Key(common.ShootCertificateKeyAlgorithm), value, keyAlgorithms()))
+		}
+	}
+
+	durations := map[string]time.Duration{}
+	for _, key := range []string{common.ShootCACertificateValidity, common.ShootCertificateValidity, common.ShootCertificateRenewalThreshold} {
+		value, ok := annotations[key]
+		if !ok {
+			continue
+		}
+		duration, err := time.ParseDuration(value)
+		if err != nil || duration <= 0 {
+			if _, changed := changed(key); changed {
+				allErrs = append(allErrs, field.Invalid(fldPath.Key(key), value, "must be a positive duration"))
+			}
+			continue
+		}
+		durations[key] = duration
+	}
+
+	_, validityChanged := changed(common.ShootCertificateValidity)
+	_, renewalThresholdChanged := changed(common.ShootCertificateRenewalThreshold)
+	if renewalThreshold, ok := durations[common.ShootCertificateRenewalThreshold]; ok && (validityChanged || renewalThresholdChanged) {
+		if validity, ok := durations[common.ShootCertificateValidity]; ok && renewalThreshold >= validity {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(common.ShootCertificateRenewalThreshold), annotations[common.ShootCertificateRenewalThreshold], fmt.Sprintf("must be less than the certificate validity %s", validity)))
+		}
+	}
+
+	return allErrs
+}
+
+func keyAlgorithms() []string {
+	var algorithms []string
+	for _, algorithm := range secrets.SupportedKeyAlgorithms {
+		algorithms = append(algorithms, string(algorithm))
+	}
+	return algorithms
+}
+
+func rotateOperations() []string {
+	var operations []string
+	for _, credentials := range common.RotatableShootCredentials.List() {
+		operations = append(operations, common.ShootOperationRotateCredentialsPrefix+credentials)
+	}
+	return operations
+}
+
 // ValidateShootUpdate validates a Shoot object before an update.
 func ValidateShootUpdate(newShoot, oldShoot *garden.Shoot) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newShoot.ObjectMeta, &oldShoot.ObjectMeta, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, ValidateShootSpecUpdate(&newShoot.Spec, &oldShoot.Spec, newShoot.DeletionTimestamp != nil, field.NewPath("spec"))...)
-	allErrs = append(allErrs, ValidateShoot(newShoot)...)
+	allErrs = append(allErrs, validateShoot(newShoot, oldShoot)...)
 
 	return allErrs
 }
@@ -1607,6 +1848,7 @@ func transformK8SNetworks(networks garden.K8SNetworks, fldPath *field.Path) (nod
 	} else {
 		allErrs = append(allErrs, field.Required(fldPath.Child("services"), "services CIDR cannot be unset"))
 	}
+	allErrs = append(allErrs, cidrvalidation.ValidateSameIPFamily(cidrs...)...)
 	allErrs = append(allErrs, validateCIDROVerlap(cidrs, cidrs, false)...)
 
 	return nodes, pods, services, allErrs
@@ -1678,6 +1920,45 @@ func validateKubernetes(kubernetes garden.Kubernetes, fldPath *field.Path) field
 	}
 
 	allErrs = append(allErrs, validateKubeControllerManager(kubernetes.Version, kubernetes.KubeControllerManager, fldPath.Child("kubeControllerManager"))...)
+	allErrs = append(allErrs, validateKubernetesCompatibility(kubernetes, fldPath)...)
+
+	return allErrs
+}
+
+// validateKubernetesCompatibility validates that the feature gates of all components as well as the admission plugins
+// and the runtime configuration of the kube-apiserver are supported by the Kubernetes version of the Shoot.
+func validateKubernetesCompatibility(kubernetes garden.Kubernetes, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if _, err := semver.NewVersion(kubernetes.Version); err != nil {
+		return allErrs
+	}
+
+	if kubeAPIServer := kubernetes.KubeAPIServer; kubeAPIServer != nil {
+		var plugins []string
+		for _, plugin := range kubeAPIServer.AdmissionPlugins {
+			plugins = append(plugins, plugin.Name)
+		}
+
+		allErrs = append(allErrs, compatibility.ValidateFeatureGates(kubeAPIServer.FeatureGates, kubernetes.Version, fldPath.Child("kubeAPIServer", "featureGates"))...)
+		allErrs = append(allErrs, compatibility.ValidateAdmissionPlugins(plugins, kubernetes.Version, fldPath.Child("kubeAPIServer", "admissionPlugins"))...)
+		allErrs = append(allErrs, compatibility.ValidateRuntimeConfig(kubeAPIServer.RuntimeConfig, kubernetes.Version, fldPath.Child("kubeAPIServer", "runtimeConfig"))...)
+	}
+	if cloudControllerManager := kubernetes.CloudControllerManager; cloudControllerManager != nil {
+		allErrs = append(allErrs, compatibility.ValidateFeatureGates(cloudControllerManager.FeatureGates, kubernetes.Version, fldPath.Child("cloudControllerManager", "featureGates"))...)
+	}
+	if kubeControllerManager := kubernetes.KubeControllerManager; kubeControllerManager != nil {
+		allErrs = append(allErrs, compatibility.ValidateFeatureGates(kubeControllerManager.FeatureGates, kubernetes.Version, fldPath.Child("kubeControllerManager", "featureGates"))...)
+	}
+	if kubeScheduler := kubernetes.KubeScheduler; kubeScheduler != nil {
+		allErrs = append(allErrs, compatibility.ValidateFeatureGates(kubeScheduler.FeatureGates, kubernetes.Version, fldPath.Child("kubeScheduler", "featureGates"))...)
+	}
+	if kubeProxy := kubernetes.KubeProxy; kubeProxy != nil {
+		allErrs = append(allErrs, compatibility.ValidateFeatureGates(kubeProxy.FeatureGates, kubernetes.Version, fldPath.Child("kubeProxy", "featureGates"))...)
+	}
+	if kubelet := kubernetes.Kubelet; kubelet != nil {
+		allErrs = append(allErrs, compatibility.ValidateFeatureGates(kubelet.FeatureGates, kubernetes.Version, fldPath.Child("kubelet", "featureGates"))...)
+	}
 
 	return allErrs
 }
@@ -1748,7 +2029,16 @@ func validateMaintenance(maintenance *garden.Maintenance, fldPath *field.Path) f
 
 	if maintenance.AutoUpdate == nil {
 		allErrs = append(allErrs, field.Required(fldPath.Child("autoUpdate"), "auto update information is required"))
+	} else if severity := maintenance.AutoUpdate.MachineImageVulnerabilitySeverity; severity != nil {
+		allErrs = append(allErrs, validateVulnerabilitySeverity(*severity, fldPath.Child("autoUpdate", "machineImageVulnerabilitySeverity"))...)
 	}
+	if maintenance.AutoUpdate != nil && maintenance.AutoUpdate.KubernetesVersionPolicy != nil {
+		if policy := *maintenance.AutoUpdate.KubernetesVersionPolicy; !availableKubernetesVersionPolicies.Has(string(policy)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("autoUpdate", "kubernetesVersionPolicy"), policy, availableKubernetesVersionPolicies.List()))
+		}
+	}
+
+	allErrs = append(allErrs, validateMaintenanceOperations(maintenance.Operations, fldPath.Child("operations"))...)
 
 	if maintenance.TimeWindow == nil {
 		allErrs = append(allErrs, field.Required(fldPath.Child("timeWindow"), "time window information is required"))
@@ -1774,6 +2064,38 @@ func validateMaintenance(maintenance *garden.Maintenance, fldPath *field.Path) f
 	return allErrs
 }
 
+func validateMaintenanceOperations(operations []string, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs   = field.ErrorList{}
+		seen      = sets.NewString()
+		rotations = 0
+	)
+
+	for i, operation := range operations {
+		idxPath := fldPath.Index(i)
+
+		if seen.Has(operation) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, operation))
+			continue
+		}
+		seen.Insert(operation)
+
+		if operation == common.ShootMaintenanceOperationRestartControlPlane {
+			continue
+		}
+		if strings.HasPrefix(operation, common.ShootOperationRotateCredentialsPrefix) && common.RotatableShootCredentials.Has(strings.TrimPrefix(operation, common.ShootOperationRotateCredentialsPrefix)) {
+			// A reconciliation rotates at most one class of credentials.
+			if rotations++; rotations > 1 {
+				allErrs = append(allErrs, field.Forbidden(idxPath, "only one class of credentials can be rotated per maintenance"))
+			}
+			continue
+		}
+		allErrs = append(allErrs, field.NotSupported(idxPath, operation, append([]string{common.ShootMaintenanceOperationRestartControlPlane}, rotateOperations()...)))
+	}
+
+	return allErrs
+}
+
 // ValidateWorker validates the worker object.
 func ValidateWorker(worker garden.Worker, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -1846,11 +2168,20 @@ func ValidateHibernation(hibernation *garden.Hibernation, fldPath *field.Path) f
 func ValidateHibernationSchedules(schedules []garden.HibernationSchedule, fldPath *field.Path) field.ErrorList {
 	var (
 		allErrs = field.ErrorList{}
-		seen    = sets.NewString()
+		// Cron specs must be unique within the same location only.
+		seenPerLocation = map[string]sets.String{}
 	)
 
 	for i, schedule := range schedules {
-		allErrs = append(allErrs, ValidateHibernationSchedule(seen, &schedule, fldPath.Index(i))...)
+		location := "UTC"
+		if schedule.Location != nil {
+			location = *schedule.Location
+		}
+		if _, ok := seenPerLocation[location]; !ok {
+			seenPerLocation[location] = sets.NewString()
+		}
+
+		allErrs = append(allErrs, ValidateHibernationSchedule(seenPerLocation[location], &schedule, fldPath.Index(i))...)
 	}
 
 	return allErrs
@@ -1873,7 +2204,8 @@ func ValidateHibernationCronSpec(seenSpecs sets.String, spec string, fldPath *fi
 }
 
 // ValidateHibernationSchedule validates the correctness of a HibernationSchedule.
-// It checks whether the set start and end time are valid cron specs.
+// It checks whether the set start and end time are valid cron specs, and whether the location and the excluded dates
+// are valid.
 func ValidateHibernationSchedule(seenSpecs sets.String, schedule *garden.HibernationSchedule, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -1886,6 +2218,22 @@ func ValidateHibernationSchedule(seenSpecs sets.String, schedule *garden.Hiberna
 	if schedule.End != nil {
 		allErrs = append(allErrs, ValidateHibernationCronSpec(seenSpecs, *schedule.End, fldPath.Child("end"))...)
 	}
+	if schedule.Location != nil {
+		if _, err := time.LoadLocation(*schedule.Location); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("location"), *schedule.Location, fmt.Sprintf("not a valid location: %v", err)))
+		}
+	}
+
+	excludeDates := sets.NewString()
+	for i, date := range schedule.ExcludeDates {
+		idxPath := fldPath.Child("excludeDates").Index(i)
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath, date, "must be a date in the format YYYY-MM-DD"))
+		} else if excludeDates.Has(date) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, date))
+		}
+		excludeDates.Insert(date)
+	}
 
 	return allErrs
 }
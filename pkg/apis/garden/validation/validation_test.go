@@ -188,6 +188,122 @@ var _ = Describe("validation", func() {
 				}))
 			})
 
+			It("should allow valid region affinity groups", func() {
+				awsCloudProfile.Spec.RegionAffinity = []garden.RegionAffinityGroup{
+					{Name: "EU", Regions: []string{"eu-west-1", "eu-central-1"}},
+					{Name: "US", Regions: []string{"us-east-1"}},
+				}
+
+				errorList := ValidateCloudProfile(awsCloudProfile)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid invalid region affinity groups", func() {
+				awsCloudProfile.Spec.RegionAffinity = []garden.RegionAffinityGroup{
+					{Name: "EU", Regions: []string{"eu-west-1"}},
+					{Name: "EU", Regions: []string{"eu-west-1", ""}},
+					{Name: "", Regions: nil},
+				}
+
+				errorList := ValidateCloudProfile(awsCloudProfile)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.regionAffinity[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.regionAffinity[1].regions[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.regionAffinity[1].regions[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.regionAffinity[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.regionAffinity[2].regions"),
+					})),
+				))
+			})
+
+			Context("status validation", func() {
+				It("should allow valid vulnerabilities", func() {
+					awsCloudProfile.Status.MachineImages = []garden.MachineImageStatus{
+						{
+							Name:  garden.MachineImageName("some-machineimage"),
+							Image: "ami-12345678",
+							Vulnerabilities: []garden.MachineImageVulnerability{
+								{ID: "CVE-2019-5736", Severity: garden.VulnerabilitySeverityCritical},
+							},
+						},
+					}
+
+					errorList := ValidateCloudProfile(awsCloudProfile)
+
+					Expect(len(errorList)).To(Equal(0))
+				})
+
+				It("should forbid invalid vulnerabilities", func() {
+					awsCloudProfile.Status.MachineImages = []garden.MachineImageStatus{
+						{
+							Name: garden.MachineImageName(""),
+							Vulnerabilities: []garden.MachineImageVulnerability{
+								{ID: "", Severity: garden.VulnerabilitySeverity("Severe")},
+							},
+						},
+					}
+
+					errorList := ValidateCloudProfile(awsCloudProfile)
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("status.machineImages[0].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("status.machineImages[0].vulnerabilities[0].id"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("status.machineImages[0].vulnerabilities[0].severity"),
+						})),
+					))
+				})
+
+				It("should forbid duplicate images and vulnerabilities", func() {
+					vulnerabilities := []garden.MachineImageVulnerability{
+						{ID: "CVE-2019-5736", Severity: garden.VulnerabilitySeverityHigh},
+						{ID: "CVE-2019-5736", Severity: garden.VulnerabilitySeverityHigh},
+					}
+					awsCloudProfile.Status.MachineImages = []garden.MachineImageStatus{
+						{Name: garden.MachineImageName("some-machineimage"), Vulnerabilities: vulnerabilities},
+						{Name: garden.MachineImageName("some-machineimage")},
+					}
+
+					awsCloudProfile.ResourceVersion = "1"
+
+					errorList := ValidateCloudProfileStatusUpdate(awsCloudProfile, awsCloudProfile)
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("status.machineImages[0].vulnerabilities[1].id"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("status.machineImages[1]"),
+						})),
+					))
+				})
+			})
+
 			Context("dns provider constraints", func() {
 				It("should enforce that at least one provider has been defined", func() {
 					awsCloudProfile.Spec.AWS.Constraints.DNSProviders = []garden.DNSProviderConstraint{}
@@ -238,6 +354,27 @@ var _ = Describe("validation", func() {
 						"Field": Equal(fmt.Sprintf("spec.%s.constraints.kubernetes.versions[0]", fldPath)),
 					}))
 				})
+
+				It("should forbid invalid and duplicate minor version expirations", func() {
+					awsCloudProfile.Spec.AWS.Constraints.Kubernetes.MinorVersionExpirations = []garden.KubernetesMinorVersionExpiration{
+						{Version: "1.10.1"},
+						{Version: "1.11"},
+						{Version: "1.11"},
+					}
+
+					errorList := ValidateCloudProfile(awsCloudProfile)
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal(fmt.Sprintf("spec.%s.constraints.kubernetes.minorVersionExpirations[0].version", fldPath)),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal(fmt.Sprintf("spec.%s.constraints.kubernetes.minorVersionExpirations[2].version", fldPath)),
+						})),
+					))
+				})
 			})
 
 			Context("machine image validation", func() {
@@ -400,6 +537,20 @@ var _ = Describe("validation", func() {
 						"Field": Equal(fmt.Sprintf("spec.%s.constraints.machineTypes[0].memory", fldPath)),
 					}))
 				})
+
+				It("should forbid machine types with negative prices", func() {
+					price := resource.MustParse("-0.5")
+					machineTypeWithPrice := machineType
+					machineTypeWithPrice.Price = &price
+					awsCloudProfile.Spec.AWS.Constraints.MachineTypes = []garden.MachineType{machineTypeWithPrice}
+
+					errorList := ValidateCloudProfile(awsCloudProfile)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal(fmt.Sprintf("spec.%s.constraints.machineTypes[0].price", fldPath)),
+					}))))
+				})
 			})
 
 			Context("volume types validation", func() {
@@ -444,6 +595,20 @@ var _ = Describe("validation", func() {
 						"Field": Equal(fmt.Sprintf("spec.%s.constraints.volumeTypes[0].class", fldPath)),
 					}))
 				})
+
+				It("should forbid volume types with negative prices", func() {
+					price := resource.MustParse("-0.1")
+					volumeTypeWithPrice := volumeTypesConstraint[0]
+					volumeTypeWithPrice.Price = &price
+					awsCloudProfile.Spec.AWS.Constraints.VolumeTypes = []garden.VolumeType{volumeTypeWithPrice}
+
+					errorList := ValidateCloudProfile(awsCloudProfile)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal(fmt.Sprintf("spec.%s.constraints.volumeTypes[0].price", fldPath)),
+					}))))
+				})
 			})
 
 			Context("zones validation", func() {
@@ -1760,11 +1925,13 @@ var _ = Describe("validation", func() {
 						Kind:     rbacv1.UserKind,
 						Name:     "john.doe@example.com",
 					},
-					Members: []rbacv1.Subject{
+					Members: []garden.ProjectMember{
 						{
-							APIGroup: "rbac.authorization.k8s.io",
-							Kind:     rbacv1.UserKind,
-							Name:     "alice.doe@example.com",
+							Subject: rbacv1.Subject{
+								APIGroup: "rbac.authorization.k8s.io",
+								Kind:     rbacv1.UserKind,
+								Name:     "alice.doe@example.com",
+							},
 						},
 					},
 				},
@@ -1825,6 +1992,48 @@ var _ = Describe("validation", func() {
 			}))))
 		})
 
+		It("should forbid Project quota references without namespace or with duplicates", func() {
+			project.Spec.Quotas = []corev1.ObjectReference{
+				{Name: "quota-1"},
+				{Name: "quota-2", Namespace: "garden"},
+				{Name: "quota-2", Namespace: "garden"},
+			}
+
+			errorList := ValidateProject(project)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.quotas[0].namespace"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.quotas[2]"),
+			}))))
+		})
+
+		It("should allow members with an expiration timestamp", func() {
+			expirationTimestamp := metav1.Now()
+			project.Spec.Members[0].ExpirationTimestamp = &expirationTimestamp
+
+			errorList := ValidateProject(project)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid an expiration timestamp for the owner", func() {
+			expirationTimestamp := metav1.Now()
+			project.Spec.Members = append(project.Spec.Members, garden.ProjectMember{
+				Subject:             *project.Spec.Owner,
+				ExpirationTimestamp: &expirationTimestamp,
+			})
+
+			errorList := ValidateProject(project)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.members[1].expirationTimestamp"),
+			}))))
+		})
+
 		DescribeTable("owner validation",
 			func(apiGroup, kind, name, namespace string, expectType field.ErrorType, field string) {
 				subject := rbacv1.Subject{
@@ -1836,7 +2045,7 @@ var _ = Describe("validation", func() {
 
 				project.Spec.Owner = &subject
 				project.Spec.CreatedBy = &subject
-				project.Spec.Members = []rbacv1.Subject{subject}
+				project.Spec.Members = []garden.ProjectMember{{Subject: subject}}
 
 				errList := ValidateProject(project)
 
@@ -2041,6 +2250,34 @@ var _ = Describe("validation", func() {
 				"Detail": Equal(`must not be a subset of "spec.networks.pods" ("10.0.1.0/24")`),
 			}))
 		})
+
+		It("should allow Seed with IPv6 networks", func() {
+			seed.Spec.Networks = garden.SeedNetworks{
+				Nodes:    garden.CIDR("fd00:10:1::/48"),
+				Pods:     garden.CIDR("fd00:10:2::/48"),
+				Services: garden.CIDR("fd00:10:3::/48"),
+			}
+
+			errorList := ValidateSeed(seed)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid Seed with networks of mixed IP families", func() {
+			seed.Spec.Networks = garden.SeedNetworks{
+				Nodes:    garden.CIDR("10.0.0.0/16"),
+				Pods:     garden.CIDR("fd00:10:2::/48"),
+				Services: garden.CIDR("10.1.0.0/16"),
+			}
+
+			errorList := ValidateSeed(seed)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("spec.networks.pods"),
+				"Detail": Equal(`must be an IPv4 CIDR, but is an IPv6 CIDR`),
+			}))
+		})
 	})
 
 	Describe("#ValidateQuota", func() {
@@ -2068,6 +2305,27 @@ var _ = Describe("validation", func() {
 			Expect(len(errorList)).To(Equal(0))
 		})
 
+		It("should allow limiting the number of Shoots and workers", func() {
+			quota.Spec.Metrics["shoots"] = resource.MustParse("10")
+			quota.Spec.Metrics["workers"] = resource.MustParse("50")
+
+			errorList := ValidateQuota(quota)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid a negative maximum lifetime extension", func() {
+			days := -1
+			quota.Spec.MaxClusterLifetimeExtensionDays = &days
+
+			errorList := ValidateQuota(quota)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.maxClusterLifetimeExtensionDays"),
+			}))))
+		})
+
 		It("should forbid Quota specification with empty or invalid keys", func() {
 			quota.ObjectMeta = metav1.ObjectMeta{}
 			quota.Spec.Scope = garden.QuotaScope("does-not-exist")
@@ -2252,6 +2510,9 @@ var _ = Describe("validation", func() {
 				ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
 					"Type": Equal(field.ErrorTypeDuplicate),
 				})))),
+			Entry("same start and end value in schedules of different locations",
+				[]garden.HibernationSchedule{{Start: makeStringPointer("1 * * * *"), End: makeStringPointer("2 * * * *")}, {Start: makeStringPointer("1 * * * *"), End: makeStringPointer("2 * * * *"), Location: makeStringPointer("Europe/Berlin")}},
+				BeEmpty()),
 			Entry("invalid schedule",
 				[]garden.HibernationSchedule{{Start: makeStringPointer("foo"), End: makeStringPointer("* * * * *")}},
 				ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
@@ -2329,6 +2590,22 @@ var _ = Describe("validation", func() {
 						"Field": Equal(field.NewPath("end").String()),
 					})),
 				)),
+			Entry("valid location and excluded dates", sets.NewString(), &garden.HibernationSchedule{Start: makeStringPointer("* * * * *"), Location: makeStringPointer("Europe/Berlin"), ExcludeDates: []string{"2019-12-24", "2019-12-25"}}, BeEmpty()),
+			Entry("invalid location", sets.NewString(), &garden.HibernationSchedule{Start: makeStringPointer("* * * * *"), Location: makeStringPointer("Foo/Bar")}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal(field.NewPath("location").String()),
+			})))),
+			Entry("invalid and duplicate excluded dates", sets.NewString(), &garden.HibernationSchedule{Start: makeStringPointer("* * * * *"), ExcludeDates: []string{"24.12.2019", "2019-12-25", "2019-12-25"}},
+				ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal(field.NewPath("excludeDates").Index(0).String()),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal(field.NewPath("excludeDates").Index(2).String()),
+					})),
+				)),
 		)
 	})
 
@@ -2545,6 +2822,111 @@ var _ = Describe("validation", func() {
 			}))
 		})
 
+		It("should allow rotating a supported class of credentials", func() {
+			shoot.Annotations = map[string]string{common.ShootOperation: "rotate-ssh-keypair"}
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid rotating an unsupported class of credentials", func() {
+			shoot.Annotations = map[string]string{common.ShootOperation: "rotate-ca-kubelet"}
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(errorList).To(HaveLen(1))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("metadata.annotations[shoot.garden.sapcloud.io/operation]"),
+			}))
+		})
+
+		It("should allow supported certificate key algorithms", func() {
+			shoot.Annotations = map[string]string{common.ShootCertificateKeyAlgorithm: "ECDSA-P256"}
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid unsupported certificate key algorithms", func() {
+			shoot.Annotations = map[string]string{common.ShootCertificateKeyAlgorithm: "RSA-1024"}
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(errorList).To(HaveLen(1))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("metadata.annotations[shoot.garden.sapcloud.io/certificate-key-algorithm]"),
+			}))
+		})
+
+		It("should allow positive certificate validities", func() {
+			shoot.Annotations = map[string]string{
+				common.ShootCACertificateValidity:       "87600h",
+				common.ShootCertificateValidity:         "8760h",
+				common.ShootCertificateRenewalThreshold: "720h",
+			}
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid invalid certificate validities", func() {
+			shoot.Annotations = map[string]string{
+				common.ShootCACertificateValidity:       "foo",
+				common.ShootCertificateValidity:         "0s",
+				common.ShootCertificateRenewalThreshold: "-1h",
+			}
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("metadata.annotations[shoot.garden.sapcloud.io/ca-certificate-validity]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("metadata.annotations[shoot.garden.sapcloud.io/certificate-validity]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("metadata.annotations[shoot.garden.sapcloud.io/certificate-renewal-threshold]"),
+				})),
+			))
+		})
+
+		It("should forbid renewal thresholds which are not less than the certificate validity", func() {
+			shoot.Annotations = map[string]string{
+				common.ShootCertificateValidity:         "720h",
+				common.ShootCertificateRenewalThreshold: "720h",
+			}
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(errorList).To(HaveLen(1))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("metadata.annotations[shoot.garden.sapcloud.io/certificate-renewal-threshold]"),
+			}))
+		})
+
+		It("should not validate unchanged annotations on updates", func() {
+			shoot.Annotations = map[string]string{
+				common.ShootCertificateKeyAlgorithm: "RSA-1024",
+				common.ShootCertificateValidity:     "foo",
+			}
+			newShoot := prepareShootForUpdate(shoot)
+			newShoot.Finalizers = nil
+
+			errorList := ValidateShootUpdate(newShoot, shoot)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
 		It("should forbid empty Shoot resources", func() {
 			shoot := &garden.Shoot{
 				ObjectMeta: metav1.ObjectMeta{},
@@ -4813,6 +5195,51 @@ var _ = Describe("validation", func() {
 			})
 		})
 
+		Context("compatibility validation", func() {
+			It("should forbid feature gates, admission plugins and APIs which are not supported by the Kubernetes version", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.FeatureGates = map[string]bool{"PodPriority": true, "NodeLease": true}
+				shoot.Spec.Kubernetes.KubeAPIServer.RuntimeConfig = map[string]bool{"scheduling.k8s.io/v1": true}
+				shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins = append(shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins, garden.AdmissionPlugin{Name: "TaintNodesByCondition"})
+				shoot.Spec.Kubernetes.Kubelet = &garden.KubeletConfig{
+					KubernetesConfig: garden.KubernetesConfig{
+						FeatureGates: map[string]bool{"Foo": true},
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.featureGates[NodeLease]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.runtimeConfig[scheduling.k8s.io/v1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.admissionPlugins[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.kubernetes.kubelet.featureGates[Foo]"),
+					})),
+				))
+			})
+
+			It("should allow them for a Kubernetes version supporting them", func() {
+				shoot.Spec.Kubernetes.Version = "1.14.1"
+				shoot.Spec.Kubernetes.KubeControllerManager = nil
+				shoot.Spec.Kubernetes.KubeAPIServer.FeatureGates = map[string]bool{"NodeLease": true}
+				shoot.Spec.Kubernetes.KubeAPIServer.RuntimeConfig = map[string]bool{"scheduling.k8s.io/v1": true}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+		})
+
 		Context("KubeControllerManager validation < 1.12", func() {
 			It("should forbid unsupported HPA configuration", func() {
 				shoot.Spec.Kubernetes.KubeControllerManager.HorizontalPodAutoscalerConfig.SyncPeriod = makeDurationPointer(100 * time.Millisecond)
@@ -4996,6 +5423,67 @@ var _ = Describe("validation", func() {
 				}))
 			})
 
+			It("should forbid unsupported machine image vulnerability severities", func() {
+				severity := garden.VulnerabilitySeverity("Severe")
+				shoot.Spec.Maintenance.AutoUpdate.MachineImageVulnerabilitySeverity = &severity
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(1))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.maintenance.autoUpdate.machineImageVulnerabilitySeverity"),
+				}))
+			})
+
+			It("should allow supported maintenance operations", func() {
+				shoot.Spec.Maintenance.Operations = []string{"restart-control-plane", "rotate-ssh-keypair"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid unsupported and duplicate maintenance operations", func() {
+				shoot.Spec.Maintenance.Operations = []string{"restart-control-plane", "rotate-ca", "restart-control-plane"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.maintenance.operations[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.maintenance.operations[2]"),
+					})),
+				))
+			})
+
+			It("should forbid rotating more than one class of credentials per maintenance", func() {
+				shoot.Spec.Maintenance.Operations = []string{"rotate-ssh-keypair", "rotate-observability-credentials"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.maintenance.operations[1]"),
+				}))))
+			})
+
+			It("should forbid unsupported Kubernetes version policies", func() {
+				policy := garden.KubernetesVersionPolicy("major")
+				shoot.Spec.Maintenance.AutoUpdate.KubernetesVersionPolicy = &policy
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.maintenance.autoUpdate.kubernetesVersionPolicy"),
+				}))))
+			})
+
 			It("should forbid not specifying the auto update section", func() {
 				shoot.Spec.Maintenance.AutoUpdate = nil
 
@@ -1016,6 +1016,7 @@ func (in *CloudProfile) DeepCopyInto(out *CloudProfile) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -1108,6 +1109,13 @@ func (in *CloudProfileSpec) DeepCopyInto(out *CloudProfileSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RegionAffinity != nil {
+		in, out := &in.RegionAffinity, &out.RegionAffinity
+		*out = make([]RegionAffinityGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1121,6 +1129,29 @@ func (in *CloudProfileSpec) DeepCopy() *CloudProfileSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudProfileStatus) DeepCopyInto(out *CloudProfileStatus) {
+	*out = *in
+	if in.MachineImages != nil {
+		in, out := &in.MachineImages, &out.MachineImages
+		*out = make([]MachineImageStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudProfileStatus.
+func (in *CloudProfileStatus) DeepCopy() *CloudProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterAutoscaler) DeepCopyInto(out *ClusterAutoscaler) {
 	*out = *in
@@ -1156,6 +1187,30 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialsRotation) DeepCopyInto(out *CredentialsRotation) {
+	*out = *in
+	if in.LastInitiationTime != nil {
+		in, out := &in.LastInitiationTime, &out.LastInitiationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastCompletionTime != nil {
+		in, out := &in.LastCompletionTime, &out.LastCompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialsRotation.
+func (in *CredentialsRotation) DeepCopy() *CredentialsRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialsRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNS) DeepCopyInto(out *DNS) {
 	*out = *in
@@ -1454,6 +1509,27 @@ func (in *Hibernation) DeepCopy() *Hibernation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationMonthStatistics) DeepCopyInto(out *HibernationMonthStatistics) {
+	*out = *in
+	if in.EstimatedSavings != nil {
+		in, out := &in.EstimatedSavings, &out.EstimatedSavings
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationMonthStatistics.
+func (in *HibernationMonthStatistics) DeepCopy() *HibernationMonthStatistics {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationMonthStatistics)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HibernationSchedule) DeepCopyInto(out *HibernationSchedule) {
 	*out = *in
@@ -1467,6 +1543,16 @@ func (in *HibernationSchedule) DeepCopyInto(out *HibernationSchedule) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExcludeDates != nil {
+		in, out := &in.ExcludeDates, &out.ExcludeDates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1480,6 +1566,33 @@ func (in *HibernationSchedule) DeepCopy() *HibernationSchedule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationStatistics) DeepCopyInto(out *HibernationStatistics) {
+	*out = *in
+	if in.HibernatedSince != nil {
+		in, out := &in.HibernatedSince, &out.HibernatedSince
+		*out = (*in).DeepCopy()
+	}
+	if in.Months != nil {
+		in, out := &in.Months, &out.Months
+		*out = make([]HibernationMonthStatistics, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationStatistics.
+func (in *HibernationStatistics) DeepCopy() *HibernationStatistics {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationStatistics)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HorizontalPodAutoscalerConfig) DeepCopyInto(out *HorizontalPodAutoscalerConfig) {
 	*out = *in
@@ -1813,6 +1926,13 @@ func (in *KubernetesConstraints) DeepCopyInto(out *KubernetesConstraints) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MinorVersionExpirations != nil {
+		in, out := &in.MinorVersionExpirations, &out.MinorVersionExpirations
+		*out = make([]KubernetesMinorVersionExpiration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1843,6 +1963,23 @@ func (in *KubernetesDashboard) DeepCopy() *KubernetesDashboard {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesMinorVersionExpiration) DeepCopyInto(out *KubernetesMinorVersionExpiration) {
+	*out = *in
+	in.ExpirationDate.DeepCopyInto(&out.ExpirationDate)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesMinorVersionExpiration.
+func (in *KubernetesMinorVersionExpiration) DeepCopy() *KubernetesMinorVersionExpiration {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesMinorVersionExpiration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LastError) DeepCopyInto(out *LastError) {
 	*out = *in
@@ -1958,6 +2095,55 @@ func (in *LocalProfile) DeepCopy() *LocalProfile {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImageStatus) DeepCopyInto(out *MachineImageStatus) {
+	*out = *in
+	if in.Vulnerabilities != nil {
+		in, out := &in.Vulnerabilities, &out.Vulnerabilities
+		*out = make([]MachineImageVulnerability, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageStatus.
+func (in *MachineImageStatus) DeepCopy() *MachineImageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImageVulnerability) DeepCopyInto(out *MachineImageVulnerability) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageVulnerability.
+func (in *MachineImageVulnerability) DeepCopy() *MachineImageVulnerability {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImageVulnerability)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineType) DeepCopyInto(out *MachineType) {
 	*out = *in
@@ -1969,6 +2155,11 @@ func (in *MachineType) DeepCopyInto(out *MachineType) {
 	out.CPU = in.CPU.DeepCopy()
 	out.GPU = in.GPU.DeepCopy()
 	out.Memory = in.Memory.DeepCopy()
+	if in.Price != nil {
+		in, out := &in.Price, &out.Price
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	return
 }
 
@@ -1988,13 +2179,18 @@ func (in *Maintenance) DeepCopyInto(out *Maintenance) {
 	if in.AutoUpdate != nil {
 		in, out := &in.AutoUpdate, &out.AutoUpdate
 		*out = new(MaintenanceAutoUpdate)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.TimeWindow != nil {
 		in, out := &in.TimeWindow, &out.TimeWindow
 		*out = new(MaintenanceTimeWindow)
 		**out = **in
 	}
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2011,6 +2207,16 @@ func (in *Maintenance) DeepCopy() *Maintenance {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MaintenanceAutoUpdate) DeepCopyInto(out *MaintenanceAutoUpdate) {
 	*out = *in
+	if in.MachineImageVulnerabilitySeverity != nil {
+		in, out := &in.MachineImageVulnerabilitySeverity, &out.MachineImageVulnerabilitySeverity
+		*out = new(VulnerabilitySeverity)
+		**out = **in
+	}
+	if in.KubernetesVersionPolicy != nil {
+		in, out := &in.KubernetesVersionPolicy, &out.KubernetesVersionPolicy
+		*out = new(KubernetesVersionPolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -2383,13 +2589,29 @@ func (in *OpenStackWorker) DeepCopy() *OpenStackWorker {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlannedChange) DeepCopyInto(out *PlannedChange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlannedChange.
+func (in *PlannedChange) DeepCopy() *PlannedChange {
+	if in == nil {
+		return nil
+	}
+	out := new(PlannedChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Project) DeepCopyInto(out *Project) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -2444,6 +2666,57 @@ func (in *ProjectList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectMember) DeepCopyInto(out *ProjectMember) {
+	*out = *in
+	out.Subject = in.Subject
+	if in.ExpirationTimestamp != nil {
+		in, out := &in.ExpirationTimestamp, &out.ExpirationTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectMember.
+func (in *ProjectMember) DeepCopy() *ProjectMember {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectQuotaStatus) DeepCopyInto(out *ProjectQuotaStatus) {
+	*out = *in
+	if in.Hard != nil {
+		in, out := &in.Hard, &out.Hard
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Used != nil {
+		in, out := &in.Used, &out.Used
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectQuotaStatus.
+func (in *ProjectQuotaStatus) DeepCopy() *ProjectQuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectQuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 	*out = *in
@@ -2469,14 +2742,21 @@ func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 	}
 	if in.Members != nil {
 		in, out := &in.Members, &out.Members
-		*out = make([]rbacv1.Subject, len(*in))
-		copy(*out, *in)
+		*out = make([]ProjectMember, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Namespace != nil {
 		in, out := &in.Namespace, &out.Namespace
 		*out = new(string)
 		**out = **in
 	}
+	if in.Quotas != nil {
+		in, out := &in.Quotas, &out.Quotas
+		*out = make([]v1.ObjectReference, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2493,6 +2773,19 @@ func (in *ProjectSpec) DeepCopy() *ProjectSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 	*out = *in
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(ProjectQuotaStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastActivityTimestamp != nil {
+		in, out := &in.LastActivityTimestamp, &out.LastActivityTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.StaleSinceTimestamp != nil {
+		in, out := &in.StaleSinceTimestamp, &out.StaleSinceTimestamp
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -2574,6 +2867,11 @@ func (in *QuotaSpec) DeepCopyInto(out *QuotaSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.MaxClusterLifetimeExtensionDays != nil {
+		in, out := &in.MaxClusterLifetimeExtensionDays, &out.MaxClusterLifetimeExtensionDays
+		*out = new(int)
+		**out = **in
+	}
 	if in.Metrics != nil {
 		in, out := &in.Metrics, &out.Metrics
 		*out = make(v1.ResourceList, len(*in))
@@ -2594,6 +2892,27 @@ func (in *QuotaSpec) DeepCopy() *QuotaSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionAffinityGroup) DeepCopyInto(out *RegionAffinityGroup) {
+	*out = *in
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionAffinityGroup.
+func (in *RegionAffinityGroup) DeepCopy() *RegionAffinityGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionAffinityGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretBinding) DeepCopyInto(out *SecretBinding) {
 	*out = *in
@@ -2703,6 +3022,26 @@ func (in *SeedCloud) DeepCopy() *SeedCloud {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedExtension) DeepCopyInto(out *SeedExtension) {
+	*out = *in
+	if in.LastHeartbeatTime != nil {
+		in, out := &in.LastHeartbeatTime, &out.LastHeartbeatTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedExtension.
+func (in *SeedExtension) DeepCopy() *SeedExtension {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedExtension)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedList) DeepCopyInto(out *SeedList) {
 	*out = *in
@@ -2791,6 +3130,18 @@ func (in *SeedStatus) DeepCopyInto(out *SeedStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Extensions != nil {
+		in, out := &in.Extensions, &out.Extensions
+		*out = make([]SeedExtension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Utilization != nil {
+		in, out := &in.Utilization, &out.Utilization
+		*out = new(SeedUtilization)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2804,6 +3155,43 @@ func (in *SeedStatus) DeepCopy() *SeedStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedUtilization) DeepCopyInto(out *SeedUtilization) {
+	*out = *in
+	if in.ShootsByPurpose != nil {
+		in, out := &in.ShootsByPurpose, &out.ShootsByPurpose
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Allocatable != nil {
+		in, out := &in.Allocatable, &out.Allocatable
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Requested != nil {
+		in, out := &in.Requested, &out.Requested
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedUtilization.
+func (in *SeedUtilization) DeepCopy() *SeedUtilization {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedUtilization)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Shoot) DeepCopyInto(out *Shoot) {
 	*out = *in
@@ -2865,6 +3253,28 @@ func (in *ShootList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootPlan) DeepCopyInto(out *ShootPlan) {
+	*out = *in
+	in.ComputedTime.DeepCopyInto(&out.ComputedTime)
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]PlannedChange, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootPlan.
+func (in *ShootPlan) DeepCopy() *ShootPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
 	*out = *in
@@ -2929,6 +3339,28 @@ func (in *ShootStatus) DeepCopyInto(out *ShootStatus) {
 		in, out := &in.RetryCycleStartTime, &out.RetryCycleStartTime
 		*out = (*in).DeepCopy()
 	}
+	if in.CredentialsRotations != nil {
+		in, out := &in.CredentialsRotations, &out.CredentialsRotations
+		*out = make([]CredentialsRotation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(ShootPlan)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenancePlan != nil {
+		in, out := &in.MaintenancePlan, &out.MaintenancePlan
+		*out = new(ShootPlan)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HibernationStatistics != nil {
+		in, out := &in.HibernationStatistics, &out.HibernationStatistics
+		*out = new(HibernationStatistics)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2950,6 +3382,11 @@ func (in *VolumeType) DeepCopyInto(out *VolumeType) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Price != nil {
+		in, out := &in.Price, &out.Price
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	return
 }
 
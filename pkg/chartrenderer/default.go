@@ -149,7 +149,8 @@ func (r *DefaultChartRenderer) renderResources(ch *chartapi.Chart, values chartu
 	}
 
 	return &RenderedChart{
-		ChartName: ch.Metadata.Name,
-		Files:     files,
+		ChartName:    ch.Metadata.Name,
+		ChartVersion: ch.Metadata.Version,
+		Files:        files,
 	}, nil
 }
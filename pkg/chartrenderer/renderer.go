@@ -23,6 +23,7 @@ type ChartRenderer interface {
 // RenderedChart holds a map of rendered templates file with template file name as key and
 // rendered template as value.
 type RenderedChart struct {
-	ChartName string
-	Files     map[string]string
+	ChartName    string
+	ChartVersion string
+	Files        map[string]string
 }
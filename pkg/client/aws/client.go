@@ -16,6 +16,7 @@ package aws
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -26,6 +27,9 @@ import (
 	"github.com/aws/aws-sdk-go/service/sts"
 )
 
+// accountAttributeMaxInstances is the name of the EC2 account attribute holding the maximum number of instances.
+const accountAttributeMaxInstances = "max-instances"
+
 // NewClient creates a new Client for the given AWS credentials <accessKeyID>, <secretAccessKey>, and
 // the AWS region <region>.
 // It initializes the clients for the various services like EC2, ELB, etc.
@@ -54,6 +58,53 @@ func (c *Client) GetAccountID() (string, error) {
 	return *getCallerIdentityOutput.Account, nil
 }
 
+// GetMaxInstances returns the maximum number of instances the AWS account may run in the region of the Client.
+func (c *Client) GetMaxInstances() (int, error) {
+	describeAccountAttributesOutput, err := c.EC2.DescribeAccountAttributes(&ec2.DescribeAccountAttributesInput{
+		AttributeNames: []*string{aws.String(accountAttributeMaxInstances)},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, attribute := range describeAccountAttributesOutput.AccountAttributes {
+		if attribute.AttributeName == nil || *attribute.AttributeName != accountAttributeMaxInstances {
+			continue
+		}
+		for _, value := range attribute.AttributeValues {
+			if value.AttributeValue != nil {
+				return strconv.Atoi(*value.AttributeValue)
+			}
+		}
+	}
+	return 0, fmt.Errorf("account attribute %s not found", accountAttributeMaxInstances)
+}
+
+// CountInstances returns the number of pending or running instances in the region of the Client.
+func (c *Client) CountInstances() (int, error) {
+	var (
+		count                  = 0
+		describeInstancesInput = &ec2.DescribeInstancesInput{
+			Filters: []*ec2.Filter{
+				{
+					Name:   aws.String("instance-state-name"),
+					Values: []*string{aws.String(ec2.InstanceStateNamePending), aws.String(ec2.InstanceStateNameRunning)},
+				},
+			},
+		}
+	)
+
+	if err := c.EC2.DescribeInstancesPages(describeInstancesInput, func(page *ec2.DescribeInstancesOutput, _ bool) bool {
+		for _, reservation := range page.Reservations {
+			count += len(reservation.Instances)
+		}
+		return true
+	}); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 // GetInternetGateway returns the ID of the internet gateway attached to the given VPC <vpcID>.
 // If there is no internet gateway attached, the returned string will be empty.
 func (c *Client) GetInternetGateway(vpcID string) (string, error) {
@@ -24,6 +24,8 @@ import (
 type ClientInterface interface {
 	GetAccountID() (string, error)
 	GetInternetGateway(string) (string, error)
+	GetMaxInstances() (int, error)
+	CountInstances() (int, error)
 
 	// The following functions are only temporary needed due to https://github.com/gardener/gardener/issues/129.
 	ListKubernetesELBs(vpcID, clusterName string) ([]string, error)
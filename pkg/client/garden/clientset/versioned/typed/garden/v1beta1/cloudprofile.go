@@ -23,6 +23,7 @@ type CloudProfilesGetter interface {
 type CloudProfileInterface interface {
 	Create(*v1beta1.CloudProfile) (*v1beta1.CloudProfile, error)
 	Update(*v1beta1.CloudProfile) (*v1beta1.CloudProfile, error)
+	UpdateStatus(*v1beta1.CloudProfile) (*v1beta1.CloudProfile, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
 	Get(name string, options v1.GetOptions) (*v1beta1.CloudProfile, error)
@@ -109,6 +110,21 @@ func (c *cloudProfiles) Update(cloudProfile *v1beta1.CloudProfile) (result *v1be
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *cloudProfiles) UpdateStatus(cloudProfile *v1beta1.CloudProfile) (result *v1beta1.CloudProfile, err error) {
+	result = &v1beta1.CloudProfile{}
+	err = c.client.Put().
+		Resource("cloudprofiles").
+		Name(cloudProfile.Name).
+		SubResource("status").
+		Body(cloudProfile).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete takes name of the cloudProfile and deletes it. Returns an error if one occurs.
 func (c *cloudProfiles) Delete(name string, options *v1.DeleteOptions) error {
 	return c.client.Delete().
@@ -78,6 +78,17 @@ func (c *FakeCloudProfiles) Update(cloudProfile *v1beta1.CloudProfile) (result *
 	return obj.(*v1beta1.CloudProfile), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeCloudProfiles) UpdateStatus(cloudProfile *v1beta1.CloudProfile) (*v1beta1.CloudProfile, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(cloudprofilesResource, "status", cloudProfile), &v1beta1.CloudProfile{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.CloudProfile), err
+}
+
 // Delete takes name of the cloudProfile and deletes it. Returns an error if one occurs.
 func (c *FakeCloudProfiles) Delete(name string, options *v1.DeleteOptions) error {
 	_, err := c.Fake.
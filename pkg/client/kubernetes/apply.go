@@ -131,6 +131,13 @@ var DefaultApplierOptions = ApplierOptions{
 			newObj.Object["spec"].(map[string]interface{})["clusterIP"] = oldObj.Object["spec"].(map[string]interface{})["clusterIP"]
 			newObj.Object["spec"].(map[string]interface{})["ports"] = ports
 		},
+		"Deployment": func(newObj, oldObj *unstructured.Unstructured) {
+			// We do not want to restart the pods of a Deployment again only because its restart marker is not part of the manifest.
+			fields := []string{"spec", "template", "metadata", "annotations", AnnotationRestartedAt}
+			if restartedAt, ok, _ := unstructured.NestedString(oldObj.Object, fields...); ok {
+				unstructured.SetNestedField(newObj.Object, restartedAt, fields...)
+			}
+		},
 		"ServiceAccount": func(newObj, oldObj *unstructured.Unstructured) {
 			// We do not want to overwrite a ServiceAccount's `.secrets[]` list or `.imagePullSecrets[]`.
 			newObj.Object["secrets"] = oldObj.Object["secrets"]
@@ -25,6 +25,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -240,6 +241,41 @@ spec:
 				Expect(resultingService.Secrets[0].Name).To(Equal("test-secret"))
 			})
 
+			It("should retain the restart marker of deployments", func() {
+				oldDeployment := appsv1.Deployment{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Deployment",
+						APIVersion: "apps/v1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-deployment",
+						Namespace: "test-ns",
+					},
+					Spec: appsv1.DeploymentSpec{
+						Template: corev1.PodTemplateSpec{
+							ObjectMeta: metav1.ObjectMeta{
+								Annotations: map[string]string{kubernetes.AnnotationRestartedAt: "2019-05-01T10:00:00Z"},
+							},
+						},
+					},
+				}
+				newDeployment := oldDeployment
+				newDeployment.Spec.Template.Annotations = map[string]string{"checksum/secret": "abc"}
+				manifest := mkManifest(&newDeployment)
+				manifestReader := kubernetes.NewManifestReader(manifest)
+
+				c.Create(context.TODO(), &oldDeployment)
+				Expect(applier.ApplyManifest(context.TODO(), manifestReader, kubernetes.DefaultApplierOptions)).To(BeNil())
+
+				resultingDeployment := &appsv1.Deployment{}
+				err := c.Get(context.TODO(), client.ObjectKey{Name: "test-deployment", Namespace: "test-ns"}, resultingDeployment)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resultingDeployment.Spec.Template.Annotations).To(Equal(map[string]string{
+					"checksum/secret":                "abc",
+					kubernetes.AnnotationRestartedAt: "2019-05-01T10:00:00Z",
+				}))
+			})
+
 			It("should create objects with namespace", func() {
 				cm := corev1.ConfigMap{
 					TypeMeta:   configMapTypeMeta,
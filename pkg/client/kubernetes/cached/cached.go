@@ -0,0 +1,203 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cached
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardencoreclientset "github.com/gardener/gardener/pkg/client/core/clientset/versioned"
+	gardenclientset "github.com/gardener/gardener/pkg/client/garden/clientset/versioned"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/pager"
+)
+
+const (
+	// DefaultMaxStaleness is the default duration for which a cached list result is served before it is
+	// fetched again from the Garden cluster.
+	DefaultMaxStaleness = 30 * time.Second
+	// DefaultPageSize is the default number of objects requested per page when listing from the Garden cluster.
+	DefaultPageSize int64 = 500
+)
+
+// ListFunc lists a single page of objects for the given list options.
+type ListFunc func(opts metav1.ListOptions) (runtime.Object, error)
+
+// ListAll lists all objects using the given <listFunc> in chunks of <pageSize> objects. It falls back to a
+// full list if the continue token expired while paging.
+func ListAll(listFunc ListFunc, pageSize int64) ([]runtime.Object, error) {
+	p := pager.New(pager.SimplePageFunc(listFunc))
+	if pageSize > 0 {
+		p.PageSize = pageSize
+	}
+
+	list, err := p.List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var items []runtime.Object
+	if err := meta.EachListItem(list, func(obj runtime.Object) error {
+		items = append(items, obj)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+type entry struct {
+	items     []runtime.Object
+	fetchedAt time.Time
+}
+
+// GardenClient serves list requests for Garden cluster resources from a cache. A cached result is reused
+// for at most <maxStaleness>, afterwards it is fetched again from the Garden cluster using paginated LIST
+// calls. The returned objects are shared between callers and must not be mutated.
+type GardenClient struct {
+	garden     gardenclientset.Interface
+	gardenCore gardencoreclientset.Interface
+
+	maxStaleness time.Duration
+	pageSize     int64
+	now          func() time.Time
+
+	lock    sync.Mutex
+	entries map[string]*entry
+}
+
+// NewGardenClient creates a new GardenClient for the given clientsets. A <maxStaleness> or <pageSize> of
+// zero means that DefaultMaxStaleness or DefaultPageSize is used, respectively.
+func NewGardenClient(garden gardenclientset.Interface, gardenCore gardencoreclientset.Interface, maxStaleness time.Duration, pageSize int64) *GardenClient {
+	if maxStaleness == 0 {
+		maxStaleness = DefaultMaxStaleness
+	}
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+
+	return &GardenClient{
+		garden:       garden,
+		gardenCore:   gardenCore,
+		maxStaleness: maxStaleness,
+		pageSize:     pageSize,
+		now:          time.Now,
+		entries:      make(map[string]*entry),
+	}
+}
+
+// Shoots returns all Shoots in the given <namespace>. An empty namespace lists Shoots in all namespaces.
+func (c *GardenClient) Shoots(namespace string) ([]*gardenv1beta1.Shoot, error) {
+	items, err := c.list("shoots/"+namespace, func(opts metav1.ListOptions) (runtime.Object, error) {
+		return c.garden.GardenV1beta1().Shoots(namespace).List(opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	shoots := make([]*gardenv1beta1.Shoot, 0, len(items))
+	for _, item := range items {
+		shoot, ok := item.(*gardenv1beta1.Shoot)
+		if !ok {
+			return nil, fmt.Errorf("unexpected object type %T in shoot list", item)
+		}
+		shoots = append(shoots, shoot)
+	}
+	return shoots, nil
+}
+
+// Seeds returns all Seeds.
+func (c *GardenClient) Seeds() ([]*gardenv1beta1.Seed, error) {
+	items, err := c.list("seeds", func(opts metav1.ListOptions) (runtime.Object, error) {
+		return c.garden.GardenV1beta1().Seeds().List(opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	seeds := make([]*gardenv1beta1.Seed, 0, len(items))
+	for _, item := range items {
+		seed, ok := item.(*gardenv1beta1.Seed)
+		if !ok {
+			return nil, fmt.Errorf("unexpected object type %T in seed list", item)
+		}
+		seeds = append(seeds, seed)
+	}
+	return seeds, nil
+}
+
+// ControllerInstallations returns all ControllerInstallations.
+func (c *GardenClient) ControllerInstallations() ([]*gardencorev1alpha1.ControllerInstallation, error) {
+	items, err := c.list("controllerinstallations", func(opts metav1.ListOptions) (runtime.Object, error) {
+		return c.gardenCore.CoreV1alpha1().ControllerInstallations().List(opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	controllerInstallations := make([]*gardencorev1alpha1.ControllerInstallation, 0, len(items))
+	for _, item := range items {
+		controllerInstallation, ok := item.(*gardencorev1alpha1.ControllerInstallation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected object type %T in controller installation list", item)
+		}
+		controllerInstallations = append(controllerInstallations, controllerInstallation)
+	}
+	return controllerInstallations, nil
+}
+
+// InvalidateControllerInstallations drops the cached ControllerInstallations so that the next read is
+// served from the Garden cluster. It should be called after ControllerInstallations have been written.
+func (c *GardenClient) InvalidateControllerInstallations() {
+	c.invalidate("controllerinstallations")
+}
+
+// Invalidate drops all cached results.
+func (c *GardenClient) Invalidate() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.entries = make(map[string]*entry)
+}
+
+func (c *GardenClient) invalidate(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.entries, key)
+}
+
+func (c *GardenClient) list(key string, listFunc ListFunc) ([]runtime.Object, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := c.now()
+	if e, ok := c.entries[key]; ok && now.Sub(e.fetchedAt) < c.maxStaleness {
+		return e.items, nil
+	}
+
+	items, err := ListAll(listFunc, c.pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	c.entries[key] = &entry{items: items, fetchedAt: now}
+	return items, nil
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cached_test
+
+import (
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardencorefake "github.com/gardener/gardener/pkg/client/core/clientset/versioned/fake"
+	gardenfake "github.com/gardener/gardener/pkg/client/garden/clientset/versioned/fake"
+	. "github.com/gardener/gardener/pkg/client/kubernetes/cached"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	testing "k8s.io/client-go/testing"
+)
+
+var _ = Describe("Cached", func() {
+	Describe("#ListAll", func() {
+		It("should follow the continue tokens and return all items", func() {
+			var (
+				calls int
+				pages = []*gardenv1beta1.ShootList{
+					{
+						ListMeta: metav1.ListMeta{Continue: "page-2"},
+						Items:    []gardenv1beta1.Shoot{{ObjectMeta: metav1.ObjectMeta{Name: "a"}}, {ObjectMeta: metav1.ObjectMeta{Name: "b"}}},
+					},
+					{
+						Items: []gardenv1beta1.Shoot{{ObjectMeta: metav1.ObjectMeta{Name: "c"}}},
+					},
+				}
+			)
+
+			items, err := ListAll(func(opts metav1.ListOptions) (runtime.Object, error) {
+				Expect(opts.Limit).To(Equal(int64(2)))
+				if calls == 1 {
+					Expect(opts.Continue).To(Equal("page-2"))
+				}
+				page := pages[calls]
+				calls++
+				return page, nil
+			}, 2)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(calls).To(Equal(2))
+			Expect(items).To(HaveLen(3))
+			Expect(items[2].(*gardenv1beta1.Shoot).Name).To(Equal("c"))
+		})
+	})
+
+	Describe("GardenClient", func() {
+		var (
+			gardenClient     *gardenfake.Clientset
+			gardenCoreClient *gardencorefake.Clientset
+			client           *GardenClient
+			now              time.Time
+			listCalls        map[string]int
+		)
+
+		BeforeEach(func() {
+			gardenClient = gardenfake.NewSimpleClientset(
+				&gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-dev"}},
+				&gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-prod"}},
+				&gardenv1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed"}},
+			)
+			gardenCoreClient = gardencorefake.NewSimpleClientset(
+				&gardencorev1alpha1.ControllerInstallation{ObjectMeta: metav1.ObjectMeta{Name: "installation"}},
+			)
+
+			listCalls = map[string]int{}
+			countLists := func(action testing.Action) (bool, runtime.Object, error) {
+				listCalls[action.GetResource().Resource]++
+				return false, nil, nil
+			}
+			gardenClient.PrependReactor("list", "*", countLists)
+			gardenCoreClient.PrependReactor("list", "*", countLists)
+
+			now = time.Now()
+			client = NewGardenClient(gardenClient, gardenCoreClient, time.Minute, 0)
+			SetNow(client, func() time.Time { return now })
+		})
+
+		It("should list shoots in the given namespace", func() {
+			shoots, err := client.Shoots("garden-dev")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shoots).To(HaveLen(1))
+			Expect(shoots[0].Name).To(Equal("foo"))
+		})
+
+		It("should list shoots in all namespaces", func() {
+			shoots, err := client.Shoots(metav1.NamespaceAll)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shoots).To(HaveLen(2))
+		})
+
+		It("should serve repeated reads from the cache until it is stale", func() {
+			seeds, err := client.Seeds()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(seeds).To(HaveLen(1))
+
+			_, err = client.Seeds()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(listCalls["seeds"]).To(Equal(1))
+
+			now = now.Add(time.Minute)
+
+			_, err = client.Seeds()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(listCalls["seeds"]).To(Equal(2))
+		})
+
+		It("should read controller installations again after invalidation", func() {
+			_, err := client.ControllerInstallations()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = gardenCoreClient.CoreV1alpha1().ControllerInstallations().Create(&gardencorev1alpha1.ControllerInstallation{ObjectMeta: metav1.ObjectMeta{Name: "other"}})
+			Expect(err).NotTo(HaveOccurred())
+
+			installations, err := client.ControllerInstallations()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(installations).To(HaveLen(1))
+
+			client.InvalidateControllerInstallations()
+
+			installations, err = client.ControllerInstallations()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(installations).To(HaveLen(2))
+			Expect(listCalls["controllerinstallations"]).To(Equal(2))
+		})
+
+		It("should drop all cached results on invalidation", func() {
+			_, err := client.Shoots(metav1.NamespaceAll)
+			Expect(err).NotTo(HaveOccurred())
+
+			client.Invalidate()
+
+			_, err = client.Shoots(metav1.NamespaceAll)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(listCalls["shoots"]).To(Equal(2))
+		})
+	})
+})
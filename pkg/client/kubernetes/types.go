@@ -91,6 +91,10 @@ const (
 
 	// StatefulSets is a constant for a Kubernetes resource with the same name.
 	StatefulSets = "statefulsets"
+
+	// AnnotationRestartedAt is a constant for an annotation on the pod template of a Deployment. Changing its value
+	// restarts the pods of the Deployment. The Applier keeps the value of an existing Deployment.
+	AnnotationRestartedAt = "garden.sapcloud.io/restarted-at"
 )
 
 var (
@@ -44,8 +44,17 @@ type ControllerManagerConfiguration struct {
 	KubernetesLogLevel klog.Level
 	// Server defines the configuration of the HTTP server.
 	Server ServerConfiguration
+	// SeedSelector restricts the Seeds managed by this controller manager (and the Shoots and BackupInfrastructures
+	// on them) to those matching the selector. If not set, all Seeds are managed.
+	SeedSelector *metav1.LabelSelector
 	// ShootBackup contains configuration settings for the etcd backups.
 	ShootBackup *ShootBackup
+	// ShootCertificates contains configuration settings for the certificates generated for Shoot clusters.
+	ShootCertificates *ShootCertificates
+	// ShootTimeouts contains the timeouts of long-running operations for Shoot clusters.
+	ShootTimeouts *ShootTimeouts
+	// Images contains configuration settings for the container images deployed by Gardener.
+	Images *ImagesConfiguration
 	// FeatureGates is a map of feature names to bools that enable or disable alpha/experimental
 	// features. This field modifies piecemeal the built-in default values from
 	// "github.com/gardener/gardener/pkg/features/gardener_features.go".
@@ -60,12 +69,25 @@ type ControllerManagerControllerConfiguration struct {
 	// CloudProfile defines the configuration of the CloudProfile controller.
 	// +optional
 	CloudProfile *CloudProfileControllerConfiguration
+	// Compliance defines the configuration of the Compliance controller. The controller is only started if it is set.
+	// +optional
+	Compliance *ComplianceControllerConfiguration
+	// ConditionStaleness defines the configuration of the ConditionStaleness controller. The controller is only started
+	// if it is set.
+	// +optional
+	ConditionStaleness *ConditionStalenessControllerConfiguration
 	// ControllerRegistration defines the configuration of the ControllerRegistration controller.
 	// +optional
 	ControllerRegistration *ControllerRegistrationControllerConfiguration
 	// ControllerInstallation defines the configuration of the ControllerInstallation controller.
 	// +optional
 	ControllerInstallation *ControllerInstallationControllerConfiguration
+	// Export defines the configuration of the Export controller. The controller is only started if it is set.
+	// +optional
+	Export *ExportControllerConfiguration
+	// Federation defines the configuration of the Federation controller. The controller is only started if it is set.
+	// +optional
+	Federation *FederationControllerConfiguration
 	// SecretBinding defines the configuration of the SecretBinding controller.
 	// +optional
 	SecretBinding *SecretBindingControllerConfiguration
@@ -78,6 +100,9 @@ type ControllerManagerControllerConfiguration struct {
 	// Seed defines the configuration of the Seed controller.
 	// +optional
 	Seed *SeedControllerConfiguration
+	// SeedCordon defines the configuration of the SeedCordon controller. The controller is only started if it is set.
+	// +optional
+	SeedCordon *SeedCordonControllerConfiguration
 	// Shoot defines the configuration of the Shoot controller.
 	Shoot ShootControllerConfiguration
 	// ShootCare defines the configuration of the ShootCare controller.
@@ -98,6 +123,65 @@ type CloudProfileControllerConfiguration struct {
 	ConcurrentSyncs int
 }
 
+// ComplianceControllerConfiguration defines the configuration of the Compliance
+// controller.
+type ComplianceControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs int
+	// SyncPeriod is the duration how often all Shoots are evaluated and the
+	// compliance report is written.
+	SyncPeriod metav1.Duration
+	// Profile is the compliance profile the Shoots are evaluated against.
+	Profile ComplianceProfile
+}
+
+// ConditionStalenessControllerConfiguration defines the configuration of the
+// ConditionStaleness controller.
+type ConditionStalenessControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs int
+	// SyncPeriod is the duration how often the conditions of all Shoots and Seeds
+	// are checked.
+	SyncPeriod metav1.Duration
+	// Threshold is the duration after which a condition which has not been updated
+	// is considered stale. It must be greater than the sync periods of the ShootCare
+	// and Seed controllers.
+	Threshold metav1.Duration
+}
+
+// ComplianceProfile defines the rules Shoots have to satisfy in order to be
+// compliant. Rules which are not enabled are not evaluated.
+type ComplianceProfile struct {
+	// HighAvailability requires that all worker pools run at least two machines
+	// and, for cloud providers supporting zones, that the Shoot spans at least
+	// two zones.
+	// +optional
+	HighAvailability bool
+	// KubernetesVersion requires that the Kubernetes version of the Shoot is
+	// current with respect to the versions offered in its CloudProfile.
+	// +optional
+	KubernetesVersion *ComplianceKubernetesVersion
+	// AuditPolicy requires that the Shoot configures an audit policy for its
+	// kube-apiserver.
+	// +optional
+	AuditPolicy bool
+}
+
+// ComplianceKubernetesVersion defines when the Kubernetes version of a Shoot is
+// current.
+type ComplianceKubernetesVersion struct {
+	// LatestPatchVersion requires that the Shoot runs the latest patch version
+	// of its minor version which is offered in the CloudProfile.
+	// +optional
+	LatestPatchVersion bool
+	// MaxMinorVersionsBehind is the maximum number of newer minor versions which
+	// may be offered in the CloudProfile.
+	// +optional
+	MaxMinorVersionsBehind *int
+}
+
 // ControllerRegistrationControllerConfiguration defines the configuration of the
 // ControllerRegistration controller.
 type ControllerRegistrationControllerConfiguration struct {
@@ -114,6 +198,39 @@ type ControllerInstallationControllerConfiguration struct {
 	ConcurrentSyncs int
 }
 
+// ExportControllerConfiguration defines the configuration of the Export
+// controller.
+type ExportControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs int
+	// Directory is the directory into which the CloudProfiles, Seeds,
+	// ControllerRegistrations and Projects are exported as YAML files. It is
+	// typically a checkout of a Git repository or a volume backed by an object
+	// store, which is synchronized by a sidecar.
+	Directory string
+}
+
+// FederationControllerConfiguration defines the configuration of the Federation
+// controller.
+type FederationControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs int
+	// SourceKubeconfig is the path to a kubeconfig for the garden cluster which is
+	// the source of truth. The selected resources are mirrored from this garden
+	// into the garden the controller manager is running against.
+	SourceKubeconfig string
+	// Resources are the resources which are mirrored. Supported values are
+	// "cloudprofiles" and "controllerregistrations". Defaults to both.
+	// +optional
+	Resources []string
+	// Selector is a label selector for the objects which are mirrored. All objects
+	// are mirrored if it is not set.
+	// +optional
+	Selector *metav1.LabelSelector
+}
+
 // SecretBindingControllerConfiguration defines the configuration of the
 // SecretBinding controller.
 type SecretBindingControllerConfiguration struct {
@@ -128,6 +245,14 @@ type ProjectControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
 	// events.
 	ConcurrentSyncs int
+	// StaleAfter is the duration after which a project without Shoots and without
+	// activity is marked as stale. Projects are not checked if it is not set.
+	// +optional
+	StaleAfter *metav1.Duration
+	// StaleDeletionGracePeriod is the duration after which a stale project is deleted.
+	// Stale projects are not deleted automatically if it is not set.
+	// +optional
+	StaleDeletionGracePeriod *metav1.Duration
 }
 
 // QuotaControllerConfiguration defines the configuration of the Quota controller.
@@ -153,6 +278,27 @@ type SeedControllerConfiguration struct {
 	SyncPeriod metav1.Duration
 }
 
+// SeedCordonControllerConfiguration defines the configuration of the SeedCordon
+// controller.
+type SeedCordonControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs int
+	// SyncPeriod is the duration how often the failure rates of all Seeds are
+	// evaluated.
+	SyncPeriod metav1.Duration
+	// MinimumShoots is the minimum number of Shoots a Seed must have for its
+	// failure rate to be evaluated.
+	MinimumShoots int
+	// CordonThreshold is the percentage of failed Shoots of a Seed at or above
+	// which the Seed is cordoned.
+	CordonThreshold int
+	// UncordonThreshold is the percentage of failed Shoots of a cordoned Seed
+	// below which the Seed is uncordoned again. It must not be greater than the
+	// CordonThreshold.
+	UncordonThreshold int
+}
+
 // ShootControllerConfiguration defines the configuration of the CloudProfile
 // controller.
 type ShootControllerConfiguration struct {
@@ -187,6 +333,58 @@ type ShootCareControllerConfiguration struct {
 	// ConditionThresholds defines the condition threshold per condition type.
 	// +optional
 	ConditionThresholds []ConditionThreshold
+	// Remediation defines the remediation actions the controller executes for well-known failure signatures.
+	// Actions which are not configured are not executed.
+	// +optional
+	Remediation *ShootCareRemediation
+	// APIServerProbes defines additional probes of the API servers of Shoots from multiple vantage points. If it is
+	// not set, the API servers are only probed by the controller manager via their internal domain.
+	// +optional
+	APIServerProbes *ShootCareAPIServerProbes
+}
+
+// ShootCareAPIServerProbes defines the additional probes of the API servers of Shoots.
+type ShootCareAPIServerProbes struct {
+	// Timeout is the timeout of a single probe.
+	Timeout metav1.Duration
+	// ExternalProbers are blackbox exporters outside of the garden and Seed clusters which additionally probe the
+	// API servers.
+	// +optional
+	ExternalProbers []ExternalAPIServerProber
+}
+
+// ExternalAPIServerProber defines a blackbox exporter probing the API servers of Shoots.
+type ExternalAPIServerProber struct {
+	// Name is the name of the vantage point of the prober.
+	Name string
+	// URL is the URL of the blackbox exporter, e.g. https://prober.example.com.
+	URL string
+	// Module is the module of the blackbox exporter used to probe the API servers. It must use the tcp prober.
+	Module string
+}
+
+// ShootCareRemediation defines the remediation actions of the ShootCare controller.
+type ShootCareRemediation struct {
+	// RestartCrashLoopingControlPlanePods defines whether crash-looping control plane pods in the Seed are deleted
+	// so that they are recreated, and how often this may happen per Shoot.
+	// +optional
+	RestartCrashLoopingControlPlanePods *RemediationRateLimit
+	// ReconcileOperatingSystemConfigs defines whether the OperatingSystemConfigs of a Shoot are reconciled again if
+	// nodes are stuck NotReady with a known cause, and how often this may happen per Shoot.
+	// +optional
+	ReconcileOperatingSystemConfigs *RemediationRateLimit
+	// NodeNotReadySignatures are substrings of the message of the Ready condition of nodes identifying the known
+	// causes for which the OperatingSystemConfigs are reconciled again.
+	// +optional
+	NodeNotReadySignatures []string
+}
+
+// RemediationRateLimit defines how often a remediation action may be executed per Shoot.
+type RemediationRateLimit struct {
+	// MaxActions is the maximum number of times the action may be executed within the period.
+	MaxActions int
+	// Period is the duration the maximum number of actions refers to.
+	Period metav1.Duration
 }
 
 // ConditionThreshold defines the duration how long a flappy condition stays in progressing state.
@@ -203,6 +401,15 @@ type ShootMaintenanceControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
 	// events.
 	ConcurrentSyncs int
+	// ConcurrentShootsPerSeed is the maximum number of Shoots on the same Seed which are reconciled at the same time
+	// because of their maintenance. The maintenance of further Shoots is deferred within their time window. If not
+	// present, the number is unlimited.
+	// +optional
+	ConcurrentShootsPerSeed *int
+	// RetryPeriod is the duration after which a deferred maintenance is retried. A random jitter of up to the same
+	// duration is added so that deferred maintenances do not start at the same time. Defaults to 1m.
+	// +optional
+	RetryPeriod *metav1.Duration
 }
 
 // ShootQuotaControllerConfiguration defines the configuration of the
@@ -222,6 +429,11 @@ type ShootHibernationControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
 	// events.
 	ConcurrentSyncs int
+	// PricePerNodeHour is the price of a node per hour (in an arbitrary currency) which
+	// is used to estimate the savings of the hibernation of Shoots. Savings are not
+	// estimated if it is not set.
+	// +optional
+	PricePerNodeHour *string
 }
 
 // BackupInfrastructureControllerConfiguration defines the configuration of the BackupInfrastructure
@@ -253,6 +465,9 @@ type ServerConfiguration struct {
 	HTTP Server
 	// HTTPS is the configuration for the HTTPS server.
 	HTTPS HTTPSServer
+	// EnableDebugHandlers enables the unauthenticated /debug endpoints of the HTTP server which expose the
+	// records of the Shoot flows and allow to change the log levels of the controllers at runtime. Defaults to false.
+	EnableDebugHandlers bool
 }
 
 // Server contains information for HTTP(S) server configuration.
@@ -283,6 +498,81 @@ type TLSServer struct {
 type ShootBackup struct {
 	// Schedule defines the cron schedule according to which a backup is taken from etcd.
 	Schedule string
+	// DeltaSnapshotPeriod is the interval in which delta snapshots are taken between two full backups. Defaults to
+	// 5 minutes.
+	DeltaSnapshotPeriod *metav1.Duration
+	// Compression is the algorithm the snapshots are compressed with (`gzip` or `none`). Defaults to `none`.
+	Compression *string
+}
+
+// ShootCertificates holds information about the key algorithm and the validity of certificates generated for Shoot
+// clusters.
+type ShootCertificates struct {
+	// KeyAlgorithm is the algorithm (and size) of the private keys of newly generated certificate authorities and
+	// certificates (`RSA-2048`, `RSA-3072`, `RSA-4096`, `ECDSA-P256` or `ECDSA-P384`). Defaults to `RSA-2048`.
+	// +optional
+	KeyAlgorithm *string
+	// CAValidity is the validity of newly generated certificate authorities. Defaults to 10 years.
+	// +optional
+	CAValidity *metav1.Duration
+	// Validity is the validity of newly generated certificates which are not certificate authorities.
+	// Defaults to 10 years.
+	// +optional
+	Validity *metav1.Duration
+	// RenewalThreshold is the remaining validity below which certificates (which are not certificate authorities)
+	// are renewed. Certificates are not renewed if it is not set.
+	// +optional
+	RenewalThreshold *metav1.Duration
+	// RenewalJitter is the maximum duration by which the renewal of a certificate is brought forward. The actual
+	// duration is derived from the serial number of the certificate, so that certificates which have been generated
+	// at the same time are not all renewed in the same reconciliation. Defaults to zero, i.e., no jitter.
+	// +optional
+	RenewalJitter *metav1.Duration
+}
+
+// ShootTimeouts holds the timeouts of long-running operations for Shoot clusters. The timeouts of a Shoot are taken
+// from the first matching profile, falling back to the default timeouts and then to the built-in defaults for every
+// timeout which is not set.
+type ShootTimeouts struct {
+	// Default contains the timeouts for all Shoots.
+	Default *OperationTimeouts
+	// Profiles contains timeouts for classes of Shoots, e.g., of a cloud provider which is consistently slower than
+	// the default timeouts allow.
+	Profiles []ShootTimeoutProfile
+}
+
+// ShootTimeoutProfile contains the timeouts for a class of Shoots.
+type ShootTimeoutProfile struct {
+	// Name is the name of the profile.
+	Name string
+	// CloudProvider restricts the profile to Shoots of the given cloud provider (e.g., aws).
+	CloudProvider *string
+	// ShootSelector restricts the profile to Shoots whose labels match the selector.
+	ShootSelector *metav1.LabelSelector
+	// Timeouts contains the timeouts of the profile.
+	Timeouts OperationTimeouts
+}
+
+// OperationTimeouts contains timeouts of long-running operations.
+type OperationTimeouts struct {
+	// Infrastructure is the timeout for the Terraform job creating the infrastructure of a Shoot. Defaults to 1 hour.
+	Infrastructure *metav1.Duration
+	// WorkerRollout is the timeout for the machine deployments of a Shoot becoming available. Defaults to 30 minutes.
+	WorkerRollout *metav1.Duration
+	// ExtensionReadiness is the timeout for extension resources (e.g., the operating system configuration) of a Shoot
+	// becoming ready. Defaults to 30 seconds.
+	ExtensionReadiness *metav1.Duration
+}
+
+// ImagesConfiguration contains configuration settings for the container images deployed by Gardener.
+type ImagesConfiguration struct {
+	// Registry replaces the registries of all images of the image vector, e.g., "registry.example.com/gardener"
+	// turns "k8s.gcr.io/hyperkube" into "registry.example.com/gardener/hyperkube". It is passed to the extensions
+	// as well. This allows running Gardener with a private registry which mirrors all required images.
+	Registry *string
+	// RequireDigests defines whether all images of the image vector must be pinned by a digest. The controller
+	// manager does not start if an image has no digest.
+	RequireDigests bool
 }
 
 const (
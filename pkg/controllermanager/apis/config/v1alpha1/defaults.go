@@ -60,6 +60,53 @@ func SetDefaults_ControllerManagerConfiguration(obj *ControllerManagerConfigurat
 			ConcurrentSyncs: 5,
 		}
 	}
+	if obj.Controllers.Compliance != nil {
+		if obj.Controllers.Compliance.ConcurrentSyncs == 0 {
+			obj.Controllers.Compliance.ConcurrentSyncs = 5
+		}
+		if obj.Controllers.Compliance.SyncPeriod.Duration == 0 {
+			obj.Controllers.Compliance.SyncPeriod = metav1.Duration{Duration: time.Hour}
+		}
+	}
+	if obj.Controllers.ConditionStaleness != nil {
+		if obj.Controllers.ConditionStaleness.ConcurrentSyncs == 0 {
+			obj.Controllers.ConditionStaleness.ConcurrentSyncs = 5
+		}
+		if obj.Controllers.ConditionStaleness.SyncPeriod.Duration == 0 {
+			obj.Controllers.ConditionStaleness.SyncPeriod = metav1.Duration{Duration: time.Minute}
+		}
+		if obj.Controllers.ConditionStaleness.Threshold.Duration == 0 {
+			obj.Controllers.ConditionStaleness.Threshold = metav1.Duration{Duration: 10 * time.Minute}
+		}
+	}
+	if obj.Controllers.SeedCordon != nil {
+		if obj.Controllers.SeedCordon.ConcurrentSyncs == 0 {
+			obj.Controllers.SeedCordon.ConcurrentSyncs = 5
+		}
+		if obj.Controllers.SeedCordon.SyncPeriod.Duration == 0 {
+			obj.Controllers.SeedCordon.SyncPeriod = metav1.Duration{Duration: time.Minute}
+		}
+		if obj.Controllers.SeedCordon.MinimumShoots == 0 {
+			obj.Controllers.SeedCordon.MinimumShoots = 5
+		}
+		if obj.Controllers.SeedCordon.CordonThreshold == 0 {
+			obj.Controllers.SeedCordon.CordonThreshold = 50
+		}
+		if obj.Controllers.SeedCordon.UncordonThreshold == 0 {
+			obj.Controllers.SeedCordon.UncordonThreshold = 20
+		}
+	}
+	if obj.Controllers.Export != nil && obj.Controllers.Export.ConcurrentSyncs == 0 {
+		obj.Controllers.Export.ConcurrentSyncs = 5
+	}
+	if obj.Controllers.Federation != nil {
+		if obj.Controllers.Federation.ConcurrentSyncs == 0 {
+			obj.Controllers.Federation.ConcurrentSyncs = 5
+		}
+		if len(obj.Controllers.Federation.Resources) == 0 {
+			obj.Controllers.Federation.Resources = []string{"cloudprofiles", "controllerregistrations"}
+		}
+	}
 	if obj.Controllers.SecretBinding == nil {
 		obj.Controllers.SecretBinding = &SecretBindingControllerConfiguration{
 			ConcurrentSyncs: 5,
@@ -95,6 +142,38 @@ func SetDefaults_ControllerManagerConfiguration(obj *ControllerManagerConfigurat
 		obj.Controllers.Shoot.RetrySyncPeriod = &durationVar
 	}
 
+	if obj.Controllers.ShootMaintenance.RetryPeriod == nil {
+		obj.Controllers.ShootMaintenance.RetryPeriod = &metav1.Duration{Duration: time.Minute}
+	}
+
+	if remediation := obj.Controllers.ShootCare.Remediation; remediation != nil {
+		for _, rateLimit := range []*RemediationRateLimit{remediation.RestartCrashLoopingControlPlanePods, remediation.ReconcileOperatingSystemConfigs} {
+			if rateLimit == nil {
+				continue
+			}
+			if rateLimit.MaxActions == 0 {
+				rateLimit.MaxActions = 3
+			}
+			if rateLimit.Period.Duration == 0 {
+				rateLimit.Period = metav1.Duration{Duration: time.Hour}
+			}
+		}
+		if remediation.ReconcileOperatingSystemConfigs != nil && len(remediation.NodeNotReadySignatures) == 0 {
+			remediation.NodeNotReadySignatures = []string{"PLEG is not healthy", "container runtime is down"}
+		}
+	}
+
+	if probes := obj.Controllers.ShootCare.APIServerProbes; probes != nil {
+		if probes.Timeout.Duration == 0 {
+			probes.Timeout = metav1.Duration{Duration: 10 * time.Second}
+		}
+		for i := range probes.ExternalProbers {
+			if len(probes.ExternalProbers[i].Module) == 0 {
+				probes.ExternalProbers[i].Module = "tcp_connect"
+			}
+		}
+	}
+
 	if obj.Controllers.BackupInfrastructure.DeletionGracePeriodDays == nil || *obj.Controllers.BackupInfrastructure.DeletionGracePeriodDays < 0 {
 		var defaultBackupInfrastructureDeletionGracePeriodDays = DefaultBackupInfrastructureDeletionGracePeriodDays
 		obj.Controllers.BackupInfrastructure.DeletionGracePeriodDays = &defaultBackupInfrastructureDeletionGracePeriodDays
@@ -109,6 +188,13 @@ func SetDefaults_ControllerManagerConfiguration(obj *ControllerManagerConfigurat
 			obj.ShootBackup.Schedule = DefaultETCDBackupSchedule
 		}
 	}
+	if obj.ShootBackup.DeltaSnapshotPeriod == nil {
+		obj.ShootBackup.DeltaSnapshotPeriod = &metav1.Duration{Duration: 5 * time.Minute}
+	}
+	if obj.ShootBackup.Compression == nil {
+		compression := DefaultETCDBackupCompression
+		obj.ShootBackup.Compression = &compression
+	}
 
 	if obj.GardenerClientConnection == nil {
 		obj.GardenerClientConnection = &obj.ClientConnection
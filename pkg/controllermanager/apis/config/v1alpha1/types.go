@@ -44,9 +44,22 @@ type ControllerManagerConfiguration struct {
 	KubernetesLogLevel klog.Level `json:"kubernetesLogLevel"`
 	// Server defines the configuration of the HTTP server.
 	Server ServerConfiguration `json:"server"`
+	// SeedSelector restricts the Seeds managed by this controller manager (and the Shoots and BackupInfrastructures
+	// on them) to those matching the selector. If not set, all Seeds are managed.
+	// +optional
+	SeedSelector *metav1.LabelSelector `json:"seedSelector,omitempty"`
 	// ShootBackup contains configuration settings for the etcd backups.
 	// +optional
 	ShootBackup *ShootBackup `json:"shootBackup,omitempty"`
+	// ShootCertificates contains configuration settings for the certificates generated for Shoot clusters.
+	// +optional
+	ShootCertificates *ShootCertificates `json:"shootCertificates,omitempty"`
+	// ShootTimeouts contains the timeouts of long-running operations for Shoot clusters.
+	// +optional
+	ShootTimeouts *ShootTimeouts `json:"shootTimeouts,omitempty"`
+	// Images contains configuration settings for the container images deployed by Gardener.
+	// +optional
+	Images *ImagesConfiguration `json:"images,omitempty"`
 	// FeatureGates is a map of feature names to bools that enable or disable alpha/experimental
 	// features. This field modifies piecemeal the built-in default values from
 	// "github.com/gardener/gardener/pkg/features/gardener_features.go".
@@ -62,12 +75,25 @@ type ControllerManagerControllerConfiguration struct {
 	// CloudProfile defines the configuration of the CloudProfile controller.
 	// +optional
 	CloudProfile *CloudProfileControllerConfiguration `json:"cloudProfile,omitempty"`
+	// Compliance defines the configuration of the Compliance controller. The controller is only started if it is set.
+	// +optional
+	Compliance *ComplianceControllerConfiguration `json:"compliance,omitempty"`
+	// ConditionStaleness defines the configuration of the ConditionStaleness controller. The controller is only started
+	// if it is set.
+	// +optional
+	ConditionStaleness *ConditionStalenessControllerConfiguration `json:"conditionStaleness,omitempty"`
 	// ControllerRegistration defines the configuration of the ControllerRegistration controller.
 	// +optional
 	ControllerRegistration *ControllerRegistrationControllerConfiguration `json:"controllerRegistration,omitempty"`
 	// ControllerInstallation defines the configuration of the ControllerInstallation controller.
 	// +optional
 	ControllerInstallation *ControllerInstallationControllerConfiguration `json:"controllerInstallation,omitempty"`
+	// Export defines the configuration of the Export controller. The controller is only started if it is set.
+	// +optional
+	Export *ExportControllerConfiguration `json:"export,omitempty"`
+	// Federation defines the configuration of the Federation controller. The controller is only started if it is set.
+	// +optional
+	Federation *FederationControllerConfiguration `json:"federation,omitempty"`
 	// SecretBinding defines the configuration of the SecretBinding controller.
 	// +optional
 	SecretBinding *SecretBindingControllerConfiguration `json:"secretBinding,omitempty"`
@@ -80,6 +106,9 @@ type ControllerManagerControllerConfiguration struct {
 	// Seed defines the configuration of the Seed controller.
 	// +optional
 	Seed *SeedControllerConfiguration `json:"seed,omitempty"`
+	// SeedCordon defines the configuration of the SeedCordon controller. The controller is only started if it is set.
+	// +optional
+	SeedCordon *SeedCordonControllerConfiguration `json:"seedCordon,omitempty"`
 	// Shoot defines the configuration of the Shoot controller.
 	Shoot ShootControllerConfiguration `json:"shoot"`
 	// ShootCare defines the configuration of the ShootCare controller.
@@ -100,6 +129,65 @@ type CloudProfileControllerConfiguration struct {
 	ConcurrentSyncs int `json:"concurrentSyncs"`
 }
 
+// ComplianceControllerConfiguration defines the configuration of the Compliance
+// controller.
+type ComplianceControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs int `json:"concurrentSyncs"`
+	// SyncPeriod is the duration how often all Shoots are evaluated and the
+	// compliance report is written.
+	SyncPeriod metav1.Duration `json:"syncPeriod"`
+	// Profile is the compliance profile the Shoots are evaluated against.
+	Profile ComplianceProfile `json:"profile"`
+}
+
+// ConditionStalenessControllerConfiguration defines the configuration of the
+// ConditionStaleness controller.
+type ConditionStalenessControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs int `json:"concurrentSyncs"`
+	// SyncPeriod is the duration how often the conditions of all Shoots and Seeds
+	// are checked.
+	SyncPeriod metav1.Duration `json:"syncPeriod"`
+	// Threshold is the duration after which a condition which has not been updated
+	// is considered stale. It must be greater than the sync periods of the ShootCare
+	// and Seed controllers.
+	Threshold metav1.Duration `json:"threshold"`
+}
+
+// ComplianceProfile defines the rules Shoots have to satisfy in order to be
+// compliant. Rules which are not enabled are not evaluated.
+type ComplianceProfile struct {
+	// HighAvailability requires that all worker pools run at least two machines
+	// and, for cloud providers supporting zones, that the Shoot spans at least
+	// two zones.
+	// +optional
+	HighAvailability bool `json:"highAvailability,omitempty"`
+	// KubernetesVersion requires that the Kubernetes version of the Shoot is
+	// current with respect to the versions offered in its CloudProfile.
+	// +optional
+	KubernetesVersion *ComplianceKubernetesVersion `json:"kubernetesVersion,omitempty"`
+	// AuditPolicy requires that the Shoot configures an audit policy for its
+	// kube-apiserver.
+	// +optional
+	AuditPolicy bool `json:"auditPolicy,omitempty"`
+}
+
+// ComplianceKubernetesVersion defines when the Kubernetes version of a Shoot is
+// current.
+type ComplianceKubernetesVersion struct {
+	// LatestPatchVersion requires that the Shoot runs the latest patch version
+	// of its minor version which is offered in the CloudProfile.
+	// +optional
+	LatestPatchVersion bool `json:"latestPatchVersion,omitempty"`
+	// MaxMinorVersionsBehind is the maximum number of newer minor versions which
+	// may be offered in the CloudProfile.
+	// +optional
+	MaxMinorVersionsBehind *int `json:"maxMinorVersionsBehind,omitempty"`
+}
+
 // ControllerRegistrationControllerConfiguration defines the configuration of the
 // ControllerRegistration controller.
 type ControllerRegistrationControllerConfiguration struct {
@@ -116,6 +204,39 @@ type ControllerInstallationControllerConfiguration struct {
 	ConcurrentSyncs int `json:"concurrentSyncs"`
 }
 
+// ExportControllerConfiguration defines the configuration of the Export
+// controller.
+type ExportControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs int `json:"concurrentSyncs"`
+	// Directory is the directory into which the CloudProfiles, Seeds,
+	// ControllerRegistrations and Projects are exported as YAML files. It is
+	// typically a checkout of a Git repository or a volume backed by an object
+	// store, which is synchronized by a sidecar.
+	Directory string `json:"directory"`
+}
+
+// FederationControllerConfiguration defines the configuration of the Federation
+// controller.
+type FederationControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs int `json:"concurrentSyncs"`
+	// SourceKubeconfig is the path to a kubeconfig for the garden cluster which is
+	// the source of truth. The selected resources are mirrored from this garden
+	// into the garden the controller manager is running against.
+	SourceKubeconfig string `json:"sourceKubeconfig"`
+	// Resources are the resources which are mirrored. Supported values are
+	// "cloudprofiles" and "controllerregistrations". Defaults to both.
+	// +optional
+	Resources []string `json:"resources,omitempty"`
+	// Selector is a label selector for the objects which are mirrored. All objects
+	// are mirrored if it is not set.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
 // SecretBindingControllerConfiguration defines the configuration of the
 // SecretBinding controller.
 type SecretBindingControllerConfiguration struct {
@@ -130,6 +251,14 @@ type ProjectControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
 	// events.
 	ConcurrentSyncs int `json:"concurrentSyncs"`
+	// StaleAfter is the duration after which a project without Shoots and without
+	// activity is marked as stale. Projects are not checked if it is not set.
+	// +optional
+	StaleAfter *metav1.Duration `json:"staleAfter,omitempty"`
+	// StaleDeletionGracePeriod is the duration after which a stale project is deleted.
+	// Stale projects are not deleted automatically if it is not set.
+	// +optional
+	StaleDeletionGracePeriod *metav1.Duration `json:"staleDeletionGracePeriod,omitempty"`
 }
 
 // QuotaControllerConfiguration defines the configuration of the Quota controller.
@@ -155,6 +284,27 @@ type SeedControllerConfiguration struct {
 	SyncPeriod metav1.Duration `json:"syncPeriod"`
 }
 
+// SeedCordonControllerConfiguration defines the configuration of the SeedCordon
+// controller.
+type SeedCordonControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs int `json:"concurrentSyncs"`
+	// SyncPeriod is the duration how often the failure rates of all Seeds are
+	// evaluated.
+	SyncPeriod metav1.Duration `json:"syncPeriod"`
+	// MinimumShoots is the minimum number of Shoots a Seed must have for its
+	// failure rate to be evaluated.
+	MinimumShoots int `json:"minimumShoots"`
+	// CordonThreshold is the percentage of failed Shoots of a Seed at or above
+	// which the Seed is cordoned.
+	CordonThreshold int `json:"cordonThreshold"`
+	// UncordonThreshold is the percentage of failed Shoots of a cordoned Seed
+	// below which the Seed is uncordoned again. It must not be greater than the
+	// CordonThreshold.
+	UncordonThreshold int `json:"uncordonThreshold"`
+}
+
 // ShootControllerConfiguration defines the configuration of the Shoot
 // controller.
 type ShootControllerConfiguration struct {
@@ -189,6 +339,62 @@ type ShootCareControllerConfiguration struct {
 	// ConditionThresholds defines the condition threshold per condition type.
 	// +optional
 	ConditionThresholds []ConditionThreshold `json:"conditionThresholds,omitempty"`
+	// Remediation defines the remediation actions the controller executes for well-known failure signatures.
+	// Actions which are not configured are not executed.
+	// +optional
+	Remediation *ShootCareRemediation `json:"remediation,omitempty"`
+	// APIServerProbes defines additional probes of the API servers of Shoots from multiple vantage points. If it is
+	// not set, the API servers are only probed by the controller manager via their internal domain.
+	// +optional
+	APIServerProbes *ShootCareAPIServerProbes `json:"apiServerProbes,omitempty"`
+}
+
+// ShootCareAPIServerProbes defines the additional probes of the API servers of Shoots.
+type ShootCareAPIServerProbes struct {
+	// Timeout is the timeout of a single probe.
+	// Defaults to 10s.
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+	// ExternalProbers are blackbox exporters outside of the garden and Seed clusters which additionally probe the
+	// API servers.
+	// +optional
+	ExternalProbers []ExternalAPIServerProber `json:"externalProbers,omitempty"`
+}
+
+// ExternalAPIServerProber defines a blackbox exporter probing the API servers of Shoots.
+type ExternalAPIServerProber struct {
+	// Name is the name of the vantage point of the prober.
+	Name string `json:"name"`
+	// URL is the URL of the blackbox exporter, e.g. https://prober.example.com.
+	URL string `json:"url"`
+	// Module is the module of the blackbox exporter used to probe the API servers. It must use the tcp prober.
+	// Defaults to tcp_connect.
+	// +optional
+	Module string `json:"module,omitempty"`
+}
+
+// ShootCareRemediation defines the remediation actions of the ShootCare controller.
+type ShootCareRemediation struct {
+	// RestartCrashLoopingControlPlanePods defines whether crash-looping control plane pods in the Seed are deleted
+	// so that they are recreated, and how often this may happen per Shoot.
+	// +optional
+	RestartCrashLoopingControlPlanePods *RemediationRateLimit `json:"restartCrashLoopingControlPlanePods,omitempty"`
+	// ReconcileOperatingSystemConfigs defines whether the OperatingSystemConfigs of a Shoot are reconciled again if
+	// nodes are stuck NotReady with a known cause, and how often this may happen per Shoot.
+	// +optional
+	ReconcileOperatingSystemConfigs *RemediationRateLimit `json:"reconcileOperatingSystemConfigs,omitempty"`
+	// NodeNotReadySignatures are substrings of the message of the Ready condition of nodes identifying the known
+	// causes for which the OperatingSystemConfigs are reconciled again.
+	// +optional
+	NodeNotReadySignatures []string `json:"nodeNotReadySignatures,omitempty"`
+}
+
+// RemediationRateLimit defines how often a remediation action may be executed per Shoot.
+type RemediationRateLimit struct {
+	// MaxActions is the maximum number of times the action may be executed within the period.
+	MaxActions int `json:"maxActions"`
+	// Period is the duration the maximum number of actions refers to.
+	Period metav1.Duration `json:"period"`
 }
 
 // ConditionThreshold defines the duration how long a flappy condition stays in progressing state.
@@ -205,6 +411,15 @@ type ShootMaintenanceControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
 	// events.
 	ConcurrentSyncs int `json:"concurrentSyncs"`
+	// ConcurrentShootsPerSeed is the maximum number of Shoots on the same Seed which are reconciled at the same time
+	// because of their maintenance. The maintenance of further Shoots is deferred within their time window. If not
+	// present, the number is unlimited.
+	// +optional
+	ConcurrentShootsPerSeed *int `json:"concurrentShootsPerSeed,omitempty"`
+	// RetryPeriod is the duration after which a deferred maintenance is retried. A random jitter of up to the same
+	// duration is added so that deferred maintenances do not start at the same time. Defaults to 1m.
+	// +optional
+	RetryPeriod *metav1.Duration `json:"retryPeriod,omitempty"`
 }
 
 // ShootQuotaControllerConfiguration defines the configuration of the
@@ -224,6 +439,11 @@ type ShootHibernationControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
 	// events.
 	ConcurrentSyncs int `json:"concurrentSyncs"`
+	// PricePerNodeHour is the price of a node per hour (in an arbitrary currency) which
+	// is used to estimate the savings of the hibernation of Shoots. Savings are not
+	// estimated if it is not set.
+	// +optional
+	PricePerNodeHour *string `json:"pricePerNodeHour,omitempty"`
 }
 
 // BackupInfrastructureControllerConfiguration defines the configuration of the BackupInfrastructure
@@ -255,6 +475,10 @@ type ServerConfiguration struct {
 	HTTP Server `json:"http"`
 	// HTTPS is the configuration for the HTTPS server.
 	HTTPS HTTPSServer `json:"https"`
+	// EnableDebugHandlers enables the unauthenticated /debug endpoints of the HTTP server which expose the
+	// records of the Shoot flows and allow to change the log levels of the controllers at runtime. Defaults to false.
+	// +optional
+	EnableDebugHandlers bool `json:"enableDebugHandlers,omitempty"`
 }
 
 // Server contains information for HTTP(S) server configuration.
@@ -285,6 +509,92 @@ type TLSServer struct {
 type ShootBackup struct {
 	// Schedule defines the cron schedule according to which a backup is taken from etcd.
 	Schedule string `json:"schedule"`
+	// DeltaSnapshotPeriod is the interval in which delta snapshots are taken between two full backups. Defaults to
+	// 5 minutes.
+	// +optional
+	DeltaSnapshotPeriod *metav1.Duration `json:"deltaSnapshotPeriod,omitempty"`
+	// Compression is the algorithm the snapshots are compressed with (`gzip` or `none`). Defaults to `none`.
+	// +optional
+	Compression *string `json:"compression,omitempty"`
+}
+
+// ShootCertificates holds information about the key algorithm and the validity of certificates generated for Shoot
+// clusters.
+type ShootCertificates struct {
+	// KeyAlgorithm is the algorithm (and size) of the private keys of newly generated certificate authorities and
+	// certificates (`RSA-2048`, `RSA-3072`, `RSA-4096`, `ECDSA-P256` or `ECDSA-P384`). Defaults to `RSA-2048`.
+	// +optional
+	KeyAlgorithm *string `json:"keyAlgorithm,omitempty"`
+	// CAValidity is the validity of newly generated certificate authorities. Defaults to 10 years.
+	// +optional
+	CAValidity *metav1.Duration `json:"caValidity,omitempty"`
+	// Validity is the validity of newly generated certificates which are not certificate authorities.
+	// Defaults to 10 years.
+	// +optional
+	Validity *metav1.Duration `json:"validity,omitempty"`
+	// RenewalThreshold is the remaining validity below which certificates (which are not certificate authorities)
+	// are renewed. Certificates are not renewed if it is not set.
+	// +optional
+	RenewalThreshold *metav1.Duration `json:"renewalThreshold,omitempty"`
+	// RenewalJitter is the maximum duration by which the renewal of a certificate is brought forward. The actual
+	// duration is derived from the serial number of the certificate, so that certificates which have been generated
+	// at the same time are not all renewed in the same reconciliation. Defaults to zero, i.e., no jitter.
+	// +optional
+	RenewalJitter *metav1.Duration `json:"renewalJitter,omitempty"`
+}
+
+// ShootTimeouts holds the timeouts of long-running operations for Shoot clusters. The timeouts of a Shoot are taken
+// from the first matching profile, falling back to the default timeouts and then to the built-in defaults for every
+// timeout which is not set.
+type ShootTimeouts struct {
+	// Default contains the timeouts for all Shoots.
+	// +optional
+	Default *OperationTimeouts `json:"default,omitempty"`
+	// Profiles contains timeouts for classes of Shoots, e.g., of a cloud provider which is consistently slower than
+	// the default timeouts allow.
+	// +optional
+	Profiles []ShootTimeoutProfile `json:"profiles,omitempty"`
+}
+
+// ShootTimeoutProfile contains the timeouts for a class of Shoots.
+type ShootTimeoutProfile struct {
+	// Name is the name of the profile.
+	Name string `json:"name"`
+	// CloudProvider restricts the profile to Shoots of the given cloud provider (e.g., aws).
+	// +optional
+	CloudProvider *string `json:"cloudProvider,omitempty"`
+	// ShootSelector restricts the profile to Shoots whose labels match the selector.
+	// +optional
+	ShootSelector *metav1.LabelSelector `json:"shootSelector,omitempty"`
+	// Timeouts contains the timeouts of the profile.
+	Timeouts OperationTimeouts `json:"timeouts"`
+}
+
+// OperationTimeouts contains timeouts of long-running operations.
+type OperationTimeouts struct {
+	// Infrastructure is the timeout for the Terraform job creating the infrastructure of a Shoot. Defaults to 1 hour.
+	// +optional
+	Infrastructure *metav1.Duration `json:"infrastructure,omitempty"`
+	// WorkerRollout is the timeout for the machine deployments of a Shoot becoming available. Defaults to 30 minutes.
+	// +optional
+	WorkerRollout *metav1.Duration `json:"workerRollout,omitempty"`
+	// ExtensionReadiness is the timeout for extension resources (e.g., the operating system configuration) of a Shoot
+	// becoming ready. Defaults to 30 seconds.
+	// +optional
+	ExtensionReadiness *metav1.Duration `json:"extensionReadiness,omitempty"`
+}
+
+// ImagesConfiguration contains configuration settings for the container images deployed by Gardener.
+type ImagesConfiguration struct {
+	// Registry replaces the registries of all images of the image vector, e.g., "registry.example.com/gardener"
+	// turns "k8s.gcr.io/hyperkube" into "registry.example.com/gardener/hyperkube". It is passed to the extensions
+	// as well. This allows running Gardener with a private registry which mirrors all required images.
+	// +optional
+	Registry *string `json:"registry,omitempty"`
+	// RequireDigests defines whether all images of the image vector must be pinned by a digest. The controller
+	// manager does not start if an image has no digest.
+	// +optional
+	RequireDigests bool `json:"requireDigests,omitempty"`
 }
 
 const (
@@ -300,4 +610,7 @@ const (
 
 	// DefaultETCDBackupSchedule is a constant for the default schedule to take backups of a Shoot cluster (daily).
 	DefaultETCDBackupSchedule = "0 */24 * * *"
+
+	// DefaultETCDBackupCompression is a constant for the default compression of the backups of a Shoot cluster.
+	DefaultETCDBackupCompression = "none"
 )
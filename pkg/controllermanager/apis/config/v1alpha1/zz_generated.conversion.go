@@ -60,6 +60,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ComplianceControllerConfiguration)(nil), (*config.ComplianceControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComplianceControllerConfiguration_To_config_ComplianceControllerConfiguration(a.(*ComplianceControllerConfiguration), b.(*config.ComplianceControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ComplianceControllerConfiguration)(nil), (*ComplianceControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ComplianceControllerConfiguration_To_v1alpha1_ComplianceControllerConfiguration(a.(*config.ComplianceControllerConfiguration), b.(*ComplianceControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ComplianceKubernetesVersion)(nil), (*config.ComplianceKubernetesVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComplianceKubernetesVersion_To_config_ComplianceKubernetesVersion(a.(*ComplianceKubernetesVersion), b.(*config.ComplianceKubernetesVersion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ComplianceKubernetesVersion)(nil), (*ComplianceKubernetesVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ComplianceKubernetesVersion_To_v1alpha1_ComplianceKubernetesVersion(a.(*config.ComplianceKubernetesVersion), b.(*ComplianceKubernetesVersion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ComplianceProfile)(nil), (*config.ComplianceProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComplianceProfile_To_config_ComplianceProfile(a.(*ComplianceProfile), b.(*config.ComplianceProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ComplianceProfile)(nil), (*ComplianceProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ComplianceProfile_To_v1alpha1_ComplianceProfile(a.(*config.ComplianceProfile), b.(*ComplianceProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ConditionStalenessControllerConfiguration)(nil), (*config.ConditionStalenessControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ConditionStalenessControllerConfiguration_To_config_ConditionStalenessControllerConfiguration(a.(*ConditionStalenessControllerConfiguration), b.(*config.ConditionStalenessControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ConditionStalenessControllerConfiguration)(nil), (*ConditionStalenessControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ConditionStalenessControllerConfiguration_To_v1alpha1_ConditionStalenessControllerConfiguration(a.(*config.ConditionStalenessControllerConfiguration), b.(*ConditionStalenessControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ConditionThreshold)(nil), (*config.ConditionThreshold)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ConditionThreshold_To_config_ConditionThreshold(a.(*ConditionThreshold), b.(*config.ConditionThreshold), scope)
 	}); err != nil {
@@ -110,6 +150,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ExportControllerConfiguration)(nil), (*config.ExportControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExportControllerConfiguration_To_config_ExportControllerConfiguration(a.(*ExportControllerConfiguration), b.(*config.ExportControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ExportControllerConfiguration)(nil), (*ExportControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ExportControllerConfiguration_To_v1alpha1_ExportControllerConfiguration(a.(*config.ExportControllerConfiguration), b.(*ExportControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ExternalAPIServerProber)(nil), (*config.ExternalAPIServerProber)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExternalAPIServerProber_To_config_ExternalAPIServerProber(a.(*ExternalAPIServerProber), b.(*config.ExternalAPIServerProber), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ExternalAPIServerProber)(nil), (*ExternalAPIServerProber)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ExternalAPIServerProber_To_v1alpha1_ExternalAPIServerProber(a.(*config.ExternalAPIServerProber), b.(*ExternalAPIServerProber), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FederationControllerConfiguration)(nil), (*config.FederationControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FederationControllerConfiguration_To_config_FederationControllerConfiguration(a.(*FederationControllerConfiguration), b.(*config.FederationControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.FederationControllerConfiguration)(nil), (*FederationControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_FederationControllerConfiguration_To_v1alpha1_FederationControllerConfiguration(a.(*config.FederationControllerConfiguration), b.(*FederationControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HTTPSServer)(nil), (*config.HTTPSServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_HTTPSServer_To_config_HTTPSServer(a.(*HTTPSServer), b.(*config.HTTPSServer), scope)
 	}); err != nil {
@@ -120,6 +190,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ImagesConfiguration)(nil), (*config.ImagesConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImagesConfiguration_To_config_ImagesConfiguration(a.(*ImagesConfiguration), b.(*config.ImagesConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ImagesConfiguration)(nil), (*ImagesConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ImagesConfiguration_To_v1alpha1_ImagesConfiguration(a.(*config.ImagesConfiguration), b.(*ImagesConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LeaderElectionConfiguration)(nil), (*config.LeaderElectionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_LeaderElectionConfiguration_To_config_LeaderElectionConfiguration(a.(*LeaderElectionConfiguration), b.(*config.LeaderElectionConfiguration), scope)
 	}); err != nil {
@@ -130,6 +210,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*OperationTimeouts)(nil), (*config.OperationTimeouts)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OperationTimeouts_To_config_OperationTimeouts(a.(*OperationTimeouts), b.(*config.OperationTimeouts), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.OperationTimeouts)(nil), (*OperationTimeouts)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_OperationTimeouts_To_v1alpha1_OperationTimeouts(a.(*config.OperationTimeouts), b.(*OperationTimeouts), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectControllerConfiguration)(nil), (*config.ProjectControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ProjectControllerConfiguration_To_config_ProjectControllerConfiguration(a.(*ProjectControllerConfiguration), b.(*config.ProjectControllerConfiguration), scope)
 	}); err != nil {
@@ -150,6 +240,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RemediationRateLimit)(nil), (*config.RemediationRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RemediationRateLimit_To_config_RemediationRateLimit(a.(*RemediationRateLimit), b.(*config.RemediationRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.RemediationRateLimit)(nil), (*RemediationRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_RemediationRateLimit_To_v1alpha1_RemediationRateLimit(a.(*config.RemediationRateLimit), b.(*RemediationRateLimit), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SecretBindingControllerConfiguration)(nil), (*config.SecretBindingControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SecretBindingControllerConfiguration_To_config_SecretBindingControllerConfiguration(a.(*SecretBindingControllerConfiguration), b.(*config.SecretBindingControllerConfiguration), scope)
 	}); err != nil {
@@ -170,6 +270,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedCordonControllerConfiguration)(nil), (*config.SeedCordonControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedCordonControllerConfiguration_To_config_SeedCordonControllerConfiguration(a.(*SeedCordonControllerConfiguration), b.(*config.SeedCordonControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SeedCordonControllerConfiguration)(nil), (*SeedCordonControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SeedCordonControllerConfiguration_To_v1alpha1_SeedCordonControllerConfiguration(a.(*config.SeedCordonControllerConfiguration), b.(*SeedCordonControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Server)(nil), (*config.Server)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Server_To_config_Server(a.(*Server), b.(*config.Server), scope)
 	}); err != nil {
@@ -200,6 +310,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootCareAPIServerProbes)(nil), (*config.ShootCareAPIServerProbes)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootCareAPIServerProbes_To_config_ShootCareAPIServerProbes(a.(*ShootCareAPIServerProbes), b.(*config.ShootCareAPIServerProbes), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootCareAPIServerProbes)(nil), (*ShootCareAPIServerProbes)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootCareAPIServerProbes_To_v1alpha1_ShootCareAPIServerProbes(a.(*config.ShootCareAPIServerProbes), b.(*ShootCareAPIServerProbes), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootCareControllerConfiguration)(nil), (*config.ShootCareControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootCareControllerConfiguration_To_config_ShootCareControllerConfiguration(a.(*ShootCareControllerConfiguration), b.(*config.ShootCareControllerConfiguration), scope)
 	}); err != nil {
@@ -210,6 +330,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootCareRemediation)(nil), (*config.ShootCareRemediation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootCareRemediation_To_config_ShootCareRemediation(a.(*ShootCareRemediation), b.(*config.ShootCareRemediation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootCareRemediation)(nil), (*ShootCareRemediation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootCareRemediation_To_v1alpha1_ShootCareRemediation(a.(*config.ShootCareRemediation), b.(*ShootCareRemediation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootCertificates)(nil), (*config.ShootCertificates)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootCertificates_To_config_ShootCertificates(a.(*ShootCertificates), b.(*config.ShootCertificates), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootCertificates)(nil), (*ShootCertificates)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootCertificates_To_v1alpha1_ShootCertificates(a.(*config.ShootCertificates), b.(*ShootCertificates), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootControllerConfiguration)(nil), (*config.ShootControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootControllerConfiguration_To_config_ShootControllerConfiguration(a.(*ShootControllerConfiguration), b.(*config.ShootControllerConfiguration), scope)
 	}); err != nil {
@@ -250,6 +390,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootTimeoutProfile)(nil), (*config.ShootTimeoutProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootTimeoutProfile_To_config_ShootTimeoutProfile(a.(*ShootTimeoutProfile), b.(*config.ShootTimeoutProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootTimeoutProfile)(nil), (*ShootTimeoutProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootTimeoutProfile_To_v1alpha1_ShootTimeoutProfile(a.(*config.ShootTimeoutProfile), b.(*ShootTimeoutProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootTimeouts)(nil), (*config.ShootTimeouts)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootTimeouts_To_config_ShootTimeouts(a.(*ShootTimeouts), b.(*config.ShootTimeouts), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootTimeouts)(nil), (*ShootTimeouts)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootTimeouts_To_v1alpha1_ShootTimeouts(a.(*config.ShootTimeouts), b.(*ShootTimeouts), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TLSServer)(nil), (*config.TLSServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_TLSServer_To_config_TLSServer(a.(*TLSServer), b.(*config.TLSServer), scope)
 	}); err != nil {
@@ -307,6 +467,104 @@ func Convert_config_CloudProfileControllerConfiguration_To_v1alpha1_CloudProfile
 	return autoConvert_config_CloudProfileControllerConfiguration_To_v1alpha1_CloudProfileControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ComplianceControllerConfiguration_To_config_ComplianceControllerConfiguration(in *ComplianceControllerConfiguration, out *config.ComplianceControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.SyncPeriod = in.SyncPeriod
+	if err := Convert_v1alpha1_ComplianceProfile_To_config_ComplianceProfile(&in.Profile, &out.Profile, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ComplianceControllerConfiguration_To_config_ComplianceControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ComplianceControllerConfiguration_To_config_ComplianceControllerConfiguration(in *ComplianceControllerConfiguration, out *config.ComplianceControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComplianceControllerConfiguration_To_config_ComplianceControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ComplianceControllerConfiguration_To_v1alpha1_ComplianceControllerConfiguration(in *config.ComplianceControllerConfiguration, out *ComplianceControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.SyncPeriod = in.SyncPeriod
+	if err := Convert_config_ComplianceProfile_To_v1alpha1_ComplianceProfile(&in.Profile, &out.Profile, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_ComplianceControllerConfiguration_To_v1alpha1_ComplianceControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ComplianceControllerConfiguration_To_v1alpha1_ComplianceControllerConfiguration(in *config.ComplianceControllerConfiguration, out *ComplianceControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ComplianceControllerConfiguration_To_v1alpha1_ComplianceControllerConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ComplianceKubernetesVersion_To_config_ComplianceKubernetesVersion(in *ComplianceKubernetesVersion, out *config.ComplianceKubernetesVersion, s conversion.Scope) error {
+	out.LatestPatchVersion = in.LatestPatchVersion
+	out.MaxMinorVersionsBehind = (*int)(unsafe.Pointer(in.MaxMinorVersionsBehind))
+	return nil
+}
+
+// Convert_v1alpha1_ComplianceKubernetesVersion_To_config_ComplianceKubernetesVersion is an autogenerated conversion function.
+func Convert_v1alpha1_ComplianceKubernetesVersion_To_config_ComplianceKubernetesVersion(in *ComplianceKubernetesVersion, out *config.ComplianceKubernetesVersion, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComplianceKubernetesVersion_To_config_ComplianceKubernetesVersion(in, out, s)
+}
+
+func autoConvert_config_ComplianceKubernetesVersion_To_v1alpha1_ComplianceKubernetesVersion(in *config.ComplianceKubernetesVersion, out *ComplianceKubernetesVersion, s conversion.Scope) error {
+	out.LatestPatchVersion = in.LatestPatchVersion
+	out.MaxMinorVersionsBehind = (*int)(unsafe.Pointer(in.MaxMinorVersionsBehind))
+	return nil
+}
+
+// Convert_config_ComplianceKubernetesVersion_To_v1alpha1_ComplianceKubernetesVersion is an autogenerated conversion function.
+func Convert_config_ComplianceKubernetesVersion_To_v1alpha1_ComplianceKubernetesVersion(in *config.ComplianceKubernetesVersion, out *ComplianceKubernetesVersion, s conversion.Scope) error {
+	return autoConvert_config_ComplianceKubernetesVersion_To_v1alpha1_ComplianceKubernetesVersion(in, out, s)
+}
+
+func autoConvert_v1alpha1_ComplianceProfile_To_config_ComplianceProfile(in *ComplianceProfile, out *config.ComplianceProfile, s conversion.Scope) error {
+	out.HighAvailability = in.HighAvailability
+	out.KubernetesVersion = (*config.ComplianceKubernetesVersion)(unsafe.Pointer(in.KubernetesVersion))
+	out.AuditPolicy = in.AuditPolicy
+	return nil
+}
+
+// Convert_v1alpha1_ComplianceProfile_To_config_ComplianceProfile is an autogenerated conversion function.
+func Convert_v1alpha1_ComplianceProfile_To_config_ComplianceProfile(in *ComplianceProfile, out *config.ComplianceProfile, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComplianceProfile_To_config_ComplianceProfile(in, out, s)
+}
+
+func autoConvert_config_ComplianceProfile_To_v1alpha1_ComplianceProfile(in *config.ComplianceProfile, out *ComplianceProfile, s conversion.Scope) error {
+	out.HighAvailability = in.HighAvailability
+	out.KubernetesVersion = (*ComplianceKubernetesVersion)(unsafe.Pointer(in.KubernetesVersion))
+	out.AuditPolicy = in.AuditPolicy
+	return nil
+}
+
+// Convert_config_ComplianceProfile_To_v1alpha1_ComplianceProfile is an autogenerated conversion function.
+func Convert_config_ComplianceProfile_To_v1alpha1_ComplianceProfile(in *config.ComplianceProfile, out *ComplianceProfile, s conversion.Scope) error {
+	return autoConvert_config_ComplianceProfile_To_v1alpha1_ComplianceProfile(in, out, s)
+}
+
+func autoConvert_v1alpha1_ConditionStalenessControllerConfiguration_To_config_ConditionStalenessControllerConfiguration(in *ConditionStalenessControllerConfiguration, out *config.ConditionStalenessControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.SyncPeriod = in.SyncPeriod
+	out.Threshold = in.Threshold
+	return nil
+}
+
+// Convert_v1alpha1_ConditionStalenessControllerConfiguration_To_config_ConditionStalenessControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ConditionStalenessControllerConfiguration_To_config_ConditionStalenessControllerConfiguration(in *ConditionStalenessControllerConfiguration, out *config.ConditionStalenessControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ConditionStalenessControllerConfiguration_To_config_ConditionStalenessControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ConditionStalenessControllerConfiguration_To_v1alpha1_ConditionStalenessControllerConfiguration(in *config.ConditionStalenessControllerConfiguration, out *ConditionStalenessControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.SyncPeriod = in.SyncPeriod
+	out.Threshold = in.Threshold
+	return nil
+}
+
+// Convert_config_ConditionStalenessControllerConfiguration_To_v1alpha1_ConditionStalenessControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ConditionStalenessControllerConfiguration_To_v1alpha1_ConditionStalenessControllerConfiguration(in *config.ConditionStalenessControllerConfiguration, out *ConditionStalenessControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ConditionStalenessControllerConfiguration_To_v1alpha1_ConditionStalenessControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ConditionThreshold_To_config_ConditionThreshold(in *ConditionThreshold, out *config.ConditionThreshold, s conversion.Scope) error {
 	out.Type = in.Type
 	out.Duration = in.Duration
@@ -373,7 +631,11 @@ func autoConvert_v1alpha1_ControllerManagerConfiguration_To_config_ControllerMan
 	if err := Convert_v1alpha1_ServerConfiguration_To_config_ServerConfiguration(&in.Server, &out.Server, s); err != nil {
 		return err
 	}
+	out.SeedSelector = (*v1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
 	out.ShootBackup = (*config.ShootBackup)(unsafe.Pointer(in.ShootBackup))
+	out.ShootCertificates = (*config.ShootCertificates)(unsafe.Pointer(in.ShootCertificates))
+	out.ShootTimeouts = (*config.ShootTimeouts)(unsafe.Pointer(in.ShootTimeouts))
+	out.Images = (*config.ImagesConfiguration)(unsafe.Pointer(in.Images))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	return nil
 }
@@ -407,7 +669,11 @@ func autoConvert_config_ControllerManagerConfiguration_To_v1alpha1_ControllerMan
 	if err := Convert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(&in.Server, &out.Server, s); err != nil {
 		return err
 	}
+	out.SeedSelector = (*v1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
 	out.ShootBackup = (*ShootBackup)(unsafe.Pointer(in.ShootBackup))
+	out.ShootCertificates = (*ShootCertificates)(unsafe.Pointer(in.ShootCertificates))
+	out.ShootTimeouts = (*ShootTimeouts)(unsafe.Pointer(in.ShootTimeouts))
+	out.Images = (*ImagesConfiguration)(unsafe.Pointer(in.Images))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	return nil
 }
@@ -422,12 +688,17 @@ func autoConvert_v1alpha1_ControllerManagerControllerConfiguration_To_config_Con
 		return err
 	}
 	out.CloudProfile = (*config.CloudProfileControllerConfiguration)(unsafe.Pointer(in.CloudProfile))
+	out.Compliance = (*config.ComplianceControllerConfiguration)(unsafe.Pointer(in.Compliance))
+	out.ConditionStaleness = (*config.ConditionStalenessControllerConfiguration)(unsafe.Pointer(in.ConditionStaleness))
 	out.ControllerRegistration = (*config.ControllerRegistrationControllerConfiguration)(unsafe.Pointer(in.ControllerRegistration))
 	out.ControllerInstallation = (*config.ControllerInstallationControllerConfiguration)(unsafe.Pointer(in.ControllerInstallation))
+	out.Export = (*config.ExportControllerConfiguration)(unsafe.Pointer(in.Export))
+	out.Federation = (*config.FederationControllerConfiguration)(unsafe.Pointer(in.Federation))
 	out.SecretBinding = (*config.SecretBindingControllerConfiguration)(unsafe.Pointer(in.SecretBinding))
 	out.Project = (*config.ProjectControllerConfiguration)(unsafe.Pointer(in.Project))
 	out.Quota = (*config.QuotaControllerConfiguration)(unsafe.Pointer(in.Quota))
 	out.Seed = (*config.SeedControllerConfiguration)(unsafe.Pointer(in.Seed))
+	out.SeedCordon = (*config.SeedCordonControllerConfiguration)(unsafe.Pointer(in.SeedCordon))
 	if err := Convert_v1alpha1_ShootControllerConfiguration_To_config_ShootControllerConfiguration(&in.Shoot, &out.Shoot, s); err != nil {
 		return err
 	}
@@ -456,12 +727,17 @@ func autoConvert_config_ControllerManagerControllerConfiguration_To_v1alpha1_Con
 		return err
 	}
 	out.CloudProfile = (*CloudProfileControllerConfiguration)(unsafe.Pointer(in.CloudProfile))
+	out.Compliance = (*ComplianceControllerConfiguration)(unsafe.Pointer(in.Compliance))
+	out.ConditionStaleness = (*ConditionStalenessControllerConfiguration)(unsafe.Pointer(in.ConditionStaleness))
 	out.ControllerRegistration = (*ControllerRegistrationControllerConfiguration)(unsafe.Pointer(in.ControllerRegistration))
 	out.ControllerInstallation = (*ControllerInstallationControllerConfiguration)(unsafe.Pointer(in.ControllerInstallation))
+	out.Export = (*ExportControllerConfiguration)(unsafe.Pointer(in.Export))
+	out.Federation = (*FederationControllerConfiguration)(unsafe.Pointer(in.Federation))
 	out.SecretBinding = (*SecretBindingControllerConfiguration)(unsafe.Pointer(in.SecretBinding))
 	out.Project = (*ProjectControllerConfiguration)(unsafe.Pointer(in.Project))
 	out.Quota = (*QuotaControllerConfiguration)(unsafe.Pointer(in.Quota))
 	out.Seed = (*SeedControllerConfiguration)(unsafe.Pointer(in.Seed))
+	out.SeedCordon = (*SeedCordonControllerConfiguration)(unsafe.Pointer(in.SeedCordon))
 	if err := Convert_config_ShootControllerConfiguration_To_v1alpha1_ShootControllerConfiguration(&in.Shoot, &out.Shoot, s); err != nil {
 		return err
 	}
@@ -505,6 +781,78 @@ func Convert_config_ControllerRegistrationControllerConfiguration_To_v1alpha1_Co
 	return autoConvert_config_ControllerRegistrationControllerConfiguration_To_v1alpha1_ControllerRegistrationControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ExportControllerConfiguration_To_config_ExportControllerConfiguration(in *ExportControllerConfiguration, out *config.ExportControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.Directory = in.Directory
+	return nil
+}
+
+// Convert_v1alpha1_ExportControllerConfiguration_To_config_ExportControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ExportControllerConfiguration_To_config_ExportControllerConfiguration(in *ExportControllerConfiguration, out *config.ExportControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExportControllerConfiguration_To_config_ExportControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ExportControllerConfiguration_To_v1alpha1_ExportControllerConfiguration(in *config.ExportControllerConfiguration, out *ExportControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.Directory = in.Directory
+	return nil
+}
+
+// Convert_config_ExportControllerConfiguration_To_v1alpha1_ExportControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ExportControllerConfiguration_To_v1alpha1_ExportControllerConfiguration(in *config.ExportControllerConfiguration, out *ExportControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ExportControllerConfiguration_To_v1alpha1_ExportControllerConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ExternalAPIServerProber_To_config_ExternalAPIServerProber(in *ExternalAPIServerProber, out *config.ExternalAPIServerProber, s conversion.Scope) error {
+	out.Name = in.Name
+	out.URL = in.URL
+	out.Module = in.Module
+	return nil
+}
+
+// Convert_v1alpha1_ExternalAPIServerProber_To_config_ExternalAPIServerProber is an autogenerated conversion function.
+func Convert_v1alpha1_ExternalAPIServerProber_To_config_ExternalAPIServerProber(in *ExternalAPIServerProber, out *config.ExternalAPIServerProber, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExternalAPIServerProber_To_config_ExternalAPIServerProber(in, out, s)
+}
+
+func autoConvert_config_ExternalAPIServerProber_To_v1alpha1_ExternalAPIServerProber(in *config.ExternalAPIServerProber, out *ExternalAPIServerProber, s conversion.Scope) error {
+	out.Name = in.Name
+	out.URL = in.URL
+	out.Module = in.Module
+	return nil
+}
+
+// Convert_config_ExternalAPIServerProber_To_v1alpha1_ExternalAPIServerProber is an autogenerated conversion function.
+func Convert_config_ExternalAPIServerProber_To_v1alpha1_ExternalAPIServerProber(in *config.ExternalAPIServerProber, out *ExternalAPIServerProber, s conversion.Scope) error {
+	return autoConvert_config_ExternalAPIServerProber_To_v1alpha1_ExternalAPIServerProber(in, out, s)
+}
+
+func autoConvert_v1alpha1_FederationControllerConfiguration_To_config_FederationControllerConfiguration(in *FederationControllerConfiguration, out *config.FederationControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.SourceKubeconfig = in.SourceKubeconfig
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	return nil
+}
+
+// Convert_v1alpha1_FederationControllerConfiguration_To_config_FederationControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_FederationControllerConfiguration_To_config_FederationControllerConfiguration(in *FederationControllerConfiguration, out *config.FederationControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FederationControllerConfiguration_To_config_FederationControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_FederationControllerConfiguration_To_v1alpha1_FederationControllerConfiguration(in *config.FederationControllerConfiguration, out *FederationControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.SourceKubeconfig = in.SourceKubeconfig
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	return nil
+}
+
+// Convert_config_FederationControllerConfiguration_To_v1alpha1_FederationControllerConfiguration is an autogenerated conversion function.
+func Convert_config_FederationControllerConfiguration_To_v1alpha1_FederationControllerConfiguration(in *config.FederationControllerConfiguration, out *FederationControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_FederationControllerConfiguration_To_v1alpha1_FederationControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_HTTPSServer_To_config_HTTPSServer(in *HTTPSServer, out *config.HTTPSServer, s conversion.Scope) error {
 	if err := Convert_v1alpha1_Server_To_config_Server(&in.Server, &out.Server, s); err != nil {
 		return err
@@ -535,6 +883,28 @@ func Convert_config_HTTPSServer_To_v1alpha1_HTTPSServer(in *config.HTTPSServer,
 	return autoConvert_config_HTTPSServer_To_v1alpha1_HTTPSServer(in, out, s)
 }
 
+func autoConvert_v1alpha1_ImagesConfiguration_To_config_ImagesConfiguration(in *ImagesConfiguration, out *config.ImagesConfiguration, s conversion.Scope) error {
+	out.Registry = (*string)(unsafe.Pointer(in.Registry))
+	out.RequireDigests = in.RequireDigests
+	return nil
+}
+
+// Convert_v1alpha1_ImagesConfiguration_To_config_ImagesConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ImagesConfiguration_To_config_ImagesConfiguration(in *ImagesConfiguration, out *config.ImagesConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImagesConfiguration_To_config_ImagesConfiguration(in, out, s)
+}
+
+func autoConvert_config_ImagesConfiguration_To_v1alpha1_ImagesConfiguration(in *config.ImagesConfiguration, out *ImagesConfiguration, s conversion.Scope) error {
+	out.Registry = (*string)(unsafe.Pointer(in.Registry))
+	out.RequireDigests = in.RequireDigests
+	return nil
+}
+
+// Convert_config_ImagesConfiguration_To_v1alpha1_ImagesConfiguration is an autogenerated conversion function.
+func Convert_config_ImagesConfiguration_To_v1alpha1_ImagesConfiguration(in *config.ImagesConfiguration, out *ImagesConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ImagesConfiguration_To_v1alpha1_ImagesConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_LeaderElectionConfiguration_To_config_LeaderElectionConfiguration(in *LeaderElectionConfiguration, out *config.LeaderElectionConfiguration, s conversion.Scope) error {
 	if err := apisconfigv1alpha1.Convert_v1alpha1_LeaderElectionConfiguration_To_config_LeaderElectionConfiguration(&in.LeaderElectionConfiguration, &out.LeaderElectionConfiguration, s); err != nil {
 		return err
@@ -563,8 +933,34 @@ func Convert_config_LeaderElectionConfiguration_To_v1alpha1_LeaderElectionConfig
 	return autoConvert_config_LeaderElectionConfiguration_To_v1alpha1_LeaderElectionConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_OperationTimeouts_To_config_OperationTimeouts(in *OperationTimeouts, out *config.OperationTimeouts, s conversion.Scope) error {
+	out.Infrastructure = (*v1.Duration)(unsafe.Pointer(in.Infrastructure))
+	out.WorkerRollout = (*v1.Duration)(unsafe.Pointer(in.WorkerRollout))
+	out.ExtensionReadiness = (*v1.Duration)(unsafe.Pointer(in.ExtensionReadiness))
+	return nil
+}
+
+// Convert_v1alpha1_OperationTimeouts_To_config_OperationTimeouts is an autogenerated conversion function.
+func Convert_v1alpha1_OperationTimeouts_To_config_OperationTimeouts(in *OperationTimeouts, out *config.OperationTimeouts, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OperationTimeouts_To_config_OperationTimeouts(in, out, s)
+}
+
+func autoConvert_config_OperationTimeouts_To_v1alpha1_OperationTimeouts(in *config.OperationTimeouts, out *OperationTimeouts, s conversion.Scope) error {
+	out.Infrastructure = (*v1.Duration)(unsafe.Pointer(in.Infrastructure))
+	out.WorkerRollout = (*v1.Duration)(unsafe.Pointer(in.WorkerRollout))
+	out.ExtensionReadiness = (*v1.Duration)(unsafe.Pointer(in.ExtensionReadiness))
+	return nil
+}
+
+// Convert_config_OperationTimeouts_To_v1alpha1_OperationTimeouts is an autogenerated conversion function.
+func Convert_config_OperationTimeouts_To_v1alpha1_OperationTimeouts(in *config.OperationTimeouts, out *OperationTimeouts, s conversion.Scope) error {
+	return autoConvert_config_OperationTimeouts_To_v1alpha1_OperationTimeouts(in, out, s)
+}
+
 func autoConvert_v1alpha1_ProjectControllerConfiguration_To_config_ProjectControllerConfiguration(in *ProjectControllerConfiguration, out *config.ProjectControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.StaleAfter = (*v1.Duration)(unsafe.Pointer(in.StaleAfter))
+	out.StaleDeletionGracePeriod = (*v1.Duration)(unsafe.Pointer(in.StaleDeletionGracePeriod))
 	return nil
 }
 
@@ -575,6 +971,8 @@ func Convert_v1alpha1_ProjectControllerConfiguration_To_config_ProjectController
 
 func autoConvert_config_ProjectControllerConfiguration_To_v1alpha1_ProjectControllerConfiguration(in *config.ProjectControllerConfiguration, out *ProjectControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.StaleAfter = (*v1.Duration)(unsafe.Pointer(in.StaleAfter))
+	out.StaleDeletionGracePeriod = (*v1.Duration)(unsafe.Pointer(in.StaleDeletionGracePeriod))
 	return nil
 }
 
@@ -603,6 +1001,28 @@ func Convert_config_QuotaControllerConfiguration_To_v1alpha1_QuotaControllerConf
 	return autoConvert_config_QuotaControllerConfiguration_To_v1alpha1_QuotaControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_RemediationRateLimit_To_config_RemediationRateLimit(in *RemediationRateLimit, out *config.RemediationRateLimit, s conversion.Scope) error {
+	out.MaxActions = in.MaxActions
+	out.Period = in.Period
+	return nil
+}
+
+// Convert_v1alpha1_RemediationRateLimit_To_config_RemediationRateLimit is an autogenerated conversion function.
+func Convert_v1alpha1_RemediationRateLimit_To_config_RemediationRateLimit(in *RemediationRateLimit, out *config.RemediationRateLimit, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RemediationRateLimit_To_config_RemediationRateLimit(in, out, s)
+}
+
+func autoConvert_config_RemediationRateLimit_To_v1alpha1_RemediationRateLimit(in *config.RemediationRateLimit, out *RemediationRateLimit, s conversion.Scope) error {
+	out.MaxActions = in.MaxActions
+	out.Period = in.Period
+	return nil
+}
+
+// Convert_config_RemediationRateLimit_To_v1alpha1_RemediationRateLimit is an autogenerated conversion function.
+func Convert_config_RemediationRateLimit_To_v1alpha1_RemediationRateLimit(in *config.RemediationRateLimit, out *RemediationRateLimit, s conversion.Scope) error {
+	return autoConvert_config_RemediationRateLimit_To_v1alpha1_RemediationRateLimit(in, out, s)
+}
+
 func autoConvert_v1alpha1_SecretBindingControllerConfiguration_To_config_SecretBindingControllerConfiguration(in *SecretBindingControllerConfiguration, out *config.SecretBindingControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	return nil
@@ -647,6 +1067,34 @@ func Convert_config_SeedControllerConfiguration_To_v1alpha1_SeedControllerConfig
 	return autoConvert_config_SeedControllerConfiguration_To_v1alpha1_SeedControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_SeedCordonControllerConfiguration_To_config_SeedCordonControllerConfiguration(in *SeedCordonControllerConfiguration, out *config.SeedCordonControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.SyncPeriod = in.SyncPeriod
+	out.MinimumShoots = in.MinimumShoots
+	out.CordonThreshold = in.CordonThreshold
+	out.UncordonThreshold = in.UncordonThreshold
+	return nil
+}
+
+// Convert_v1alpha1_SeedCordonControllerConfiguration_To_config_SeedCordonControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SeedCordonControllerConfiguration_To_config_SeedCordonControllerConfiguration(in *SeedCordonControllerConfiguration, out *config.SeedCordonControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedCordonControllerConfiguration_To_config_SeedCordonControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_SeedCordonControllerConfiguration_To_v1alpha1_SeedCordonControllerConfiguration(in *config.SeedCordonControllerConfiguration, out *SeedCordonControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.SyncPeriod = in.SyncPeriod
+	out.MinimumShoots = in.MinimumShoots
+	out.CordonThreshold = in.CordonThreshold
+	out.UncordonThreshold = in.UncordonThreshold
+	return nil
+}
+
+// Convert_config_SeedCordonControllerConfiguration_To_v1alpha1_SeedCordonControllerConfiguration is an autogenerated conversion function.
+func Convert_config_SeedCordonControllerConfiguration_To_v1alpha1_SeedCordonControllerConfiguration(in *config.SeedCordonControllerConfiguration, out *SeedCordonControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SeedCordonControllerConfiguration_To_v1alpha1_SeedCordonControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_Server_To_config_Server(in *Server, out *config.Server, s conversion.Scope) error {
 	out.BindAddress = in.BindAddress
 	out.Port = in.Port
@@ -676,6 +1124,7 @@ func autoConvert_v1alpha1_ServerConfiguration_To_config_ServerConfiguration(in *
 	if err := Convert_v1alpha1_HTTPSServer_To_config_HTTPSServer(&in.HTTPS, &out.HTTPS, s); err != nil {
 		return err
 	}
+	out.EnableDebugHandlers = in.EnableDebugHandlers
 	return nil
 }
 
@@ -691,6 +1140,7 @@ func autoConvert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in *
 	if err := Convert_config_HTTPSServer_To_v1alpha1_HTTPSServer(&in.HTTPS, &out.HTTPS, s); err != nil {
 		return err
 	}
+	out.EnableDebugHandlers = in.EnableDebugHandlers
 	return nil
 }
 
@@ -701,6 +1151,8 @@ func Convert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in *conf
 
 func autoConvert_v1alpha1_ShootBackup_To_config_ShootBackup(in *ShootBackup, out *config.ShootBackup, s conversion.Scope) error {
 	out.Schedule = in.Schedule
+	out.DeltaSnapshotPeriod = (*v1.Duration)(unsafe.Pointer(in.DeltaSnapshotPeriod))
+	out.Compression = (*string)(unsafe.Pointer(in.Compression))
 	return nil
 }
 
@@ -711,6 +1163,8 @@ func Convert_v1alpha1_ShootBackup_To_config_ShootBackup(in *ShootBackup, out *co
 
 func autoConvert_config_ShootBackup_To_v1alpha1_ShootBackup(in *config.ShootBackup, out *ShootBackup, s conversion.Scope) error {
 	out.Schedule = in.Schedule
+	out.DeltaSnapshotPeriod = (*v1.Duration)(unsafe.Pointer(in.DeltaSnapshotPeriod))
+	out.Compression = (*string)(unsafe.Pointer(in.Compression))
 	return nil
 }
 
@@ -719,10 +1173,34 @@ func Convert_config_ShootBackup_To_v1alpha1_ShootBackup(in *config.ShootBackup,
 	return autoConvert_config_ShootBackup_To_v1alpha1_ShootBackup(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootCareAPIServerProbes_To_config_ShootCareAPIServerProbes(in *ShootCareAPIServerProbes, out *config.ShootCareAPIServerProbes, s conversion.Scope) error {
+	out.Timeout = in.Timeout
+	out.ExternalProbers = *(*[]config.ExternalAPIServerProber)(unsafe.Pointer(&in.ExternalProbers))
+	return nil
+}
+
+// Convert_v1alpha1_ShootCareAPIServerProbes_To_config_ShootCareAPIServerProbes is an autogenerated conversion function.
+func Convert_v1alpha1_ShootCareAPIServerProbes_To_config_ShootCareAPIServerProbes(in *ShootCareAPIServerProbes, out *config.ShootCareAPIServerProbes, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootCareAPIServerProbes_To_config_ShootCareAPIServerProbes(in, out, s)
+}
+
+func autoConvert_config_ShootCareAPIServerProbes_To_v1alpha1_ShootCareAPIServerProbes(in *config.ShootCareAPIServerProbes, out *ShootCareAPIServerProbes, s conversion.Scope) error {
+	out.Timeout = in.Timeout
+	out.ExternalProbers = *(*[]ExternalAPIServerProber)(unsafe.Pointer(&in.ExternalProbers))
+	return nil
+}
+
+// Convert_config_ShootCareAPIServerProbes_To_v1alpha1_ShootCareAPIServerProbes is an autogenerated conversion function.
+func Convert_config_ShootCareAPIServerProbes_To_v1alpha1_ShootCareAPIServerProbes(in *config.ShootCareAPIServerProbes, out *ShootCareAPIServerProbes, s conversion.Scope) error {
+	return autoConvert_config_ShootCareAPIServerProbes_To_v1alpha1_ShootCareAPIServerProbes(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootCareControllerConfiguration_To_config_ShootCareControllerConfiguration(in *ShootCareControllerConfiguration, out *config.ShootCareControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.SyncPeriod = in.SyncPeriod
 	out.ConditionThresholds = *(*[]config.ConditionThreshold)(unsafe.Pointer(&in.ConditionThresholds))
+	out.Remediation = (*config.ShootCareRemediation)(unsafe.Pointer(in.Remediation))
+	out.APIServerProbes = (*config.ShootCareAPIServerProbes)(unsafe.Pointer(in.APIServerProbes))
 	return nil
 }
 
@@ -735,6 +1213,8 @@ func autoConvert_config_ShootCareControllerConfiguration_To_v1alpha1_ShootCareCo
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.SyncPeriod = in.SyncPeriod
 	out.ConditionThresholds = *(*[]ConditionThreshold)(unsafe.Pointer(&in.ConditionThresholds))
+	out.Remediation = (*ShootCareRemediation)(unsafe.Pointer(in.Remediation))
+	out.APIServerProbes = (*ShootCareAPIServerProbes)(unsafe.Pointer(in.APIServerProbes))
 	return nil
 }
 
@@ -743,6 +1223,58 @@ func Convert_config_ShootCareControllerConfiguration_To_v1alpha1_ShootCareContro
 	return autoConvert_config_ShootCareControllerConfiguration_To_v1alpha1_ShootCareControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootCareRemediation_To_config_ShootCareRemediation(in *ShootCareRemediation, out *config.ShootCareRemediation, s conversion.Scope) error {
+	out.RestartCrashLoopingControlPlanePods = (*config.RemediationRateLimit)(unsafe.Pointer(in.RestartCrashLoopingControlPlanePods))
+	out.ReconcileOperatingSystemConfigs = (*config.RemediationRateLimit)(unsafe.Pointer(in.ReconcileOperatingSystemConfigs))
+	out.NodeNotReadySignatures = *(*[]string)(unsafe.Pointer(&in.NodeNotReadySignatures))
+	return nil
+}
+
+// Convert_v1alpha1_ShootCareRemediation_To_config_ShootCareRemediation is an autogenerated conversion function.
+func Convert_v1alpha1_ShootCareRemediation_To_config_ShootCareRemediation(in *ShootCareRemediation, out *config.ShootCareRemediation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootCareRemediation_To_config_ShootCareRemediation(in, out, s)
+}
+
+func autoConvert_config_ShootCareRemediation_To_v1alpha1_ShootCareRemediation(in *config.ShootCareRemediation, out *ShootCareRemediation, s conversion.Scope) error {
+	out.RestartCrashLoopingControlPlanePods = (*RemediationRateLimit)(unsafe.Pointer(in.RestartCrashLoopingControlPlanePods))
+	out.ReconcileOperatingSystemConfigs = (*RemediationRateLimit)(unsafe.Pointer(in.ReconcileOperatingSystemConfigs))
+	out.NodeNotReadySignatures = *(*[]string)(unsafe.Pointer(&in.NodeNotReadySignatures))
+	return nil
+}
+
+// Convert_config_ShootCareRemediation_To_v1alpha1_ShootCareRemediation is an autogenerated conversion function.
+func Convert_config_ShootCareRemediation_To_v1alpha1_ShootCareRemediation(in *config.ShootCareRemediation, out *ShootCareRemediation, s conversion.Scope) error {
+	return autoConvert_config_ShootCareRemediation_To_v1alpha1_ShootCareRemediation(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootCertificates_To_config_ShootCertificates(in *ShootCertificates, out *config.ShootCertificates, s conversion.Scope) error {
+	out.KeyAlgorithm = (*string)(unsafe.Pointer(in.KeyAlgorithm))
+	out.CAValidity = (*v1.Duration)(unsafe.Pointer(in.CAValidity))
+	out.Validity = (*v1.Duration)(unsafe.Pointer(in.Validity))
+	out.RenewalThreshold = (*v1.Duration)(unsafe.Pointer(in.RenewalThreshold))
+	out.RenewalJitter = (*v1.Duration)(unsafe.Pointer(in.RenewalJitter))
+	return nil
+}
+
+// Convert_v1alpha1_ShootCertificates_To_config_ShootCertificates is an autogenerated conversion function.
+func Convert_v1alpha1_ShootCertificates_To_config_ShootCertificates(in *ShootCertificates, out *config.ShootCertificates, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootCertificates_To_config_ShootCertificates(in, out, s)
+}
+
+func autoConvert_config_ShootCertificates_To_v1alpha1_ShootCertificates(in *config.ShootCertificates, out *ShootCertificates, s conversion.Scope) error {
+	out.KeyAlgorithm = (*string)(unsafe.Pointer(in.KeyAlgorithm))
+	out.CAValidity = (*v1.Duration)(unsafe.Pointer(in.CAValidity))
+	out.Validity = (*v1.Duration)(unsafe.Pointer(in.Validity))
+	out.RenewalThreshold = (*v1.Duration)(unsafe.Pointer(in.RenewalThreshold))
+	out.RenewalJitter = (*v1.Duration)(unsafe.Pointer(in.RenewalJitter))
+	return nil
+}
+
+// Convert_config_ShootCertificates_To_v1alpha1_ShootCertificates is an autogenerated conversion function.
+func Convert_config_ShootCertificates_To_v1alpha1_ShootCertificates(in *config.ShootCertificates, out *ShootCertificates, s conversion.Scope) error {
+	return autoConvert_config_ShootCertificates_To_v1alpha1_ShootCertificates(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootControllerConfiguration_To_config_ShootControllerConfiguration(in *ShootControllerConfiguration, out *config.ShootControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.RespectSyncPeriodOverwrite = (*bool)(unsafe.Pointer(in.RespectSyncPeriodOverwrite))
@@ -773,6 +1305,7 @@ func Convert_config_ShootControllerConfiguration_To_v1alpha1_ShootControllerConf
 
 func autoConvert_v1alpha1_ShootHibernationControllerConfiguration_To_config_ShootHibernationControllerConfiguration(in *ShootHibernationControllerConfiguration, out *config.ShootHibernationControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.PricePerNodeHour = (*string)(unsafe.Pointer(in.PricePerNodeHour))
 	return nil
 }
 
@@ -783,6 +1316,7 @@ func Convert_v1alpha1_ShootHibernationControllerConfiguration_To_config_ShootHib
 
 func autoConvert_config_ShootHibernationControllerConfiguration_To_v1alpha1_ShootHibernationControllerConfiguration(in *config.ShootHibernationControllerConfiguration, out *ShootHibernationControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.PricePerNodeHour = (*string)(unsafe.Pointer(in.PricePerNodeHour))
 	return nil
 }
 
@@ -793,6 +1327,8 @@ func Convert_config_ShootHibernationControllerConfiguration_To_v1alpha1_ShootHib
 
 func autoConvert_v1alpha1_ShootMaintenanceControllerConfiguration_To_config_ShootMaintenanceControllerConfiguration(in *ShootMaintenanceControllerConfiguration, out *config.ShootMaintenanceControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.ConcurrentShootsPerSeed = (*int)(unsafe.Pointer(in.ConcurrentShootsPerSeed))
+	out.RetryPeriod = (*v1.Duration)(unsafe.Pointer(in.RetryPeriod))
 	return nil
 }
 
@@ -803,6 +1339,8 @@ func Convert_v1alpha1_ShootMaintenanceControllerConfiguration_To_config_ShootMai
 
 func autoConvert_config_ShootMaintenanceControllerConfiguration_To_v1alpha1_ShootMaintenanceControllerConfiguration(in *config.ShootMaintenanceControllerConfiguration, out *ShootMaintenanceControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.ConcurrentShootsPerSeed = (*int)(unsafe.Pointer(in.ConcurrentShootsPerSeed))
+	out.RetryPeriod = (*v1.Duration)(unsafe.Pointer(in.RetryPeriod))
 	return nil
 }
 
@@ -833,6 +1371,58 @@ func Convert_config_ShootQuotaControllerConfiguration_To_v1alpha1_ShootQuotaCont
 	return autoConvert_config_ShootQuotaControllerConfiguration_To_v1alpha1_ShootQuotaControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootTimeoutProfile_To_config_ShootTimeoutProfile(in *ShootTimeoutProfile, out *config.ShootTimeoutProfile, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CloudProvider = (*string)(unsafe.Pointer(in.CloudProvider))
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	if err := Convert_v1alpha1_OperationTimeouts_To_config_OperationTimeouts(&in.Timeouts, &out.Timeouts, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ShootTimeoutProfile_To_config_ShootTimeoutProfile is an autogenerated conversion function.
+func Convert_v1alpha1_ShootTimeoutProfile_To_config_ShootTimeoutProfile(in *ShootTimeoutProfile, out *config.ShootTimeoutProfile, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootTimeoutProfile_To_config_ShootTimeoutProfile(in, out, s)
+}
+
+func autoConvert_config_ShootTimeoutProfile_To_v1alpha1_ShootTimeoutProfile(in *config.ShootTimeoutProfile, out *ShootTimeoutProfile, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CloudProvider = (*string)(unsafe.Pointer(in.CloudProvider))
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	if err := Convert_config_OperationTimeouts_To_v1alpha1_OperationTimeouts(&in.Timeouts, &out.Timeouts, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_ShootTimeoutProfile_To_v1alpha1_ShootTimeoutProfile is an autogenerated conversion function.
+func Convert_config_ShootTimeoutProfile_To_v1alpha1_ShootTimeoutProfile(in *config.ShootTimeoutProfile, out *ShootTimeoutProfile, s conversion.Scope) error {
+	return autoConvert_config_ShootTimeoutProfile_To_v1alpha1_ShootTimeoutProfile(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootTimeouts_To_config_ShootTimeouts(in *ShootTimeouts, out *config.ShootTimeouts, s conversion.Scope) error {
+	out.Default = (*config.OperationTimeouts)(unsafe.Pointer(in.Default))
+	out.Profiles = *(*[]config.ShootTimeoutProfile)(unsafe.Pointer(&in.Profiles))
+	return nil
+}
+
+// Convert_v1alpha1_ShootTimeouts_To_config_ShootTimeouts is an autogenerated conversion function.
+func Convert_v1alpha1_ShootTimeouts_To_config_ShootTimeouts(in *ShootTimeouts, out *config.ShootTimeouts, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootTimeouts_To_config_ShootTimeouts(in, out, s)
+}
+
+func autoConvert_config_ShootTimeouts_To_v1alpha1_ShootTimeouts(in *config.ShootTimeouts, out *ShootTimeouts, s conversion.Scope) error {
+	out.Default = (*OperationTimeouts)(unsafe.Pointer(in.Default))
+	out.Profiles = *(*[]ShootTimeoutProfile)(unsafe.Pointer(&in.Profiles))
+	return nil
+}
+
+// Convert_config_ShootTimeouts_To_v1alpha1_ShootTimeouts is an autogenerated conversion function.
+func Convert_config_ShootTimeouts_To_v1alpha1_ShootTimeouts(in *config.ShootTimeouts, out *ShootTimeouts, s conversion.Scope) error {
+	return autoConvert_config_ShootTimeouts_To_v1alpha1_ShootTimeouts(in, out, s)
+}
+
 func autoConvert_v1alpha1_TLSServer_To_config_TLSServer(in *TLSServer, out *config.TLSServer, s conversion.Scope) error {
 	out.ServerCertPath = in.ServerCertPath
 	out.ServerKeyPath = in.ServerKeyPath
@@ -0,0 +1,160 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package validation
+
+import (
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateControllerManagerConfiguration validates the given ControllerManagerConfiguration.
+func ValidateControllerManagerConfiguration(conf *config.ControllerManagerConfiguration) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.ShootCertificates != nil {
+		allErrs = append(allErrs, validateShootCertificates(conf.ShootCertificates, field.NewPath("shootCertificates"))...)
+	}
+	if conf.ShootTimeouts != nil {
+		allErrs = append(allErrs, validateShootTimeouts(conf.ShootTimeouts, field.NewPath("shootTimeouts"))...)
+	}
+	if conf.Controllers.SeedCordon != nil {
+		allErrs = append(allErrs, validateSeedCordon(conf.Controllers.SeedCordon, field.NewPath("controllers", "seedCordon"))...)
+	}
+	if conf.SeedSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(conf.SeedSelector, field.NewPath("seedSelector"))...)
+
+		// The global controllers would run in every controller manager and race each other otherwise.
+		if !conf.LeaderElection.LeaderElect {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("seedSelector"), "a seed selector requires leader election (leaderElection.leaderElect) so that the global controllers only run once"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateSeedCordon(seedCordon *config.SeedCordonControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for name, threshold := range map[string]int{
+		"cordonThreshold":   seedCordon.CordonThreshold,
+		"uncordonThreshold": seedCordon.UncordonThreshold,
+	} {
+		if threshold < 0 || threshold > 100 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(name), threshold, "must be a percentage between 0 and 100"))
+		}
+	}
+
+	// Seeds would be cordoned and uncordoned again with every sync otherwise.
+	if seedCordon.UncordonThreshold > seedCordon.CordonThreshold {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("uncordonThreshold"), seedCordon.UncordonThreshold, fmt.Sprintf("must not be greater than the cordon threshold %d", seedCordon.CordonThreshold)))
+	}
+
+	return allErrs
+}
+
+func validateShootCertificates(shootCertificates *config.ShootCertificates, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if keyAlgorithm := shootCertificates.KeyAlgorithm; keyAlgorithm != nil {
+		if _, err := secrets.ParseKeyAlgorithm(*keyAlgorithm); err != nil || len(*keyAlgorithm) == 0 {
+			var supported []string
+			for _, algorithm := range secrets.SupportedKeyAlgorithms {
+				supported = append(supported, string(algorithm))
+			}
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("keyAlgorithm"), *keyAlgorithm, supported))
+		}
+	}
+
+	for name, duration := range map[string]*metav1.Duration{
+		"caValidity":       shootCertificates.CAValidity,
+		"validity":         shootCertificates.Validity,
+		"renewalThreshold": shootCertificates.RenewalThreshold,
+	} {
+		if duration != nil && duration.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(name), duration.Duration.String(), "must be positive"))
+		}
+	}
+	if jitter := shootCertificates.RenewalJitter; jitter != nil && jitter.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("renewalJitter"), jitter.Duration.String(), "must not be negative"))
+	}
+
+	validity := secrets.DefaultCertificateValidity
+	if shootCertificates.Validity != nil {
+		validity = shootCertificates.Validity.Duration
+	}
+	if threshold := shootCertificates.RenewalThreshold; threshold != nil && threshold.Duration > 0 && validity > 0 {
+		renewal := threshold.Duration
+		if shootCertificates.RenewalJitter != nil {
+			renewal += shootCertificates.RenewalJitter.Duration
+		}
+		if renewal >= validity {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("renewalThreshold"), threshold.Duration.String(), fmt.Sprintf("plus the renewal jitter must be less than the certificate validity %s", validity)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateShootTimeouts(shootTimeouts *config.ShootTimeouts, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if shootTimeouts.Default != nil {
+		allErrs = append(allErrs, validateOperationTimeouts(shootTimeouts.Default, fldPath.Child("default"))...)
+	}
+
+	names := sets.NewString()
+	for i, profile := range shootTimeouts.Profiles {
+		idxPath := fldPath.Child("profiles").Index(i)
+
+		if len(profile.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if names.Has(profile.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), profile.Name))
+		}
+		names.Insert(profile.Name)
+
+		if profile.ShootSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(profile.ShootSelector, idxPath.Child("shootSelector"))...)
+		}
+		allErrs = append(allErrs, validateOperationTimeouts(&profile.Timeouts, idxPath.Child("timeouts"))...)
+	}
+
+	return allErrs
+}
+
+func validateOperationTimeouts(timeouts *config.OperationTimeouts, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, timeout := range []struct {
+		name     string
+		duration *metav1.Duration
+	}{
+		{"infrastructure", timeouts.Infrastructure},
+		{"workerRollout", timeouts.WorkerRollout},
+		{"extensionReadiness", timeouts.ExtensionReadiness},
+	} {
+		if timeout.duration != nil && timeout.duration.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(timeout.name), timeout.duration.Duration.String(), "must be positive"))
+		}
+	}
+
+	return allErrs
+}
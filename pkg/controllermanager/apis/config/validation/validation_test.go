@@ -0,0 +1,223 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package validation_test
+
+import (
+	"time"
+
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/apis/config/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("#ValidateControllerManagerConfiguration", func() {
+	var conf *config.ControllerManagerConfiguration
+
+	BeforeEach(func() {
+		conf = &config.ControllerManagerConfiguration{}
+	})
+
+	It("should allow an empty configuration", func() {
+		Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+	})
+
+	Context("shoot certificates", func() {
+		It("should allow supported key algorithms", func() {
+			keyAlgorithm := "ECDSA-P384"
+			conf.ShootCertificates = &config.ShootCertificates{KeyAlgorithm: &keyAlgorithm}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should forbid unsupported key algorithms", func() {
+			keyAlgorithm := "DSA-1024"
+			conf.ShootCertificates = &config.ShootCertificates{KeyAlgorithm: &keyAlgorithm}
+
+			errorList := ValidateControllerManagerConfiguration(conf)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("shootCertificates.keyAlgorithm"),
+			}))))
+		})
+
+		It("should forbid non-positive validities", func() {
+			conf.ShootCertificates = &config.ShootCertificates{
+				CAValidity:       &metav1.Duration{},
+				Validity:         &metav1.Duration{Duration: -time.Hour},
+				RenewalThreshold: &metav1.Duration{},
+				RenewalJitter:    &metav1.Duration{Duration: -time.Hour},
+			}
+
+			errorList := ValidateControllerManagerConfiguration(conf)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("shootCertificates.caValidity")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("shootCertificates.validity")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("shootCertificates.renewalThreshold")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("shootCertificates.renewalJitter")})),
+			))
+		})
+
+		It("should forbid renewal thresholds which are not less than the validity", func() {
+			conf.ShootCertificates = &config.ShootCertificates{
+				Validity:         &metav1.Duration{Duration: 720 * time.Hour},
+				RenewalThreshold: &metav1.Duration{Duration: 700 * time.Hour},
+				RenewalJitter:    &metav1.Duration{Duration: 20 * time.Hour},
+			}
+
+			errorList := ValidateControllerManagerConfiguration(conf)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("shootCertificates.renewalThreshold"),
+			}))))
+		})
+	})
+
+	Context("shoot timeouts", func() {
+		It("should allow positive timeouts", func() {
+			conf.ShootTimeouts = &config.ShootTimeouts{
+				Default: &config.OperationTimeouts{Infrastructure: &metav1.Duration{Duration: time.Hour}},
+				Profiles: []config.ShootTimeoutProfile{{
+					Name:     "slow",
+					Timeouts: config.OperationTimeouts{WorkerRollout: &metav1.Duration{Duration: time.Hour}},
+				}},
+			}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should forbid zero or negative timeouts", func() {
+			conf.ShootTimeouts = &config.ShootTimeouts{
+				Default: &config.OperationTimeouts{
+					Infrastructure:     &metav1.Duration{},
+					WorkerRollout:      &metav1.Duration{Duration: -time.Minute},
+					ExtensionReadiness: &metav1.Duration{Duration: time.Second},
+				},
+				Profiles: []config.ShootTimeoutProfile{{
+					Name:     "slow",
+					Timeouts: config.OperationTimeouts{ExtensionReadiness: &metav1.Duration{Duration: -time.Second}},
+				}},
+			}
+
+			errorList := ValidateControllerManagerConfiguration(conf)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("shootTimeouts.default.infrastructure")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("shootTimeouts.default.workerRollout")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("shootTimeouts.profiles[0].timeouts.extensionReadiness")})),
+			))
+		})
+
+		It("should forbid profiles without or with duplicate names", func() {
+			conf.ShootTimeouts = &config.ShootTimeouts{
+				Profiles: []config.ShootTimeoutProfile{{}, {Name: "slow"}, {Name: "slow"}},
+			}
+
+			errorList := ValidateControllerManagerConfiguration(conf)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("shootTimeouts.profiles[0].name")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeDuplicate), "Field": Equal("shootTimeouts.profiles[2].name")})),
+			))
+		})
+
+		It("should forbid invalid shoot selectors", func() {
+			conf.ShootTimeouts = &config.ShootTimeouts{
+				Profiles: []config.ShootTimeoutProfile{{
+					Name: "slow",
+					ShootSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+						Key:      "foo",
+						Operator: metav1.LabelSelectorOpIn,
+					}}},
+				}},
+			}
+
+			errorList := ValidateControllerManagerConfiguration(conf)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Field": Equal("shootTimeouts.profiles[0].shootSelector.matchExpressions[0].values"),
+			}))))
+		})
+	})
+
+	Context("seed cordon", func() {
+		It("should allow an uncordon threshold which is less than the cordon threshold", func() {
+			conf.Controllers.SeedCordon = &config.SeedCordonControllerConfiguration{CordonThreshold: 50, UncordonThreshold: 20}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should forbid an uncordon threshold which is greater than the cordon threshold", func() {
+			conf.Controllers.SeedCordon = &config.SeedCordonControllerConfiguration{CordonThreshold: 20, UncordonThreshold: 50}
+
+			errorList := ValidateControllerManagerConfiguration(conf)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("controllers.seedCordon.uncordonThreshold"),
+			}))))
+		})
+
+		It("should forbid thresholds which are no percentages", func() {
+			conf.Controllers.SeedCordon = &config.SeedCordonControllerConfiguration{CordonThreshold: 101, UncordonThreshold: -1}
+
+			errorList := ValidateControllerManagerConfiguration(conf)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.seedCordon.cordonThreshold")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("controllers.seedCordon.uncordonThreshold")})),
+			))
+		})
+	})
+
+	Context("seed selector", func() {
+		BeforeEach(func() {
+			conf.SeedSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"seed.gardener.cloud/group": "eu"}}
+		})
+
+		It("should allow a seed selector with leader election", func() {
+			conf.LeaderElection.LeaderElect = true
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should forbid a seed selector without leader election", func() {
+			errorList := ValidateControllerManagerConfiguration(conf)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("seedSelector"),
+			}))))
+		})
+
+		It("should forbid invalid seed selectors", func() {
+			conf.LeaderElection.LeaderElect = true
+			conf.SeedSelector.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: "foo", Operator: "invalid"}}
+
+			errorList := ValidateControllerManagerConfiguration(conf)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("seedSelector.matchExpressions[0].operator"),
+			}))))
+		})
+	})
+})
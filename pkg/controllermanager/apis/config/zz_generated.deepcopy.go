@@ -64,6 +64,84 @@ func (in *CloudProfileControllerConfiguration) DeepCopy() *CloudProfileControlle
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComplianceControllerConfiguration) DeepCopyInto(out *ComplianceControllerConfiguration) {
+	*out = *in
+	out.SyncPeriod = in.SyncPeriod
+	in.Profile.DeepCopyInto(&out.Profile)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComplianceControllerConfiguration.
+func (in *ComplianceControllerConfiguration) DeepCopy() *ComplianceControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ComplianceControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComplianceKubernetesVersion) DeepCopyInto(out *ComplianceKubernetesVersion) {
+	*out = *in
+	if in.MaxMinorVersionsBehind != nil {
+		in, out := &in.MaxMinorVersionsBehind, &out.MaxMinorVersionsBehind
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComplianceKubernetesVersion.
+func (in *ComplianceKubernetesVersion) DeepCopy() *ComplianceKubernetesVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(ComplianceKubernetesVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComplianceProfile) DeepCopyInto(out *ComplianceProfile) {
+	*out = *in
+	if in.KubernetesVersion != nil {
+		in, out := &in.KubernetesVersion, &out.KubernetesVersion
+		*out = new(ComplianceKubernetesVersion)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComplianceProfile.
+func (in *ComplianceProfile) DeepCopy() *ComplianceProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ComplianceProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConditionStalenessControllerConfiguration) DeepCopyInto(out *ConditionStalenessControllerConfiguration) {
+	*out = *in
+	out.SyncPeriod = in.SyncPeriod
+	out.Threshold = in.Threshold
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionStalenessControllerConfiguration.
+func (in *ConditionStalenessControllerConfiguration) DeepCopy() *ConditionStalenessControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionStalenessControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConditionThreshold) DeepCopyInto(out *ConditionThreshold) {
 	*out = *in
@@ -110,10 +188,30 @@ func (in *ControllerManagerConfiguration) DeepCopyInto(out *ControllerManagerCon
 	in.Controllers.DeepCopyInto(&out.Controllers)
 	out.LeaderElection = in.LeaderElection
 	out.Server = in.Server
+	if in.SeedSelector != nil {
+		in, out := &in.SeedSelector, &out.SeedSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ShootBackup != nil {
 		in, out := &in.ShootBackup, &out.ShootBackup
 		*out = new(ShootBackup)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShootCertificates != nil {
+		in, out := &in.ShootCertificates, &out.ShootCertificates
+		*out = new(ShootCertificates)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShootTimeouts != nil {
+		in, out := &in.ShootTimeouts, &out.ShootTimeouts
+		*out = new(ShootTimeouts)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = new(ImagesConfiguration)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.FeatureGates != nil {
 		in, out := &in.FeatureGates, &out.FeatureGates
@@ -152,6 +250,16 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(CloudProfileControllerConfiguration)
 		**out = **in
 	}
+	if in.Compliance != nil {
+		in, out := &in.Compliance, &out.Compliance
+		*out = new(ComplianceControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConditionStaleness != nil {
+		in, out := &in.ConditionStaleness, &out.ConditionStaleness
+		*out = new(ConditionStalenessControllerConfiguration)
+		**out = **in
+	}
 	if in.ControllerRegistration != nil {
 		in, out := &in.ControllerRegistration, &out.ControllerRegistration
 		*out = new(ControllerRegistrationControllerConfiguration)
@@ -162,6 +270,16 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(ControllerInstallationControllerConfiguration)
 		**out = **in
 	}
+	if in.Export != nil {
+		in, out := &in.Export, &out.Export
+		*out = new(ExportControllerConfiguration)
+		**out = **in
+	}
+	if in.Federation != nil {
+		in, out := &in.Federation, &out.Federation
+		*out = new(FederationControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SecretBinding != nil {
 		in, out := &in.SecretBinding, &out.SecretBinding
 		*out = new(SecretBindingControllerConfiguration)
@@ -170,7 +288,7 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 	if in.Project != nil {
 		in, out := &in.Project, &out.Project
 		*out = new(ProjectControllerConfiguration)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Quota != nil {
 		in, out := &in.Quota, &out.Quota
@@ -182,11 +300,16 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(SeedControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SeedCordon != nil {
+		in, out := &in.SeedCordon, &out.SeedCordon
+		*out = new(SeedCordonControllerConfiguration)
+		**out = **in
+	}
 	in.Shoot.DeepCopyInto(&out.Shoot)
 	in.ShootCare.DeepCopyInto(&out.ShootCare)
-	out.ShootMaintenance = in.ShootMaintenance
+	in.ShootMaintenance.DeepCopyInto(&out.ShootMaintenance)
 	out.ShootQuota = in.ShootQuota
-	out.ShootHibernation = in.ShootHibernation
+	in.ShootHibernation.DeepCopyInto(&out.ShootHibernation)
 	return
 }
 
@@ -216,6 +339,64 @@ func (in *ControllerRegistrationControllerConfiguration) DeepCopy() *ControllerR
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExportControllerConfiguration) DeepCopyInto(out *ExportControllerConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportControllerConfiguration.
+func (in *ExportControllerConfiguration) DeepCopy() *ExportControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ExportControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalAPIServerProber) DeepCopyInto(out *ExternalAPIServerProber) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAPIServerProber.
+func (in *ExternalAPIServerProber) DeepCopy() *ExternalAPIServerProber {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalAPIServerProber)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FederationControllerConfiguration) DeepCopyInto(out *FederationControllerConfiguration) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederationControllerConfiguration.
+func (in *FederationControllerConfiguration) DeepCopy() *FederationControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(FederationControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPSServer) DeepCopyInto(out *HTTPSServer) {
 	*out = *in
@@ -234,6 +415,27 @@ func (in *HTTPSServer) DeepCopy() *HTTPSServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagesConfiguration) DeepCopyInto(out *ImagesConfiguration) {
+	*out = *in
+	if in.Registry != nil {
+		in, out := &in.Registry, &out.Registry
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagesConfiguration.
+func (in *ImagesConfiguration) DeepCopy() *ImagesConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagesConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LeaderElectionConfiguration) DeepCopyInto(out *LeaderElectionConfiguration) {
 	*out = *in
@@ -251,9 +453,50 @@ func (in *LeaderElectionConfiguration) DeepCopy() *LeaderElectionConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationTimeouts) DeepCopyInto(out *OperationTimeouts) {
+	*out = *in
+	if in.Infrastructure != nil {
+		in, out := &in.Infrastructure, &out.Infrastructure
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.WorkerRollout != nil {
+		in, out := &in.WorkerRollout, &out.WorkerRollout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ExtensionReadiness != nil {
+		in, out := &in.ExtensionReadiness, &out.ExtensionReadiness
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationTimeouts.
+func (in *OperationTimeouts) DeepCopy() *OperationTimeouts {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationTimeouts)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectControllerConfiguration) DeepCopyInto(out *ProjectControllerConfiguration) {
 	*out = *in
+	if in.StaleAfter != nil {
+		in, out := &in.StaleAfter, &out.StaleAfter
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.StaleDeletionGracePeriod != nil {
+		in, out := &in.StaleDeletionGracePeriod, &out.StaleDeletionGracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -283,6 +526,23 @@ func (in *QuotaControllerConfiguration) DeepCopy() *QuotaControllerConfiguration
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemediationRateLimit) DeepCopyInto(out *RemediationRateLimit) {
+	*out = *in
+	out.Period = in.Period
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemediationRateLimit.
+func (in *RemediationRateLimit) DeepCopy() *RemediationRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(RemediationRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretBindingControllerConfiguration) DeepCopyInto(out *SecretBindingControllerConfiguration) {
 	*out = *in
@@ -321,6 +581,23 @@ func (in *SeedControllerConfiguration) DeepCopy() *SeedControllerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedCordonControllerConfiguration) DeepCopyInto(out *SeedCordonControllerConfiguration) {
+	*out = *in
+	out.SyncPeriod = in.SyncPeriod
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedCordonControllerConfiguration.
+func (in *SeedCordonControllerConfiguration) DeepCopy() *SeedCordonControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedCordonControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -358,6 +635,16 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootBackup) DeepCopyInto(out *ShootBackup) {
 	*out = *in
+	if in.DeltaSnapshotPeriod != nil {
+		in, out := &in.DeltaSnapshotPeriod, &out.DeltaSnapshotPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -371,6 +658,28 @@ func (in *ShootBackup) DeepCopy() *ShootBackup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootCareAPIServerProbes) DeepCopyInto(out *ShootCareAPIServerProbes) {
+	*out = *in
+	out.Timeout = in.Timeout
+	if in.ExternalProbers != nil {
+		in, out := &in.ExternalProbers, &out.ExternalProbers
+		*out = make([]ExternalAPIServerProber, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCareAPIServerProbes.
+func (in *ShootCareAPIServerProbes) DeepCopy() *ShootCareAPIServerProbes {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootCareAPIServerProbes)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootCareControllerConfiguration) DeepCopyInto(out *ShootCareControllerConfiguration) {
 	*out = *in
@@ -380,6 +689,16 @@ func (in *ShootCareControllerConfiguration) DeepCopyInto(out *ShootCareControlle
 		*out = make([]ConditionThreshold, len(*in))
 		copy(*out, *in)
 	}
+	if in.Remediation != nil {
+		in, out := &in.Remediation, &out.Remediation
+		*out = new(ShootCareRemediation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIServerProbes != nil {
+		in, out := &in.APIServerProbes, &out.APIServerProbes
+		*out = new(ShootCareAPIServerProbes)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -393,6 +712,78 @@ func (in *ShootCareControllerConfiguration) DeepCopy() *ShootCareControllerConfi
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootCareRemediation) DeepCopyInto(out *ShootCareRemediation) {
+	*out = *in
+	if in.RestartCrashLoopingControlPlanePods != nil {
+		in, out := &in.RestartCrashLoopingControlPlanePods, &out.RestartCrashLoopingControlPlanePods
+		*out = new(RemediationRateLimit)
+		**out = **in
+	}
+	if in.ReconcileOperatingSystemConfigs != nil {
+		in, out := &in.ReconcileOperatingSystemConfigs, &out.ReconcileOperatingSystemConfigs
+		*out = new(RemediationRateLimit)
+		**out = **in
+	}
+	if in.NodeNotReadySignatures != nil {
+		in, out := &in.NodeNotReadySignatures, &out.NodeNotReadySignatures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCareRemediation.
+func (in *ShootCareRemediation) DeepCopy() *ShootCareRemediation {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootCareRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootCertificates) DeepCopyInto(out *ShootCertificates) {
+	*out = *in
+	if in.KeyAlgorithm != nil {
+		in, out := &in.KeyAlgorithm, &out.KeyAlgorithm
+		*out = new(string)
+		**out = **in
+	}
+	if in.CAValidity != nil {
+		in, out := &in.CAValidity, &out.CAValidity
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Validity != nil {
+		in, out := &in.Validity, &out.Validity
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RenewalThreshold != nil {
+		in, out := &in.RenewalThreshold, &out.RenewalThreshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RenewalJitter != nil {
+		in, out := &in.RenewalJitter, &out.RenewalJitter
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCertificates.
+func (in *ShootCertificates) DeepCopy() *ShootCertificates {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootCertificates)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootControllerConfiguration) DeepCopyInto(out *ShootControllerConfiguration) {
 	*out = *in
@@ -424,6 +815,11 @@ func (in *ShootControllerConfiguration) DeepCopy() *ShootControllerConfiguration
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootHibernationControllerConfiguration) DeepCopyInto(out *ShootHibernationControllerConfiguration) {
 	*out = *in
+	if in.PricePerNodeHour != nil {
+		in, out := &in.PricePerNodeHour, &out.PricePerNodeHour
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -440,6 +836,16 @@ func (in *ShootHibernationControllerConfiguration) DeepCopy() *ShootHibernationC
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootMaintenanceControllerConfiguration) DeepCopyInto(out *ShootMaintenanceControllerConfiguration) {
 	*out = *in
+	if in.ConcurrentShootsPerSeed != nil {
+		in, out := &in.ConcurrentShootsPerSeed, &out.ConcurrentShootsPerSeed
+		*out = new(int)
+		**out = **in
+	}
+	if in.RetryPeriod != nil {
+		in, out := &in.RetryPeriod, &out.RetryPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -470,6 +876,61 @@ func (in *ShootQuotaControllerConfiguration) DeepCopy() *ShootQuotaControllerCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootTimeoutProfile) DeepCopyInto(out *ShootTimeoutProfile) {
+	*out = *in
+	if in.CloudProvider != nil {
+		in, out := &in.CloudProvider, &out.CloudProvider
+		*out = new(string)
+		**out = **in
+	}
+	if in.ShootSelector != nil {
+		in, out := &in.ShootSelector, &out.ShootSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Timeouts.DeepCopyInto(&out.Timeouts)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootTimeoutProfile.
+func (in *ShootTimeoutProfile) DeepCopy() *ShootTimeoutProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootTimeoutProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootTimeouts) DeepCopyInto(out *ShootTimeouts) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(OperationTimeouts)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]ShootTimeoutProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootTimeouts.
+func (in *ShootTimeouts) DeepCopy() *ShootTimeouts {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootTimeouts)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSServer) DeepCopyInto(out *TLSServer) {
 	*out = *in
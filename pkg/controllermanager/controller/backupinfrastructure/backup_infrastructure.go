@@ -41,6 +41,7 @@ type Controller struct {
 	k8sGardenInformers gardeninformers.SharedInformerFactory
 
 	config      *config.ControllerManagerConfiguration
+	seedFilter  *controllerutils.SeedFilter
 	control     ControlInterface
 	recorder    record.EventRecorder
 	secrets     map[string]*corev1.Secret
@@ -58,7 +59,7 @@ type Controller struct {
 // NewBackupInfrastructureController takes a Kubernetes client for the Garden clusters <k8sGardenClient>, a struct
 // holding information about the acting Gardener, a <backupInfrastructureInformer>, and a <recorder> for
 // event recording. It creates a new Gardener controller.
-func NewBackupInfrastructureController(k8sGardenClient kubernetes.Interface, gardenInformerFactory gardeninformers.SharedInformerFactory, config *config.ControllerManagerConfiguration, identity *gardenv1beta1.Gardener, gardenNamespace string, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, recorder record.EventRecorder) *Controller {
+func NewBackupInfrastructureController(k8sGardenClient kubernetes.Interface, gardenInformerFactory gardeninformers.SharedInformerFactory, config *config.ControllerManagerConfiguration, seedFilter *controllerutils.SeedFilter, identity *gardenv1beta1.Gardener, gardenNamespace string, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, recorder record.EventRecorder) *Controller {
 	var (
 		gardenv1beta1Informer        = gardenInformerFactory.Garden().V1beta1()
 		backupInfrastructureInformer = gardenv1beta1Informer.BackupInfrastructures()
@@ -69,6 +70,7 @@ func NewBackupInfrastructureController(k8sGardenClient kubernetes.Interface, gar
 		k8sGardenClient:            k8sGardenClient,
 		k8sGardenInformers:         gardenInformerFactory,
 		config:                     config,
+		seedFilter:                 seedFilter,
 		control:                    NewDefaultControl(k8sGardenClient, gardenv1beta1Informer, secrets, imageVector, identity, config, recorder),
 		recorder:                   recorder,
 		secrets:                    secrets,
@@ -97,6 +97,14 @@ func (c *Controller) reconcileBackupInfrastructureKey(key string) error {
 
 	backupInfrastructureLogger := logger.NewFieldLogger(logger.Logger, "backupinfrastructure", fmt.Sprintf("%s/%s", backupInfrastructure.Namespace, backupInfrastructure.Name))
 
+	// Ignore BackupInfrastructures on Seeds which are not managed by this controller manager. They are checked again
+	// after the sync period in case the labels of their Seed have changed.
+	if !c.seedFilter.SeedNameMatches(backupInfrastructure.Spec.Seed) {
+		backupInfrastructureLogger.Debug("Skipping because the Seed of the BackupInfrastructure is not selected by the seed selector")
+		c.backupInfrastructureQueue.AddAfter(key, c.config.Controllers.BackupInfrastructure.SyncPeriod.Duration)
+		return nil
+	}
+
 	if backupInfrastructure.DeletionTimestamp != nil && !sets.NewString(backupInfrastructure.Finalizers...).Has(gardenv1beta1.GardenerName) {
 		backupInfrastructureLogger.Debug("Do not need to do anything as the BackupInfrastructure does not have my finalizer")
 		c.backupInfrastructureQueue.Forget(key)
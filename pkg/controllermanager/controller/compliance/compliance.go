@@ -0,0 +1,159 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compliance
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
+	gardenmetrics "github.com/gardener/gardener/pkg/controllermanager/metrics"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// Controller evaluates Shoots against a compliance profile. It maintains the Compliant condition of the Shoots and a
+// garden-wide compliance report.
+type Controller struct {
+	k8sGardenClient kubernetes.Interface
+	config          *config.ComplianceControllerConfiguration
+
+	shootLister        gardenlisters.ShootLister
+	cloudProfileLister gardenlisters.CloudProfileLister
+
+	complianceQueue    workqueue.RateLimitingInterface
+	shootSynced        cache.InformerSynced
+	cloudProfileSynced cache.InformerSynced
+
+	workerCh               chan int
+	numberOfRunningWorkers int
+}
+
+// NewComplianceController takes a Kubernetes client <k8sGardenClient> for the Garden cluster, the informer factory
+// for the Garden API group and the <config> of the controller. It creates a new controller which evaluates all Shoots
+// against the configured compliance profile.
+func NewComplianceController(k8sGardenClient kubernetes.Interface, gardenInformerFactory gardeninformers.SharedInformerFactory, config *config.ComplianceControllerConfiguration) *Controller {
+	var (
+		gardenv1beta1Informer = gardenInformerFactory.Garden().V1beta1()
+		shootInformer         = gardenv1beta1Informer.Shoots()
+		cloudProfileInformer  = gardenv1beta1Informer.CloudProfiles()
+	)
+
+	complianceController := &Controller{
+		k8sGardenClient:    k8sGardenClient,
+		config:             config,
+		shootLister:        shootInformer.Lister(),
+		cloudProfileLister: cloudProfileInformer.Lister(),
+		complianceQueue:    workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "compliance"),
+		workerCh:           make(chan int),
+	}
+
+	shootInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    complianceController.shootAdd,
+		UpdateFunc: complianceController.shootUpdate,
+	})
+	cloudProfileInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: complianceController.cloudProfileUpdate,
+	})
+
+	complianceController.shootSynced = shootInformer.Informer().HasSynced
+	complianceController.cloudProfileSynced = cloudProfileInformer.Informer().HasSynced
+
+	return complianceController
+}
+
+// Run runs the Controller until the given stop channel can be read from.
+func (c *Controller) Run(ctx context.Context, workers int) {
+	var waitGroup sync.WaitGroup
+
+	if !cache.WaitForCacheSync(ctx.Done(), c.shootSynced, c.cloudProfileSynced) {
+		logger.Logger.Error("Timed out waiting for caches to sync")
+		return
+	}
+
+	// Count number of running workers.
+	go func() {
+		for {
+			select {
+			case res := <-c.workerCh:
+				c.numberOfRunningWorkers += res
+				logger.Logger.Debugf("Current number of running Compliance workers is %d", c.numberOfRunningWorkers)
+			}
+		}
+	}()
+
+	logger.Logger.Infof("Compliance controller initialized (rules: %v).", Rules(c.config.Profile))
+
+	for i := 0; i < workers; i++ {
+		controllerutils.CreateWorker(ctx, c.complianceQueue, "Compliance", c.reconcileComplianceKey, &waitGroup, c.workerCh)
+	}
+
+	// All Shoots are evaluated periodically as the result of some rules depends on time (e.g., newly offered versions
+	// in the CloudProfile), and the report is written afterwards.
+	go wait.Until(func() {
+		shoots, err := c.shootLister.List(labels.Everything())
+		if err != nil {
+			logger.Logger.Errorf("[COMPLIANCE] Could not list Shoots: %v", err)
+			return
+		}
+		for _, shoot := range shoots {
+			c.shootAdd(shoot)
+		}
+
+		if err := c.writeReport(); err != nil {
+			logger.Logger.Errorf("[COMPLIANCE] Could not write compliance report: %v", err)
+		}
+	}, c.config.SyncPeriod.Duration, ctx.Done())
+
+	// Shutdown handling
+	<-ctx.Done()
+	c.complianceQueue.ShutDown()
+
+	for {
+		if c.complianceQueue.Len() == 0 && c.numberOfRunningWorkers == 0 {
+			logger.Logger.Debug("No running Compliance worker and no items left in the queues. Terminated Compliance controller...")
+			break
+		}
+		logger.Logger.Debugf("Waiting for %d Compliance worker(s) to finish (%d item(s) left in the queues)...", c.numberOfRunningWorkers, c.complianceQueue.Len())
+		time.Sleep(5 * time.Second)
+	}
+
+	waitGroup.Wait()
+}
+
+// RunningWorkers returns the number of running workers.
+func (c *Controller) RunningWorkers() int {
+	return c.numberOfRunningWorkers
+}
+
+// CollectMetrics implements gardenmetrics.ControllerMetricsCollector interface
+func (c *Controller) CollectMetrics(ch chan<- prometheus.Metric) {
+	metric, err := prometheus.NewConstMetric(gardenmetrics.ControllerWorkerSum, prometheus.GaugeValue, float64(c.RunningWorkers()), "compliance")
+	if err != nil {
+		gardenmetrics.ScrapeFailures.With(prometheus.Labels{"kind": "compliance-controller"}).Inc()
+		return
+	}
+	ch <- metric
+}
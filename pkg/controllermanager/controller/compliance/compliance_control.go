@@ -0,0 +1,376 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compliance
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	"github.com/Masterminds/semver"
+	"github.com/ghodss/yaml"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// RuleHighAvailability is the name of the rule requiring highly available worker pools.
+	RuleHighAvailability = "HighAvailability"
+	// RuleKubernetesVersion is the name of the rule requiring a current Kubernetes version.
+	RuleKubernetesVersion = "KubernetesVersion"
+	// RuleAuditPolicy is the name of the rule requiring an audit policy for the kube-apiserver.
+	RuleAuditPolicy = "AuditPolicy"
+)
+
+const (
+	// ReportConfigMapName is the name of the ConfigMap in the garden namespace which contains the compliance report.
+	ReportConfigMapName = "compliance-report"
+	// ReportDataKey is the key in the data of the ConfigMap which contains the compliance report.
+	ReportDataKey = "report.yaml"
+)
+
+func (c *Controller) shootAdd(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		logger.Logger.Errorf("Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+	c.complianceQueue.Add(key)
+}
+
+func (c *Controller) shootUpdate(oldObj, newObj interface{}) {
+	oldShoot, ok1 := oldObj.(*gardenv1beta1.Shoot)
+	newShoot, ok2 := newObj.(*gardenv1beta1.Shoot)
+	if !ok1 || !ok2 {
+		return
+	}
+
+	if !apiequality.Semantic.DeepEqual(oldShoot.Spec, newShoot.Spec) {
+		c.shootAdd(newObj)
+	}
+}
+
+func (c *Controller) cloudProfileUpdate(oldObj, newObj interface{}) {
+	oldCloudProfile, ok1 := oldObj.(*gardenv1beta1.CloudProfile)
+	newCloudProfile, ok2 := newObj.(*gardenv1beta1.CloudProfile)
+	if !ok1 || !ok2 || apiequality.Semantic.DeepEqual(oldCloudProfile.Spec, newCloudProfile.Spec) {
+		return
+	}
+
+	shoots, err := c.shootLister.List(labels.Everything())
+	if err != nil {
+		logger.Logger.Errorf("[COMPLIANCE] Could not list Shoots using CloudProfile %s: %v", newCloudProfile.Name, err)
+		return
+	}
+	for _, shoot := range shoots {
+		if shoot.Spec.Cloud.Profile == newCloudProfile.Name {
+			c.shootAdd(shoot)
+		}
+	}
+}
+
+func (c *Controller) reconcileComplianceKey(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	shoot, err := c.shootLister.Shoots(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		logger.Logger.Debugf("[COMPLIANCE] %s - skipping because Shoot has been deleted", key)
+		return nil
+	}
+	if err != nil {
+		logger.Logger.Infof("[COMPLIANCE] %s - unable to retrieve object from store: %v", key, err)
+		return err
+	}
+	if shoot.DeletionTimestamp != nil {
+		logger.Logger.Debugf("[COMPLIANCE] %s - skipping because Shoot is marked as to be deleted", key)
+		return nil
+	}
+
+	condition := helper.GetCondition(shoot.Status.Conditions, gardenv1beta1.ShootCompliant)
+	if condition == nil {
+		condition = helper.InitCondition(gardenv1beta1.ShootCompliant, "", "")
+	}
+
+	violations, err := c.evaluate(shoot)
+	if err != nil {
+		condition = helper.UpdatedConditionUnknownError(condition, err)
+	} else {
+		condition = ComputeCondition(condition, violations)
+	}
+
+	_, err = kutil.TryUpdateShootConditions(c.k8sGardenClient.Garden(), retry.DefaultBackoff, shoot.ObjectMeta, func(s *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
+		if existing := helper.GetCondition(s.Status.Conditions, gardenv1beta1.ShootCompliant); existing != nil && !conditionChanged(existing, condition) {
+			return s, nil
+		}
+		s.Status.Conditions = helper.SetCondition(s.Status.Conditions, *condition)
+		return s, nil
+	})
+	return err
+}
+
+// conditionChanged returns true if the status, reason or message of the <existing> condition differs from the new
+// <condition>. The update time alone is no reason to update the Shoot.
+func conditionChanged(existing, condition *gardenv1beta1.Condition) bool {
+	return existing.Status != condition.Status || existing.Reason != condition.Reason || existing.Message != condition.Message
+}
+
+func (c *Controller) evaluate(shoot *gardenv1beta1.Shoot) ([]Violation, error) {
+	cloudProfile, err := c.cloudProfileLister.Get(shoot.Spec.Cloud.Profile)
+	if err != nil {
+		return nil, err
+	}
+	return Evaluate(c.config.Profile, shoot, cloudProfile)
+}
+
+// writeReport evaluates all Shoots and writes the compliance report into the garden namespace. The ConfigMap is only
+// updated if the report changes.
+func (c *Controller) writeReport() error {
+	shoots, err := c.shootLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	var results []ShootResult
+	for _, shoot := range shoots {
+		if shoot.DeletionTimestamp != nil {
+			continue
+		}
+
+		result := ShootResult{Namespace: shoot.Namespace, Name: shoot.Name}
+		violations, err := c.evaluate(shoot)
+		if err != nil {
+			result.Error = err.Error()
+		}
+		result.Violations = violations
+		results = append(results, result)
+	}
+
+	data, err := yaml.Marshal(NewReport(c.config.Profile, results))
+	if err != nil {
+		return err
+	}
+
+	configMap, err := c.k8sGardenClient.GetConfigMap(common.GardenNamespace, ReportConfigMapName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err == nil && configMap.Data[ReportDataKey] == string(data) {
+		return nil
+	}
+
+	if _, err := c.k8sGardenClient.CreateConfigMap(common.GardenNamespace, ReportConfigMapName, map[string]string{ReportDataKey: string(data)}, true); err != nil {
+		return err
+	}
+
+	logger.Logger.Infof("[COMPLIANCE] Wrote compliance report for %d Shoot(s) to %s/%s", len(results), common.GardenNamespace, ReportConfigMapName)
+	return nil
+}
+
+// Violation describes a rule of the compliance profile which is not satisfied by a Shoot.
+type Violation struct {
+	// Rule is the name of the violated rule.
+	Rule string `json:"rule"`
+	// Message is a human-readable description of the violation.
+	Message string `json:"message"`
+}
+
+// Rules returns the names of the rules which are enabled in the given <profile>.
+func Rules(profile config.ComplianceProfile) []string {
+	var rules []string
+	if profile.HighAvailability {
+		rules = append(rules, RuleHighAvailability)
+	}
+	if profile.KubernetesVersion != nil {
+		rules = append(rules, RuleKubernetesVersion)
+	}
+	if profile.AuditPolicy {
+		rules = append(rules, RuleAuditPolicy)
+	}
+	return rules
+}
+
+// Evaluate evaluates the given <shoot> against the rules of the <profile>. The <cloudProfile> is the CloudProfile
+// referenced by the Shoot. It returns the violations of all enabled rules.
+func Evaluate(profile config.ComplianceProfile, shoot *gardenv1beta1.Shoot, cloudProfile *gardenv1beta1.CloudProfile) ([]Violation, error) {
+	var violations []Violation
+
+	if profile.HighAvailability {
+		cloudProvider, err := helper.DetermineCloudProviderInShoot(shoot.Spec.Cloud)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, worker := range helper.GetShootCloudProviderWorkers(cloudProvider, shoot) {
+			if worker.AutoScalerMin < 2 {
+				violations = append(violations, Violation{RuleHighAvailability, fmt.Sprintf("worker pool %q runs less than two machines", worker.Name)})
+			}
+		}
+		if zones := helper.GetShootZones(cloudProvider, shoot); zones != nil && len(zones) < 2 {
+			violations = append(violations, Violation{RuleHighAvailability, "the Shoot does not span at least two zones"})
+		}
+	}
+
+	if versionProfile := profile.KubernetesVersion; versionProfile != nil {
+		versionViolations, err := evaluateKubernetesVersion(*versionProfile, shoot.Spec.Kubernetes.Version, cloudProfile)
+		if err != nil {
+			return nil, err
+		}
+		violations = append(violations, versionViolations...)
+	}
+
+	if profile.AuditPolicy {
+		if apiServer := shoot.Spec.Kubernetes.KubeAPIServer; apiServer == nil ||
+			apiServer.AuditConfig == nil ||
+			apiServer.AuditConfig.AuditPolicy == nil ||
+			apiServer.AuditConfig.AuditPolicy.ConfigMapRef == nil {
+			violations = append(violations, Violation{RuleAuditPolicy, "the kube-apiserver does not use an audit policy"})
+		}
+	}
+
+	return violations, nil
+}
+
+func evaluateKubernetesVersion(profile config.ComplianceKubernetesVersion, currentVersion string, cloudProfile *gardenv1beta1.CloudProfile) ([]Violation, error) {
+	var violations []Violation
+
+	if profile.LatestPatchVersion {
+		newerPatchVersionFound, latestPatchVersion, err := helper.DetermineLatestKubernetesVersion(*cloudProfile, currentVersion)
+		if err != nil {
+			return nil, err
+		}
+		if newerPatchVersionFound {
+			violations = append(violations, Violation{RuleKubernetesVersion, fmt.Sprintf("the newer patch version %s is available", latestPatchVersion)})
+		}
+	}
+
+	if profile.MaxMinorVersionsBehind != nil {
+		current, err := semver.NewVersion(currentVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		versions, err := helper.GetKubernetesVersionsFromCloudProfile(*cloudProfile)
+		if err != nil {
+			return nil, err
+		}
+
+		newerMinorVersions := map[string]struct{}{}
+		for _, version := range versions {
+			v, err := semver.NewVersion(version)
+			if err != nil {
+				return nil, err
+			}
+			if v.Major() > current.Major() || (v.Major() == current.Major() && v.Minor() > current.Minor()) {
+				newerMinorVersions[fmt.Sprintf("%d.%d", v.Major(), v.Minor())] = struct{}{}
+			}
+		}
+
+		if len(newerMinorVersions) > *profile.MaxMinorVersionsBehind {
+			violations = append(violations, Violation{RuleKubernetesVersion, fmt.Sprintf("the version is %d minor version(s) behind, at most %d are allowed", len(newerMinorVersions), *profile.MaxMinorVersionsBehind)})
+		}
+	}
+
+	return violations, nil
+}
+
+// ComputeCondition computes the Compliant condition of a Shoot based on its <violations>.
+func ComputeCondition(condition *gardenv1beta1.Condition, violations []Violation) *gardenv1beta1.Condition {
+	if len(violations) == 0 {
+		return helper.UpdatedCondition(condition, gardenv1beta1.ConditionTrue, "ShootCompliant", "The Shoot satisfies all rules of the compliance profile.")
+	}
+
+	messages := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		messages = append(messages, fmt.Sprintf("%s: %s", violation.Rule, violation.Message))
+	}
+	return helper.UpdatedCondition(condition, gardenv1beta1.ConditionFalse, "ComplianceViolations", strings.Join(messages, "; "))
+}
+
+// Report is the garden-wide summary of the compliance of all Shoots.
+type Report struct {
+	// Rules are the names of the rules which are enabled in the compliance profile.
+	Rules []string `json:"rules"`
+	// Shoots is the number of evaluated Shoots.
+	Shoots int `json:"shoots"`
+	// CompliantShoots is the number of Shoots which satisfy all rules.
+	CompliantShoots int `json:"compliantShoots"`
+	// ViolationsByRule is the number of Shoots violating a rule, keyed by the name of the rule.
+	ViolationsByRule map[string]int `json:"violationsByRule,omitempty"`
+	// NonCompliantShoots are the Shoots which violate at least one rule or could not be evaluated.
+	NonCompliantShoots []ShootResult `json:"nonCompliantShoots,omitempty"`
+}
+
+// ShootResult is the result of the evaluation of a single Shoot.
+type ShootResult struct {
+	// Namespace is the namespace of the Shoot.
+	Namespace string `json:"namespace"`
+	// Name is the name of the Shoot.
+	Name string `json:"name"`
+	// Violations are the violated rules.
+	Violations []Violation `json:"violations,omitempty"`
+	// Error is the reason why the Shoot could not be evaluated.
+	Error string `json:"error,omitempty"`
+}
+
+// NewReport creates a new Report for the given <results>. The non-compliant Shoots are sorted by namespace and name,
+// hence the report only changes if the results change.
+func NewReport(profile config.ComplianceProfile, results []ShootResult) *Report {
+	report := &Report{
+		Rules:  Rules(profile),
+		Shoots: len(results),
+	}
+
+	for _, result := range results {
+		if len(result.Violations) == 0 && len(result.Error) == 0 {
+			report.CompliantShoots++
+			continue
+		}
+		report.NonCompliantShoots = append(report.NonCompliantShoots, result)
+
+		violatedRules := map[string]struct{}{}
+		for _, violation := range result.Violations {
+			violatedRules[violation.Rule] = struct{}{}
+		}
+		for rule := range violatedRules {
+			if report.ViolationsByRule == nil {
+				report.ViolationsByRule = map[string]int{}
+			}
+			report.ViolationsByRule[rule]++
+		}
+	}
+
+	sort.Slice(report.NonCompliantShoots, func(i, j int) bool {
+		a, b := report.NonCompliantShoots[i], report.NonCompliantShoots[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+
+	return report
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compliance_test
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/compliance"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("Compliance", func() {
+	var (
+		one = 1
+
+		profile = config.ComplianceProfile{
+			HighAvailability: true,
+			KubernetesVersion: &config.ComplianceKubernetesVersion{
+				LatestPatchVersion:     true,
+				MaxMinorVersionsBehind: &one,
+			},
+			AuditPolicy: true,
+		}
+
+		cloudProfile *gardenv1beta1.CloudProfile
+		shoot        *gardenv1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		cloudProfile = &gardenv1beta1.CloudProfile{
+			Spec: gardenv1beta1.CloudProfileSpec{
+				AWS: &gardenv1beta1.AWSProfile{
+					Constraints: gardenv1beta1.AWSConstraints{
+						Kubernetes: gardenv1beta1.KubernetesConstraints{
+							Versions: []string{"1.13.4", "1.12.6", "1.12.5", "1.11.8"},
+						},
+					},
+				},
+			},
+		}
+
+		shoot = &gardenv1beta1.Shoot{
+			Spec: gardenv1beta1.ShootSpec{
+				Cloud: gardenv1beta1.Cloud{
+					AWS: &gardenv1beta1.AWSCloud{
+						Workers: []gardenv1beta1.AWSWorker{
+							{Worker: gardenv1beta1.Worker{Name: "pool-1", AutoScalerMin: 2, AutoScalerMax: 3}},
+						},
+						Zones: []string{"eu-west-1a", "eu-west-1b"},
+					},
+				},
+				Kubernetes: gardenv1beta1.Kubernetes{
+					Version: "1.12.6",
+					KubeAPIServer: &gardenv1beta1.KubeAPIServerConfig{
+						AuditConfig: &gardenv1beta1.AuditConfig{
+							AuditPolicy: &gardenv1beta1.AuditPolicy{
+								ConfigMapRef: &corev1.LocalObjectReference{Name: "audit-policy"},
+							},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#Rules", func() {
+		It("should return the enabled rules", func() {
+			Expect(Rules(profile)).To(Equal([]string{RuleHighAvailability, RuleKubernetesVersion, RuleAuditPolicy}))
+			Expect(Rules(config.ComplianceProfile{AuditPolicy: true})).To(Equal([]string{RuleAuditPolicy}))
+		})
+	})
+
+	Describe("#Evaluate", func() {
+		It("should not find any violations", func() {
+			violations, err := Evaluate(profile, shoot, cloudProfile)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(violations).To(BeEmpty())
+		})
+
+		It("should find violations of all rules", func() {
+			shoot.Spec.Cloud.AWS.Workers[0].AutoScalerMin = 1
+			shoot.Spec.Cloud.AWS.Zones = []string{"eu-west-1a"}
+			shoot.Spec.Kubernetes.Version = "1.11.7"
+			shoot.Spec.Kubernetes.KubeAPIServer = nil
+
+			violations, err := Evaluate(profile, shoot, cloudProfile)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(violations).To(Equal([]Violation{
+				{Rule: RuleHighAvailability, Message: `worker pool "pool-1" runs less than two machines`},
+				{Rule: RuleHighAvailability, Message: "the Shoot does not span at least two zones"},
+				{Rule: RuleKubernetesVersion, Message: "the newer patch version 1.11.8 is available"},
+				{Rule: RuleKubernetesVersion, Message: "the version is 2 minor version(s) behind, at most 1 are allowed"},
+				{Rule: RuleAuditPolicy, Message: "the kube-apiserver does not use an audit policy"},
+			}))
+		})
+
+		It("should not evaluate disabled rules", func() {
+			shoot.Spec.Kubernetes.KubeAPIServer = nil
+
+			violations, err := Evaluate(config.ComplianceProfile{HighAvailability: true}, shoot, cloudProfile)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(violations).To(BeEmpty())
+		})
+
+		It("should fail for invalid versions", func() {
+			shoot.Spec.Kubernetes.Version = "foo"
+
+			_, err := Evaluate(profile, shoot, cloudProfile)
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#ComputeCondition", func() {
+		var condition = &gardenv1beta1.Condition{Type: gardenv1beta1.ShootCompliant, Status: gardenv1beta1.ConditionUnknown}
+
+		It("should return a true condition", func() {
+			Expect(ComputeCondition(condition, nil)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(gardenv1beta1.ShootCompliant),
+				"Status": Equal(gardenv1beta1.ConditionTrue),
+				"Reason": Equal("ShootCompliant"),
+			})))
+		})
+
+		It("should return a false condition listing the violations", func() {
+			violations := []Violation{
+				{Rule: RuleHighAvailability, Message: "foo"},
+				{Rule: RuleAuditPolicy, Message: "bar"},
+			}
+
+			Expect(ComputeCondition(condition, violations)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(gardenv1beta1.ShootCompliant),
+				"Status":  Equal(gardenv1beta1.ConditionFalse),
+				"Reason":  Equal("ComplianceViolations"),
+				"Message": Equal("HighAvailability: foo; AuditPolicy: bar"),
+			})))
+		})
+	})
+
+	Describe("#NewReport", func() {
+		It("should summarize the results", func() {
+			results := []ShootResult{
+				{Namespace: "garden-b", Name: "shoot", Violations: []Violation{{Rule: RuleHighAvailability, Message: "foo"}, {Rule: RuleHighAvailability, Message: "bar"}}},
+				{Namespace: "garden-a", Name: "shoot"},
+				{Namespace: "garden-a", Name: "other", Error: "cloudprofile.garden.sapcloud.io \"aws\" not found"},
+				{Namespace: "garden-a", Name: "broken", Violations: []Violation{{Rule: RuleAuditPolicy, Message: "baz"}}},
+			}
+
+			Expect(NewReport(profile, results)).To(Equal(&Report{
+				Rules:            []string{RuleHighAvailability, RuleKubernetesVersion, RuleAuditPolicy},
+				Shoots:           4,
+				CompliantShoots:  1,
+				ViolationsByRule: map[string]int{RuleHighAvailability: 1, RuleAuditPolicy: 1},
+				NonCompliantShoots: []ShootResult{
+					results[3],
+					results[2],
+					results[0],
+				},
+			}))
+		})
+	})
+})
@@ -67,7 +67,7 @@ type Controller struct {
 }
 
 // NewController instantiates a new ControllerInstallation controller.
-func NewController(k8sGardenClient kubernetes.Interface, gardenInformerFactory gardeninformers.SharedInformerFactory, gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory, config *config.ControllerManagerConfiguration, recorder record.EventRecorder) *Controller {
+func NewController(k8sGardenClient kubernetes.Interface, gardenInformerFactory gardeninformers.SharedInformerFactory, gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory, config *config.ControllerManagerConfiguration, seedFilter *controllerutils.SeedFilter, recorder record.EventRecorder) *Controller {
 	var (
 		gardenInformer     = gardenInformerFactory.Garden().V1beta1()
 		gardenCoreInformer = gardenCoreInformerFactory.Core().V1alpha1()
@@ -88,7 +88,7 @@ func NewController(k8sGardenClient kubernetes.Interface, gardenInformerFactory g
 		k8sGardenClient:               k8sGardenClient,
 		k8sGardenInformers:            gardenInformerFactory,
 		k8sGardenCoreInformers:        gardenCoreInformerFactory,
-		controllerInstallationControl: NewDefaultControllerInstallationControl(k8sGardenClient, gardenInformerFactory, gardenCoreInformerFactory, recorder, config, seedLister, controllerRegistrationLister, controllerInstallationLister),
+		controllerInstallationControl: NewDefaultControllerInstallationControl(k8sGardenClient, gardenInformerFactory, gardenCoreInformerFactory, recorder, config, seedFilter, seedLister, controllerRegistrationLister, controllerInstallationLister),
 		config:                        config,
 		recorder:                      recorder,
 
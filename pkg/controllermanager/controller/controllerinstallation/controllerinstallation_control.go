@@ -19,6 +19,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"strings"
+	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	"github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
@@ -29,8 +32,13 @@ import (
 	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
+	controllermanagerfeatures "github.com/gardener/gardener/pkg/controllermanager/features"
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/oci"
 
 	multierror "github.com/hashicorp/go-multierror"
 
@@ -52,7 +60,8 @@ import (
 	"k8s.io/client-go/util/retry"
 )
 
-const installationTypeHelm = "helm"
+// InstallationTypeHelm is the deployment type of ControllerRegistrations whose charts are installed by this controller.
+const InstallationTypeHelm = "helm"
 
 func (c *Controller) controllerInstallationAdd(obj interface{}) {
 	key, err := cache.MetaNamespaceKeyFunc(obj)
@@ -115,8 +124,8 @@ type ControlInterface interface {
 // implements the documented semantics for ControllerInstallations. updater is the UpdaterInterface used
 // to update the status of ControllerInstallations. You should use an instance returned from NewDefaultControllerInstallationControl() for any
 // scenario other than testing.
-func NewDefaultControllerInstallationControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory, recorder record.EventRecorder, config *config.ControllerManagerConfiguration, seedLister gardenlisters.SeedLister, controllerRegistrationLister gardencorelisters.ControllerRegistrationLister, controllerInstallationLister gardencorelisters.ControllerInstallationLister) ControlInterface {
-	return &defaultControllerInstallationControl{k8sGardenClient, k8sGardenInformers, k8sGardenCoreInformers, recorder, config, seedLister, controllerRegistrationLister, controllerInstallationLister}
+func NewDefaultControllerInstallationControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory, recorder record.EventRecorder, config *config.ControllerManagerConfiguration, seedFilter *controllerutils.SeedFilter, seedLister gardenlisters.SeedLister, controllerRegistrationLister gardencorelisters.ControllerRegistrationLister, controllerInstallationLister gardencorelisters.ControllerInstallationLister) ControlInterface {
+	return &defaultControllerInstallationControl{k8sGardenClient, k8sGardenInformers, k8sGardenCoreInformers, recorder, config, seedFilter, seedLister, controllerRegistrationLister, controllerInstallationLister}
 }
 
 type defaultControllerInstallationControl struct {
@@ -125,6 +134,7 @@ type defaultControllerInstallationControl struct {
 	k8sGardenCoreInformers       gardencoreinformers.SharedInformerFactory
 	recorder                     record.EventRecorder
 	config                       *config.ControllerManagerConfiguration
+	seedFilter                   *controllerutils.SeedFilter
 	seedLister                   gardenlisters.SeedLister
 	controllerRegistrationLister gardencorelisters.ControllerRegistrationLister
 	controllerInstallationLister gardencorelisters.ControllerInstallationLister
@@ -183,6 +193,17 @@ func (c *defaultControllerInstallationControl) reconcile(controllerInstallation
 		return err
 	}
 
+	// Changes of the ControllerRegistration are only installed once the ControllerRegistration controller has rolled
+	// them out to this installation (according to the deployment strategy).
+	registrationSpecHash, err := ComputeRegistrationSpecHash(controllerRegistration.Spec)
+	if err != nil {
+		return err
+	}
+	if hash, ok := controllerInstallation.Labels[common.RegistrationSpecHash]; ok && hash != registrationSpecHash {
+		logger.Infof("Changes of ControllerRegistration %q have not been rolled out to this installation yet", controllerRegistration.Name)
+		return nil
+	}
+
 	// TODO: Seed controller should maintain a cache of Seed objects and kubernetes clients, chartrenders, whatever is needed
 	k8sSeedClient, err := c.getSeedClient(controllerInstallation)
 	if err != nil {
@@ -205,21 +226,70 @@ func (c *defaultControllerInstallationControl) reconcile(controllerInstallation
 		return err
 	}
 
+	seed, err := c.seedLister.Get(controllerInstallation.Spec.SeedRef.Name)
+	if err != nil {
+		return err
+	}
+	pinnedVersion, versionPinned := seed.Annotations[common.SeedExtensionVersionPrefix+controllerRegistration.Name]
+	if versionPinned {
+		if err := pinChartVersion(&helmDeployment, pinnedVersion); err != nil {
+			conditionValid = helper.UpdatedCondition(conditionValid, corev1.ConditionFalse, "ChartVersionCannotBePinned", fmt.Sprintf("Chart version pinned by the Seed cannot be used: %+v", err))
+			return err
+		}
+	}
+
 	namespace := getNamespaceForControllerInstallation(controllerInstallation)
 	if _, err := controllerutil.CreateOrUpdate(context.TODO(), k8sSeedClient.Client(), namespace, func(existing runtime.Object) error { return nil }); err != nil {
 		return err
 	}
 
-	release, err := chartRenderer.RenderArchive(helmDeployment.Chart, controllerRegistration.Name, namespace.Name, helmDeployment.Values)
+	pullCredentials, err := c.getPullCredentials(&helmDeployment)
+	if err != nil {
+		conditionValid = helper.UpdatedCondition(conditionValid, corev1.ConditionFalse, "PullSecretCannotBeRead", fmt.Sprintf("Pull secret cannot be read: %+v", err))
+		return err
+	}
+	pullSecretDeployed, err := reconcilePullSecret(k8sSeedClient, namespace.Name, &helmDeployment, pullCredentials)
+	if err != nil {
+		conditionValid = helper.UpdatedCondition(conditionValid, corev1.ConditionFalse, "PullSecretCannotBeDeployed", fmt.Sprintf("Pull secret cannot be deployed to the seed: %+v", err))
+		return err
+	}
+
+	// Mix-in some standard values that Gardener provides to all extensions.
+	standardValues := map[string]interface{}{
+		"featureGates": featureGateValues(),
+	}
+	if c.config.Images != nil && c.config.Images.Registry != nil {
+		standardValues["imageRegistry"] = *c.config.Images.Registry
+	}
+	if pullSecretDeployed {
+		standardValues["imagePullSecrets"] = []interface{}{
+			map[string]interface{}{"name": pullSecretName},
+		}
+	}
+	gardenerValues := map[string]interface{}{
+		"gardener": standardValues,
+	}
+
+	chart, err := fetchChart(&helmDeployment, pullCredentials)
+	if err != nil {
+		conditionValid = helper.UpdatedCondition(conditionValid, corev1.ConditionFalse, "ChartCannotBeFetched", fmt.Sprintf("Chart cannot be fetched: %+v", err))
+		return err
+	}
+
+	release, err := chartRenderer.RenderArchive(chart, controllerRegistration.Name, namespace.Name, utils.MergeMaps(helmDeployment.Values, gardenerValues))
 	if err != nil {
 		conditionValid = helper.UpdatedCondition(conditionValid, corev1.ConditionFalse, "ChartCannotBeRendered", fmt.Sprintf("Chart rendering process failed: %+v", err))
 		return err
 	}
-	conditionValid = helper.UpdatedCondition(conditionValid, corev1.ConditionTrue, "RegistrationValid", "Chart could be rendered successfully.")
+	if versionPinned {
+		conditionValid = helper.UpdatedCondition(conditionValid, corev1.ConditionTrue, "RegistrationValid", fmt.Sprintf("Chart in version %q pinned by the Seed could be rendered successfully.", pinnedVersion))
+	} else {
+		conditionValid = helper.UpdatedCondition(conditionValid, corev1.ConditionTrue, "RegistrationValid", "Chart could be rendered successfully.")
+	}
 
 	var (
 		manifest        = release.Manifest()
-		newResources    DeployedResources
+		newResources    = DeployedResources{ChartVersion: release.ChartVersion}
 		newResourcesSet = sets.NewString()
 
 		decoder    = yaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), 1024)
@@ -253,24 +323,13 @@ func (c *defaultControllerInstallationControl) reconcile(controllerInstallation
 		return err
 	}
 
-	status, err := json.Marshal(newResources)
-	if err != nil {
-		conditionInstalled = helper.UpdatedCondition(conditionInstalled, corev1.ConditionFalse, "InstallationFailed", fmt.Sprintf("Could not marshal status for new resources: %+v", err))
-		return err
+	// The hash of the installed registration specification is only updated after the new resources have been applied
+	// successfully.
+	if oldResources, err := ReadDeployedResources(controllerInstallation); err == nil && oldResources != nil {
+		newResources.RegistrationSpecHash = oldResources.RegistrationSpecHash
 	}
 
-	controllerInstallation, err = kutil.TryUpdateControllerInstallationStatusWithEqualFunc(c.k8sGardenClient.GardenCore(), retry.DefaultBackoff, controllerInstallation.ObjectMeta,
-		func(controllerInstallation *gardencorev1alpha1.ControllerInstallation) (*gardencorev1alpha1.ControllerInstallation, error) {
-			controllerInstallation.Status.ProviderStatus = &gardencorev1alpha1.ProviderConfig{
-				RawExtension: runtime.RawExtension{
-					Raw: status,
-				},
-			}
-			return controllerInstallation, nil
-		}, func(cur, updated *gardencorev1alpha1.ControllerInstallation) bool {
-			return equality.Semantic.DeepEqual(cur.Status.ProviderStatus, updated.Status.ProviderStatus)
-		},
-	)
+	controllerInstallation, err = c.updateDeployedResources(controllerInstallation, newResources)
 	if err != nil {
 		conditionInstalled = helper.UpdatedCondition(conditionInstalled, corev1.ConditionFalse, "InstallationFailed", fmt.Sprintf("Could not write status for new resources: %+v", err))
 		return err
@@ -281,6 +340,13 @@ func (c *defaultControllerInstallationControl) reconcile(controllerInstallation
 		return err
 	}
 
+	newResources.RegistrationSpecHash = registrationSpecHash
+	controllerInstallation, err = c.updateDeployedResources(controllerInstallation, newResources)
+	if err != nil {
+		conditionInstalled = helper.UpdatedCondition(conditionInstalled, corev1.ConditionFalse, "InstallationFailed", fmt.Sprintf("Could not write status for new resources: %+v", err))
+		return err
+	}
+
 	conditionInstalled = helper.UpdatedCondition(conditionInstalled, corev1.ConditionTrue, "InstallationSuccessful", "Installation of new resources succeeded.")
 	return nil
 }
@@ -332,6 +398,26 @@ func (c *defaultControllerInstallationControl) delete(controllerInstallation *ga
 	return err
 }
 
+func (c *defaultControllerInstallationControl) updateDeployedResources(controllerInstallation *gardencorev1alpha1.ControllerInstallation, deployedResources DeployedResources) (*gardencorev1alpha1.ControllerInstallation, error) {
+	status, err := json.Marshal(deployedResources)
+	if err != nil {
+		return nil, err
+	}
+
+	return kutil.TryUpdateControllerInstallationStatusWithEqualFunc(c.k8sGardenClient.GardenCore(), retry.DefaultBackoff, controllerInstallation.ObjectMeta,
+		func(controllerInstallation *gardencorev1alpha1.ControllerInstallation) (*gardencorev1alpha1.ControllerInstallation, error) {
+			controllerInstallation.Status.ProviderStatus = &gardencorev1alpha1.ProviderConfig{
+				RawExtension: runtime.RawExtension{
+					Raw: status,
+				},
+			}
+			return controllerInstallation, nil
+		}, func(cur, updated *gardencorev1alpha1.ControllerInstallation) bool {
+			return equality.Semantic.DeepEqual(cur.Status.ProviderStatus, updated.Status.ProviderStatus)
+		},
+	)
+}
+
 func (c *defaultControllerInstallationControl) updateConditions(controllerInstallation *gardencorev1alpha1.ControllerInstallation, conditions ...gardencorev1alpha1.Condition) (*gardencorev1alpha1.ControllerInstallation, error) {
 	return kutil.TryUpdateControllerInstallationStatusWithEqualFunc(c.k8sGardenClient.GardenCore(), retry.DefaultBackoff, controllerInstallation.ObjectMeta,
 		func(controllerInstallation *gardencorev1alpha1.ControllerInstallation) (*gardencorev1alpha1.ControllerInstallation, error) {
@@ -344,22 +430,21 @@ func (c *defaultControllerInstallationControl) updateConditions(controllerInstal
 }
 
 func (c *defaultControllerInstallationControl) isResponsible(controllerInstallation *gardencorev1alpha1.ControllerInstallation) (bool, error) {
+	if !c.seedFilter.SeedNameMatches(controllerInstallation.Spec.SeedRef.Name) {
+		return false, nil
+	}
+
 	controllerRegistration, err := c.controllerRegistrationLister.Get(controllerInstallation.Spec.RegistrationRef.Name)
 	if err != nil {
 		return false, err
 	}
 
-	return controllerRegistration.Spec.Deployment.Type == installationTypeHelm, nil
+	return controllerRegistration.Spec.Deployment.Type == InstallationTypeHelm, nil
 }
 
 func (c *defaultControllerInstallationControl) cleanOldResources(k8sSeedClient kubernetes.Interface, controllerInstallation *gardencorev1alpha1.ControllerInstallation, newResourcesSet sets.String) (bool, error) {
-	providerStatus := controllerInstallation.Status.ProviderStatus
-	if providerStatus == nil {
-		return false, nil
-	}
-
-	var oldResources DeployedResources
-	if err := json.Unmarshal(providerStatus.Raw, &oldResources); err != nil {
+	oldResources, err := ReadDeployedResources(controllerInstallation)
+	if err != nil || oldResources == nil {
 		return false, err
 	}
 
@@ -421,3 +506,121 @@ func getNamespaceForControllerInstallation(controllerInstallation *gardencorev1a
 		},
 	}
 }
+
+const (
+	// chartPullTimeout is the timeout for all requests to an OCI registry while pulling a chart.
+	chartPullTimeout = 2 * time.Minute
+	// chartCacheSize is the maximum number of charts which are cached in memory.
+	chartCacheSize = 32
+)
+
+// chartPuller pulls the charts of extensions from OCI registries. It is shared by all reconciliations so that
+// charts referenced by digest are only pulled once.
+var chartPuller = oci.NewChartPuller(&http.Client{Timeout: chartPullTimeout}, chartCacheSize)
+
+// pullSecretName is the name of the secret in the extension namespace in the seed which contains the credentials for
+// the OCI registry the chart is pulled from.
+const pullSecretName = "extension-pull-secret"
+
+// getPullCredentials reads the credentials for the OCI registry referenced by the given HelmDeployment. It returns nil
+// if no pull secret is referenced.
+func (c *defaultControllerInstallationControl) getPullCredentials(helmDeployment *HelmDeployment) (*oci.Credentials, error) {
+	if helmDeployment.OCIRepository == nil || helmDeployment.OCIRepository.PullSecretRef == nil {
+		return nil, nil
+	}
+
+	secret, err := c.k8sGardenClient.GetSecret(common.GardenNamespace, helmDeployment.OCIRepository.PullSecretRef.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &oci.Credentials{
+		Username:        string(secret.Data["username"]),
+		Password:        string(secret.Data["password"]),
+		TokenRealmHosts: helmDeployment.OCIRepository.TokenRealmHosts,
+	}, nil
+}
+
+// reconcilePullSecret deploys the credentials for the OCI registry referenced by the given HelmDeployment as image pull
+// secret into the given namespace in the seed, so that the extension can pull its images from the same registry. The
+// secret is deleted if no credentials are given. It returns whether the secret has been deployed.
+func reconcilePullSecret(k8sSeedClient kubernetes.Interface, namespace string, helmDeployment *HelmDeployment, credentials *oci.Credentials) (bool, error) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pullSecretName,
+			Namespace: namespace,
+		},
+	}
+
+	if credentials == nil {
+		if err := k8sSeedClient.Client().Delete(context.TODO(), secret); err != nil && !apierrors.IsNotFound(err) {
+			return false, err
+		}
+		return false, nil
+	}
+
+	ref, err := oci.ParseRef(helmDeployment.OCIRepository.Ref)
+	if err != nil {
+		return false, err
+	}
+	dockerConfig, err := oci.DockerConfigJSON(ref.Registry, credentials)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := controllerutil.CreateOrUpdate(context.TODO(), k8sSeedClient.Client(), secret, func(existing runtime.Object) error {
+		s := existing.(*corev1.Secret)
+		s.Type = corev1.SecretTypeDockerConfigJson
+		s.Data = map[string][]byte{corev1.DockerConfigJsonKey: dockerConfig}
+		return nil
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// fetchChart returns the chart tarball of the given HelmDeployment, pulling it from an OCI registry with the given
+// credentials if necessary.
+func fetchChart(helmDeployment *HelmDeployment, credentials *oci.Credentials) ([]byte, error) {
+	if len(helmDeployment.Chart) > 0 || helmDeployment.OCIRepository == nil {
+		return helmDeployment.Chart, nil
+	}
+	return chartPuller.Pull(helmDeployment.OCIRepository.Ref, credentials, []byte(helmDeployment.OCIRepository.PublicKey))
+}
+
+// pinChartVersion replaces the tag and digest of the OCI reference of the given HelmDeployment with the given version.
+// The version is either a tag, a digest, or a tag followed by `@` and a digest.
+func pinChartVersion(helmDeployment *HelmDeployment, version string) error {
+	if len(helmDeployment.Chart) > 0 || helmDeployment.OCIRepository == nil {
+		return fmt.Errorf("only charts pulled from an OCI repository can be pinned")
+	}
+
+	ref, err := oci.ParseRef(helmDeployment.OCIRepository.Ref)
+	if err != nil {
+		return err
+	}
+
+	separator := ":"
+	if strings.HasPrefix(version, "sha256:") {
+		separator = "@"
+	}
+	pinnedRef, err := oci.ParseRef(ref.Registry + "/" + ref.Repository + separator + version)
+	if err != nil {
+		return err
+	}
+	if pinnedRef.Registry != ref.Registry || pinnedRef.Repository != ref.Repository {
+		return fmt.Errorf("invalid version %q", version)
+	}
+
+	helmDeployment.OCIRepository.Ref = pinnedRef.String()
+	return nil
+}
+
+// featureGateValues returns the states of the Gardener feature gates in a format that can be passed as values to
+// the Helm charts of extensions.
+func featureGateValues() map[string]interface{} {
+	values := make(map[string]interface{})
+	for feature, enabled := range controllermanagerfeatures.States() {
+		values[feature] = enabled
+	}
+	return values
+}
@@ -15,6 +15,13 @@
 package controllerinstallation
 
 import (
+	"encoding/json"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
+
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -22,12 +29,77 @@ import (
 type HelmDeployment struct {
 	// Chart is a Helm chart tarball.
 	Chart []byte `json:"chart,omitempty"`
+	// OCIRepository is a reference to a Helm chart in an OCI registry. It is only used if no chart tarball is given.
+	OCIRepository *OCIRepository `json:"ociRepository,omitempty"`
 	// Values is a map of values for the given chart.
 	Values map[string]interface{} `json:"values,omitempty"`
 }
 
+// OCIRepository is a reference to a Helm chart in an OCI registry.
+type OCIRepository struct {
+	// Ref is the reference to the chart, e.g. `registry.example.com/charts/foo:1.0.0`. It should contain a digest
+	// (`...@sha256:<digest>`) which the pulled chart is verified against.
+	Ref string `json:"ref"`
+	// PullSecretRef is a reference to a secret in the garden namespace containing the `username` and `password`
+	// used to authenticate against the registry.
+	PullSecretRef *corev1.LocalObjectReference `json:"pullSecretRef,omitempty"`
+	// TokenRealmHosts are the hosts besides the registry host which may receive the credentials of the pull secret
+	// when requesting a token, e.g. `auth.docker.io` for `registry-1.docker.io`.
+	TokenRealmHosts []string `json:"tokenRealmHosts,omitempty"`
+	// PublicKey is a PEM-encoded public key. If given, the chart must be signed with the corresponding private key
+	// by cosign.
+	PublicKey string `json:"publicKey,omitempty"`
+}
+
 // DeployedResources is a providerStatus specific type for ControllerInstallation.
 type DeployedResources struct {
+	// ChartVersion is the version of the chart the resources have been rendered from.
+	ChartVersion string `json:"chartVersion,omitempty"`
+	// RegistrationSpecHash is the hash of the ControllerRegistration specification which has been installed
+	// successfully.
+	RegistrationSpecHash string `json:"registrationSpecHash,omitempty"`
 	// Resources is a list of objects that have been created.
 	Resources []corev1.ObjectReference `json:"resources,omitempty"`
 }
+
+// ReadDeployedResources reads the DeployedResources from the provider status of the given ControllerInstallation.
+// It returns nil if the ControllerInstallation has no provider status.
+func ReadDeployedResources(controllerInstallation *gardencorev1alpha1.ControllerInstallation) (*DeployedResources, error) {
+	providerStatus := controllerInstallation.Status.ProviderStatus
+	if providerStatus == nil {
+		return nil, nil
+	}
+
+	deployedResources := &DeployedResources{}
+	if err := json.Unmarshal(providerStatus.Raw, deployedResources); err != nil {
+		return nil, err
+	}
+	return deployedResources, nil
+}
+
+// IsRolledOut returns whether the ControllerRegistration specification the given ControllerInstallation is labeled
+// with has been installed successfully.
+func IsRolledOut(controllerInstallation *gardencorev1alpha1.ControllerInstallation) bool {
+	deployedResources, err := ReadDeployedResources(controllerInstallation)
+	if err != nil || deployedResources == nil || deployedResources.RegistrationSpecHash != controllerInstallation.Labels[common.RegistrationSpecHash] {
+		return false
+	}
+
+	conditionInstalled := helper.GetCondition(controllerInstallation.Status.Conditions, gardencorev1alpha1.ControllerInstallationInstalled)
+	return conditionInstalled != nil && conditionInstalled.Status == corev1.ConditionTrue
+}
+
+// ComputeRegistrationSpecHash computes the hash of the given ControllerRegistration specification which is put into
+// the RegistrationSpecHash label of the ControllerInstallations.
+func ComputeRegistrationSpecHash(spec gardencorev1alpha1.ControllerRegistrationSpec) (string, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+
+	var specMap map[string]interface{}
+	if err := json.Unmarshal(data, &specMap); err != nil {
+		return "", err
+	}
+	return utils.HashForMap(specMap)[:16], nil
+}
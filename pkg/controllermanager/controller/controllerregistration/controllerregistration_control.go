@@ -17,6 +17,7 @@ package controllerregistration
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	"github.com/gardener/gardener/pkg/operation/common"
 
@@ -30,6 +31,8 @@ import (
 	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/controllerinstallation"
+	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	"github.com/gardener/gardener/pkg/logger"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	multierror "github.com/hashicorp/go-multierror"
@@ -129,7 +132,7 @@ func (c *defaultControllerRegistrationControl) reconcile(controllerRegistration
 	var (
 		err              error
 		result           error
-		installationsMap = map[string]string{}
+		installationsMap = map[string]*gardencorev1alpha1.ControllerInstallation{}
 
 		mustWriteFinalizer = false
 	)
@@ -160,21 +163,35 @@ func (c *defaultControllerRegistrationControl) reconcile(controllerRegistration
 		}
 	}
 
-	// Live lookup to prevent working on a stale cache and trying to create multiple installations for the same
-	// registration/seed combination.
-	controllerInstallationList, err := c.k8sGardenClient.GardenCore().CoreV1alpha1().ControllerInstallations().List(metav1.ListOptions{})
+	controllerInstallationList, err := c.controllerInstallationLister.List(labels.Everything())
 	if err != nil {
 		return err
 	}
 
-	for _, controllerInstallation := range controllerInstallationList.Items {
+	for _, controllerInstallation := range controllerInstallationList {
 		if controllerInstallation.Spec.RegistrationRef.Name == controllerRegistration.Name {
-			installationsMap[controllerInstallation.Spec.SeedRef.Name] = controllerInstallation.Name
+			installationsMap[controllerInstallation.Spec.SeedRef.Name] = controllerInstallation
 		}
 	}
 
+	registrationSpecHash, err := controllerinstallation.ComputeRegistrationSpecHash(controllerRegistration.Spec)
+	if err != nil {
+		return err
+	}
+
+	var pendingSeeds = sets.NewString()
+	if deployment := controllerRegistration.Spec.Deployment; deployment != nil && deployment.Type == controllerinstallation.InstallationTypeHelm {
+		pendingSeeds = SeedsPendingRollout(deployment.Strategy, seedList, installationsMap, registrationSpecHash)
+	}
+	if pendingSeeds.Len() > 0 {
+		logger.Infof("Rollout of changes is pending for %d seed(s): %v", pendingSeeds.Len(), pendingSeeds.List())
+	}
+
 	for _, seed := range seedList {
-		if err := c.reconcileSeedInstallations(controllerRegistration, seed, installationsMap); err != nil {
+		if pendingSeeds.Has(seed.Name) {
+			continue
+		}
+		if err := c.reconcileSeedInstallations(controllerRegistration, seed, installationsMap, registrationSpecHash); err != nil {
 			result = multierror.Append(result, err)
 		}
 	}
@@ -182,10 +199,10 @@ func (c *defaultControllerRegistrationControl) reconcile(controllerRegistration
 	return result
 }
 
-func (c *defaultControllerRegistrationControl) reconcileSeedInstallations(controllerRegistration *gardencorev1alpha1.ControllerRegistration, seed *gardenv1beta1.Seed, installationsMap map[string]string) error {
+func (c *defaultControllerRegistrationControl) reconcileSeedInstallations(controllerRegistration *gardencorev1alpha1.ControllerRegistration, seed *gardenv1beta1.Seed, installationsMap map[string]*gardencorev1alpha1.ControllerInstallation, registrationSpecHash string) error {
 	if seed.DeletionTimestamp != nil {
 		if installation, ok := installationsMap[seed.Name]; ok {
-			if err := c.k8sGardenClient.GardenCore().CoreV1alpha1().ControllerInstallations().Delete(installation, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			if err := c.k8sGardenClient.GardenCore().CoreV1alpha1().ControllerInstallations().Delete(installation.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
 				return err
 			}
 		}
@@ -220,18 +237,10 @@ func (c *defaultControllerRegistrationControl) reconcileSeedInstallations(contro
 	if err != nil {
 		return err
 	}
-	registrationSpecMap, err := convertObjToMap(controllerRegistration.Spec)
-	if err != nil {
-		return err
-	}
-
-	var (
-		seedSpecHash         = utils.HashForMap(seedSpecMap)[:16]
-		registrationSpecHash = utils.HashForMap(registrationSpecMap)[:16]
-	)
+	seedSpecHash := utils.HashForMap(seedSpecMap)[:16]
 
-	if name, ok := installationsMap[seed.Name]; ok {
-		_, err := kutil.CreateOrPatchControllerInstallation(c.k8sGardenClient.GardenCore(), metav1.ObjectMeta{Name: name}, func(controllerInstallation *gardencorev1alpha1.ControllerInstallation) *gardencorev1alpha1.ControllerInstallation {
+	if installation, ok := installationsMap[seed.Name]; ok {
+		_, err := kutil.CreateOrPatchControllerInstallation(c.k8sGardenClient.GardenCore(), metav1.ObjectMeta{Name: installation.Name}, func(controllerInstallation *gardencorev1alpha1.ControllerInstallation) *gardencorev1alpha1.ControllerInstallation {
 			kutil.SetMetaDataLabel(&controllerInstallation.ObjectMeta, common.SeedSpecHash, seedSpecHash)
 			kutil.SetMetaDataLabel(&controllerInstallation.ObjectMeta, common.RegistrationSpecHash, registrationSpecHash)
 			controllerInstallation.Spec = installationSpec
@@ -242,7 +251,7 @@ func (c *defaultControllerRegistrationControl) reconcileSeedInstallations(contro
 
 	controllerInstallation := &gardencorev1alpha1.ControllerInstallation{
 		ObjectMeta: metav1.ObjectMeta{
-			GenerateName: fmt.Sprintf("%s-", controllerRegistration.Name),
+			Name: controllerInstallationName(controllerRegistration.Name, seed.Name),
 			Labels: map[string]string{
 				common.SeedSpecHash:         seedSpecHash,
 				common.RegistrationSpecHash: registrationSpecHash,
@@ -251,8 +260,17 @@ func (c *defaultControllerRegistrationControl) reconcileSeedInstallations(contro
 		Spec: installationSpec,
 	}
 
-	_, err = c.k8sGardenClient.GardenCore().CoreV1alpha1().ControllerInstallations().Create(controllerInstallation)
-	return err
+	// The lister might not contain installations created by a previous reconciliation yet. As the name of the
+	// installation is unique per registration/seed combination, this cannot lead to a second installation.
+	if _, err := c.k8sGardenClient.GardenCore().CoreV1alpha1().ControllerInstallations().Create(controllerInstallation); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// controllerInstallationName returns the name of the ControllerInstallation for the given registration and seed.
+func controllerInstallationName(registrationName, seedName string) string {
+	return fmt.Sprintf("%s-%s", registrationName, utils.ComputeSHA256Hex([]byte(registrationName + "/" + seedName))[:8])
 }
 
 func (c *defaultControllerRegistrationControl) delete(controllerRegistration *gardencorev1alpha1.ControllerRegistration, logger logrus.FieldLogger) error {
@@ -261,18 +279,16 @@ func (c *defaultControllerRegistrationControl) delete(controllerRegistration *ga
 		count  int
 	)
 
-	controllerInstallationList, err := c.controllerInstallationLister.List(labels.Everything())
+	controllerInstallationList, err := controllerutils.ControllerInstallationsByIndex(c.k8sGardenCoreInformers.Core().V1alpha1().ControllerInstallations().Informer().GetIndexer(), controllerutils.ControllerInstallationRegistrationRefName, controllerRegistration.Name)
 	if err != nil {
 		return err
 	}
 
 	for _, controllerInstallation := range controllerInstallationList {
-		if controllerInstallation.Spec.RegistrationRef.Name == controllerRegistration.Name {
-			count++
+		count++
 
-			if err := c.k8sGardenClient.GardenCore().CoreV1alpha1().ControllerInstallations().Delete(controllerInstallation.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
-				result = multierror.Append(result, err)
-			}
+		if err := c.k8sGardenClient.GardenCore().CoreV1alpha1().ControllerInstallations().Delete(controllerInstallation.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			result = multierror.Append(result, err)
 		}
 	}
 
@@ -294,6 +310,57 @@ func (c *defaultControllerRegistrationControl) delete(controllerRegistration *ga
 	return err
 }
 
+// SeedsPendingRollout returns the names of the Seeds to which the changes of a ControllerRegistration (identified by
+// the hash of its specification) must not be rolled out yet according to the given deployment strategy. Changes are
+// rolled out to at most `maxUnavailable` Seeds at the same time, ordered by the values of the `orderLabel` of the
+// Seeds. The next Seeds follow only after the changes have been installed successfully to the previous ones. Seeds
+// without ControllerInstallation and Seeds which are being deleted are never pending.
+func SeedsPendingRollout(strategy *gardencorev1alpha1.ControllerDeploymentStrategy, seeds []*gardenv1beta1.Seed, installations map[string]*gardencorev1alpha1.ControllerInstallation, registrationSpecHash string) sets.String {
+	pending := sets.NewString()
+	if strategy == nil || strategy.MaxUnavailable == nil {
+		return pending
+	}
+
+	var (
+		outdated    []*gardenv1beta1.Seed
+		unavailable int
+	)
+
+	for _, seed := range seeds {
+		installation, ok := installations[seed.Name]
+		if !ok || seed.DeletionTimestamp != nil {
+			continue
+		}
+
+		if installation.Labels[common.RegistrationSpecHash] != registrationSpecHash {
+			outdated = append(outdated, seed)
+		} else if !controllerinstallation.IsRolledOut(installation) {
+			unavailable++
+		}
+	}
+
+	sort.Slice(outdated, func(i, j int) bool {
+		if strategy.OrderLabel != nil {
+			valueI, okI := outdated[i].Labels[*strategy.OrderLabel]
+			valueJ, okJ := outdated[j].Labels[*strategy.OrderLabel]
+			if okI != okJ {
+				return okI
+			}
+			if valueI != valueJ {
+				return valueI < valueJ
+			}
+		}
+		return outdated[i].Name < outdated[j].Name
+	})
+
+	for i, seed := range outdated {
+		if i >= int(*strategy.MaxUnavailable)-unavailable {
+			pending.Insert(seed.Name)
+		}
+	}
+	return pending
+}
+
 func convertObjToMap(in interface{}) (map[string]interface{}, error) {
 	var out map[string]interface{}
 
@@ -0,0 +1,127 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerregistration_test
+
+import (
+	"encoding/json"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/controllerinstallation"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/controllerregistration"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("ControllerRegistration", func() {
+	Describe("#SeedsPendingRollout", func() {
+		const (
+			oldHash    = "old"
+			newHash    = "new"
+			orderLabel = "rollout-wave"
+		)
+
+		var (
+			maxUnavailable int32 = 1
+			strategy       *gardencorev1alpha1.ControllerDeploymentStrategy
+
+			seeds         []*gardenv1beta1.Seed
+			installations map[string]*gardencorev1alpha1.ControllerInstallation
+
+			newSeed = func(name, wave string) *gardenv1beta1.Seed {
+				seed := &gardenv1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: name}}
+				if len(wave) > 0 {
+					seed.Labels = map[string]string{orderLabel: wave}
+				}
+				return seed
+			}
+			newInstallation = func(labelHash, installedHash string) *gardencorev1alpha1.ControllerInstallation {
+				status, err := json.Marshal(controllerinstallation.DeployedResources{RegistrationSpecHash: installedHash})
+				Expect(err).NotTo(HaveOccurred())
+
+				return &gardencorev1alpha1.ControllerInstallation{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{common.RegistrationSpecHash: labelHash},
+					},
+					Status: gardencorev1alpha1.ControllerInstallationStatus{
+						Conditions: []gardencorev1alpha1.Condition{
+							{Type: gardencorev1alpha1.ControllerInstallationInstalled, Status: corev1.ConditionTrue},
+						},
+						ProviderStatus: &gardencorev1alpha1.ProviderConfig{RawExtension: runtime.RawExtension{Raw: status}},
+					},
+				}
+			}
+		)
+
+		BeforeEach(func() {
+			label := orderLabel
+			strategy = &gardencorev1alpha1.ControllerDeploymentStrategy{
+				MaxUnavailable: &maxUnavailable,
+				OrderLabel:     &label,
+			}
+
+			seeds = []*gardenv1beta1.Seed{
+				newSeed("a", ""),
+				newSeed("b", "2"),
+				newSeed("c", "1"),
+			}
+			installations = map[string]*gardencorev1alpha1.ControllerInstallation{
+				"a": newInstallation(oldHash, oldHash),
+				"b": newInstallation(oldHash, oldHash),
+				"c": newInstallation(oldHash, oldHash),
+			}
+		})
+
+		It("should not hold back any seed without strategy", func() {
+			Expect(SeedsPendingRollout(nil, seeds, installations, newHash).List()).To(BeEmpty())
+		})
+
+		It("should roll out to the first seed in the order of the label values", func() {
+			Expect(SeedsPendingRollout(strategy, seeds, installations, newHash).List()).To(ConsistOf("a", "b"))
+		})
+
+		It("should order by name if no order label is given", func() {
+			strategy.OrderLabel = nil
+
+			Expect(SeedsPendingRollout(strategy, seeds, installations, newHash).List()).To(ConsistOf("b", "c"))
+		})
+
+		It("should hold back all seeds while the previous wave has not been installed", func() {
+			installations["c"] = newInstallation(newHash, oldHash)
+
+			Expect(SeedsPendingRollout(strategy, seeds, installations, newHash).List()).To(ConsistOf("a", "b"))
+		})
+
+		It("should continue with the next wave once the previous one has been installed", func() {
+			installations["c"] = newInstallation(newHash, newHash)
+
+			Expect(SeedsPendingRollout(strategy, seeds, installations, newHash).List()).To(ConsistOf("a"))
+		})
+
+		It("should never hold back seeds without installation or which are being deleted", func() {
+			now := metav1.Now()
+			seeds[0].DeletionTimestamp = &now
+			delete(installations, "b")
+
+			Expect(SeedsPendingRollout(strategy, seeds, installations, newHash).List()).To(BeEmpty())
+		})
+	})
+})
@@ -24,6 +24,7 @@ import (
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	"github.com/gardener/gardener/pkg/logger"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
@@ -95,9 +96,26 @@ type SeedControlInterface interface {
 // to update the status of Seeds. You should use an instance returned from NewDefaultSeedControl() for any
 // scenario other than testing.
 func NewDefaultSeedControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory, recorder record.EventRecorder, config *config.ControllerManagerConfiguration, controllerRegistrationLister gardencorelisters.ControllerRegistrationLister, controllerInstallationLister gardencorelisters.ControllerInstallationLister, controllerRegistrationQueue workqueue.RateLimitingInterface) SeedControlInterface {
-	return &defaultSeedControl{k8sGardenClient, k8sGardenInformers, k8sGardenCoreInformers, recorder, config, controllerRegistrationLister, controllerInstallationLister, controllerRegistrationQueue}
+	control := &defaultSeedControl{
+		k8sGardenClient:              k8sGardenClient,
+		k8sGardenInformers:           k8sGardenInformers,
+		k8sGardenCoreInformers:       k8sGardenCoreInformers,
+		recorder:                     recorder,
+		config:                       config,
+		controllerRegistrationLister: controllerRegistrationLister,
+		controllerInstallationLister: controllerInstallationLister,
+		controllerRegistrationQueue:  controllerRegistrationQueue,
+	}
+	// Seeds are updated frequently (e.g., by their heartbeats), hence, all ControllerRegistrations are enqueued
+	// immediately at most once per interval for the same Seed, and further changes are processed at the end of it.
+	control.enqueueControllerRegistrations = controllerutils.RateLimitEnqueueMapped(controllerRegistrationQueue, control.allControllerRegistrations, seedToControllerRegistrationsMappingInterval)
+	return control
 }
 
+// seedToControllerRegistrationsMappingInterval is the minimum interval between two enqueuings of all
+// ControllerRegistrations for the same Seed.
+const seedToControllerRegistrationsMappingInterval = 10 * time.Second
+
 type defaultSeedControl struct {
 	k8sGardenClient              kubernetes.Interface
 	k8sGardenInformers           gardeninformers.SharedInformerFactory
@@ -107,43 +125,49 @@ type defaultSeedControl struct {
 	controllerRegistrationLister gardencorelisters.ControllerRegistrationLister
 	controllerInstallationLister gardencorelisters.ControllerInstallationLister
 	controllerRegistrationQueue  workqueue.RateLimitingInterface
-}
 
-func (c *defaultSeedControl) Reconcile(obj *gardenv1beta1.Seed) error {
-	var (
-		seed   = obj.DeepCopy()
-		logger = logger.NewFieldLogger(logger.Logger, "controllerregistration-seed", seed.Name)
-		result error
-	)
+	enqueueControllerRegistrations func(obj interface{}) error
+}
 
+func (c *defaultSeedControl) allControllerRegistrations(obj interface{}) ([]string, error) {
 	controllerRegistrationList, err := c.controllerRegistrationLister.List(labels.Everything())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	var (
+		keys   []string
+		result error
+	)
 	for _, controllerRegistration := range controllerRegistrationList {
 		key, err := cache.MetaNamespaceKeyFunc(controllerRegistration)
 		if err != nil {
 			result = multierror.Append(result, err)
 			continue
 		}
-		c.controllerRegistrationQueue.Add(key)
+		keys = append(keys, key)
 	}
+	return keys, result
+}
 
-	if result != nil {
-		return result
+func (c *defaultSeedControl) Reconcile(obj *gardenv1beta1.Seed) error {
+	var (
+		seed   = obj.DeepCopy()
+		logger = logger.NewFieldLogger(logger.Logger, "controllerregistration-seed", seed.Name)
+	)
+
+	if err := c.enqueueControllerRegistrations(seed); err != nil {
+		return err
 	}
 
 	if seed.DeletionTimestamp != nil {
-		controllerInstallationList, err := c.controllerInstallationLister.List(labels.Everything())
+		controllerInstallationList, err := controllerutils.ControllerInstallationsByIndex(c.k8sGardenCoreInformers.Core().V1alpha1().ControllerInstallations().Informer().GetIndexer(), controllerutils.ControllerInstallationSeedRefName, seed.Name)
 		if err != nil {
 			return err
 		}
 
-		for _, controllerInstallation := range controllerInstallationList {
-			if controllerInstallation.Spec.SeedRef.Name == seed.Name {
-				return fmt.Errorf("ControllerInstallations for seed %q still pending, cannot release seed", seed.Name)
-			}
+		if len(controllerInstallationList) > 0 {
+			return fmt.Errorf("ControllerInstallations for seed %q still pending, cannot release seed", seed.Name)
 		}
 
 		_, err = kutil.TryUpdateSeedWithEqualFunc(c.k8sGardenClient.Garden(), retry.DefaultBackoff, seed.ObjectMeta, func(s *gardenv1beta1.Seed) (*gardenv1beta1.Seed, error) {
@@ -0,0 +1,135 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package cordon
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
+	gardenmetrics "github.com/gardener/gardener/pkg/controllermanager/metrics"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// Controller cordons Seeds on which too many Shoots failed, so that no new Shoots are scheduled to them, and uncordons
+// them again once their Shoots recovered.
+type Controller struct {
+	k8sGardenClient kubernetes.Interface
+	config          *config.SeedCordonControllerConfiguration
+	recorder        record.EventRecorder
+
+	shootLister gardenlisters.ShootLister
+	seedLister  gardenlisters.SeedLister
+
+	seedQueue   workqueue.RateLimitingInterface
+	shootSynced cache.InformerSynced
+	seedSynced  cache.InformerSynced
+
+	workerCh               chan int
+	numberOfRunningWorkers int
+}
+
+// NewSeedCordonController takes a Kubernetes client <k8sGardenClient> for the Garden cluster, the informer factory for
+// the Garden API group, the <config> of the controller and an event recorder. It creates a new controller which
+// cordons and uncordons Seeds depending on the failure rate of their Shoots.
+func NewSeedCordonController(k8sGardenClient kubernetes.Interface, gardenInformerFactory gardeninformers.SharedInformerFactory, config *config.SeedCordonControllerConfiguration, recorder record.EventRecorder) *Controller {
+	var (
+		gardenv1beta1Informer = gardenInformerFactory.Garden().V1beta1()
+		shootInformer         = gardenv1beta1Informer.Shoots()
+		seedInformer          = gardenv1beta1Informer.Seeds()
+	)
+
+	return &Controller{
+		k8sGardenClient: k8sGardenClient,
+		config:          config,
+		recorder:        recorder,
+		shootLister:     shootInformer.Lister(),
+		seedLister:      seedInformer.Lister(),
+		seedQueue:       workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "seed-cordon"),
+		shootSynced:     shootInformer.Informer().HasSynced,
+		seedSynced:      seedInformer.Informer().HasSynced,
+		workerCh:        make(chan int),
+	}
+}
+
+// Run runs the Controller until the given stop channel can be read from.
+func (c *Controller) Run(ctx context.Context, workers int) {
+	var waitGroup sync.WaitGroup
+
+	if !cache.WaitForCacheSync(ctx.Done(), c.shootSynced, c.seedSynced) {
+		logger.Logger.Error("Timed out waiting for caches to sync")
+		return
+	}
+
+	// Count number of running workers.
+	go func() {
+		for {
+			select {
+			case res := <-c.workerCh:
+				c.numberOfRunningWorkers += res
+				logger.Logger.Debugf("Current number of running SeedCordon workers is %d", c.numberOfRunningWorkers)
+			}
+		}
+	}()
+
+	logger.Logger.Infof("SeedCordon controller initialized (cordon threshold: %d%%, uncordon threshold: %d%%).", c.config.CordonThreshold, c.config.UncordonThreshold)
+
+	for i := 0; i < workers; i++ {
+		controllerutils.CreateWorker(ctx, c.seedQueue, "Seed Cordon", c.reconcileSeedKey, &waitGroup, c.workerCh)
+	}
+
+	// The failure rates are evaluated periodically instead of on every Shoot event to avoid flapping.
+	go wait.Until(c.enqueueAll, c.config.SyncPeriod.Duration, ctx.Done())
+
+	// Shutdown handling
+	<-ctx.Done()
+	c.seedQueue.ShutDown()
+
+	for {
+		if c.seedQueue.Len() == 0 && c.numberOfRunningWorkers == 0 {
+			logger.Logger.Debug("No running SeedCordon worker and no items left in the queues. Terminated SeedCordon controller...")
+			break
+		}
+		logger.Logger.Debugf("Waiting for %d SeedCordon worker(s) to finish (%d item(s) left in the queues)...", c.numberOfRunningWorkers, c.seedQueue.Len())
+		time.Sleep(5 * time.Second)
+	}
+
+	waitGroup.Wait()
+}
+
+// RunningWorkers returns the number of running workers.
+func (c *Controller) RunningWorkers() int {
+	return c.numberOfRunningWorkers
+}
+
+// CollectMetrics implements gardenmetrics.ControllerMetricsCollector interface
+func (c *Controller) CollectMetrics(ch chan<- prometheus.Metric) {
+	metric, err := prometheus.NewConstMetric(gardenmetrics.ControllerWorkerSum, prometheus.GaugeValue, float64(c.RunningWorkers()), "seedcordon")
+	if err != nil {
+		gardenmetrics.ScrapeFailures.With(prometheus.Labels{"kind": "seedcordon-controller"}).Inc()
+		return
+	}
+	ch <- metric
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package cordon
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+)
+
+// IsFailed returns whether the last operation of the given Shoot failed or ended with an error.
+func IsFailed(shoot *gardenv1beta1.Shoot) bool {
+	if shoot.Status.LastOperation == nil {
+		return false
+	}
+	state := shoot.Status.LastOperation.State
+	return state == gardenv1beta1.ShootLastOperationStateFailed || state == gardenv1beta1.ShootLastOperationStateError
+}
+
+// FailureRate returns the number of the given Shoots scheduled to the Seed with the given name and the number of
+// those which failed.
+func FailureRate(shoots []*gardenv1beta1.Shoot, seedName string) (total, failed int) {
+	for _, shoot := range shoots {
+		if shoot.Spec.Cloud.Seed == nil || *shoot.Spec.Cloud.Seed != seedName {
+			continue
+		}
+		total++
+		if IsFailed(shoot) {
+			failed++
+		}
+	}
+	return total, failed
+}
+
+// ShouldBeCordoned returns whether a Seed with <total> Shoots of which <failed> failed shall be cordoned. Seeds with
+// less than the minimum number of Shoots are never cordoned. Seeds which are already <cordoned> stay cordoned until
+// their failure rate dropped below the uncordon threshold, so that they do not flap.
+func ShouldBeCordoned(config *config.SeedCordonControllerConfiguration, cordoned bool, total, failed int) bool {
+	if total == 0 || total < config.MinimumShoots {
+		return false
+	}
+	percentage := failed * 100 / total
+	if cordoned {
+		return percentage >= config.UncordonThreshold
+	}
+	return percentage >= config.CordonThreshold
+}
+
+// enqueueAll adds all Seeds to the queue.
+func (c *Controller) enqueueAll() {
+	seeds, err := c.seedLister.List(labels.Everything())
+	if err != nil {
+		logger.Logger.Errorf("[SEED CORDON] Could not list Seeds: %v", err)
+		return
+	}
+
+	for _, seed := range seeds {
+		if key, err := cache.MetaNamespaceKeyFunc(seed); err == nil {
+			c.seedQueue.Add(key)
+		}
+	}
+}
+
+func (c *Controller) reconcileSeedKey(key string) error {
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	seed, err := c.seedLister.Get(name)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// Seeds which have been cordoned (or explicitly uncordoned) by an operator are left alone.
+	value, ok := seed.Annotations[common.SeedCordoned]
+	if ok && value != common.SeedCordonedAutomatically {
+		return nil
+	}
+
+	shoots, err := c.shootLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	var (
+		cordoned      = ok
+		total, failed = FailureRate(shoots, seed.Name)
+	)
+	if ShouldBeCordoned(c.config, cordoned, total, failed) == cordoned {
+		return nil
+	}
+
+	if cordoned {
+		logger.Logger.Infof("[SEED CORDON] Uncordoning Seed %s (%d/%d Shoots failed)", seed.Name, failed, total)
+	} else {
+		logger.Logger.Infof("[SEED CORDON] Cordoning Seed %s (%d/%d Shoots failed)", seed.Name, failed, total)
+	}
+
+	updated, err := kutil.TryUpdateSeedAnnotations(c.k8sGardenClient.Garden(), retry.DefaultBackoff, seed.ObjectMeta, func(seed *gardenv1beta1.Seed) (*gardenv1beta1.Seed, error) {
+		if value, ok := seed.Annotations[common.SeedCordoned]; ok && value != common.SeedCordonedAutomatically {
+			return seed, nil
+		}
+		if cordoned {
+			delete(seed.Annotations, common.SeedCordoned)
+		} else {
+			if seed.Annotations == nil {
+				seed.Annotations = make(map[string]string)
+			}
+			seed.Annotations[common.SeedCordoned] = common.SeedCordonedAutomatically
+		}
+		return seed, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	switch updated.Annotations[common.SeedCordoned] {
+	case "":
+		c.recorder.Eventf(updated, corev1.EventTypeNormal, gardenv1beta1.SeedEventUncordoned, "Uncordoned the Seed because only %d of %d Shoots failed", failed, total)
+	case common.SeedCordonedAutomatically:
+		c.recorder.Eventf(updated, corev1.EventTypeWarning, gardenv1beta1.SeedEventCordoned, "Cordoned the Seed because %d of %d Shoots failed", failed, total)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package cordon_test
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/cordon"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cordon", func() {
+	Describe("#FailureRate", func() {
+		It("should count the (failed) Shoots of the given Seed", func() {
+			var (
+				seedName  = "seed"
+				otherSeed = "other-seed"
+				shoot     = func(seedName *string, state gardenv1beta1.ShootLastOperationState) *gardenv1beta1.Shoot {
+					shoot := &gardenv1beta1.Shoot{Spec: gardenv1beta1.ShootSpec{Cloud: gardenv1beta1.Cloud{Seed: seedName}}}
+					if len(state) > 0 {
+						shoot.Status.LastOperation = &gardenv1beta1.LastOperation{State: state}
+					}
+					return shoot
+				}
+			)
+
+			total, failed := FailureRate([]*gardenv1beta1.Shoot{
+				shoot(&seedName, gardenv1beta1.ShootLastOperationStateSucceeded),
+				shoot(&seedName, gardenv1beta1.ShootLastOperationStateFailed),
+				shoot(&seedName, gardenv1beta1.ShootLastOperationStateError),
+				shoot(&seedName, ""),
+				shoot(&otherSeed, gardenv1beta1.ShootLastOperationStateFailed),
+				shoot(nil, gardenv1beta1.ShootLastOperationStateFailed),
+			}, seedName)
+
+			Expect(total).To(Equal(4))
+			Expect(failed).To(Equal(2))
+		})
+	})
+
+	DescribeTable("#ShouldBeCordoned",
+		func(cordoned bool, total, failed int, expected bool) {
+			cfg := &config.SeedCordonControllerConfiguration{MinimumShoots: 5, CordonThreshold: 50, UncordonThreshold: 20}
+
+			Expect(ShouldBeCordoned(cfg, cordoned, total, failed)).To(Equal(expected))
+		},
+		Entry("too few shoots", false, 4, 4, false),
+		Entry("cordoned seed with too few shoots", true, 4, 4, false),
+		Entry("failure rate below the cordon threshold", false, 10, 4, false),
+		Entry("failure rate at the cordon threshold", false, 10, 5, true),
+		Entry("cordoned seed with failure rate above the uncordon threshold", true, 10, 3, true),
+		Entry("cordoned seed with failure rate below the uncordon threshold", true, 10, 1, false),
+	)
+})
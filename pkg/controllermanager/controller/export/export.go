@@ -0,0 +1,178 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package export
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
+	gardenmetrics "github.com/gardener/gardener/pkg/controllermanager/metrics"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// Controller exports garden resources as YAML files into a directory.
+type Controller struct {
+	config *config.ExportControllerConfiguration
+
+	control   ControlInterface
+	resources []Resource
+
+	exportQueue workqueue.RateLimitingInterface
+	synced      []cache.InformerSynced
+
+	workerCh               chan int
+	numberOfRunningWorkers int
+}
+
+// NewExportController takes the informer factories for the Garden API groups and the <config> of the controller.
+// It creates a new controller which exports the CloudProfiles, Seeds, ControllerRegistrations and Projects.
+func NewExportController(gardenInformerFactory gardeninformers.SharedInformerFactory, gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory, config *config.ExportControllerConfiguration) *Controller {
+	var (
+		gardenv1beta1Informer      = gardenInformerFactory.Garden().V1beta1()
+		gardenCoreV1alpha1Informer = gardenCoreInformerFactory.Core().V1alpha1()
+
+		cloudProfileInformer           = gardenv1beta1Informer.CloudProfiles()
+		seedInformer                   = gardenv1beta1Informer.Seeds()
+		projectInformer                = gardenv1beta1Informer.Projects()
+		controllerRegistrationInformer = gardenCoreV1alpha1Informer.ControllerRegistrations()
+
+		cloudProfileLister           = cloudProfileInformer.Lister()
+		seedLister                   = seedInformer.Lister()
+		projectLister                = projectInformer.Lister()
+		controllerRegistrationLister = controllerRegistrationInformer.Lister()
+
+		informers = map[string]cache.SharedIndexInformer{
+			"cloudprofiles":           cloudProfileInformer.Informer(),
+			"seeds":                   seedInformer.Informer(),
+			"projects":                projectInformer.Informer(),
+			"controllerregistrations": controllerRegistrationInformer.Informer(),
+		}
+		resources = []Resource{
+			{
+				Name:             "cloudprofiles",
+				GroupVersionKind: gardenv1beta1.SchemeGroupVersion.WithKind("CloudProfile"),
+				Get:              func(name string) (runtime.Object, error) { return cloudProfileLister.Get(name) },
+			},
+			{
+				Name:             "seeds",
+				GroupVersionKind: gardenv1beta1.SchemeGroupVersion.WithKind("Seed"),
+				Get:              func(name string) (runtime.Object, error) { return seedLister.Get(name) },
+			},
+			{
+				Name:             "projects",
+				GroupVersionKind: gardenv1beta1.SchemeGroupVersion.WithKind("Project"),
+				Get:              func(name string) (runtime.Object, error) { return projectLister.Get(name) },
+			},
+			{
+				Name:             "controllerregistrations",
+				GroupVersionKind: gardencorev1alpha1.SchemeGroupVersion.WithKind("ControllerRegistration"),
+				Get:              func(name string) (runtime.Object, error) { return controllerRegistrationLister.Get(name) },
+			},
+		}
+	)
+
+	exportController := &Controller{
+		config:      config,
+		control:     NewDefaultControl(config.Directory),
+		resources:   resources,
+		exportQueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "export"),
+		workerCh:    make(chan int),
+	}
+
+	for _, resource := range resources {
+		informer := informers[resource.Name]
+		informer.AddEventHandler(exportController.eventHandler(resource.Name))
+		exportController.synced = append(exportController.synced, informer.HasSynced)
+	}
+
+	return exportController
+}
+
+// Run runs the Controller until the given stop channel can be read from.
+func (c *Controller) Run(ctx context.Context, workers int) {
+	var waitGroup sync.WaitGroup
+
+	if !cache.WaitForCacheSync(ctx.Done(), c.synced...) {
+		logger.Logger.Error("Timed out waiting for caches to sync")
+		return
+	}
+
+	// Objects which have been deleted while the controller was not running are only known from the exported files.
+	keys, err := c.control.ExportedKeys(c.resources)
+	if err != nil {
+		logger.Logger.Errorf("Could not determine the exported resources in %s: %v", c.config.Directory, err)
+	}
+	for _, key := range keys {
+		c.exportQueue.Add(key)
+	}
+
+	// Count number of running workers.
+	go func() {
+		for {
+			select {
+			case res := <-c.workerCh:
+				c.numberOfRunningWorkers += res
+				logger.Logger.Debugf("Current number of running Export workers is %d", c.numberOfRunningWorkers)
+			}
+		}
+	}()
+
+	logger.Logger.Infof("Export controller initialized (exporting to %s).", c.config.Directory)
+
+	for i := 0; i < workers; i++ {
+		controllerutils.CreateWorker(ctx, c.exportQueue, "Export", c.reconcileExportKey, &waitGroup, c.workerCh)
+	}
+
+	// Shutdown handling
+	<-ctx.Done()
+	c.exportQueue.ShutDown()
+
+	for {
+		if c.exportQueue.Len() == 0 && c.numberOfRunningWorkers == 0 {
+			logger.Logger.Debug("No running Export worker and no items left in the queues. Terminated Export controller...")
+			break
+		}
+		logger.Logger.Debugf("Waiting for %d Export worker(s) to finish (%d item(s) left in the queues)...", c.numberOfRunningWorkers, c.exportQueue.Len())
+		time.Sleep(5 * time.Second)
+	}
+
+	waitGroup.Wait()
+}
+
+// RunningWorkers returns the number of running workers.
+func (c *Controller) RunningWorkers() int {
+	return c.numberOfRunningWorkers
+}
+
+// CollectMetrics implements gardenmetrics.ControllerMetricsCollector interface
+func (c *Controller) CollectMetrics(ch chan<- prometheus.Metric) {
+	metric, err := prometheus.NewConstMetric(gardenmetrics.ControllerWorkerSum, prometheus.GaugeValue, float64(c.RunningWorkers()), "export")
+	if err != nil {
+		gardenmetrics.ScrapeFailures.With(prometheus.Labels{"kind": "export-controller"}).Inc()
+		return
+	}
+	ch <- metric
+}
@@ -0,0 +1,233 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/logger"
+
+	"github.com/ghodss/yaml"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+)
+
+const fileExtension = ".yaml"
+
+// Resource describes a kind of garden resources which is exported.
+type Resource struct {
+	// Name is the plural name of the resource. It is used as name of the directory containing the exported objects.
+	Name string
+	// GroupVersionKind is the group, version and kind which is written into the exported objects.
+	GroupVersionKind schema.GroupVersionKind
+	// Get returns the object with the given name.
+	Get func(name string) (runtime.Object, error)
+}
+
+// fieldsToRemove are the fields which are specific to the garden cluster the objects have been read from. They are
+// removed so that the exported objects only change if their specification changes and can be applied to a new garden.
+var fieldsToRemove = [][]string{
+	{"status"},
+	{"metadata", "creationTimestamp"},
+	{"metadata", "deletionGracePeriodSeconds"},
+	{"metadata", "deletionTimestamp"},
+	{"metadata", "finalizers"},
+	{"metadata", "generation"},
+	{"metadata", "initializers"},
+	{"metadata", "ownerReferences"},
+	{"metadata", "resourceVersion"},
+	{"metadata", "selfLink"},
+	{"metadata", "uid"},
+	{"metadata", "annotations", "kubectl.kubernetes.io/last-applied-configuration"},
+}
+
+func (c *Controller) eventHandler(resourceName string) cache.ResourceEventHandlerFuncs {
+	add := func(obj interface{}) {
+		key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+		if err != nil {
+			logger.Logger.Errorf("Couldn't get key for object %+v: %v", obj, err)
+			return
+		}
+		c.exportQueue.Add(resourceName + "/" + key)
+	}
+
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: add,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			add(newObj)
+		},
+		DeleteFunc: add,
+	}
+}
+
+func (c *Controller) reconcileExportKey(key string) error {
+	resourceName, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	var resource *Resource
+	for i := range c.resources {
+		if c.resources[i].Name == resourceName {
+			resource = &c.resources[i]
+			break
+		}
+	}
+	if resource == nil {
+		logger.Logger.Infof("[EXPORT] %s - skipping because resource %q is not exported", key, resourceName)
+		return nil
+	}
+
+	obj, err := resource.Get(name)
+	if apierrors.IsNotFound(err) {
+		logger.Logger.Debugf("[EXPORT] %s - removing because object has been deleted", key)
+		return c.control.Remove(*resource, name)
+	}
+	if err != nil {
+		logger.Logger.Infof("[EXPORT] %s - unable to retrieve object from store: %v", key, err)
+		return err
+	}
+
+	return c.control.Export(*resource, name, obj)
+}
+
+// ControlInterface implements the control logic for exporting garden resources. It is implemented as an interface to
+// allow for extensions that provide different semantics. Currently, there is only one implementation.
+type ControlInterface interface {
+	// Export writes the given object of the given resource into the export directory. The file is only written if its
+	// content changes.
+	Export(resource Resource, name string, obj runtime.Object) error
+	// Remove removes the exported object of the given resource with the given name.
+	Remove(resource Resource, name string) error
+	// ExportedKeys returns the keys (<resource>/<name>) of all objects of the given resources which are currently
+	// exported.
+	ExportedKeys(resources []Resource) ([]string, error)
+}
+
+// NewDefaultControl returns a new instance of the default implementation ControlInterface that implements the
+// documented semantics for exporting garden resources into the given <directory>. You should use an instance returned
+// from NewDefaultControl() for any scenario other than testing.
+func NewDefaultControl(directory string) ControlInterface {
+	return &defaultControl{directory}
+}
+
+type defaultControl struct {
+	directory string
+}
+
+func (c *defaultControl) path(resource Resource, name string) string {
+	return filepath.Join(c.directory, resource.Name, name+fileExtension)
+}
+
+func (c *defaultControl) Export(resource Resource, name string, obj runtime.Object) error {
+	data, err := Normalize(obj, resource.GroupVersionKind)
+	if err != nil {
+		return err
+	}
+
+	path := c.path(resource, name)
+	if existing, err := ioutil.ReadFile(path); err == nil && bytes.Equal(existing, data) {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	// The file is written atomically so that a concurrent synchronization of the directory never sees partial content.
+	tmpFile, err := ioutil.TempFile(filepath.Dir(path), "."+name)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmpFile.Name(), 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpFile.Name(), path); err != nil {
+		return err
+	}
+
+	logger.Logger.Infof("[EXPORT] Exported %s/%s to %s", resource.Name, name, path)
+	return nil
+}
+
+func (c *defaultControl) Remove(resource Resource, name string) error {
+	path := c.path(resource, name)
+	if err := os.Remove(path); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	logger.Logger.Infof("[EXPORT] Removed %s/%s from %s", resource.Name, name, path)
+	return nil
+}
+
+func (c *defaultControl) ExportedKeys(resources []Resource) ([]string, error) {
+	var keys []string
+	for _, resource := range resources {
+		files, err := ioutil.ReadDir(filepath.Join(c.directory, resource.Name))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		for _, file := range files {
+			if file.IsDir() || strings.HasPrefix(file.Name(), ".") || !strings.HasSuffix(file.Name(), fileExtension) {
+				continue
+			}
+			keys = append(keys, resource.Name+"/"+strings.TrimSuffix(file.Name(), fileExtension))
+		}
+	}
+	return keys, nil
+}
+
+// Normalize serializes the given object as YAML with the given <gvk>. It removes the status and all metadata which is
+// specific to the garden cluster the object has been read from. The keys are sorted, hence the result is stable.
+func Normalize(obj runtime.Object, gvk schema.GroupVersionKind) ([]byte, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert object to unstructured: %v", err)
+	}
+
+	u := &unstructured.Unstructured{Object: content}
+	u.SetGroupVersionKind(gvk)
+	for _, fields := range fieldsToRemove {
+		unstructured.RemoveNestedField(u.Object, fields...)
+	}
+	if len(u.GetAnnotations()) == 0 {
+		unstructured.RemoveNestedField(u.Object, "metadata", "annotations")
+	}
+
+	return yaml.Marshal(u.Object)
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package export_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/export"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Export", func() {
+	var (
+		resource = Resource{
+			Name:             "seeds",
+			GroupVersionKind: gardenv1beta1.SchemeGroupVersion.WithKind("Seed"),
+		}
+
+		seed *gardenv1beta1.Seed
+	)
+
+	BeforeEach(func() {
+		seed = &gardenv1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "seed",
+				Labels:            map[string]string{"foo": "bar"},
+				Annotations:       map[string]string{"kubectl.kubernetes.io/last-applied-configuration": "{}"},
+				UID:               types.UID("1234"),
+				ResourceVersion:   "42",
+				Generation:        2,
+				CreationTimestamp: metav1.Now(),
+				Finalizers:        []string{gardenv1beta1.GardenerName},
+			},
+			Spec: gardenv1beta1.SeedSpec{
+				Cloud: gardenv1beta1.SeedCloud{Profile: "aws", Region: "eu-west-1"},
+			},
+			Status: gardenv1beta1.SeedStatus{
+				Conditions: []gardenv1beta1.Condition{{Type: gardenv1beta1.SeedAvailable}},
+			},
+		}
+	})
+
+	Describe("#Normalize", func() {
+		It("should remove the status and the cluster-specific metadata", func() {
+			data, err := Normalize(seed, resource.GroupVersionKind)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).To(Equal(`apiVersion: garden.sapcloud.io/v1beta1
+kind: Seed
+metadata:
+  labels:
+    foo: bar
+  name: seed
+spec:
+  cloud:
+    profile: aws
+    region: eu-west-1
+  ingressDomain: ""
+  networks:
+    nodes: ""
+    pods: ""
+    services: ""
+  secretRef: {}
+`))
+		})
+
+		It("should not change the object", func() {
+			_, err := Normalize(seed, resource.GroupVersionKind)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(seed.Status.Conditions).To(HaveLen(1))
+			Expect(seed.Annotations).To(HaveLen(1))
+		})
+	})
+
+	Describe("#NewDefaultControl", func() {
+		var (
+			directory string
+			control   ControlInterface
+			path      string
+		)
+
+		BeforeEach(func() {
+			var err error
+			directory, err = ioutil.TempDir("", "export")
+			Expect(err).NotTo(HaveOccurred())
+
+			control = NewDefaultControl(directory)
+			path = filepath.Join(directory, "seeds", "seed.yaml")
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(directory)).To(Succeed())
+		})
+
+		It("should export and remove objects", func() {
+			Expect(control.Export(resource, seed.Name, seed)).To(Succeed())
+
+			data, err := ioutil.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			expected, err := Normalize(seed, resource.GroupVersionKind)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(Equal(expected))
+
+			keys, err := control.ExportedKeys([]Resource{resource, {Name: "projects"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keys).To(ConsistOf("seeds/seed"))
+
+			Expect(control.Remove(resource, seed.Name)).To(Succeed())
+			_, err = os.Stat(path)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+			Expect(control.Remove(resource, seed.Name)).To(Succeed())
+		})
+
+		It("should not rewrite unchanged objects", func() {
+			Expect(control.Export(resource, seed.Name, seed)).To(Succeed())
+			before, err := os.Stat(path)
+			Expect(err).NotTo(HaveOccurred())
+
+			seed.ResourceVersion = "43"
+			seed.Status = gardenv1beta1.SeedStatus{}
+			Expect(control.Export(resource, seed.Name, seed)).To(Succeed())
+
+			after, err := os.Stat(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(os.SameFile(before, after)).To(BeTrue())
+		})
+	})
+})
@@ -24,13 +24,19 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	backupinfrastructurecontroller "github.com/gardener/gardener/pkg/controllermanager/controller/backupinfrastructure"
 	cloudprofilecontroller "github.com/gardener/gardener/pkg/controllermanager/controller/cloudprofile"
+	compliancecontroller "github.com/gardener/gardener/pkg/controllermanager/controller/compliance"
 	controllerinstallationcontroller "github.com/gardener/gardener/pkg/controllermanager/controller/controllerinstallation"
 	controllerregistrationcontroller "github.com/gardener/gardener/pkg/controllermanager/controller/controllerregistration"
+	cordoncontroller "github.com/gardener/gardener/pkg/controllermanager/controller/cordon"
+	exportcontroller "github.com/gardener/gardener/pkg/controllermanager/controller/export"
+	federationcontroller "github.com/gardener/gardener/pkg/controllermanager/controller/federation"
 	projectcontroller "github.com/gardener/gardener/pkg/controllermanager/controller/project"
 	quotacontroller "github.com/gardener/gardener/pkg/controllermanager/controller/quota"
 	secretbindingcontroller "github.com/gardener/gardener/pkg/controllermanager/controller/secretbinding"
 	seedcontroller "github.com/gardener/gardener/pkg/controllermanager/controller/seed"
 	shootcontroller "github.com/gardener/gardener/pkg/controllermanager/controller/shoot"
+	stalenesscontroller "github.com/gardener/gardener/pkg/controllermanager/controller/staleness"
+	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	gardenmetrics "github.com/gardener/gardener/pkg/controllermanager/metrics"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation/common"
@@ -41,7 +47,9 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/leaderelection"
 	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // GardenControllerFactory contains information relevant to controllers for the Garden API group.
@@ -54,10 +62,12 @@ type GardenControllerFactory struct {
 	k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory
 	k8sInformers           kubeinformers.SharedInformerFactory
 	recorder               record.EventRecorder
+	globalLeaderElection   *leaderelection.LeaderElectionConfig
 }
 
-// NewGardenControllerFactory creates a new factory for controllers for the Garden API group.
-func NewGardenControllerFactory(k8sGardenClient kubernetes.Interface, gardenInformerFactory gardeninformers.SharedInformerFactory, gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory, kubeInformerFactory kubeinformers.SharedInformerFactory, cfg *config.ControllerManagerConfiguration, identity *gardenv1beta1.Gardener, gardenNamespace string, recorder record.EventRecorder) *GardenControllerFactory {
+// NewGardenControllerFactory creates a new factory for controllers for the Garden API group. If <globalLeaderElection>
+// is given, the controllers acting on the whole Garden cluster only run while holding its lock.
+func NewGardenControllerFactory(k8sGardenClient kubernetes.Interface, gardenInformerFactory gardeninformers.SharedInformerFactory, gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory, kubeInformerFactory kubeinformers.SharedInformerFactory, cfg *config.ControllerManagerConfiguration, identity *gardenv1beta1.Gardener, gardenNamespace string, recorder record.EventRecorder, globalLeaderElection *leaderelection.LeaderElectionConfig) *GardenControllerFactory {
 	return &GardenControllerFactory{
 		cfg:                    cfg,
 		identity:               identity,
@@ -67,9 +77,19 @@ func NewGardenControllerFactory(k8sGardenClient kubernetes.Interface, gardenInfo
 		k8sGardenCoreInformers: gardenCoreInformerFactory,
 		k8sInformers:           kubeInformerFactory,
 		recorder:               recorder,
+		globalLeaderElection:   globalLeaderElection,
 	}
 }
 
+// AddIndexers adds the indexers used by the controllers to the informers of the given factories. Indexers can only be
+// added before an informer has been started, hence, this must be called before any of the factories is started.
+func AddIndexers(gardenInformerFactory gardeninformers.SharedInformerFactory, gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory) error {
+	if err := controllerutils.AddShootIndexers(gardenInformerFactory.Garden().V1beta1().Shoots().Informer()); err != nil {
+		return err
+	}
+	return controllerutils.AddControllerInstallationIndexers(gardenCoreInformerFactory.Core().V1alpha1().ControllerInstallations().Informer())
+}
+
 // Run starts all the controllers for the Garden API group. It also performs bootstrapping tasks.
 func (f *GardenControllerFactory) Run(ctx context.Context) {
 	var (
@@ -119,6 +139,22 @@ func (f *GardenControllerFactory) Run(ctx context.Context) {
 	if err != nil {
 		panic(err)
 	}
+	var requireDigests bool
+	if images := f.cfg.Images; images != nil {
+		if images.Registry != nil {
+			imageVector = imageVector.WithRegistry(*images.Registry)
+			logger.Logger.Infof("Replaced the registries of all images by %s.", *images.Registry)
+		}
+		requireDigests = images.RequireDigests
+	}
+	if err := imageVector.Validate(requireDigests); err != nil {
+		panic(err)
+	}
+
+	seedFilter, err := controllerutils.NewSeedFilter(f.k8sGardenInformers.Garden().V1beta1().Seeds().Lister(), f.cfg.SeedSelector)
+	if err != nil {
+		panic(err)
+	}
 
 	if err := garden.BootstrapCluster(f.k8sGardenClient, common.GardenNamespace, secrets); err != nil {
 		logger.Logger.Errorf("Failed to bootstrap the Garden cluster: %s", err.Error())
@@ -128,32 +164,96 @@ func (f *GardenControllerFactory) Run(ctx context.Context) {
 
 	// Initialize the workqueue metrics collection.
 	gardenmetrics.RegisterWorkqueMetrics()
+	// Initialize the metrics about the performance of Shoot operations.
+	gardenmetrics.RegisterShootMetrics()
 
 	var (
-		shootController                  = shootcontroller.NewShootController(f.k8sGardenClient, f.k8sGardenInformers, f.k8sGardenCoreInformers, f.k8sInformers, f.cfg, f.identity, f.gardenNamespace, secrets, imageVector, f.recorder)
-		seedController                   = seedcontroller.NewSeedController(f.k8sGardenClient, f.k8sGardenInformers, f.k8sInformers, secrets, imageVector, f.cfg, f.recorder)
+		shootController                  = shootcontroller.NewShootController(f.k8sGardenClient, f.k8sGardenInformers, f.k8sGardenCoreInformers, f.k8sInformers, f.cfg, seedFilter, f.identity, f.gardenNamespace, secrets, imageVector, f.recorder)
+		seedController                   = seedcontroller.NewSeedController(f.k8sGardenClient, f.k8sGardenInformers, f.k8sGardenCoreInformers, f.k8sInformers, secrets, imageVector, f.cfg, seedFilter, f.recorder)
 		quotaController                  = quotacontroller.NewQuotaController(f.k8sGardenClient, f.k8sGardenInformers, f.recorder)
-		projectController                = projectcontroller.NewProjectController(f.k8sGardenClient, f.k8sGardenInformers, f.k8sInformers, f.recorder)
+		projectController                = projectcontroller.NewProjectController(f.k8sGardenClient, f.k8sGardenInformers, f.k8sInformers, f.cfg.Controllers.Project, f.recorder)
 		cloudProfileController           = cloudprofilecontroller.NewCloudProfileController(f.k8sGardenClient, f.k8sGardenInformers)
 		secretBindingController          = secretbindingcontroller.NewSecretBindingController(f.k8sGardenClient, f.k8sGardenInformers, f.k8sInformers, f.recorder)
-		backupInfrastructureController   = backupinfrastructurecontroller.NewBackupInfrastructureController(f.k8sGardenClient, f.k8sGardenInformers, f.cfg, f.identity, f.gardenNamespace, secrets, imageVector, f.recorder)
+		backupInfrastructureController   = backupinfrastructurecontroller.NewBackupInfrastructureController(f.k8sGardenClient, f.k8sGardenInformers, f.cfg, seedFilter, f.identity, f.gardenNamespace, secrets, imageVector, f.recorder)
 		controllerRegistrationController = controllerregistrationcontroller.NewController(f.k8sGardenClient, f.k8sGardenInformers, f.k8sGardenCoreInformers, f.cfg, f.recorder)
-		controllerInstallationController = controllerinstallationcontroller.NewController(f.k8sGardenClient, f.k8sGardenInformers, f.k8sGardenCoreInformers, f.cfg, f.recorder)
+		controllerInstallationController = controllerinstallationcontroller.NewController(f.k8sGardenClient, f.k8sGardenInformers, f.k8sGardenCoreInformers, f.cfg, seedFilter, f.recorder)
 	)
 
 	// Initialize the Controller metrics collection.
 	gardenmetrics.RegisterControllerMetrics(shootController, seedController, quotaController, cloudProfileController, secretBindingController, backupInfrastructureController)
 
+	// The following controllers only act on the Seeds matching the seed selector, hence, they run in every instance.
 	go shootController.Run(ctx, f.cfg.Controllers.Shoot.ConcurrentSyncs, f.cfg.Controllers.ShootCare.ConcurrentSyncs, f.cfg.Controllers.ShootMaintenance.ConcurrentSyncs, f.cfg.Controllers.ShootQuota.ConcurrentSyncs, f.cfg.Controllers.ShootHibernation.ConcurrentSyncs)
 	go seedController.Run(ctx, f.cfg.Controllers.Seed.ConcurrentSyncs)
-	go quotaController.Run(ctx, f.cfg.Controllers.Quota.ConcurrentSyncs)
-	go projectController.Run(ctx, f.cfg.Controllers.Project.ConcurrentSyncs)
-	go cloudProfileController.Run(ctx, f.cfg.Controllers.CloudProfile.ConcurrentSyncs)
-	go secretBindingController.Run(ctx, f.cfg.Controllers.SecretBinding.ConcurrentSyncs)
 	go backupInfrastructureController.Run(ctx, f.cfg.Controllers.BackupInfrastructure.ConcurrentSyncs)
-	go controllerRegistrationController.Run(ctx, f.cfg.Controllers.ControllerRegistration.ConcurrentSyncs)
 	go controllerInstallationController.Run(ctx, f.cfg.Controllers.ControllerInstallation.ConcurrentSyncs)
 
+	// The following controllers act on the whole Garden cluster, hence, they must only run in one instance.
+	globalControllers := []func(context.Context){
+		func(ctx context.Context) {
+			quotaController.Run(ctx, f.cfg.Controllers.Quota.ConcurrentSyncs)
+		},
+		func(ctx context.Context) {
+			projectController.Run(ctx, f.cfg.Controllers.Project.ConcurrentSyncs)
+		},
+		func(ctx context.Context) {
+			cloudProfileController.Run(ctx, f.cfg.Controllers.CloudProfile.ConcurrentSyncs)
+		},
+		func(ctx context.Context) {
+			secretBindingController.Run(ctx, f.cfg.Controllers.SecretBinding.ConcurrentSyncs)
+		},
+		func(ctx context.Context) {
+			controllerRegistrationController.Run(ctx, f.cfg.Controllers.ControllerRegistration.ConcurrentSyncs)
+		},
+	}
+
+	if complianceConfig := f.cfg.Controllers.Compliance; complianceConfig != nil {
+		complianceController := compliancecontroller.NewComplianceController(f.k8sGardenClient, f.k8sGardenInformers, complianceConfig)
+		globalControllers = append(globalControllers, func(ctx context.Context) {
+			complianceController.Run(ctx, complianceConfig.ConcurrentSyncs)
+		})
+	}
+
+	if conditionStalenessConfig := f.cfg.Controllers.ConditionStaleness; conditionStalenessConfig != nil {
+		gardenmetrics.RegisterConditionMetrics()
+		conditionStalenessController := stalenesscontroller.NewConditionStalenessController(f.k8sGardenClient, f.k8sGardenInformers, conditionStalenessConfig)
+		globalControllers = append(globalControllers, func(ctx context.Context) {
+			conditionStalenessController.Run(ctx, conditionStalenessConfig.ConcurrentSyncs)
+		})
+	}
+
+	if seedCordonConfig := f.cfg.Controllers.SeedCordon; seedCordonConfig != nil {
+		seedCordonController := cordoncontroller.NewSeedCordonController(f.k8sGardenClient, f.k8sGardenInformers, seedCordonConfig, f.recorder)
+		globalControllers = append(globalControllers, func(ctx context.Context) {
+			seedCordonController.Run(ctx, seedCordonConfig.ConcurrentSyncs)
+		})
+	}
+
+	if exportConfig := f.cfg.Controllers.Export; exportConfig != nil {
+		exportController := exportcontroller.NewExportController(f.k8sGardenInformers, f.k8sGardenCoreInformers, exportConfig)
+		globalControllers = append(globalControllers, func(ctx context.Context) {
+			exportController.Run(ctx, exportConfig.ConcurrentSyncs)
+		})
+	}
+
+	if federationConfig := f.cfg.Controllers.Federation; federationConfig != nil {
+		sourceGardenClient, err := kubernetes.NewClientFromFile(federationConfig.SourceKubeconfig, &f.cfg.ClientConnection, client.Options{
+			Scheme: kubernetes.GardenScheme,
+		})
+		if err != nil {
+			panic(err)
+		}
+		federationController, err := federationcontroller.NewFederationController(f.k8sGardenClient, f.k8sGardenInformers, f.k8sGardenCoreInformers, sourceGardenClient, f.recorder, federationConfig)
+		if err != nil {
+			panic(err)
+		}
+		globalControllers = append(globalControllers, func(ctx context.Context) {
+			federationController.Run(ctx, federationConfig.ConcurrentSyncs)
+		})
+	}
+
+	f.runGlobalControllers(ctx, globalControllers)
+
 	logger.Logger.Infof("Gardener controller manager (version %s) initialized.", version.Get().GitVersion)
 
 	// Shutdown handling
@@ -162,3 +262,40 @@ func (f *GardenControllerFactory) Run(ctx context.Context) {
 	logger.Logger.Infof("I have received a stop signal and will no longer watch events of the Garden API group.")
 	logger.Logger.Infof("Bye Bye!")
 }
+
+// runGlobalControllers starts the given controllers which act on the whole Garden cluster. If a leader election
+// configuration for them is given, they are only started once the lock has been acquired, so that only one of
+// multiple controller managers (each managing a subset of the Seeds) runs them. Losing the lock is fatal as the
+// controllers cannot be restarted.
+func (f *GardenControllerFactory) runGlobalControllers(ctx context.Context, controllers []func(context.Context)) {
+	run := func(ctx context.Context) {
+		for _, controller := range controllers {
+			go controller(ctx)
+		}
+	}
+
+	if f.globalLeaderElection == nil {
+		run(ctx)
+		return
+	}
+
+	leaderElection := *f.globalLeaderElection
+	leaderElection.Callbacks = leaderelection.LeaderCallbacks{
+		OnStartedLeading: func(leaderCtx context.Context) {
+			logger.Logger.Info("Acquired leadership for the global controllers, starting them.")
+			run(leaderCtx)
+		},
+		OnStoppedLeading: func() {
+			if ctx.Err() != nil {
+				return
+			}
+			panic("Lost leadership for the global controllers")
+		},
+	}
+	leaderElector, err := leaderelection.NewLeaderElector(leaderElection)
+	if err != nil {
+		panic(err)
+	}
+	logger.Logger.Info("Waiting for leadership for the global controllers.")
+	go leaderElector.Run(ctx)
+}
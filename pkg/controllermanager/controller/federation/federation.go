@@ -0,0 +1,215 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package federation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
+	gardenmetrics "github.com/gardener/gardener/pkg/controllermanager/metrics"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/prometheus/client_golang/prometheus"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// Controller mirrors garden resources from a source garden into the garden the controller manager is running against.
+type Controller struct {
+	config *config.FederationControllerConfiguration
+
+	control   ControlInterface
+	resources []Resource
+	selector  labels.Selector
+
+	sourceGardenInformers     gardeninformers.SharedInformerFactory
+	sourceGardenCoreInformers gardencoreinformers.SharedInformerFactory
+
+	federationQueue workqueue.RateLimitingInterface
+	synced          []cache.InformerSynced
+
+	workerCh               chan int
+	numberOfRunningWorkers int
+}
+
+// NewFederationController takes a Kubernetes client <k8sGardenClient> and the informer factories for the Garden API
+// groups of the target garden, a Kubernetes client <sourceGardenClient> for the source garden, an event <recorder>
+// and the <config> of the controller. It creates a new controller which mirrors the configured resources from the
+// source garden into the target garden.
+func NewFederationController(k8sGardenClient kubernetes.Interface, gardenInformerFactory gardeninformers.SharedInformerFactory, gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory, sourceGardenClient kubernetes.Interface, recorder record.EventRecorder, config *config.FederationControllerConfiguration) (*Controller, error) {
+	selector := labels.Everything()
+	if config.Selector != nil {
+		var err error
+		if selector, err = metav1.LabelSelectorAsSelector(config.Selector); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		sourceGardenInformers     = gardeninformers.NewSharedInformerFactory(sourceGardenClient.Garden(), 0)
+		sourceGardenCoreInformers = gardencoreinformers.NewSharedInformerFactory(sourceGardenClient.GardenCore(), 0)
+
+		sourceCloudProfileInformer           = sourceGardenInformers.Garden().V1beta1().CloudProfiles()
+		sourceControllerRegistrationInformer = sourceGardenCoreInformers.Core().V1alpha1().ControllerRegistrations()
+		cloudProfileInformer                 = gardenInformerFactory.Garden().V1beta1().CloudProfiles()
+		controllerRegistrationInformer       = gardenCoreInformerFactory.Core().V1alpha1().ControllerRegistrations()
+
+		cloudProfiles           = k8sGardenClient.Garden().GardenV1beta1().CloudProfiles()
+		controllerRegistrations = k8sGardenClient.GardenCore().CoreV1alpha1().ControllerRegistrations()
+
+		informers = map[string][]cache.SharedIndexInformer{
+			"cloudprofiles":           {sourceCloudProfileInformer.Informer(), cloudProfileInformer.Informer()},
+			"controllerregistrations": {sourceControllerRegistrationInformer.Informer(), controllerRegistrationInformer.Informer()},
+		}
+		supportedResources = []Resource{
+			{
+				Name:             "cloudprofiles",
+				GroupVersionKind: gardenv1beta1.SchemeGroupVersion.WithKind("CloudProfile"),
+				GetSource:        func(name string) (runtime.Object, error) { return sourceCloudProfileInformer.Lister().Get(name) },
+				GetTarget:        func(name string) (runtime.Object, error) { return cloudProfileInformer.Lister().Get(name) },
+				Create: func(obj runtime.Object) error {
+					_, err := cloudProfiles.Create(obj.(*gardenv1beta1.CloudProfile))
+					return err
+				},
+				Update: func(obj runtime.Object) error {
+					_, err := cloudProfiles.Update(obj.(*gardenv1beta1.CloudProfile))
+					return err
+				},
+				Delete: func(name string) error { return cloudProfiles.Delete(name, &metav1.DeleteOptions{}) },
+			},
+			{
+				Name:             "controllerregistrations",
+				GroupVersionKind: gardencorev1alpha1.SchemeGroupVersion.WithKind("ControllerRegistration"),
+				GetSource: func(name string) (runtime.Object, error) {
+					return sourceControllerRegistrationInformer.Lister().Get(name)
+				},
+				GetTarget: func(name string) (runtime.Object, error) { return controllerRegistrationInformer.Lister().Get(name) },
+				Create: func(obj runtime.Object) error {
+					_, err := controllerRegistrations.Create(obj.(*gardencorev1alpha1.ControllerRegistration))
+					return err
+				},
+				Update: func(obj runtime.Object) error {
+					_, err := controllerRegistrations.Update(obj.(*gardencorev1alpha1.ControllerRegistration))
+					return err
+				},
+				Delete: func(name string) error { return controllerRegistrations.Delete(name, &metav1.DeleteOptions{}) },
+			},
+		}
+	)
+
+	federationController := &Controller{
+		config:                    config,
+		control:                   NewDefaultControl(recorder),
+		selector:                  selector,
+		sourceGardenInformers:     sourceGardenInformers,
+		sourceGardenCoreInformers: sourceGardenCoreInformers,
+		federationQueue:           workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "federation"),
+		workerCh:                  make(chan int),
+	}
+
+	for _, name := range config.Resources {
+		var resource *Resource
+		for i := range supportedResources {
+			if supportedResources[i].Name == name {
+				resource = &supportedResources[i]
+				break
+			}
+		}
+		if resource == nil {
+			return nil, fmt.Errorf("resource %q cannot be mirrored", name)
+		}
+		federationController.resources = append(federationController.resources, *resource)
+
+		for _, informer := range informers[name] {
+			informer.AddEventHandler(federationController.eventHandler(name))
+			federationController.synced = append(federationController.synced, informer.HasSynced)
+		}
+	}
+
+	return federationController, nil
+}
+
+// Run runs the Controller until the given stop channel can be read from.
+func (c *Controller) Run(ctx context.Context, workers int) {
+	var waitGroup sync.WaitGroup
+
+	c.sourceGardenInformers.Start(ctx.Done())
+	c.sourceGardenCoreInformers.Start(ctx.Done())
+
+	if !cache.WaitForCacheSync(ctx.Done(), c.synced...) {
+		logger.Logger.Error("Timed out waiting for caches to sync")
+		return
+	}
+
+	// Count number of running workers.
+	go func() {
+		for {
+			select {
+			case res := <-c.workerCh:
+				c.numberOfRunningWorkers += res
+				logger.Logger.Debugf("Current number of running Federation workers is %d", c.numberOfRunningWorkers)
+			}
+		}
+	}()
+
+	logger.Logger.Info("Federation controller initialized.")
+
+	for i := 0; i < workers; i++ {
+		controllerutils.CreateWorker(ctx, c.federationQueue, "Federation", c.reconcileFederationKey, &waitGroup, c.workerCh)
+	}
+
+	// Shutdown handling
+	<-ctx.Done()
+	c.federationQueue.ShutDown()
+
+	for {
+		if c.federationQueue.Len() == 0 && c.numberOfRunningWorkers == 0 {
+			logger.Logger.Debug("No running Federation worker and no items left in the queues. Terminated Federation controller...")
+			break
+		}
+		logger.Logger.Debugf("Waiting for %d Federation worker(s) to finish (%d item(s) left in the queues)...", c.numberOfRunningWorkers, c.federationQueue.Len())
+		time.Sleep(5 * time.Second)
+	}
+
+	waitGroup.Wait()
+}
+
+// RunningWorkers returns the number of running workers.
+func (c *Controller) RunningWorkers() int {
+	return c.numberOfRunningWorkers
+}
+
+// CollectMetrics implements gardenmetrics.ControllerMetricsCollector interface
+func (c *Controller) CollectMetrics(ch chan<- prometheus.Metric) {
+	metric, err := prometheus.NewConstMetric(gardenmetrics.ControllerWorkerSum, prometheus.GaugeValue, float64(c.RunningWorkers()), "federation")
+	if err != nil {
+		gardenmetrics.ScrapeFailures.With(prometheus.Labels{"kind": "federation-controller"}).Inc()
+		return
+	}
+	ch <- metric
+}
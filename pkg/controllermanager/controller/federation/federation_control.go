@@ -0,0 +1,291 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package federation
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/controllermanager/controller/export"
+	"github.com/gardener/gardener/pkg/logger"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	// SourceHashAnnotation is the annotation on mirrored objects whose value is the hash of the object in the source
+	// garden at the time it has been mirrored. Objects without this annotation have not been created by the
+	// Federation controller and are never changed by it.
+	SourceHashAnnotation = "federation.garden.sapcloud.io/source-hash"
+
+	// EventConflict is an event reason for objects which cannot be mirrored because they have been created or
+	// modified in the target garden.
+	EventConflict = "FederationConflict"
+)
+
+// Resource describes a kind of garden resources which is mirrored.
+type Resource struct {
+	// Name is the plural name of the resource.
+	Name string
+	// GroupVersionKind is the group, version and kind of the resource.
+	GroupVersionKind schema.GroupVersionKind
+	// GetSource returns the object with the given name from the source garden.
+	GetSource func(name string) (runtime.Object, error)
+	// GetTarget returns the object with the given name from the target garden.
+	GetTarget func(name string) (runtime.Object, error)
+	// Create creates the given object in the target garden.
+	Create func(obj runtime.Object) error
+	// Update updates the given object in the target garden.
+	Update func(obj runtime.Object) error
+	// Delete deletes the object with the given name in the target garden.
+	Delete func(name string) error
+}
+
+func (c *Controller) eventHandler(resourceName string) cache.ResourceEventHandlerFuncs {
+	add := func(obj interface{}) {
+		key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+		if err != nil {
+			logger.Logger.Errorf("Couldn't get key for object %+v: %v", obj, err)
+			return
+		}
+		c.federationQueue.Add(resourceName + "/" + key)
+	}
+
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: add,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			add(newObj)
+		},
+		DeleteFunc: add,
+	}
+}
+
+func (c *Controller) reconcileFederationKey(key string) error {
+	resourceName, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	var resource *Resource
+	for i := range c.resources {
+		if c.resources[i].Name == resourceName {
+			resource = &c.resources[i]
+			break
+		}
+	}
+	if resource == nil {
+		logger.Logger.Infof("[FEDERATION] %s - skipping because resource %q is not mirrored", key, resourceName)
+		return nil
+	}
+
+	source, err := resource.GetSource(name)
+	if apierrors.IsNotFound(err) {
+		source = nil
+	} else if err != nil {
+		logger.Logger.Infof("[FEDERATION] %s - unable to retrieve object from source store: %v", key, err)
+		return err
+	}
+	if source != nil {
+		sourceMeta, err := meta.Accessor(source)
+		if err != nil {
+			return err
+		}
+		if !c.selector.Matches(labels.Set(sourceMeta.GetLabels())) {
+			source = nil
+		}
+	}
+
+	target, err := resource.GetTarget(name)
+	if apierrors.IsNotFound(err) {
+		target = nil
+	} else if err != nil {
+		logger.Logger.Infof("[FEDERATION] %s - unable to retrieve object from target store: %v", key, err)
+		return err
+	}
+
+	return c.control.Mirror(*resource, name, source, target)
+}
+
+// ControlInterface implements the control logic for mirroring garden resources. It is implemented as an interface to
+// allow for extensions that provide different semantics. Currently, there is only one implementation.
+type ControlInterface interface {
+	// Mirror makes the object with the given name in the target garden equal to the object in the source garden.
+	// <source> and <target> are nil if the object does not exist in the respective garden. Objects which have been
+	// created or modified in the target garden are not changed, a conflict is reported instead.
+	Mirror(resource Resource, name string, source, target runtime.Object) error
+}
+
+// NewDefaultControl returns a new instance of the default implementation ControlInterface that implements the
+// documented semantics for mirroring garden resources. You should use an instance returned from NewDefaultControl()
+// for any scenario other than testing.
+func NewDefaultControl(recorder record.EventRecorder) ControlInterface {
+	return &defaultControl{recorder}
+}
+
+type defaultControl struct {
+	recorder record.EventRecorder
+}
+
+func (c *defaultControl) Mirror(resource Resource, name string, source, target runtime.Object) error {
+	key := resource.Name + "/" + name
+
+	var (
+		targetMeta      metav1.Object
+		mirrored        bool
+		modifiedLocally bool
+		recordedHash    string
+		sourceHash      string
+		err             error
+	)
+
+	if target != nil {
+		if targetMeta, err = meta.Accessor(target); err != nil {
+			return err
+		}
+		recordedHash, mirrored = targetMeta.GetAnnotations()[SourceHashAnnotation]
+		if mirrored {
+			targetHash, err := Hash(target, resource.GroupVersionKind)
+			if err != nil {
+				return err
+			}
+			modifiedLocally = targetHash != recordedHash
+		}
+	}
+
+	if source == nil {
+		if !mirrored {
+			return nil
+		}
+		if modifiedLocally {
+			c.reportConflict(target, key, "it has been removed from the source garden but modified in this garden")
+			return nil
+		}
+
+		if err := resource.Delete(name); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		logger.Logger.Infof("[FEDERATION] %s - deleted because it has been removed from the source garden", key)
+		return nil
+	}
+
+	if sourceHash, err = Hash(source, resource.GroupVersionKind); err != nil {
+		return err
+	}
+
+	if target == nil {
+		obj, err := mirrorObject(source, sourceHash, nil)
+		if err != nil {
+			return err
+		}
+		if err := resource.Create(obj); err != nil {
+			return err
+		}
+		logger.Logger.Infof("[FEDERATION] %s - created", key)
+		return nil
+	}
+
+	switch {
+	case !mirrored:
+		c.reportConflict(target, key, "it has not been created by the Federation controller")
+		return nil
+	case modifiedLocally:
+		c.reportConflict(target, key, "it has been modified in this garden")
+		return nil
+	case recordedHash == sourceHash:
+		return nil
+	}
+
+	obj, err := mirrorObject(source, sourceHash, targetMeta)
+	if err != nil {
+		return err
+	}
+	if err := resource.Update(obj); err != nil {
+		return err
+	}
+	logger.Logger.Infof("[FEDERATION] %s - updated", key)
+	return nil
+}
+
+func (c *defaultControl) reportConflict(target runtime.Object, key, reason string) {
+	message := fmt.Sprintf("Object is not mirrored from the source garden because %s.", reason)
+	logger.Logger.Infof("[FEDERATION] %s - conflict: %s", key, message)
+	c.recorder.Event(target, corev1.EventTypeWarning, EventConflict, message)
+}
+
+// Hash computes the hash of the given object which is compared between the source and the target garden. It is based
+// on the normalized object without status and garden specific metadata (see export.Normalize) and ignores the
+// SourceHashAnnotation.
+func Hash(obj runtime.Object, gvk schema.GroupVersionKind) (string, error) {
+	obj = obj.DeepCopyObject()
+	objMeta, err := meta.Accessor(obj)
+	if err != nil {
+		return "", err
+	}
+	if annotations := objMeta.GetAnnotations(); annotations != nil {
+		delete(annotations, SourceHashAnnotation)
+		objMeta.SetAnnotations(annotations)
+	}
+
+	data, err := export.Normalize(obj, gvk)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// mirrorObject returns a copy of the given <source> object without the metadata which is specific to the source garden
+// and with the SourceHashAnnotation. If <targetMeta> is given then its resource version and finalizers are kept.
+func mirrorObject(source runtime.Object, sourceHash string, targetMeta metav1.Object) (runtime.Object, error) {
+	obj := source.DeepCopyObject()
+	objMeta, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	objMeta.SetUID("")
+	objMeta.SetSelfLink("")
+	objMeta.SetResourceVersion("")
+	objMeta.SetGeneration(0)
+	objMeta.SetCreationTimestamp(metav1.Time{})
+	objMeta.SetDeletionTimestamp(nil)
+	objMeta.SetDeletionGracePeriodSeconds(nil)
+	objMeta.SetFinalizers(nil)
+	objMeta.SetOwnerReferences(nil)
+
+	if targetMeta != nil {
+		objMeta.SetResourceVersion(targetMeta.GetResourceVersion())
+		objMeta.SetFinalizers(targetMeta.GetFinalizers())
+	}
+
+	annotations := objMeta.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[SourceHashAnnotation] = sourceHash
+	objMeta.SetAnnotations(annotations)
+
+	return obj, nil
+}
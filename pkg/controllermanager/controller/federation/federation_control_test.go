@@ -0,0 +1,192 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package federation_test
+
+import (
+	"errors"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/federation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("Federation", func() {
+	var (
+		gvk = gardenv1beta1.SchemeGroupVersion.WithKind("CloudProfile")
+
+		recorder *record.FakeRecorder
+		control  ControlInterface
+		resource Resource
+
+		created *gardenv1beta1.CloudProfile
+		updated *gardenv1beta1.CloudProfile
+		deleted string
+
+		source *gardenv1beta1.CloudProfile
+	)
+
+	BeforeEach(func() {
+		recorder = record.NewFakeRecorder(10)
+		control = NewDefaultControl(recorder)
+
+		created, updated, deleted = nil, nil, ""
+		resource = Resource{
+			Name:             "cloudprofiles",
+			GroupVersionKind: gvk,
+			Create: func(obj runtime.Object) error {
+				created = obj.(*gardenv1beta1.CloudProfile)
+				return nil
+			},
+			Update: func(obj runtime.Object) error {
+				updated = obj.(*gardenv1beta1.CloudProfile)
+				return nil
+			},
+			Delete: func(name string) error {
+				deleted = name
+				return nil
+			},
+		}
+
+		source = &gardenv1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "aws",
+				Labels:          map[string]string{"foo": "bar"},
+				UID:             types.UID("1234"),
+				ResourceVersion: "42",
+				Finalizers:      []string{gardenv1beta1.GardenerName},
+			},
+			Spec: gardenv1beta1.CloudProfileSpec{
+				AWS: &gardenv1beta1.AWSProfile{},
+			},
+		}
+	})
+
+	// mirrored returns the object as it would have been created in the target garden from the given source object.
+	mirrored := func(source *gardenv1beta1.CloudProfile) *gardenv1beta1.CloudProfile {
+		Expect(control.Mirror(resource, source.Name, source, nil)).To(Succeed())
+		Expect(created).NotTo(BeNil())
+
+		target := created.DeepCopy()
+		target.UID = types.UID("5678")
+		target.ResourceVersion = "1"
+		target.Finalizers = []string{gardenv1beta1.GardenerName}
+		created = nil
+		return target
+	}
+
+	Describe("#Mirror", func() {
+		It("should create objects which do not exist in the target garden", func() {
+			Expect(control.Mirror(resource, "aws", source, nil)).To(Succeed())
+
+			hash, err := Hash(source, gvk)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(created).NotTo(BeNil())
+			Expect(created.Annotations).To(HaveKeyWithValue(SourceHashAnnotation, hash))
+			Expect(created.Labels).To(Equal(source.Labels))
+			Expect(created.Spec).To(Equal(source.Spec))
+			Expect(created.UID).To(BeEmpty())
+			Expect(created.ResourceVersion).To(BeEmpty())
+			Expect(created.Finalizers).To(BeEmpty())
+		})
+
+		It("should do nothing if the object has not changed", func() {
+			target := mirrored(source)
+
+			Expect(control.Mirror(resource, "aws", source, target)).To(Succeed())
+			Expect(created).To(BeNil())
+			Expect(updated).To(BeNil())
+		})
+
+		It("should update objects which have changed in the source garden", func() {
+			target := mirrored(source)
+			source.Spec.AWS.Constraints.DNSProviders = []gardenv1beta1.DNSProviderConstraint{{Name: gardenv1beta1.DNSAWSRoute53}}
+
+			Expect(control.Mirror(resource, "aws", source, target)).To(Succeed())
+
+			hash, err := Hash(source, gvk)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(updated).NotTo(BeNil())
+			Expect(updated.Annotations).To(HaveKeyWithValue(SourceHashAnnotation, hash))
+			Expect(updated.Spec).To(Equal(source.Spec))
+			Expect(updated.UID).To(BeEmpty())
+			Expect(updated.ResourceVersion).To(Equal(target.ResourceVersion))
+			Expect(updated.Finalizers).To(Equal(target.Finalizers))
+		})
+
+		It("should report a conflict for objects which have not been created by the controller", func() {
+			target := source.DeepCopy()
+
+			Expect(control.Mirror(resource, "aws", source, target)).To(Succeed())
+			Expect(updated).To(BeNil())
+			Expect(recorder.Events).To(Receive(ContainSubstring(EventConflict)))
+		})
+
+		It("should report a conflict for objects which have been modified in the target garden", func() {
+			target := mirrored(source)
+			target.Labels["foo"] = "baz"
+			source.Labels["foo"] = "qux"
+
+			Expect(control.Mirror(resource, "aws", source, target)).To(Succeed())
+			Expect(updated).To(BeNil())
+			Expect(recorder.Events).To(Receive(ContainSubstring(EventConflict)))
+		})
+
+		It("should delete mirrored objects which have been removed from the source garden", func() {
+			target := mirrored(source)
+
+			Expect(control.Mirror(resource, "aws", nil, target)).To(Succeed())
+			Expect(deleted).To(Equal("aws"))
+		})
+
+		It("should not delete mirrored objects which have been modified in the target garden", func() {
+			target := mirrored(source)
+			target.Labels["foo"] = "baz"
+
+			Expect(control.Mirror(resource, "aws", nil, target)).To(Succeed())
+			Expect(deleted).To(BeEmpty())
+			Expect(recorder.Events).To(Receive(ContainSubstring(EventConflict)))
+		})
+
+		It("should not delete objects which have not been created by the controller", func() {
+			Expect(control.Mirror(resource, "aws", nil, source)).To(Succeed())
+			Expect(deleted).To(BeEmpty())
+			Expect(recorder.Events).NotTo(Receive())
+		})
+
+		It("should ignore objects which have already been deleted", func() {
+			target := mirrored(source)
+			resource.Delete = func(name string) error {
+				return apierrors.NewNotFound(gardenv1beta1.Resource("cloudprofiles"), name)
+			}
+
+			Expect(control.Mirror(resource, "aws", nil, target)).To(Succeed())
+		})
+
+		It("should return the error if the object cannot be created", func() {
+			resource.Create = func(obj runtime.Object) error { return errors.New("fake") }
+
+			Expect(control.Mirror(resource, "aws", source, nil)).NotTo(Succeed())
+		})
+	})
+})
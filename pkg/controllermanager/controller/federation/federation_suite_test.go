@@ -0,0 +1,33 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package federation_test
+
+import (
+	"testing"
+
+	"github.com/gardener/gardener/pkg/logger"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestFederation(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Controller Federation Suite")
+}
+
+var _ = BeforeSuite(func() {
+	logger.Logger = logger.AddWriter(logger.NewLogger(""), GinkgoWriter)
+})
@@ -24,6 +24,7 @@ import (
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
 	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation/common"
@@ -60,16 +61,33 @@ type Controller struct {
 	namespaceQueue  workqueue.RateLimitingInterface
 	namespaceSynced cache.InformerSynced
 
-	shootLister gardenlisters.ShootLister
+	projectQuotaQueue    workqueue.RateLimitingInterface
+	projectStaleQueue    workqueue.RateLimitingInterface
+	projectActivityQueue workqueue.RateLimitingInterface
+	projectMemberQueue   workqueue.RateLimitingInterface
+
+	shootLister        gardenlisters.ShootLister
+	shootSynced        cache.InformerSynced
+	quotaLister        gardenlisters.QuotaLister
+	quotaSynced        cache.InformerSynced
+	cloudProfileLister gardenlisters.CloudProfileLister
+	cloudProfileSynced cache.InformerSynced
+
+	secretLister        kubecorev1listers.SecretLister
+	secretSynced        cache.InformerSynced
+	secretBindingLister gardenlisters.SecretBindingLister
+	secretBindingSynced cache.InformerSynced
+
+	config *config.ProjectControllerConfiguration
 
 	workerCh               chan int
 	numberOfRunningWorkers int
 }
 
 // NewProjectController takes a Kubernetes client for the Garden clusters <k8sGardenClient>, a struct
-// holding information about the acting Gardener, a <projectInformer>, and a <recorder> for
-// event recording. It creates a new Gardener controller.
-func NewProjectController(k8sGardenClient kubernetes.Interface, gardenInformerFactory gardeninformers.SharedInformerFactory, kubeInformerFactory kubeinformers.SharedInformerFactory, recorder record.EventRecorder) *Controller {
+// holding information about the acting Gardener, a <projectInformer>, the controller <config>, and a
+// <recorder> for event recording. It creates a new Gardener controller.
+func NewProjectController(k8sGardenClient kubernetes.Interface, gardenInformerFactory gardeninformers.SharedInformerFactory, kubeInformerFactory kubeinformers.SharedInformerFactory, config *config.ProjectControllerConfiguration, recorder record.EventRecorder) *Controller {
 	var (
 		gardenv1beta1Informer = gardenInformerFactory.Garden().V1beta1()
 		corev1Informer        = kubeInformerFactory.Core().V1()
@@ -80,19 +98,36 @@ func NewProjectController(k8sGardenClient kubernetes.Interface, gardenInformerFa
 		namespaceInformer = corev1Informer.Namespaces()
 		namespaceLister   = namespaceInformer.Lister()
 
+		shootInformer        = gardenv1beta1Informer.Shoots()
+		quotaInformer        = gardenv1beta1Informer.Quotas()
+		cloudProfileInformer = gardenv1beta1Informer.CloudProfiles()
+
+		secretInformer        = corev1Informer.Secrets()
+		secretBindingInformer = gardenv1beta1Informer.SecretBindings()
+
 		projectUpdater = NewRealUpdater(k8sGardenClient, projectLister)
 	)
 
 	projectController := &Controller{
-		k8sGardenClient:    k8sGardenClient,
-		k8sGardenInformers: gardenInformerFactory,
-		control:            NewDefaultControl(k8sGardenClient, gardenInformerFactory, recorder, projectUpdater, namespaceLister),
-		recorder:           recorder,
-		projectLister:      projectLister,
-		projectQueue:       workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "Project"),
-		namespaceLister:    namespaceLister,
-		namespaceQueue:     workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "Namespace"),
-		workerCh:           make(chan int),
+		k8sGardenClient:      k8sGardenClient,
+		k8sGardenInformers:   gardenInformerFactory,
+		control:              NewDefaultControl(k8sGardenClient, gardenInformerFactory, recorder, projectUpdater, namespaceLister),
+		recorder:             recorder,
+		projectLister:        projectLister,
+		projectQueue:         workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "Project"),
+		namespaceLister:      namespaceLister,
+		namespaceQueue:       workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "Namespace"),
+		projectQuotaQueue:    workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "ProjectQuota"),
+		projectStaleQueue:    workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "ProjectStale"),
+		projectActivityQueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "ProjectActivity"),
+		projectMemberQueue:   workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "ProjectMember"),
+		shootLister:          shootInformer.Lister(),
+		quotaLister:          quotaInformer.Lister(),
+		cloudProfileLister:   cloudProfileInformer.Lister(),
+		secretLister:         secretInformer.Lister(),
+		secretBindingLister:  secretBindingInformer.Lister(),
+		config:               config,
+		workerCh:             make(chan int),
 	}
 
 	projectInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -100,8 +135,52 @@ func NewProjectController(k8sGardenClient kubernetes.Interface, gardenInformerFa
 		UpdateFunc: projectController.projectUpdate,
 		DeleteFunc: projectController.projectDelete,
 	})
+	projectInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    projectController.projectQuotaAdd,
+		UpdateFunc: projectController.projectQuotaUpdate,
+	})
+	projectInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    projectController.projectMemberAdd,
+		UpdateFunc: projectController.projectMemberUpdate,
+	})
+
+	shootInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    projectController.shootQuotaAdd,
+		UpdateFunc: projectController.shootQuotaUpdate,
+		DeleteFunc: projectController.shootQuotaAdd,
+	})
+
+	quotaInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: projectController.quotaUpdate,
+	})
+
+	if config.StaleAfter != nil {
+		projectInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    projectController.projectStaleAdd,
+			UpdateFunc: projectController.projectActivityUpdate,
+		})
+
+		activityHandler := cache.ResourceEventHandlerFuncs{
+			UpdateFunc: projectController.namespacedActivityUpdate,
+			DeleteFunc: projectController.namespacedActivity,
+		}
+		secretInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+			FilterFunc: projectController.isActivitySecret,
+			Handler:    activityHandler,
+		})
+		secretBindingInformer.Informer().AddEventHandler(activityHandler)
+		shootInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			DeleteFunc: projectController.namespacedActivity,
+		})
+	}
+
 	projectController.projectSynced = projectInformer.Informer().HasSynced
 	projectController.namespaceSynced = namespaceInformer.Informer().HasSynced
+	projectController.shootSynced = shootInformer.Informer().HasSynced
+	projectController.quotaSynced = quotaInformer.Informer().HasSynced
+	projectController.cloudProfileSynced = cloudProfileInformer.Informer().HasSynced
+	projectController.secretSynced = secretInformer.Informer().HasSynced
+	projectController.secretBindingSynced = secretBindingInformer.Informer().HasSynced
 
 	return projectController
 }
@@ -110,7 +189,7 @@ func NewProjectController(k8sGardenClient kubernetes.Interface, gardenInformerFa
 func (c *Controller) Run(ctx context.Context, workers int) {
 	var waitGroup sync.WaitGroup
 
-	if !cache.WaitForCacheSync(ctx.Done(), c.projectSynced, c.namespaceSynced) {
+	if !cache.WaitForCacheSync(ctx.Done(), c.projectSynced, c.namespaceSynced, c.shootSynced, c.quotaSynced, c.cloudProfileSynced, c.secretSynced, c.secretBindingSynced) {
 		logger.Logger.Error("Timed out waiting for caches to sync")
 		return
 	}
@@ -141,24 +220,38 @@ func (c *Controller) Run(ctx context.Context, workers int) {
 
 	for i := 0; i < workers; i++ {
 		controllerutils.CreateWorker(ctx, c.projectQueue, "Project", c.reconcileProjectKey, &waitGroup, c.workerCh)
+		controllerutils.CreateWorker(ctx, c.projectQuotaQueue, "ProjectQuota", c.reconcileProjectQuotaKey, &waitGroup, c.workerCh)
+		controllerutils.CreateWorker(ctx, c.projectMemberQueue, "ProjectMember", c.reconcileProjectMemberKey, &waitGroup, c.workerCh)
+		if c.config.StaleAfter != nil {
+			controllerutils.CreateWorker(ctx, c.projectStaleQueue, "ProjectStale", c.reconcileProjectStaleKey, &waitGroup, c.workerCh)
+			controllerutils.CreateWorker(ctx, c.projectActivityQueue, "ProjectActivity", c.reconcileProjectActivityKey, &waitGroup, c.workerCh)
+		}
 	}
 
 	// Shutdown handling
 	<-ctx.Done()
 	c.projectQueue.ShutDown()
+	c.projectQuotaQueue.ShutDown()
+	c.projectStaleQueue.ShutDown()
+	c.projectActivityQueue.ShutDown()
+	c.projectMemberQueue.ShutDown()
 
 	for {
-		if c.projectQueue.Len() == 0 && c.numberOfRunningWorkers == 0 {
+		if c.queueLengths() == 0 && c.numberOfRunningWorkers == 0 {
 			logger.Logger.Debug("No running Project worker and no items left in the queues. Terminated Project controller...")
 			break
 		}
-		logger.Logger.Debugf("Waiting for %d Project worker(s) to finish (%d item(s) left in the queues)...", c.numberOfRunningWorkers, c.projectQueue.Len())
+		logger.Logger.Debugf("Waiting for %d Project worker(s) to finish (%d item(s) left in the queues)...", c.numberOfRunningWorkers, c.queueLengths())
 		time.Sleep(5 * time.Second)
 	}
 
 	waitGroup.Wait()
 }
 
+func (c *Controller) queueLengths() int {
+	return c.projectQueue.Len() + c.projectQuotaQueue.Len() + c.projectStaleQueue.Len() + c.projectActivityQueue.Len() + c.projectMemberQueue.Len()
+}
+
 // RunningWorkers returns the number of running workers.
 func (c *Controller) RunningWorkers() int {
 	return c.numberOfRunningWorkers
@@ -194,7 +287,10 @@ func (c *Controller) migrateRoleBindingToProjectMembers() error {
 	for _, roleBinding := range roleBindingList.Items {
 		if projectName, ok := namespaceToProject[roleBinding.Namespace]; ok {
 			if _, err := kutils.TryUpdateProject(c.k8sGardenClient.Garden(), retry.DefaultBackoff, metav1.ObjectMeta{Name: projectName}, func(project *gardenv1beta1.Project) (*gardenv1beta1.Project, error) {
-				project.Spec.Members = roleBinding.Subjects
+				project.Spec.Members = make([]gardenv1beta1.ProjectMember, 0, len(roleBinding.Subjects))
+				for _, subject := range roleBinding.Subjects {
+					project.Spec.Members = append(project.Spec.Members, gardenv1beta1.ProjectMember{Subject: subject})
+				}
 				return project, nil
 			}); err != nil {
 				result = multierror.Append(result, err)
@@ -101,13 +101,14 @@ func (c *defaultControl) reconcile(project *gardenv1beta1.Project, projectLogger
 
 	// Create RBAC rules to allow project owner and project members to read, update, and delete the project.
 	// We also create a RoleBinding in the namespace that binds all members to the garden.sapcloud.io:system:project-member
-	// role to ensure access for listing shoots, creating secrets, etc.
+	// role to ensure access for listing shoots, creating secrets, etc. Expired members which have not been removed yet
+	// are not bound anymore.
 	if err := common.ApplyChart(c.k8sGardenClient, chartRenderer, filepath.Join(common.ChartPath, "garden-project", "charts", "project-rbac"), "project-rbac", namespace.Name, map[string]interface{}{
 		"project": map[string]interface{}{
 			"name":    project.Name,
 			"uid":     project.UID,
 			"owner":   project.Spec.Owner,
-			"members": project.Spec.Members,
+			"members": projectMemberSubjects(project.Spec.Members, time.Now()),
 		},
 	}, nil); err != nil {
 		c.reportEvent(project, true, gardenv1beta1.ProjectEventNamespaceReconcileFailed, "Error while creating RBAC rules for namespace %q: %+v", namespace.Name, err)
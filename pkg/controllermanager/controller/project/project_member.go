@@ -0,0 +1,136 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/logger"
+	kutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+)
+
+func (c *Controller) projectMemberAdd(obj interface{}) {
+	project, ok := obj.(*gardenv1beta1.Project)
+	if !ok {
+		return
+	}
+	for _, member := range project.Spec.Members {
+		if member.ExpirationTimestamp != nil {
+			c.projectMemberQueue.Add(project.Name)
+			return
+		}
+	}
+}
+
+func (c *Controller) projectMemberUpdate(oldObj, newObj interface{}) {
+	oldProject, ok1 := oldObj.(*gardenv1beta1.Project)
+	newProject, ok2 := newObj.(*gardenv1beta1.Project)
+	if !ok1 || !ok2 {
+		return
+	}
+	if apiequality.Semantic.DeepEqual(oldProject.Spec.Members, newProject.Spec.Members) {
+		return
+	}
+	c.projectMemberAdd(newObj)
+}
+
+func (c *Controller) reconcileProjectMemberKey(key string) error {
+	project, err := c.projectLister.Get(key)
+	if apierrors.IsNotFound(err) {
+		logger.Logger.Debugf("[PROJECT MEMBER] %s - skipping because Project has been deleted", key)
+		return nil
+	}
+	if err != nil {
+		logger.Logger.Infof("[PROJECT MEMBER] %s - unable to retrieve object from store: %v", key, err)
+		return err
+	}
+	if project.DeletionTimestamp != nil {
+		return nil
+	}
+
+	var (
+		now                            = time.Now()
+		_, expired, nextExpirationTime = SplitExpiredProjectMembers(project.Spec.Members, now)
+	)
+
+	if len(expired) > 0 {
+		// Removing the members changes the specification of the project, hence, the project is reconciled afterwards
+		// and the RBAC rules of the expired members are removed.
+		if _, err := kutils.TryUpdateProject(c.k8sGardenClient.Garden(), retry.DefaultBackoff, project.ObjectMeta, func(project *gardenv1beta1.Project) (*gardenv1beta1.Project, error) {
+			project.Spec.Members, _, _ = SplitExpiredProjectMembers(project.Spec.Members, now)
+			return project, nil
+		}); err != nil {
+			return err
+		}
+
+		for _, member := range expired {
+			logger.Logger.Infof("[PROJECT MEMBER] %s - removed expired member %s %q", key, member.Kind, member.Name)
+			c.recorder.Eventf(project, corev1.EventTypeNormal, gardenv1beta1.ProjectEventMemberExpired, "Member %s %q expired at %s and has been removed from the project", member.Kind, member.Name, member.ExpirationTimestamp.UTC().Format(time.RFC3339))
+		}
+	}
+
+	if nextExpirationTime != nil {
+		c.projectMemberQueue.AddAfter(key, nextExpirationTime.Sub(now))
+	}
+	return nil
+}
+
+// SplitExpiredProjectMembers splits the given members into the ones which are still active and the ones whose
+// expiration timestamp has passed at <now>. It also returns the earliest expiration time of the active members (nil
+// if none of them expires).
+func SplitExpiredProjectMembers(members []gardenv1beta1.ProjectMember, now time.Time) ([]gardenv1beta1.ProjectMember, []gardenv1beta1.ProjectMember, *time.Time) {
+	var (
+		active, expired    []gardenv1beta1.ProjectMember
+		nextExpirationTime *time.Time
+	)
+
+	for _, member := range members {
+		if member.ExpirationTimestamp == nil {
+			active = append(active, member)
+			continue
+		}
+
+		expirationTime := member.ExpirationTimestamp.Time
+		if !now.Before(expirationTime) {
+			expired = append(expired, member)
+			continue
+		}
+
+		active = append(active, member)
+		if nextExpirationTime == nil || expirationTime.Before(*nextExpirationTime) {
+			nextExpirationTime = &expirationTime
+		}
+	}
+
+	return active, expired, nextExpirationTime
+}
+
+// projectMemberSubjects returns the subjects of the given members which have not expired at <now>.
+func projectMemberSubjects(members []gardenv1beta1.ProjectMember, now time.Time) []rbacv1.Subject {
+	active, _, _ := SplitExpiredProjectMembers(members, now)
+
+	subjects := make([]rbacv1.Subject, 0, len(active))
+	for _, member := range active {
+		subjects = append(subjects, member.Subject)
+	}
+	return subjects
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project_test
+
+import (
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ProjectMember", func() {
+	var now = time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	member := func(name string, expirationTime *time.Time) gardenv1beta1.ProjectMember {
+		m := gardenv1beta1.ProjectMember{
+			Subject: rbacv1.Subject{
+				APIGroup: "rbac.authorization.k8s.io",
+				Kind:     rbacv1.UserKind,
+				Name:     name,
+			},
+		}
+		if expirationTime != nil {
+			m.ExpirationTimestamp = &metav1.Time{Time: *expirationTime}
+		}
+		return m
+	}
+
+	Describe("#SplitExpiredProjectMembers", func() {
+		It("should keep members without expiration timestamp", func() {
+			members := []gardenv1beta1.ProjectMember{member("alice", nil), member("bob", nil)}
+
+			active, expired, nextExpirationTime := SplitExpiredProjectMembers(members, now)
+
+			Expect(active).To(Equal(members))
+			Expect(expired).To(BeEmpty())
+			Expect(nextExpirationTime).To(BeNil())
+		})
+
+		It("should split off the expired members and return the next expiration time", func() {
+			var (
+				expired1 = now.Add(-time.Hour)
+				expired2 = now
+				future1  = now.Add(2 * time.Hour)
+				future2  = now.Add(time.Hour)
+				members  = []gardenv1beta1.ProjectMember{
+					member("alice", nil),
+					member("bob", &expired1),
+					member("carol", &future1),
+					member("dave", &expired2),
+					member("eve", &future2),
+				}
+			)
+
+			active, expired, nextExpirationTime := SplitExpiredProjectMembers(members, now)
+
+			Expect(active).To(Equal([]gardenv1beta1.ProjectMember{members[0], members[2], members[4]}))
+			Expect(expired).To(Equal([]gardenv1beta1.ProjectMember{members[1], members[3]}))
+			Expect(nextExpirationTime).NotTo(BeNil())
+			Expect(*nextExpirationTime).To(Equal(future2))
+		})
+	})
+})
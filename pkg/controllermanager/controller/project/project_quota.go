@@ -0,0 +1,277 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
+	kutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+)
+
+func (c *Controller) projectQuotaAdd(obj interface{}) {
+	project, ok := obj.(*gardenv1beta1.Project)
+	if !ok {
+		return
+	}
+	if len(project.Spec.Quotas) == 0 && project.Status.Quota == nil {
+		return
+	}
+	c.projectQuotaQueue.Add(project.Name)
+}
+
+func (c *Controller) projectQuotaUpdate(oldObj, newObj interface{}) {
+	oldProject, ok1 := oldObj.(*gardenv1beta1.Project)
+	newProject, ok2 := newObj.(*gardenv1beta1.Project)
+	if !ok1 || !ok2 {
+		return
+	}
+	if apiequality.Semantic.DeepEqual(oldProject.Spec.Quotas, newProject.Spec.Quotas) && newProject.Status.Quota != nil {
+		return
+	}
+	c.projectQuotaAdd(newObj)
+}
+
+func (c *Controller) quotaUpdate(oldObj, newObj interface{}) {
+	oldQuota, ok1 := oldObj.(*gardenv1beta1.Quota)
+	newQuota, ok2 := newObj.(*gardenv1beta1.Quota)
+	if !ok1 || !ok2 {
+		return
+	}
+	if apiequality.Semantic.DeepEqual(oldQuota.Spec.Metrics, newQuota.Spec.Metrics) {
+		return
+	}
+
+	projects, err := c.projectLister.List(labels.Everything())
+	if err != nil {
+		logger.Logger.Errorf("Couldn't list projects for quota %s/%s: %v", newQuota.Namespace, newQuota.Name, err)
+		return
+	}
+	for _, project := range projects {
+		for _, quotaRef := range project.Spec.Quotas {
+			if quotaRef.Namespace == newQuota.Namespace && quotaRef.Name == newQuota.Name {
+				c.projectQuotaQueue.Add(project.Name)
+				break
+			}
+		}
+	}
+}
+
+func (c *Controller) shootQuotaAdd(obj interface{}) {
+	shoot, ok := obj.(*gardenv1beta1.Shoot)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		if shoot, ok = tombstone.Obj.(*gardenv1beta1.Shoot); !ok {
+			return
+		}
+	}
+
+	project, err := common.ProjectForNamespace(c.projectLister, shoot.Namespace)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			logger.Logger.Errorf("Couldn't get project for namespace %s: %v", shoot.Namespace, err)
+		}
+		return
+	}
+	c.projectQuotaAdd(project)
+}
+
+func (c *Controller) shootQuotaUpdate(oldObj, newObj interface{}) {
+	oldShoot, ok1 := oldObj.(*gardenv1beta1.Shoot)
+	newShoot, ok2 := newObj.(*gardenv1beta1.Shoot)
+	if !ok1 || !ok2 {
+		return
+	}
+	if oldShoot.Generation == newShoot.Generation {
+		return
+	}
+	c.shootQuotaAdd(newObj)
+}
+
+func (c *Controller) reconcileProjectQuotaKey(key string) error {
+	project, err := c.projectLister.Get(key)
+	if apierrors.IsNotFound(err) {
+		logger.Logger.Debugf("[PROJECT QUOTA] %s - skipping because Project has been deleted", key)
+		return nil
+	}
+	if err != nil {
+		logger.Logger.Infof("[PROJECT QUOTA] %s - unable to retrieve object from store: %v", key, err)
+		return err
+	}
+
+	status, err := c.computeProjectQuotaStatus(project)
+	if err != nil {
+		logger.Logger.Errorf("[PROJECT QUOTA] %s - could not compute the quota usage: %v", key, err)
+		return err
+	}
+
+	_, err = kutils.TryUpdateProjectStatus(c.k8sGardenClient.Garden(), retry.DefaultRetry, project.ObjectMeta, func(project *gardenv1beta1.Project) (*gardenv1beta1.Project, error) {
+		project.Status.Quota = status
+		return project, nil
+	})
+	return err
+}
+
+func (c *Controller) computeProjectQuotaStatus(project *gardenv1beta1.Project) (*gardenv1beta1.ProjectQuotaStatus, error) {
+	if len(project.Spec.Quotas) == 0 || project.Spec.Namespace == nil {
+		return nil, nil
+	}
+
+	var quotas []*gardenv1beta1.Quota
+	for _, quotaRef := range project.Spec.Quotas {
+		quota, err := c.quotaLister.Quotas(quotaRef.Namespace).Get(quotaRef.Name)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		quotas = append(quotas, quota)
+	}
+
+	shoots, err := c.shootLister.Shoots(*project.Spec.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	return ComputeProjectQuotaStatus(quotas, shoots, c.cloudProfileLister)
+}
+
+// ComputeProjectQuotaStatus returns the most restrictive limits of the given quotas and the resources of these metrics
+// which are allocated by the given Shoots. Like the ShootQuotaValidator, the maximum number of workers of the Shoots
+// is taken into account. The storage metrics are not reported.
+func ComputeProjectQuotaStatus(quotas []*gardenv1beta1.Quota, shoots []*gardenv1beta1.Shoot, cloudProfileLister gardenlisters.CloudProfileLister) (*gardenv1beta1.ProjectQuotaStatus, error) {
+	if len(quotas) == 0 {
+		return nil, nil
+	}
+
+	hard := corev1.ResourceList{}
+	for _, quota := range quotas {
+		for metric, limit := range quota.Spec.Metrics {
+			if current, ok := hard[metric]; !ok || limit.Cmp(current) < 0 {
+				hard[metric] = limit
+			}
+		}
+	}
+
+	allocated := corev1.ResourceList{}
+	for _, shoot := range shoots {
+		resources, err := shootResources(shoot, cloudProfileLister)
+		if err != nil {
+			return nil, err
+		}
+		for metric, quantity := range resources {
+			sum := allocated[metric]
+			sum.Add(quantity)
+			allocated[metric] = sum
+		}
+	}
+
+	used := corev1.ResourceList{}
+	for metric := range hard {
+		switch metric {
+		case gardenv1beta1.QuotaMetricStorageStandard, gardenv1beta1.QuotaMetricStoragePremium:
+			continue
+		}
+		if quantity, ok := allocated[metric]; ok {
+			used[metric] = quantity
+		} else {
+			used[metric] = *resource.NewQuantity(0, resource.DecimalSI)
+		}
+	}
+
+	return &gardenv1beta1.ProjectQuotaStatus{
+		Hard: hard,
+		Used: used,
+	}, nil
+}
+
+func shootResources(shoot *gardenv1beta1.Shoot, cloudProfileLister gardenlisters.CloudProfileLister) (corev1.ResourceList, error) {
+	cloudProvider, err := helper.DetermineCloudProviderInShoot(shoot.Spec.Cloud)
+	if err != nil {
+		return nil, err
+	}
+	cloudProfile, err := cloudProfileLister.Get(shoot.Spec.Cloud.Profile)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		countLB      int64 = 1
+		countWorkers int64
+		cpu          resource.Quantity
+		gpu          resource.Quantity
+		memory       resource.Quantity
+		machineTypes = machineTypesOfCloudProfile(cloudProvider, cloudProfile)
+	)
+
+	for _, worker := range helper.GetShootCloudProviderWorkers(cloudProvider, shoot) {
+		countWorkers += int64(worker.AutoScalerMax)
+
+		// Machine types which are not (or no longer) part of the cloud profile cannot be accounted.
+		machineType, ok := machineTypes[worker.MachineType]
+		if !ok {
+			continue
+		}
+		for i := 0; i < worker.AutoScalerMax; i++ {
+			cpu.Add(machineType.CPU)
+			gpu.Add(machineType.GPU)
+			memory.Add(machineType.Memory)
+		}
+	}
+
+	if shoot.Spec.Addons != nil && shoot.Spec.Addons.NginxIngress != nil && shoot.Spec.Addons.NginxIngress.Enabled {
+		countLB++
+	}
+
+	return corev1.ResourceList{
+		gardenv1beta1.QuotaMetricCPU:          cpu,
+		gardenv1beta1.QuotaMetricGPU:          gpu,
+		gardenv1beta1.QuotaMetricMemory:       memory,
+		gardenv1beta1.QuotaMetricLoadbalancer: *resource.NewQuantity(countLB, resource.DecimalSI),
+		gardenv1beta1.QuotaMetricShoots:       *resource.NewQuantity(1, resource.DecimalSI),
+		gardenv1beta1.QuotaMetricWorkers:      *resource.NewQuantity(countWorkers, resource.DecimalSI),
+	}, nil
+}
+
+func machineTypesOfCloudProfile(cloudProvider gardenv1beta1.CloudProvider, cloudProfile *gardenv1beta1.CloudProfile) map[string]gardenv1beta1.MachineType {
+	var machineTypes []gardenv1beta1.MachineType
+	if cloudProvider == gardenv1beta1.CloudProviderAlicloud {
+		for _, machineType := range cloudProfile.Spec.Alicloud.Constraints.MachineTypes {
+			machineTypes = append(machineTypes, machineType.MachineType)
+		}
+	} else {
+		machineTypes = helper.GetMachineTypesFromCloudProfile(cloudProvider, cloudProfile)
+	}
+
+	out := make(map[string]gardenv1beta1.MachineType, len(machineTypes))
+	for _, machineType := range machineTypes {
+		out[machineType.Name] = machineType
+	}
+	return out
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project_test
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+var _ = Describe("ProjectQuota", func() {
+	Describe("#ComputeProjectQuotaStatus", func() {
+		var (
+			cloudProfileLister gardenlisters.CloudProfileLister
+			shoots             []*gardenv1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			Expect(indexer.Add(&gardenv1beta1.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+				Spec: gardenv1beta1.CloudProfileSpec{
+					GCP: &gardenv1beta1.GCPProfile{
+						Constraints: gardenv1beta1.GCPConstraints{
+							MachineTypes: []gardenv1beta1.MachineType{
+								{Name: "n1-standard-2", CPU: resource.MustParse("2"), GPU: resource.MustParse("0"), Memory: resource.MustParse("8Gi")},
+							},
+						},
+					},
+				},
+			})).To(Succeed())
+			cloudProfileLister = gardenlisters.NewCloudProfileLister(indexer)
+
+			shoot := &gardenv1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot-1", Namespace: "garden-dev"},
+				Spec: gardenv1beta1.ShootSpec{
+					Cloud: gardenv1beta1.Cloud{
+						Profile: "profile",
+						GCP: &gardenv1beta1.GCPCloud{
+							Workers: []gardenv1beta1.GCPWorker{
+								{Worker: gardenv1beta1.Worker{Name: "pool-1", MachineType: "n1-standard-2", AutoScalerMin: 1, AutoScalerMax: 2}},
+								{Worker: gardenv1beta1.Worker{Name: "pool-2", MachineType: "n1-standard-2", AutoScalerMin: 1, AutoScalerMax: 1}},
+							},
+						},
+					},
+				},
+			}
+			shoot2 := shoot.DeepCopy()
+			shoot2.Name = "shoot-2"
+			shoots = []*gardenv1beta1.Shoot{shoot, shoot2}
+		})
+
+		It("should not report a status without quotas", func() {
+			status, err := ComputeProjectQuotaStatus(nil, shoots, cloudProfileLister)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status).To(BeNil())
+		})
+
+		It("should report the most restrictive limits and the used resources of their metrics", func() {
+			quotas := []*gardenv1beta1.Quota{
+				{
+					Spec: gardenv1beta1.QuotaSpec{
+						Scope: gardenv1beta1.QuotaScopeProject,
+						Metrics: corev1.ResourceList{
+							gardenv1beta1.QuotaMetricShoots: resource.MustParse("5"),
+							gardenv1beta1.QuotaMetricCPU:    resource.MustParse("100"),
+						},
+					},
+				},
+				{
+					Spec: gardenv1beta1.QuotaSpec{
+						Scope: gardenv1beta1.QuotaScopeProject,
+						Metrics: corev1.ResourceList{
+							gardenv1beta1.QuotaMetricCPU:             resource.MustParse("20"),
+							gardenv1beta1.QuotaMetricWorkers:         resource.MustParse("10"),
+							gardenv1beta1.QuotaMetricStorageStandard: resource.MustParse("100Gi"),
+						},
+					},
+				},
+			}
+
+			status, err := ComputeProjectQuotaStatus(quotas, shoots, cloudProfileLister)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Hard).To(HaveLen(4))
+			Expect(status.Hard.Cpu().String()).To(Equal("20"))
+			Expect(status.Used).To(HaveLen(3))
+			Expect(status.Used.Cpu().String()).To(Equal("12"))
+			Expect(quantityOf(status.Used, gardenv1beta1.QuotaMetricShoots)).To(Equal("2"))
+			Expect(quantityOf(status.Used, gardenv1beta1.QuotaMetricWorkers)).To(Equal("6"))
+		})
+	})
+})
+
+func quantityOf(resources corev1.ResourceList, name corev1.ResourceName) string {
+	quantity := resources[name]
+	return quantity.String()
+}
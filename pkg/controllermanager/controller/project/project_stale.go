@@ -0,0 +1,276 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
+	kutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// projectStaleSyncPeriod is the period after which projects are checked for staleness again.
+	projectStaleSyncPeriod = 12 * time.Hour
+	// projectActivityMinInterval is the minimum interval between two updates of the last activity of a project.
+	projectActivityMinInterval = time.Hour
+)
+
+func (c *Controller) projectStaleAdd(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		logger.Logger.Errorf("Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+	c.projectStaleQueue.Add(key)
+}
+
+func (c *Controller) projectActivityUpdate(oldObj, newObj interface{}) {
+	oldProject, ok1 := oldObj.(*gardenv1beta1.Project)
+	newProject, ok2 := newObj.(*gardenv1beta1.Project)
+	if !ok1 || !ok2 {
+		return
+	}
+	if oldProject.Generation == newProject.Generation {
+		return
+	}
+	c.projectActivityQueue.Add(newProject.Name)
+}
+
+// namespacedActivity records an activity in the project of the namespace of the given object. Only updates and
+// deletions are taken into account, because the informers emit add events for all existing objects on start. The
+// creation of objects is considered by their creation timestamp.
+func (c *Controller) namespacedActivity(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+
+	project, err := common.ProjectForNamespace(c.projectLister, accessor.GetNamespace())
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			logger.Logger.Errorf("Couldn't get project for namespace %s: %v", accessor.GetNamespace(), err)
+		}
+		return
+	}
+	c.projectActivityQueue.Add(project.Name)
+}
+
+func (c *Controller) namespacedActivityUpdate(oldObj, newObj interface{}) {
+	c.namespacedActivity(newObj)
+}
+
+// isActivitySecret returns whether changes of the given secret count as activity, i.e., whether it is referenced by
+// a SecretBinding. Other secrets in the project namespaces are mostly maintained by Gardener itself (e.g., the
+// kubeconfigs of the Shoots).
+func (c *Controller) isActivitySecret(obj interface{}) bool {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		return false
+	}
+
+	secretBindings, err := c.secretBindingLister.List(labels.Everything())
+	if err != nil {
+		logger.Logger.Errorf("Couldn't list secret bindings: %v", err)
+		return false
+	}
+	return IsSecretReferenced(secret, secretBindings)
+}
+
+func (c *Controller) reconcileProjectActivityKey(key string) error {
+	project, err := c.projectLister.Get(key)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	now := metav1.Now()
+	if !NeedsActivityUpdate(project, now.Time, projectActivityMinInterval) {
+		return nil
+	}
+	if _, err := kutils.TryUpdateProjectStatus(c.k8sGardenClient.Garden(), retry.DefaultRetry, project.ObjectMeta, func(project *gardenv1beta1.Project) (*gardenv1beta1.Project, error) {
+		project.Status.LastActivityTimestamp = &now
+		return project, nil
+	}); err != nil {
+		return err
+	}
+
+	// An activity ends the staleness of the project.
+	c.projectStaleQueue.Add(key)
+	return nil
+}
+
+func (c *Controller) reconcileProjectStaleKey(key string) error {
+	project, err := c.projectLister.Get(key)
+	if apierrors.IsNotFound(err) {
+		logger.Logger.Debugf("[PROJECT STALE] %s - skipping because Project has been deleted", key)
+		return nil
+	}
+	if err != nil {
+		logger.Logger.Infof("[PROJECT STALE] %s - unable to retrieve object from store: %v", key, err)
+		return err
+	}
+	if project.DeletionTimestamp != nil || project.Spec.Namespace == nil {
+		return nil
+	}
+	namespace := *project.Spec.Namespace
+
+	shoots, err := c.shootLister.Shoots(namespace).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	secrets, err := c.secretLister.Secrets(namespace).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	allSecretBindings, err := c.secretBindingLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	var (
+		referencedSecrets []*corev1.Secret
+		secretBindings    []*gardenv1beta1.SecretBinding
+	)
+	for _, secret := range secrets {
+		if IsSecretReferenced(secret, allSecretBindings) {
+			referencedSecrets = append(referencedSecrets, secret)
+		}
+	}
+	for _, secretBinding := range allSecretBindings {
+		if secretBinding.Namespace == namespace {
+			secretBindings = append(secretBindings, secretBinding)
+		}
+	}
+
+	var (
+		lastActivity = LastProjectActivity(project, referencedSecrets, secretBindings)
+		now          = time.Now()
+	)
+
+	staleSince, deleteProject := CheckProjectStaleness(project, len(shoots) > 0, lastActivity, now, c.config.StaleAfter.Duration, c.config.StaleDeletionGracePeriod)
+
+	if staleSince != nil && project.Status.StaleSinceTimestamp == nil {
+		message := "Project has had no Shoots and no activity since %s and is marked as stale"
+		if gracePeriod := c.config.StaleDeletionGracePeriod; gracePeriod != nil {
+			message += ", it will be deleted after " + gracePeriod.Duration.String()
+		}
+		c.recorder.Eventf(project, corev1.EventTypeWarning, gardenv1beta1.ProjectEventStale, message, lastActivity.UTC().Format(time.RFC3339))
+	}
+
+	if _, err := kutils.TryUpdateProjectStatus(c.k8sGardenClient.Garden(), retry.DefaultRetry, project.ObjectMeta, func(project *gardenv1beta1.Project) (*gardenv1beta1.Project, error) {
+		project.Status.StaleSinceTimestamp = staleSince
+		return project, nil
+	}); err != nil {
+		return err
+	}
+
+	if deleteProject {
+		logger.Logger.Infof("[PROJECT STALE] %s - deleting the stale project", key)
+		c.recorder.Eventf(project, corev1.EventTypeWarning, gardenv1beta1.ProjectEventStaleDeletion, "Project has been stale since %s and is deleted", staleSince.UTC().Format(time.RFC3339))
+
+		// We have to annotate the Project to confirm the deletion.
+		if _, err := kutils.TryUpdateProject(c.k8sGardenClient.Garden(), retry.DefaultBackoff, project.ObjectMeta, func(project *gardenv1beta1.Project) (*gardenv1beta1.Project, error) {
+			metav1.SetMetaDataAnnotation(&project.ObjectMeta, common.ConfirmationDeletion, "true")
+			return project, nil
+		}); err != nil {
+			return err
+		}
+
+		if err := c.k8sGardenClient.Garden().GardenV1beta1().Projects().Delete(project.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	c.projectStaleQueue.AddAfter(key, projectStaleSyncPeriod)
+	return nil
+}
+
+// LastProjectActivity returns the time of the last activity in the given project. It is the latest of the creation
+// of the project, the last activity recorded in its status and the creation of the given secrets (referenced by
+// SecretBindings) and SecretBindings of its namespace.
+func LastProjectActivity(project *gardenv1beta1.Project, secrets []*corev1.Secret, secretBindings []*gardenv1beta1.SecretBinding) time.Time {
+	lastActivity := project.CreationTimestamp.Time
+	if timestamp := project.Status.LastActivityTimestamp; timestamp != nil && timestamp.Time.After(lastActivity) {
+		lastActivity = timestamp.Time
+	}
+	for _, secret := range secrets {
+		if secret.CreationTimestamp.Time.After(lastActivity) {
+			lastActivity = secret.CreationTimestamp.Time
+		}
+	}
+	for _, secretBinding := range secretBindings {
+		if secretBinding.CreationTimestamp.Time.After(lastActivity) {
+			lastActivity = secretBinding.CreationTimestamp.Time
+		}
+	}
+	return lastActivity
+}
+
+// NeedsActivityUpdate returns whether the last activity recorded in the status of the given project has to be updated.
+// It is only updated if it is older than <minInterval>, so that frequent changes do not cause frequent updates.
+func NeedsActivityUpdate(project *gardenv1beta1.Project, now time.Time, minInterval time.Duration) bool {
+	timestamp := project.Status.LastActivityTimestamp
+	return timestamp == nil || now.Sub(timestamp.Time) >= minInterval
+}
+
+// IsSecretReferenced returns whether the given secret is referenced by one of the given SecretBindings.
+func IsSecretReferenced(secret *corev1.Secret, secretBindings []*gardenv1beta1.SecretBinding) bool {
+	for _, secretBinding := range secretBindings {
+		namespace := secretBinding.SecretRef.Namespace
+		if len(namespace) == 0 {
+			namespace = secretBinding.Namespace
+		}
+		if secretBinding.SecretRef.Name == secret.Name && namespace == secret.Namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckProjectStaleness returns the time since when the given project is stale (nil if it is not stale) and whether
+// it must be deleted. A project is stale if it has no Shoots and its last activity is longer ago than <staleAfter>.
+// Stale projects must be deleted once they have been stale for longer than the optional <deletionGracePeriod>.
+func CheckProjectStaleness(project *gardenv1beta1.Project, hasShoots bool, lastActivity, now time.Time, staleAfter time.Duration, deletionGracePeriod *metav1.Duration) (*metav1.Time, bool) {
+	if hasShoots || now.Sub(lastActivity) < staleAfter {
+		return nil, false
+	}
+
+	staleSince := project.Status.StaleSinceTimestamp
+	if staleSince == nil {
+		staleSince = &metav1.Time{Time: now}
+	}
+
+	return staleSince, deletionGracePeriod != nil && now.Sub(staleSince.Time) >= deletionGracePeriod.Duration
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project_test
+
+import (
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ProjectStale", func() {
+	var (
+		now        = time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+		staleAfter = 30 * 24 * time.Hour
+		project    *gardenv1beta1.Project
+	)
+
+	BeforeEach(func() {
+		project = &gardenv1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "dev",
+				CreationTimestamp: metav1.Time{Time: now.Add(-100 * 24 * time.Hour)},
+			},
+		}
+	})
+
+	Describe("#LastProjectActivity", func() {
+		It("should return the creation of the project if nothing else happened", func() {
+			Expect(LastProjectActivity(project, nil, nil)).To(Equal(project.CreationTimestamp.Time))
+		})
+
+		It("should return the latest of the recorded activity and the creation of secrets and bindings", func() {
+			project.Status.LastActivityTimestamp = &metav1.Time{Time: now.Add(-50 * 24 * time.Hour)}
+			secrets := []*corev1.Secret{
+				{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.Time{Time: now.Add(-40 * 24 * time.Hour)}}},
+			}
+			secretBindings := []*gardenv1beta1.SecretBinding{
+				{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.Time{Time: now.Add(-60 * 24 * time.Hour)}}},
+			}
+
+			Expect(LastProjectActivity(project, secrets, secretBindings)).To(Equal(now.Add(-40 * 24 * time.Hour)))
+		})
+	})
+
+	Describe("#NeedsActivityUpdate", func() {
+		It("should require an update if no activity has been recorded yet", func() {
+			Expect(NeedsActivityUpdate(project, now, time.Hour)).To(BeTrue())
+		})
+
+		It("should not require an update if the recorded activity is recent", func() {
+			project.Status.LastActivityTimestamp = &metav1.Time{Time: now.Add(-time.Minute)}
+
+			Expect(NeedsActivityUpdate(project, now, time.Hour)).To(BeFalse())
+		})
+
+		It("should require an update if the recorded activity is older than the minimum interval", func() {
+			project.Status.LastActivityTimestamp = &metav1.Time{Time: now.Add(-2 * time.Hour)}
+
+			Expect(NeedsActivityUpdate(project, now, time.Hour)).To(BeTrue())
+		})
+	})
+
+	Describe("#IsSecretReferenced", func() {
+		var secret *corev1.Secret
+
+		BeforeEach(func() {
+			secret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "aws", Namespace: "garden-dev"}}
+		})
+
+		It("should return true for secrets referenced by a SecretBinding in the same namespace", func() {
+			secretBindings := []*gardenv1beta1.SecretBinding{
+				{ObjectMeta: metav1.ObjectMeta{Namespace: "garden-dev"}, SecretRef: corev1.SecretReference{Name: "aws"}},
+			}
+
+			Expect(IsSecretReferenced(secret, secretBindings)).To(BeTrue())
+		})
+
+		It("should return true for secrets referenced by a SecretBinding in another namespace", func() {
+			secretBindings := []*gardenv1beta1.SecretBinding{
+				{ObjectMeta: metav1.ObjectMeta{Namespace: "garden-prod"}, SecretRef: corev1.SecretReference{Name: "aws", Namespace: "garden-dev"}},
+			}
+
+			Expect(IsSecretReferenced(secret, secretBindings)).To(BeTrue())
+		})
+
+		It("should return false for secrets which are not referenced", func() {
+			secretBindings := []*gardenv1beta1.SecretBinding{
+				{ObjectMeta: metav1.ObjectMeta{Namespace: "garden-prod"}, SecretRef: corev1.SecretReference{Name: "aws"}},
+				{ObjectMeta: metav1.ObjectMeta{Namespace: "garden-dev"}, SecretRef: corev1.SecretReference{Name: "gcp"}},
+			}
+
+			Expect(IsSecretReferenced(secret, secretBindings)).To(BeFalse())
+		})
+	})
+
+	Describe("#CheckProjectStaleness", func() {
+		It("should not mark projects with Shoots as stale", func() {
+			staleSince, deleteProject := CheckProjectStaleness(project, true, project.CreationTimestamp.Time, now, staleAfter, nil)
+
+			Expect(staleSince).To(BeNil())
+			Expect(deleteProject).To(BeFalse())
+		})
+
+		It("should not mark projects with recent activity as stale", func() {
+			project.Status.StaleSinceTimestamp = &metav1.Time{Time: now.Add(-time.Hour)}
+
+			staleSince, deleteProject := CheckProjectStaleness(project, false, now.Add(-time.Minute), now, staleAfter, nil)
+
+			Expect(staleSince).To(BeNil())
+			Expect(deleteProject).To(BeFalse())
+		})
+
+		It("should mark inactive projects as stale", func() {
+			staleSince, deleteProject := CheckProjectStaleness(project, false, project.CreationTimestamp.Time, now, staleAfter, nil)
+
+			Expect(staleSince).To(Equal(&metav1.Time{Time: now}))
+			Expect(deleteProject).To(BeFalse())
+		})
+
+		It("should delete projects once they have been stale for the grace period", func() {
+			gracePeriod := &metav1.Duration{Duration: 7 * 24 * time.Hour}
+			project.Status.StaleSinceTimestamp = &metav1.Time{Time: now.Add(-6 * 24 * time.Hour)}
+
+			_, deleteProject := CheckProjectStaleness(project, false, project.CreationTimestamp.Time, now, staleAfter, gracePeriod)
+			Expect(deleteProject).To(BeFalse())
+
+			project.Status.StaleSinceTimestamp = &metav1.Time{Time: now.Add(-8 * 24 * time.Hour)}
+
+			staleSince, deleteProject := CheckProjectStaleness(project, false, project.CreationTimestamp.Time, now, staleAfter, gracePeriod)
+			Expect(staleSince).To(Equal(project.Status.StaleSinceTimestamp))
+			Expect(deleteProject).To(BeTrue())
+		})
+	})
+})
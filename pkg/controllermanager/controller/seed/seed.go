@@ -19,6 +19,7 @@ import (
 	"sync"
 	"time"
 
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
 	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
@@ -42,7 +43,8 @@ type Controller struct {
 
 	k8sInformers kubeinformers.SharedInformerFactory
 
-	config *config.ControllerManagerConfiguration
+	config     *config.ControllerManagerConfiguration
+	seedFilter *controllerutils.SeedFilter
 
 	control  ControlInterface
 	recorder record.EventRecorder
@@ -53,6 +55,8 @@ type Controller struct {
 
 	shootLister gardenlisters.ShootLister
 
+	controllerInstallationSynced cache.InformerSynced
+
 	workerCh               chan int
 	numberOfRunningWorkers int
 }
@@ -60,24 +64,30 @@ type Controller struct {
 // NewSeedController takes a Kubernetes client for the Garden clusters <k8sGardenClient>, a struct
 // holding information about the acting Gardener, a <seedInformer>, and a <recorder> for
 // event recording. It creates a new Gardener controller.
-func NewSeedController(k8sGardenClient kubernetes.Interface, gardenInformerFactory gardeninformers.SharedInformerFactory, kubeInformerFactory kubeinformers.SharedInformerFactory, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, config *config.ControllerManagerConfiguration, recorder record.EventRecorder) *Controller {
+func NewSeedController(k8sGardenClient kubernetes.Interface, gardenInformerFactory gardeninformers.SharedInformerFactory, gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory, kubeInformerFactory kubeinformers.SharedInformerFactory, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, config *config.ControllerManagerConfiguration, seedFilter *controllerutils.SeedFilter, recorder record.EventRecorder) *Controller {
 	var (
-		gardenv1beta1Informer = gardenInformerFactory.Garden().V1beta1()
-		corev1Informer        = kubeInformerFactory.Core().V1()
+		gardenv1beta1Informer      = gardenInformerFactory.Garden().V1beta1()
+		gardenCoreV1alpha1Informer = gardenCoreInformerFactory.Core().V1alpha1()
+		corev1Informer             = kubeInformerFactory.Core().V1()
 
 		seedInformer               = gardenv1beta1Informer.Seeds()
 		seedLister                 = seedInformer.Lister()
 		seedUpdater                = NewRealUpdater(k8sGardenClient, seedLister)
 		secretLister               = corev1Informer.Secrets().Lister()
-		shootLister                = gardenv1beta1Informer.Shoots().Lister()
+		shootInformer              = gardenv1beta1Informer.Shoots()
+		shootLister                = shootInformer.Lister()
 		backupInfrastructureLister = gardenv1beta1Informer.BackupInfrastructures().Lister()
+
+		controllerInstallationInformer = gardenCoreV1alpha1Informer.ControllerInstallations()
+		controllerInstallationIndexer  = controllerInstallationInformer.Informer().GetIndexer()
 	)
 
 	seedController := &Controller{
 		k8sGardenClient:    k8sGardenClient,
 		k8sGardenInformers: gardenInformerFactory,
-		control:            NewDefaultControl(k8sGardenClient, gardenInformerFactory, secrets, imageVector, recorder, seedUpdater, config, secretLister, shootLister, backupInfrastructureLister),
+		control:            NewDefaultControl(k8sGardenClient, gardenInformerFactory, secrets, imageVector, recorder, seedUpdater, config, secretLister, shootInformer.Informer().GetIndexer(), backupInfrastructureLister, controllerInstallationIndexer),
 		config:             config,
+		seedFilter:         seedFilter,
 		recorder:           recorder,
 		seedLister:         seedLister,
 		seedQueue:          workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "seed"),
@@ -92,6 +102,13 @@ func NewSeedController(k8sGardenClient kubernetes.Interface, gardenInformerFacto
 	})
 	seedController.seedSynced = seedInformer.Informer().HasSynced
 
+	controllerInstallationInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    seedController.controllerInstallationAdd,
+		UpdateFunc: seedController.controllerInstallationUpdate,
+		DeleteFunc: seedController.controllerInstallationAdd,
+	})
+	seedController.controllerInstallationSynced = controllerInstallationInformer.Informer().HasSynced
+
 	return seedController
 }
 
@@ -99,7 +116,7 @@ func NewSeedController(k8sGardenClient kubernetes.Interface, gardenInformerFacto
 func (c *Controller) Run(ctx context.Context, workers int) {
 	var waitGroup sync.WaitGroup
 
-	if !cache.WaitForCacheSync(ctx.Done(), c.seedSynced) {
+	if !cache.WaitForCacheSync(ctx.Done(), c.seedSynced, c.controllerInstallationSynced) {
 		logger.Logger.Error("Timed out waiting for caches to sync")
 		return
 	}
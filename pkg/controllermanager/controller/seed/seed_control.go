@@ -18,18 +18,24 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorehelper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
 	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/controllerinstallation"
 	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
 	seedpkg "github.com/gardener/gardener/pkg/operation/seed"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -37,6 +43,7 @@ import (
 	kubecorev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
 )
 
 func (c *Controller) seedAdd(obj interface{}) {
@@ -87,6 +94,11 @@ func (c *Controller) reconcileSeedKey(key string) error {
 		return err
 	}
 
+	if !c.seedFilter.SeedMatches(seed) {
+		logger.Logger.Debugf("[SEED RECONCILE] %s - skipping because Seed is not selected by the seed selector", key)
+		return nil
+	}
+
 	if err := c.control.ReconcileSeed(seed, key); err != nil {
 		c.seedQueue.AddAfter(key, 15*time.Second)
 	} else {
@@ -109,21 +121,22 @@ type ControlInterface interface {
 // implements the documented semantics for Seeds. updater is the UpdaterInterface used
 // to update the status of Seeds. You should use an instance returned from NewDefaultControl() for any
 // scenario other than testing.
-func NewDefaultControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, recorder record.EventRecorder, updater UpdaterInterface, config *config.ControllerManagerConfiguration, secretLister kubecorev1listers.SecretLister, shootLister gardenlisters.ShootLister, backupInfrastructureLister gardenlisters.BackupInfrastructureLister) ControlInterface {
-	return &defaultControl{k8sGardenClient, k8sGardenInformers, secrets, imageVector, recorder, updater, config, secretLister, shootLister, backupInfrastructureLister}
+func NewDefaultControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, recorder record.EventRecorder, updater UpdaterInterface, config *config.ControllerManagerConfiguration, secretLister kubecorev1listers.SecretLister, shootIndexer cache.Indexer, backupInfrastructureLister gardenlisters.BackupInfrastructureLister, controllerInstallationIndexer cache.Indexer) ControlInterface {
+	return &defaultControl{k8sGardenClient, k8sGardenInformers, secrets, imageVector, recorder, updater, config, secretLister, shootIndexer, backupInfrastructureLister, controllerInstallationIndexer}
 }
 
 type defaultControl struct {
-	k8sGardenClient            kubernetes.Interface
-	k8sGardenInformers         gardeninformers.SharedInformerFactory
-	secrets                    map[string]*corev1.Secret
-	imageVector                imagevector.ImageVector
-	recorder                   record.EventRecorder
-	updater                    UpdaterInterface
-	config                     *config.ControllerManagerConfiguration
-	secretLister               kubecorev1listers.SecretLister
-	shootLister                gardenlisters.ShootLister
-	backupInfrastructureLister gardenlisters.BackupInfrastructureLister
+	k8sGardenClient               kubernetes.Interface
+	k8sGardenInformers            gardeninformers.SharedInformerFactory
+	secrets                       map[string]*corev1.Secret
+	imageVector                   imagevector.ImageVector
+	recorder                      record.EventRecorder
+	updater                       UpdaterInterface
+	config                        *config.ControllerManagerConfiguration
+	secretLister                  kubecorev1listers.SecretLister
+	shootIndexer                  cache.Indexer
+	backupInfrastructureLister    gardenlisters.BackupInfrastructureLister
+	controllerInstallationIndexer cache.Indexer
 }
 
 func (c *defaultControl) ReconcileSeed(obj *gardenv1beta1.Seed, key string) error {
@@ -146,7 +159,7 @@ func (c *defaultControl) ReconcileSeed(obj *gardenv1beta1.Seed, key string) erro
 			return nil
 		}
 
-		associatedShoots, err := controllerutils.DetermineShootAssociations(seed, c.shootLister)
+		associatedShoots, err := controllerutils.DetermineShootAssociationsByIndex(seed.Name, c.shootIndexer)
 		if err != nil {
 			seedLogger.Error(err.Error())
 			return err
@@ -229,8 +242,27 @@ func (c *defaultControl) ReconcileSeed(obj *gardenv1beta1.Seed, key string) erro
 		return err
 	}
 
+	// Rebuild the state of the Shoots from their namespaces in the Seed cluster if requested, e.g. after the state
+	// has been lost. The annotation is removed once the re-adoption has succeeded.
+	if seed.Annotations[common.SeedOperation] == common.SeedOperationReadopt {
+		if err := c.readoptShootNamespaces(seed, seedObj.Secret, seedLogger); err != nil {
+			c.recorder.Eventf(seed, corev1.EventTypeWarning, gardenv1beta1.SeedEventShootNamespacesReadoptionError, "Could not re-adopt the shoot namespaces: %v", err)
+			seedLogger.Error(err.Error())
+			return err
+		}
+
+		seed, err = kutil.TryUpdateSeed(c.k8sGardenClient.Garden(), retry.DefaultRetry, seed.ObjectMeta, func(seed *gardenv1beta1.Seed) (*gardenv1beta1.Seed, error) {
+			delete(seed.Annotations, common.SeedOperation)
+			return seed, nil
+		})
+		if err != nil {
+			seedLogger.Error(err.Error())
+			return err
+		}
+	}
+
 	// Fetching associated shoots for the current seed
-	associatedShoots, err := controllerutils.DetermineShootAssociations(seed, c.shootLister)
+	associatedShoots, err := controllerutils.DetermineShootAssociationsByIndex(seed.Name, c.shootIndexer)
 	if err != nil {
 		seedLogger.Error(err.Error())
 		return err
@@ -262,16 +294,70 @@ func (c *defaultControl) ReconcileSeed(obj *gardenv1beta1.Seed, key string) erro
 }
 
 func (c *defaultControl) updateSeedStatus(seed *gardenv1beta1.Seed, conditions ...gardenv1beta1.Condition) error {
-	if !helper.ConditionsNeedUpdate(seed.Status.Conditions, conditions) {
+	extensions, err := c.computeSeedExtensions(seed)
+	if err != nil {
+		logger.Logger.Errorf("Could not compute the extensions of the Seed: %+v", err)
+		extensions = seed.Status.Extensions
+	}
+
+	utilization, err := c.computeSeedUtilization(seed)
+	if err != nil {
+		logger.Logger.Errorf("Could not compute the utilization of the Seed: %+v", err)
+		utilization = seed.Status.Utilization
+	}
+
+	if !helper.ConditionsNeedUpdate(seed.Status.Conditions, conditions) &&
+		apiequality.Semantic.DeepEqual(seed.Status.Extensions, extensions) &&
+		apiequality.Semantic.DeepEqual(seed.Status.Utilization, utilization) {
 		return nil
 	}
 
 	seed.Status.Conditions = conditions
+	seed.Status.Extensions = extensions
+	seed.Status.Utilization = utilization
 
-	_, err := c.updater.UpdateSeedStatus(seed)
+	_, err = c.updater.UpdateSeedStatus(seed)
 	if err != nil {
 		logger.Logger.Errorf("Could not update the Seed status: %+v", err)
 	}
 
 	return err
 }
+
+// computeSeedExtensions computes the names, versions, and health of the extensions installed on the given Seed
+// based on the ControllerInstallations referencing it.
+func (c *defaultControl) computeSeedExtensions(seed *gardenv1beta1.Seed) ([]gardenv1beta1.SeedExtension, error) {
+	controllerInstallationList, err := controllerutils.ControllerInstallationsByIndex(c.controllerInstallationIndexer, controllerutils.ControllerInstallationSeedRefName, seed.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var extensions []gardenv1beta1.SeedExtension
+	for _, controllerInstallation := range controllerInstallationList {
+		extension := gardenv1beta1.SeedExtension{
+			Name:    controllerInstallation.Spec.RegistrationRef.Name,
+			Healthy: isConditionTrue(controllerInstallation.Status.Conditions, gardencorev1alpha1.ControllerInstallationValid) && isConditionTrue(controllerInstallation.Status.Conditions, gardencorev1alpha1.ControllerInstallationInstalled),
+		}
+
+		if deployedResources, err := controllerinstallation.ReadDeployedResources(controllerInstallation); err == nil && deployedResources != nil {
+			extension.Version = deployedResources.ChartVersion
+		}
+
+		for _, condition := range controllerInstallation.Status.Conditions {
+			if extension.LastHeartbeatTime == nil || extension.LastHeartbeatTime.Before(&condition.LastUpdateTime) {
+				lastUpdateTime := condition.LastUpdateTime
+				extension.LastHeartbeatTime = &lastUpdateTime
+			}
+		}
+
+		extensions = append(extensions, extension)
+	}
+
+	sort.Slice(extensions, func(i, j int) bool { return extensions[i].Name < extensions[j].Name })
+	return extensions, nil
+}
+
+func isConditionTrue(conditions []gardencorev1alpha1.Condition, conditionType gardencorev1alpha1.ConditionType) bool {
+	condition := gardencorehelper.GetCondition(conditions, conditionType)
+	return condition != nil && condition.Status == corev1.ConditionTrue
+}
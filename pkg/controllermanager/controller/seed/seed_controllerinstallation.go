@@ -0,0 +1,49 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package seed
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/tools/cache"
+)
+
+// controllerInstallationAdd enqueues the Seed of the given ControllerInstallation so that the extensions reported in
+// its status are kept up to date.
+func (c *Controller) controllerInstallationAdd(obj interface{}) {
+	controllerInstallation, ok := obj.(*gardencorev1alpha1.ControllerInstallation)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		if controllerInstallation, ok = tombstone.Obj.(*gardencorev1alpha1.ControllerInstallation); !ok {
+			return
+		}
+	}
+	c.seedQueue.Add(controllerInstallation.Spec.SeedRef.Name)
+}
+
+func (c *Controller) controllerInstallationUpdate(oldObj, newObj interface{}) {
+	oldControllerInstallation, ok1 := oldObj.(*gardencorev1alpha1.ControllerInstallation)
+	newControllerInstallation, ok2 := newObj.(*gardencorev1alpha1.ControllerInstallation)
+	if !ok1 || !ok2 {
+		return
+	}
+	if apiequality.Semantic.DeepEqual(oldControllerInstallation.Spec, newControllerInstallation.Spec) && apiequality.Semantic.DeepEqual(oldControllerInstallation.Status, newControllerInstallation.Status) {
+		return
+	}
+	c.controllerInstallationAdd(newObj)
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
+	"github.com/gardener/gardener/pkg/operation/common"
+	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ShootNamespaceMatch is a Shoot together with its namespace in the Seed cluster.
+type ShootNamespaceMatch struct {
+	Shoot     *gardenv1beta1.Shoot
+	Namespace *corev1.Namespace
+}
+
+// MatchShootNamespaces assigns the given shoot namespaces of a Seed cluster to the given Shoots. A namespace belongs to a
+// Shoot if its UID annotation carries the (recorded) UID of the Shoot, or, if the Shoot has been re-created, if its name
+// is the technical id of the Shoot. It returns the matches and the names of the namespaces that do not belong to any Shoot.
+func MatchShootNamespaces(namespaces []corev1.Namespace, shoots []*gardenv1beta1.Shoot, projectLister gardenlisters.ProjectLister) ([]ShootNamespaceMatch, []string, error) {
+	var (
+		byUID  = make(map[types.UID]*corev1.Namespace, len(namespaces))
+		byName = make(map[string]*corev1.Namespace, len(namespaces))
+	)
+	for i := range namespaces {
+		namespace := &namespaces[i]
+		if uid := namespace.Annotations[common.ShootUID]; len(uid) > 0 {
+			byUID[types.UID(uid)] = namespace
+		}
+		byName[namespace.Name] = namespace
+	}
+
+	var (
+		matches []ShootNamespaceMatch
+		adopted = sets.NewString()
+	)
+
+	for _, shoot := range shoots {
+		namespace, ok := byUID[shoot.Status.UID]
+		if !ok {
+			namespace, ok = byUID[shoot.UID]
+		}
+		if !ok {
+			project, err := common.ProjectForNamespace(projectLister, shoot.Namespace)
+			if err != nil {
+				return nil, nil, err
+			}
+			namespace, ok = byName[shootpkg.ComputeTechnicalID(project.Name, shoot)]
+		}
+		if !ok || adopted.Has(namespace.Name) {
+			continue
+		}
+
+		adopted.Insert(namespace.Name)
+		matches = append(matches, ShootNamespaceMatch{Shoot: shoot, Namespace: namespace})
+	}
+
+	var orphaned []string
+	for _, namespace := range namespaces {
+		if !adopted.Has(namespace.Name) {
+			orphaned = append(orphaned, namespace.Name)
+		}
+	}
+	sort.Strings(orphaned)
+
+	return matches, orphaned, nil
+}
+
+// readoptShootNamespaces rebuilds the state of the Shoots referencing the given Seed from their namespaces in the Seed
+// cluster. It restores the recorded UIDs and technical ids of the Shoots as well as their finalizers, so that subsequent
+// reconciliations and deletions operate on the existing namespaces instead of creating new ones or orphaning them.
+// Namespaces that do not belong to any Shoot are only reported, they are never deleted.
+func (c *defaultControl) readoptShootNamespaces(seed *gardenv1beta1.Seed, seedSecret *corev1.Secret, seedLogger logrus.FieldLogger) error {
+	k8sSeedClient, err := kubernetes.NewClientFromSecretObject(seedSecret, client.Options{
+		Scheme: kubernetes.SeedScheme,
+	})
+	if err != nil {
+		return err
+	}
+
+	namespaceList, err := k8sSeedClient.ListNamespaces(metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", common.GardenRole, common.GardenRoleShoot),
+	})
+	if err != nil {
+		return err
+	}
+
+	shoots, err := controllerutils.ShootsByIndex(c.shootIndexer, controllerutils.ShootSeedName, seed.Name)
+	if err != nil {
+		return err
+	}
+
+	matches, orphaned, err := MatchShootNamespaces(namespaceList.Items, shoots, c.k8sGardenInformers.Garden().V1beta1().Projects().Lister())
+	if err != nil {
+		return err
+	}
+
+	var adopted []string
+	for _, match := range matches {
+		shoot, namespace := match.Shoot, match.Namespace
+		key := fmt.Sprintf("%s/%s", shoot.Namespace, shoot.Name)
+
+		if len(shoot.Status.TechnicalID) > 0 && shoot.Status.TechnicalID != namespace.Name {
+			seedLogger.Warnf("Not re-adopting namespace %s because Shoot %s already refers to namespace %s", namespace.Name, key, shoot.Status.TechnicalID)
+			continue
+		}
+
+		uid := types.UID(namespace.Annotations[common.ShootUID])
+		if len(uid) == 0 {
+			uid = shoot.UID
+		}
+
+		if len(shoot.Status.UID) == 0 || len(shoot.Status.TechnicalID) == 0 {
+			if _, err := kutil.TryUpdateShootStatus(c.k8sGardenClient.Garden(), retry.DefaultRetry, shoot.ObjectMeta, func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
+				if len(shoot.Status.UID) == 0 {
+					shoot.Status.UID = uid
+				}
+				if len(shoot.Status.TechnicalID) == 0 {
+					shoot.Status.TechnicalID = namespace.Name
+				}
+				return shoot, nil
+			}); err != nil {
+				return err
+			}
+		}
+
+		if shoot.DeletionTimestamp == nil && !sets.NewString(shoot.Finalizers...).Has(gardenv1beta1.GardenerName) {
+			if _, err := kutil.TryUpdateShoot(c.k8sGardenClient.Garden(), retry.DefaultRetry, shoot.ObjectMeta, func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
+				finalizers := sets.NewString(shoot.Finalizers...)
+				finalizers.Insert(gardenv1beta1.GardenerName)
+				shoot.Finalizers = finalizers.UnsortedList()
+				return shoot, nil
+			}); err != nil {
+				return err
+			}
+		}
+
+		adopted = append(adopted, fmt.Sprintf("%s (%s)", key, namespace.Name))
+	}
+
+	seedLogger.Infof("Re-adopted the namespaces of %d Shoots: %v", len(adopted), adopted)
+	c.recorder.Eventf(seed, corev1.EventTypeNormal, gardenv1beta1.SeedEventShootNamespacesReadopted, "Re-adopted the namespaces of %d Shoots", len(adopted))
+
+	if len(orphaned) > 0 {
+		seedLogger.Warnf("The following shoot namespaces do not belong to any Shoot: %v", orphaned)
+		c.recorder.Eventf(seed, corev1.EventTypeWarning, gardenv1beta1.SeedEventOrphanedShootNamespaces, "The following shoot namespaces do not belong to any Shoot: %s", strings.Join(orphaned, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed_test
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seed"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Re-adoption", func() {
+	Describe("#MatchShootNamespaces", func() {
+		var (
+			projectNamespace = "garden-dev"
+			project          = &gardenv1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+				Spec:       gardenv1beta1.ProjectSpec{Namespace: &projectNamespace},
+			}
+
+			gardenInformerFactory gardeninformers.SharedInformerFactory
+		)
+
+		newNamespace := func(name, uid string) corev1.Namespace {
+			namespace := corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+			if len(uid) > 0 {
+				namespace.Annotations = map[string]string{common.ShootUID: uid}
+			}
+			return namespace
+		}
+
+		newShoot := func(name, uid string, status gardenv1beta1.ShootStatus) *gardenv1beta1.Shoot {
+			return &gardenv1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Namespace: projectNamespace, Name: name, UID: types.UID("new-" + uid)},
+				Status:     status,
+			}
+		}
+
+		BeforeEach(func() {
+			gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
+			Expect(gardenInformerFactory.Garden().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+		})
+
+		It("should match the namespaces by the recorded UID, the UID, and the technical id of the Shoots", func() {
+			var (
+				namespaces = []corev1.Namespace{
+					newNamespace("shoot--dev--recorded", "recorded"),
+					newNamespace("shoot--dev--lost", "new-lost"),
+					newNamespace("shoot--dev--recreated", "old"),
+					newNamespace("shoot--dev--orphan", "orphan"),
+				}
+				recorded  = newShoot("recorded", "recorded", gardenv1beta1.ShootStatus{UID: "recorded", TechnicalID: "shoot--dev--recorded"})
+				lost      = newShoot("lost", "lost", gardenv1beta1.ShootStatus{})
+				recreated = newShoot("recreated", "recreated", gardenv1beta1.ShootStatus{})
+				unknown   = newShoot("unknown", "unknown", gardenv1beta1.ShootStatus{})
+			)
+
+			matches, orphaned, err := MatchShootNamespaces(namespaces, []*gardenv1beta1.Shoot{recorded, lost, recreated, unknown}, gardenInformerFactory.Garden().V1beta1().Projects().Lister())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(matches).To(ConsistOf(
+				ShootNamespaceMatch{Shoot: recorded, Namespace: &namespaces[0]},
+				ShootNamespaceMatch{Shoot: lost, Namespace: &namespaces[1]},
+				ShootNamespaceMatch{Shoot: recreated, Namespace: &namespaces[2]},
+			))
+			Expect(orphaned).To(Equal([]string{"shoot--dev--orphan"}))
+		})
+
+		It("should assign a namespace to one Shoot only", func() {
+			var (
+				namespaces = []corev1.Namespace{newNamespace("shoot--dev--foo", "foo")}
+				first      = newShoot("first", "first", gardenv1beta1.ShootStatus{UID: "foo"})
+				second     = newShoot("second", "second", gardenv1beta1.ShootStatus{UID: "foo"})
+			)
+
+			matches, orphaned, err := MatchShootNamespaces(namespaces, []*gardenv1beta1.Shoot{first, second}, gardenInformerFactory.Garden().V1beta1().Projects().Lister())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(matches).To(Equal([]ShootNamespaceMatch{{Shoot: first, Namespace: &namespaces[0]}}))
+			Expect(orphaned).To(BeEmpty())
+		})
+
+		It("should fail if the project of a Shoot cannot be determined", func() {
+			shoot := newShoot("foo", "foo", gardenv1beta1.ShootStatus{})
+			shoot.Namespace = "garden-unknown"
+
+			_, _, err := MatchShootNamespaces(nil, []*gardenv1beta1.Shoot{shoot}, gardenInformerFactory.Garden().V1beta1().Projects().Lister())
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
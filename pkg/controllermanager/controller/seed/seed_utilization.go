@@ -0,0 +1,132 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed
+
+import (
+	"context"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
+	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/fields"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// utilizationResources are the resources which are summarized in the utilization of a Seed.
+var utilizationResources = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory, corev1.ResourcePods}
+
+// computeSeedUtilization summarizes the Shoots scheduled to the given Seed and the allocatable and requested
+// resources of the Seed cluster.
+func (c *defaultControl) computeSeedUtilization(seed *gardenv1beta1.Seed) (*gardenv1beta1.SeedUtilization, error) {
+	shoots, err := controllerutils.ShootsByIndex(c.shootIndexer, controllerutils.ShootSeedName, seed.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	utilization := &gardenv1beta1.SeedUtilization{
+		Shoots:          len(shoots),
+		ShootsByPurpose: make(map[string]int),
+	}
+	for _, shoot := range shoots {
+		utilization.ShootsByPurpose[shootpkg.PurposeOf(shoot)]++
+	}
+
+	secret, err := c.secretLister.Secrets(seed.Spec.SecretRef.Namespace).Get(seed.Spec.SecretRef.Name)
+	if err != nil {
+		return nil, err
+	}
+	k8sSeedClient, err := kubernetes.NewClientFromSecretObject(secret, client.Options{
+		Scheme: kubernetes.SeedScheme,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.TODO()
+
+	allocatable := make(corev1.ResourceList)
+	nodeList := &corev1.NodeList{}
+	if err := kutil.ListPaged(ctx, k8sSeedClient.Client(), nil, nodeList, kutil.DefaultListPageSize, func() error {
+		for _, node := range nodeList.Items {
+			addResources(allocatable, node.Status.Allocatable)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	requested := make(corev1.ResourceList)
+	podList := &corev1.PodList{}
+	podListOptions := &client.ListOptions{
+		FieldSelector: fields.AndSelectors(
+			fields.OneTermNotEqualSelector("status.phase", string(corev1.PodSucceeded)),
+			fields.OneTermNotEqualSelector("status.phase", string(corev1.PodFailed)),
+		),
+	}
+	if err := kutil.ListPaged(ctx, k8sSeedClient.Client(), podListOptions, podList, kutil.DefaultListPageSize, func() error {
+		for _, pod := range podList.Items {
+			addResources(requested, podRequests(&pod))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	utilization.Allocatable = allocatable
+	utilization.Requested = requested
+	return utilization, nil
+}
+
+// podRequests returns the resources requested by the given pod, i.e., the sum of the requests of its containers or
+// the requests of its largest init container, whichever is higher. A pod always requests one pod.
+func podRequests(pod *corev1.Pod) corev1.ResourceList {
+	requests := corev1.ResourceList{
+		corev1.ResourcePods: *resource.NewQuantity(1, resource.DecimalSI),
+	}
+
+	for _, container := range pod.Spec.Containers {
+		addResources(requests, container.Resources.Requests)
+	}
+
+	for _, container := range pod.Spec.InitContainers {
+		for _, name := range utilizationResources {
+			if quantity, ok := container.Resources.Requests[name]; ok {
+				if current, ok := requests[name]; !ok || quantity.Cmp(current) > 0 {
+					requests[name] = quantity.DeepCopy()
+				}
+			}
+		}
+	}
+
+	return requests
+}
+
+// addResources adds the quantities of the utilization resources in <resources> to <sum>.
+func addResources(sum, resources corev1.ResourceList) {
+	for _, name := range utilizationResources {
+		quantity, ok := resources[name]
+		if !ok {
+			continue
+		}
+		current := sum[name]
+		current.Add(quantity)
+		sum[name] = current
+	}
+}
@@ -24,13 +24,12 @@ import (
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions/core/v1alpha1"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation/common"
 
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 )
@@ -139,16 +138,13 @@ func (c *defaultControllerInstallationControl) Reconcile(controllerInstallationO
 		resources[resource.Kind] = resource.Type
 	}
 
-	shootList, err := c.k8sGardenInformers.Shoots().Lister().Shoots(metav1.NamespaceAll).List(labels.Everything())
+	shootList, err := controllerutils.ShootsByIndex(c.k8sGardenInformers.Shoots().Informer().GetIndexer(), controllerutils.ShootSeedName, controllerInstallation.Spec.SeedRef.Name)
 	if err != nil {
 		return nil, err
 	}
 
 	var shootsRequiringEnqueueing []*gardenv1beta1.Shoot
 	for _, shoot := range shootList {
-		if seed := shoot.Spec.Cloud.Seed; seed == nil || *seed != controllerInstallation.Spec.SeedRef.Name {
-			continue
-		}
 		if !c.isDependentOnResource(resources, shoot) {
 			continue
 		}
@@ -31,6 +31,7 @@ import (
 	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	gardenmetrics "github.com/gardener/gardener/pkg/controllermanager/metrics"
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/reconcilescheduler"
@@ -38,6 +39,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/robfig/cron"
+	"github.com/sirupsen/logrus"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -57,6 +59,7 @@ type Controller struct {
 	k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory
 
 	config                        *config.ControllerManagerConfiguration
+	seedFilter                    *controllerutils.SeedFilter
 	control                       ControlInterface
 	careControl                   CareControlInterface
 	maintenanceControl            MaintenanceControlInterface
@@ -67,6 +70,9 @@ type Controller struct {
 	imageVector                   imagevector.ImageVector
 	scheduler                     reconcilescheduler.Interface
 	shootToHibernationCron        map[string]*cron.Cron
+	remediationRateLimiter        *RemediationRateLimiter
+	careLogger                    *logrus.Logger
+	maintenanceLogger             *logrus.Logger
 
 	seedLister                   gardenlisters.SeedLister
 	shootLister                  gardenlisters.ShootLister
@@ -85,6 +91,8 @@ type Controller struct {
 	shootHibernationQueue       workqueue.RateLimitingInterface
 	controllerInstallationQueue workqueue.RateLimitingInterface
 
+	shootHibernationStatisticsQueue workqueue.RateLimitingInterface
+
 	shootSynced                  cache.InformerSynced
 	seedSynced                   cache.InformerSynced
 	cloudProfileSynced           cache.InformerSynced
@@ -102,7 +110,7 @@ type Controller struct {
 // NewShootController takes a Kubernetes client for the Garden clusters <k8sGardenClient>, a struct
 // holding information about the acting Gardener, a <shootInformer>, and a <recorder> for
 // event recording. It creates a new Gardener controller.
-func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory, kubeInformerFactory kubeinformers.SharedInformerFactory, config *config.ControllerManagerConfiguration, identity *gardenv1beta1.Gardener, gardenNamespace string, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, recorder record.EventRecorder) *Controller {
+func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory, kubeInformerFactory kubeinformers.SharedInformerFactory, config *config.ControllerManagerConfiguration, seedFilter *controllerutils.SeedFilter, identity *gardenv1beta1.Gardener, gardenNamespace string, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, recorder record.EventRecorder) *Controller {
 	var (
 		gardenV1beta1Informer      = k8sGardenInformers.Garden().V1beta1()
 		gardenCoreV1alpha1Informer = k8sGardenCoreInformers.Core().V1alpha1()
@@ -125,6 +133,11 @@ func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenInformers
 
 		controllerInstallationInformer = gardenCoreV1alpha1Informer.ControllerInstallations()
 		controllerInstallationLister   = controllerInstallationInformer.Lister()
+
+		careLogger        = logger.NewControllerLogger("shoot-care")
+		maintenanceLogger = logger.NewControllerLogger("shoot-maintenance")
+
+		remediationRateLimiter = NewRemediationRateLimiter()
 	)
 
 	shootController := &Controller{
@@ -133,16 +146,20 @@ func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenInformers
 		k8sGardenCoreInformers: k8sGardenCoreInformers,
 
 		config:                        config,
+		seedFilter:                    seedFilter,
 		control:                       NewDefaultControl(k8sGardenClient, gardenV1beta1Informer, secrets, imageVector, identity, config, gardenNamespace, recorder),
-		careControl:                   NewDefaultCareControl(k8sGardenClient, gardenV1beta1Informer, secrets, imageVector, identity, config),
-		maintenanceControl:            NewDefaultMaintenanceControl(k8sGardenClient, gardenV1beta1Informer, secrets, imageVector, identity, recorder),
-		quotaControl:                  NewDefaultQuotaControl(k8sGardenClient, gardenV1beta1Informer),
+		careControl:                   NewDefaultCareControl(k8sGardenClient, gardenV1beta1Informer, secrets, imageVector, identity, config, recorder, remediationRateLimiter, careLogger),
+		maintenanceControl:            NewDefaultMaintenanceControl(k8sGardenClient, gardenV1beta1Informer, secrets, imageVector, identity, recorder, maintenanceLogger),
+		quotaControl:                  NewDefaultQuotaControl(k8sGardenClient, gardenV1beta1Informer, recorder),
 		controllerInstallationControl: NewDefaultControllerInstallationControl(k8sGardenClient, gardenV1beta1Informer, gardenCoreV1alpha1Informer, recorder),
 		recorder:                      recorder,
 		secrets:                       secrets,
 		imageVector:                   imageVector,
 		scheduler:                     reconcilescheduler.New(nil),
 		shootToHibernationCron:        make(map[string]*cron.Cron),
+		remediationRateLimiter:        remediationRateLimiter,
+		careLogger:                    careLogger,
+		maintenanceLogger:             maintenanceLogger,
 
 		seedLister:                   seedLister,
 		shootLister:                  shootLister,
@@ -152,7 +169,7 @@ func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenInformers
 		controllerInstallationLister: controllerInstallationLister,
 
 		seedQueue:                   workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "seed"),
-		shootQueue:                  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot"),
+		shootQueue:                  controllerutils.NewPriorityQueue(workqueue.DefaultControllerRateLimiter(), "shoot", expediteShoot(shootLister)),
 		shootCareQueue:              workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-care"),
 		shootMaintenanceQueue:       workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-maintenance"),
 		shootQuotaQueue:             workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-quota"),
@@ -161,6 +178,8 @@ func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenInformers
 		shootHibernationQueue:       workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-hibernation"),
 		controllerInstallationQueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-controllerinstallation"),
 
+		shootHibernationStatisticsQueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-hibernation-statistics"),
+
 		workerCh: make(chan int),
 	}
 
@@ -186,8 +205,13 @@ func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenInformers
 		DeleteFunc: shootController.shootMaintenanceDelete,
 	})
 
+	gardenV1beta1Informer.CloudProfiles().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: shootController.cloudProfileMaintenanceUpdate,
+	})
+
 	shootInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    shootController.shootQuotaAdd,
+		UpdateFunc: shootController.shootQuotaUpdate,
 		DeleteFunc: shootController.shootQuotaDelete,
 	})
 
@@ -197,6 +221,11 @@ func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenInformers
 		DeleteFunc: shootController.shootHibernationDelete,
 	})
 
+	shootInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    shootController.shootHibernationStatisticsAdd,
+		UpdateFunc: shootController.shootHibernationStatisticsUpdate,
+	})
+
 	configMapInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    shootController.configMapAdd,
 		UpdateFunc: shootController.configMapUpdate,
@@ -291,6 +320,7 @@ func (c *Controller) Run(ctx context.Context, shootWorkers, shootCareWorkers, sh
 	}
 	for i := 0; i < shootHibernationWorkers; i++ {
 		controllerutils.CreateWorker(ctx, c.shootHibernationQueue, "Scheduled Shoot Hibernation", c.reconcileShootHibernationKey, &waitGroup, c.workerCh)
+		controllerutils.CreateWorker(ctx, c.shootHibernationStatisticsQueue, "Shoot Hibernation Statistics", c.reconcileShootHibernationStatisticsKey, &waitGroup, c.workerCh)
 	}
 
 	// Shutdown handling
@@ -302,6 +332,7 @@ func (c *Controller) Run(ctx context.Context, shootWorkers, shootCareWorkers, sh
 	c.shootSeedQueue.ShutDown()
 	c.configMapQueue.ShutDown()
 	c.shootHibernationQueue.ShutDown()
+	c.shootHibernationStatisticsQueue.ShutDown()
 	c.controllerInstallationQueue.ShutDown()
 
 	for {
@@ -315,7 +346,8 @@ func (c *Controller) Run(ctx context.Context, shootWorkers, shootCareWorkers, sh
 			configMapQueueLength              = c.configMapQueue.Len()
 			shootHibernationQueueLength       = c.shootHibernationQueue.Len()
 			controllerInstallationQueueLength = c.controllerInstallationQueue.Len()
-			queueLengths                      = shootQueueLength + shootCareQueueLength + shootMaintenanceQueueLength + shootQuotaQueueLength + shootSeedQueueLength + seedQueueLength + configMapQueueLength + shootHibernationQueueLength + controllerInstallationQueueLength
+			hibernationStatisticsQueueLength  = c.shootHibernationStatisticsQueue.Len()
+			queueLengths                      = shootQueueLength + shootCareQueueLength + shootMaintenanceQueueLength + shootQuotaQueueLength + shootSeedQueueLength + seedQueueLength + configMapQueueLength + shootHibernationQueueLength + controllerInstallationQueueLength + hibernationStatisticsQueueLength
 		)
 		if queueLengths == 0 && c.numberOfRunningWorkers == 0 {
 			logger.Logger.Debug("No running Shoot worker and no items left in the queues. Terminated Shoot controller...")
@@ -343,6 +375,35 @@ func (c *Controller) CollectMetrics(ch chan<- prometheus.Metric) {
 	ch <- metric
 }
 
+// expediteShoot returns a function which determines whether the Shoot with the given key must be reconciled before
+// all other Shoots, i.e., whether an operator has requested an operation or one of its conditions is failing.
+func expediteShoot(shootLister gardenlisters.ShootLister) controllerutils.ExpediteFunc {
+	return func(item interface{}) bool {
+		key, ok := item.(string)
+		if !ok {
+			return false
+		}
+		namespace, name, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil {
+			return false
+		}
+		shoot, err := shootLister.Shoots(namespace).Get(name)
+		if err != nil {
+			return false
+		}
+
+		if _, ok := shoot.Annotations[common.ShootOperation]; ok {
+			return true
+		}
+		for _, condition := range shoot.Status.Conditions {
+			if condition.Status == gardenv1beta1.ConditionFalse {
+				return true
+			}
+		}
+		return false
+	}
+}
+
 func (c *Controller) getShootQueue(obj interface{}) workqueue.RateLimitingInterface {
 	if shoot, ok := obj.(*gardenv1beta1.Shoot); ok && shootIsSeed(shoot) {
 		return c.shootSeedQueue
@@ -29,9 +29,11 @@ import (
 	botanistpkg "github.com/gardener/gardener/pkg/operation/botanist"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 )
 
@@ -50,16 +52,19 @@ func (c *Controller) reconcileShootCareKey(key string) error {
 	}
 	shoot, err := c.shootLister.Shoots(namespace).Get(name)
 	if apierrors.IsNotFound(err) {
-		logger.Logger.Infof("[SHOOT CARE] Stopping care operations for Shoot %s since it has been deleted", key)
+		c.careLogger.Infof("[SHOOT CARE] Stopping care operations for Shoot %s since it has been deleted", key)
+		c.remediationRateLimiter.Forget(key)
 		c.shootCareQueue.Done(key)
 		return nil
 	}
 	if err != nil {
-		logger.Logger.Infof("[SHOOT CARE] %s - unable to retrieve object from store: %v", key, err)
+		c.careLogger.Infof("[SHOOT CARE] %s - unable to retrieve object from store: %v", key, err)
 		return err
 	}
 
-	if err := c.careControl.Care(shoot, key); err != nil {
+	if !c.seedFilter.ShootMatches(shoot) {
+		c.careLogger.Debugf("[SHOOT CARE] %s - skipping because the Seed of the Shoot is not selected by the seed selector", key)
+	} else if err := c.careControl.Care(shoot, key); err != nil {
 		return err
 	}
 
@@ -76,9 +81,10 @@ type CareControlInterface interface {
 // NewDefaultCareControl returns a new instance of the default implementation CareControlInterface that
 // implements the documented semantics for caring for Shoots. updater is the UpdaterInterface used
 // to update the status of Shoots. You should use an instance returned from NewDefaultCareControl() for any
-// scenario other than testing.
-func NewDefaultCareControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.Interface, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, identity *gardenv1beta1.Gardener, config *config.ControllerManagerConfiguration) CareControlInterface {
-	return &defaultCareControl{k8sGardenClient, k8sGardenInformers, secrets, imageVector, identity, config}
+// scenario other than testing. The remediation actions are recorded as events with the <recorder> and rate-limited
+// with the <remediationRateLimiter>.
+func NewDefaultCareControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.Interface, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, identity *gardenv1beta1.Gardener, config *config.ControllerManagerConfiguration, recorder record.EventRecorder, remediationRateLimiter *RemediationRateLimiter, careLogger *logrus.Logger) CareControlInterface {
+	return &defaultCareControl{k8sGardenClient, k8sGardenInformers, secrets, imageVector, identity, config, recorder, remediationRateLimiter, careLogger}
 }
 
 type defaultCareControl struct {
@@ -88,6 +94,10 @@ type defaultCareControl struct {
 	imageVector        imagevector.ImageVector
 	identity           *gardenv1beta1.Gardener
 	config             *config.ControllerManagerConfiguration
+	recorder           record.EventRecorder
+
+	remediationRateLimiter *RemediationRateLimiter
+	logger                 *logrus.Logger
 }
 
 func (c *defaultCareControl) conditionThresholdsToProgressingMapping() map[gardenv1beta1.ConditionType]time.Duration {
@@ -114,11 +124,11 @@ func shootClientInitializer(b *botanistpkg.Botanist) func() error {
 func (c *defaultCareControl) Care(shootObj *gardenv1beta1.Shoot, key string) error {
 	var (
 		shoot       = shootObj.DeepCopy()
-		shootLogger = logger.NewShootLogger(logger.Logger, shoot.Name, shoot.Namespace, "")
+		shootLogger = logger.NewShootLogger(c.logger, shoot.Name, shoot.Namespace, "")
 	)
 	shootLogger.Debugf("[SHOOT CARE] %s", key)
 
-	operation, err := operation.New(shoot, shootLogger, c.k8sGardenClient, c.k8sGardenInformers, c.identity, c.secrets, c.imageVector, nil)
+	operation, err := operation.New(shoot, shootLogger, c.k8sGardenClient, c.k8sGardenInformers, c.identity, c.secrets, c.imageVector, nil, nil, nil)
 	if err != nil {
 		shootLogger.Errorf("could not initialize a new operation: %s", err.Error())
 		return nil // We do not want to run in the exponential backoff for the condition checks.
@@ -155,6 +165,7 @@ func (c *defaultCareControl) Care(shootObj *gardenv1beta1.Shoot, key string) err
 	conditionAPIServerAvailable, conditionControlPlaneHealthy, conditionEveryNodeReady, conditionSystemComponentsHealthy = botanist.HealthChecks(
 		initializeShootClients,
 		c.conditionThresholdsToProgressingMapping(),
+		c.config.Controllers.ShootCare.APIServerProbes,
 		conditionAPIServerAvailable,
 		conditionControlPlaneHealthy,
 		conditionEveryNodeReady,
@@ -168,6 +179,9 @@ func (c *defaultCareControl) Care(shootObj *gardenv1beta1.Shoot, key string) err
 		return nil // We do not want to run in the exponential backoff for the condition checks.
 	}
 
+	// Execute remediation actions for well-known failure signatures
+	c.remediate(botanist, shoot, key, initializeShootClients, conditionControlPlaneHealthy, conditionEveryNodeReady)
+
 	// Mark Shoot as healthy/unhealthy
 	kutil.TryUpdateShootLabels(
 		c.k8sGardenClient.Garden(),
@@ -183,7 +197,10 @@ func (c *defaultCareControl) Care(shootObj *gardenv1beta1.Shoot, key string) err
 func (c *defaultCareControl) updateShootConditions(shoot *gardenv1beta1.Shoot, conditions ...gardenv1beta1.Condition) (*gardenv1beta1.Shoot, error) {
 	newShoot, err := kutil.TryUpdateShootConditions(c.k8sGardenClient.Garden(), retry.DefaultBackoff, shoot.ObjectMeta,
 		func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
-			shoot.Status.Conditions = conditions
+			// Conditions of other controllers, e.g. the compliance controller, are kept.
+			for _, condition := range conditions {
+				shoot.Status.Conditions = helper.SetCondition(shoot.Status.Conditions, condition)
+			}
 			return shoot, nil
 		})
 
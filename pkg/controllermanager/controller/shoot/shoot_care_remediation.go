@@ -0,0 +1,218 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	botanistpkg "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// remediationRestartCrashLoopingControlPlanePods is the name of the remediation action deleting crash-looping
+	// control plane pods.
+	remediationRestartCrashLoopingControlPlanePods = "restart-crashlooping-controlplane-pods"
+	// remediationReconcileOperatingSystemConfigs is the name of the remediation action reconciling the
+	// OperatingSystemConfigs again.
+	remediationReconcileOperatingSystemConfigs = "reconcile-operatingsystemconfigs"
+
+	// reasonCrashLoopBackOff is the reason of waiting containers which are crash-looping.
+	reasonCrashLoopBackOff = "CrashLoopBackOff"
+)
+
+// RemediationRateLimiter limits how often remediation actions are executed per Shoot.
+type RemediationRateLimiter struct {
+	lock       sync.Mutex
+	executions map[string][]time.Time
+}
+
+// NewRemediationRateLimiter returns a new RemediationRateLimiter.
+func NewRemediationRateLimiter() *RemediationRateLimiter {
+	return &RemediationRateLimiter{executions: make(map[string][]time.Time)}
+}
+
+// Allow returns true and records an execution if the action identified by the <key> has been executed less often
+// than allowed by the <limit> within the period before <now>.
+func (r *RemediationRateLimiter) Allow(key string, limit config.RemediationRateLimit, now time.Time) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var recent []time.Time
+	for _, execution := range r.executions[key] {
+		if now.Sub(execution) < limit.Period.Duration {
+			recent = append(recent, execution)
+		}
+	}
+
+	if len(recent) >= limit.MaxActions {
+		r.executions[key] = recent
+		return false
+	}
+
+	r.executions[key] = append(recent, now)
+	return true
+}
+
+// Forget removes all recorded executions of the actions for the Shoot identified by the <shootKey>.
+func (r *RemediationRateLimiter) Forget(shootKey string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for key := range r.executions {
+		if strings.HasPrefix(key, shootKey+"/") {
+			delete(r.executions, key)
+		}
+	}
+}
+
+// CrashLoopingPods returns the pods of which at least one container is waiting in the CrashLoopBackOff state.
+func CrashLoopingPods(pods []corev1.Pod) []corev1.Pod {
+	var out []corev1.Pod
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.State.Waiting != nil && status.State.Waiting.Reason == reasonCrashLoopBackOff {
+				out = append(out, pod)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// NodesNotReadyWithKnownCause returns the names of the nodes which are not ready and whose Ready condition message
+// contains one of the given <signatures>.
+func NodesNotReadyWithKnownCause(nodes []corev1.Node, signatures []string) []string {
+	var out []string
+	for _, node := range nodes {
+		for _, condition := range node.Status.Conditions {
+			if condition.Type != corev1.NodeReady || condition.Status == corev1.ConditionTrue {
+				continue
+			}
+			for _, signature := range signatures {
+				if strings.Contains(condition.Message, signature) {
+					out = append(out, node.Name)
+					break
+				}
+			}
+		}
+	}
+	return out
+}
+
+// remediate executes the configured remediation actions for the well-known failure signatures of the Shoot. The
+// actions are rate-limited per Shoot and recorded as events.
+func (c *defaultCareControl) remediate(botanist *botanistpkg.Botanist, shoot *gardenv1beta1.Shoot, key string, initializeShootClients func() error, controlPlane, nodes *gardenv1beta1.Condition) {
+	remediation := c.config.Controllers.ShootCare.Remediation
+	if remediation == nil || botanist.Shoot.IsHibernated {
+		return
+	}
+
+	if remediation.RestartCrashLoopingControlPlanePods != nil && controlPlane.Status != gardenv1beta1.ConditionTrue {
+		if err := c.restartCrashLoopingControlPlanePods(botanist, shoot, key, *remediation.RestartCrashLoopingControlPlanePods); err != nil {
+			botanist.Logger.Errorf("Could not restart crash-looping control plane pods: %+v", err)
+		}
+	}
+
+	if remediation.ReconcileOperatingSystemConfigs != nil && nodes.Status != gardenv1beta1.ConditionTrue {
+		if err := initializeShootClients(); err != nil {
+			botanist.Logger.Debugf("Skipping remediation of nodes because the Shoot client could not be initialized: %+v", err)
+			return
+		}
+		if err := c.reconcileOperatingSystemConfigs(botanist, shoot, key, *remediation.ReconcileOperatingSystemConfigs, remediation.NodeNotReadySignatures); err != nil {
+			botanist.Logger.Errorf("Could not reconcile the operating system configs again: %+v", err)
+		}
+	}
+}
+
+func (c *defaultCareControl) restartCrashLoopingControlPlanePods(botanist *botanistpkg.Botanist, shoot *gardenv1beta1.Shoot, key string, limit config.RemediationRateLimit) error {
+	podList, err := botanist.K8sSeedClient.Kubernetes().CoreV1().Pods(botanist.Shoot.SeedNamespace).List(metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(map[string]string{common.GardenRole: common.GardenRoleControlPlane}).String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, pod := range CrashLoopingPods(podList.Items) {
+		if !c.remediationRateLimiter.Allow(key+"/"+remediationRestartCrashLoopingControlPlanePods, limit, time.Now()) {
+			botanist.Logger.Infof("Not restarting crash-looping control plane pod %s because the remediation rate limit has been reached", pod.Name)
+			return nil
+		}
+
+		if err := botanist.K8sSeedClient.Kubernetes().CoreV1().Pods(pod.Namespace).Delete(pod.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		c.recorder.Eventf(shoot, corev1.EventTypeNormal, "RemediationRestartedPod", "Restarted crash-looping control plane pod %s", pod.Name)
+	}
+	return nil
+}
+
+func (c *defaultCareControl) reconcileOperatingSystemConfigs(botanist *botanistpkg.Botanist, shoot *gardenv1beta1.Shoot, key string, limit config.RemediationRateLimit, signatures []string) error {
+	ctx := context.TODO()
+
+	nodeList := &corev1.NodeList{}
+	if err := botanist.K8sShootClient.Client().List(ctx, nil, nodeList); err != nil {
+		return err
+	}
+
+	nodeNames := NodesNotReadyWithKnownCause(nodeList.Items, signatures)
+	if len(nodeNames) == 0 {
+		return nil
+	}
+	if !c.remediationRateLimiter.Allow(key+"/"+remediationReconcileOperatingSystemConfigs, limit, time.Now()) {
+		botanist.Logger.Infof("Not reconciling the operating system configs for the not ready nodes %s because the remediation rate limit has been reached", strings.Join(nodeNames, ", "))
+		return nil
+	}
+
+	oscList := &extensionsv1alpha1.OperatingSystemConfigList{}
+	if err := botanist.K8sSeedClient.Client().List(ctx, client.InNamespace(botanist.Shoot.SeedNamespace), oscList); err != nil {
+		return err
+	}
+
+	var annotated int
+	for _, osc := range oscList.Items {
+		// OperatingSystemConfigs which are still annotated have not been reconciled since the last request, annotating
+		// them again would not trigger another reconciliation.
+		if osc.DeletionTimestamp != nil || osc.Annotations[extensionsv1alpha1.OperationAnnotation] == extensionsv1alpha1.OperationReconcile {
+			continue
+		}
+		osc := osc.DeepCopy()
+		metav1.SetMetaDataAnnotation(&osc.ObjectMeta, extensionsv1alpha1.OperationAnnotation, extensionsv1alpha1.OperationReconcile)
+		if err := botanist.K8sSeedClient.Client().Update(ctx, osc); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		annotated++
+	}
+	if annotated == 0 {
+		return nil
+	}
+	c.recorder.Eventf(shoot, corev1.EventTypeNormal, "RemediationReconciledOperatingSystemConfigs", "Reconciling the operating system configs again because the nodes %s are not ready with a known cause", strings.Join(nodeNames, ", "))
+	return nil
+}
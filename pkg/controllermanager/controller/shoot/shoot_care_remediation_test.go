@@ -0,0 +1,123 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot_test
+
+import (
+	"time"
+
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Shoot Care Remediation", func() {
+	Describe("#RemediationRateLimiter", func() {
+		var (
+			limiter *RemediationRateLimiter
+			limit   = config.RemediationRateLimit{MaxActions: 2, Period: metav1.Duration{Duration: time.Hour}}
+			now     = time.Date(2019, 1, 1, 12, 0, 0, 0, time.UTC)
+		)
+
+		BeforeEach(func() {
+			limiter = NewRemediationRateLimiter()
+		})
+
+		It("should allow the maximum number of actions within the period", func() {
+			Expect(limiter.Allow("garden-foo/bar/action", limit, now)).To(BeTrue())
+			Expect(limiter.Allow("garden-foo/bar/action", limit, now.Add(time.Minute))).To(BeTrue())
+			Expect(limiter.Allow("garden-foo/bar/action", limit, now.Add(2*time.Minute))).To(BeFalse())
+			Expect(limiter.Allow("garden-foo/bar/other-action", limit, now.Add(2*time.Minute))).To(BeTrue())
+		})
+
+		It("should allow actions again once the period has passed", func() {
+			Expect(limiter.Allow("garden-foo/bar/action", limit, now)).To(BeTrue())
+			Expect(limiter.Allow("garden-foo/bar/action", limit, now.Add(time.Minute))).To(BeTrue())
+			Expect(limiter.Allow("garden-foo/bar/action", limit, now.Add(time.Hour))).To(BeTrue())
+			Expect(limiter.Allow("garden-foo/bar/action", limit, now.Add(time.Hour))).To(BeFalse())
+		})
+
+		It("should forget the actions of a Shoot", func() {
+			Expect(limiter.Allow("garden-foo/bar/action", limit, now)).To(BeTrue())
+			Expect(limiter.Allow("garden-foo/bar/action", limit, now)).To(BeTrue())
+			Expect(limiter.Allow("garden-foo/baz/action", limit, now)).To(BeTrue())
+			Expect(limiter.Allow("garden-foo/baz/action", limit, now)).To(BeTrue())
+
+			limiter.Forget("garden-foo/bar")
+
+			Expect(limiter.Allow("garden-foo/bar/action", limit, now)).To(BeTrue())
+			Expect(limiter.Allow("garden-foo/baz/action", limit, now)).To(BeFalse())
+		})
+	})
+
+	Describe("#CrashLoopingPods", func() {
+		It("should return the pods with crash-looping containers", func() {
+			var (
+				waiting = func(reason string) corev1.ContainerStatus {
+					return corev1.ContainerStatus{State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: reason}}}
+				}
+				running      = corev1.ContainerStatus{State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}}
+				crashLooping = corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver"},
+					Status:     corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{running, waiting("CrashLoopBackOff")}},
+				}
+				pods = []corev1.Pod{
+					crashLooping,
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "kube-scheduler"},
+						Status:     corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{running}},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager"},
+						Status:     corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{waiting("ContainerCreating")}},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "terminating", DeletionTimestamp: &metav1.Time{}},
+						Status:     corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{waiting("CrashLoopBackOff")}},
+					},
+				}
+			)
+
+			Expect(CrashLoopingPods(pods)).To(Equal([]corev1.Pod{crashLooping}))
+		})
+	})
+
+	Describe("#NodesNotReadyWithKnownCause", func() {
+		It("should return the not ready nodes with a known cause", func() {
+			var (
+				node = func(name string, status corev1.ConditionStatus, message string) corev1.Node {
+					return corev1.Node{
+						ObjectMeta: metav1.ObjectMeta{Name: name},
+						Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+							{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionFalse},
+							{Type: corev1.NodeReady, Status: status, Message: message},
+						}},
+					}
+				}
+				nodes = []corev1.Node{
+					node("ready", corev1.ConditionTrue, "kubelet is posting ready status"),
+					node("pleg", corev1.ConditionFalse, "PLEG is not healthy: pleg was last seen active 3m0s ago"),
+					node("unknown-cause", corev1.ConditionFalse, "something else happened"),
+					node("runtime", corev1.ConditionUnknown, "container runtime is down"),
+				}
+			)
+
+			Expect(NodesNotReadyWithKnownCause(nodes, []string{"PLEG is not healthy", "container runtime is down"})).To(ConsistOf("pleg", "runtime"))
+		})
+	})
+})
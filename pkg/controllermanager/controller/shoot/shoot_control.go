@@ -25,10 +25,13 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
+	gardenmetrics "github.com/gardener/gardener/pkg/controllermanager/metrics"
+	"github.com/gardener/gardener/pkg/controllermanager/server/handlers"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/flow"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/reconcilescheduler"
@@ -62,6 +65,12 @@ func (c *Controller) shootUpdate(oldObj, newObj interface{}) {
 	shootLogger.Debugf(string(oldShootJSON))
 	shootLogger.Debugf(string(newShootJSON))
 
+	// A plan of the changes of a reconciliation can be requested without changing the specification.
+	if newShoot.Annotations[common.ShootOperation] == common.ShootOperationPlan {
+		c.shootAdd(newObj)
+		return
+	}
+
 	// If the generation did not change for an update event (i.e., no changes to the .spec section have
 	// been made), we do not want to add the Shoot to the queue. The period reconciliation is handled
 	// elsewhere by adding the Shoot to the queue to dedicated times.
@@ -129,6 +138,14 @@ func (c *Controller) reconcileShootKey(key string) error {
 		reconcileErr error
 	)
 
+	// Ignore Shoots on Seeds which are not managed by this controller manager. They are checked again after the sync
+	// period in case the labels of their Seed have changed.
+	if !c.seedFilter.ShootMatches(shoot) {
+		shootLogger.Debug("Skipping because the Seed of the Shoot is not selected by the seed selector")
+		c.getShootQueue(shoot).AddAfter(key, c.config.Controllers.Shoot.SyncPeriod.Duration)
+		return nil
+	}
+
 	// Ignore Shoots which do not have the gardener finalizer.
 	if shoot.DeletionTimestamp != nil && !sets.NewString(shoot.Finalizers...).Has(gardenv1beta1.GardenerName) {
 		shootLogger.Debug("Do not need to do anything as the Shoot does not have my finalizer")
@@ -137,6 +154,12 @@ func (c *Controller) reconcileShootKey(key string) error {
 		return nil
 	}
 
+	// If a plan has been requested then only the changes of a reconciliation are computed. The regular reconciliation
+	// is still triggered by the sync period or by a change of the specification.
+	if shoot.DeletionTimestamp == nil && shoot.Annotations[common.ShootOperation] == common.ShootOperationPlan {
+		return c.control.PlanShoot(shoot, key)
+	}
+
 	shootElement, err := c.newShootElement(shoot)
 	if err != nil {
 		return err
@@ -253,6 +276,9 @@ type ControlInterface interface {
 	// exit exceptionally at any point provided they wish the update to be re-run at a later point in time.
 	// The bool return value determines whether the Shoot should be automatically requeued for reconciliation.
 	ReconcileShoot(shoot *gardenv1beta1.Shoot, key string) (bool, error)
+	// PlanShoot computes the changes which a reconciliation of the Shoot would make without applying them, and writes
+	// them into the status of the Shoot.
+	PlanShoot(shoot *gardenv1beta1.Shoot, key string) error
 }
 
 // NewDefaultControl returns a new instance of the default implementation ControlInterface that
@@ -294,7 +320,7 @@ func (c *defaultControl) ReconcileShoot(shootObj *gardenv1beta1.Shoot, key strin
 	shootJSON, _ := json.Marshal(shoot)
 	shootLogger.Debugf(string(shootJSON))
 
-	operation, err := operation.New(shoot, shootLogger, c.k8sGardenClient, c.k8sGardenInformers, c.identity, c.secrets, c.imageVector, c.config.ShootBackup)
+	operation, err := operation.New(shoot, shootLogger, c.k8sGardenClient, c.k8sGardenInformers, c.identity, c.secrets, c.imageVector, c.config.ShootBackup, c.config.ShootCertificates, c.config.ShootTimeouts)
 	if err != nil {
 		shootLogger.Errorf("Could not initialize a new operation: %s", err.Error())
 		return true, err
@@ -396,3 +422,54 @@ func seedHasShootOwnerReference(meta metav1.ObjectMeta) (bool, string) {
 
 	return false, ""
 }
+
+const (
+	flowNameReconcile = "reconcile"
+	flowNameDelete    = "delete"
+)
+
+// flowRecorder returns the recorder for the flow with the given name of the Shoot of the given operation. The record
+// of its latest execution is served on the /debug/flows endpoint of the Gardener controller manager.
+func flowRecorder(o *operation.Operation, flowName string) *flow.Recorder {
+	return handlers.FlowRecorder(flowRecorderKey(o, flowName))
+}
+
+// forgetFlowRecorders removes the recorders of all flows of the Shoot of the given operation.
+func forgetFlowRecorders(o *operation.Operation) {
+	for _, flowName := range []string{flowNameReconcile, flowNameDelete} {
+		handlers.ForgetFlowRecorder(flowRecorderKey(o, flowName))
+	}
+}
+
+func flowRecorderKey(o *operation.Operation, flowName string) string {
+	return fmt.Sprintf("%s/%s/%s", o.Shoot.Info.Namespace, o.Shoot.Info.Name, flowName)
+}
+
+// observeFlowMetrics observes the durations of the latest execution of the flow with the given name and of its tasks
+// for the Shoot of the given operation.
+func observeFlowMetrics(o *operation.Operation, flowName string) {
+	record := flowRecorder(o, flowName).Record()
+	if record == nil || record.End == nil {
+		return
+	}
+
+	var (
+		purpose = o.Shoot.GetPurpose()
+		seed    = o.Seed.Info.Name
+	)
+
+	gardenmetrics.ShootOperationDuration.WithLabelValues(flowName, flowResult(len(record.Error) == 0), purpose, seed).Observe(record.Duration.Seconds())
+	for _, task := range record.Tasks {
+		if task.End == nil {
+			continue
+		}
+		gardenmetrics.ShootFlowTaskDuration.WithLabelValues(flowName, string(task.ID), flowResult(task.State == flow.TaskStateSucceeded), purpose, seed).Observe(task.Duration.Seconds())
+	}
+}
+
+func flowResult(succeeded bool) string {
+	if succeeded {
+		return "succeeded"
+	}
+	return "failed"
+}
@@ -278,7 +278,9 @@ func (c *defaultControl) deleteShoot(o *operation.Operation) *gardenv1beta1.Last
 	err = f.Run(flow.Opts{
 		Logger:           o.Logger,
 		ProgressReporter: o.ReportShootProgress,
+		Recorder:         flowRecorder(o, flowNameDelete),
 	})
+	observeFlowMetrics(o, flowNameDelete)
 	if err != nil {
 		o.Logger.Errorf("Error deleting Shoot %q: %+v", o.Shoot.Info.Name, err)
 
@@ -288,6 +290,7 @@ func (c *defaultControl) deleteShoot(o *operation.Operation) *gardenv1beta1.Last
 		}
 	}
 
+	forgetFlowRecorders(o)
 	o.Logger.Infof("Successfully deleted Shoot %q", o.Shoot.Info.Name)
 	return nil
 }
@@ -0,0 +1,86 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package shoot
+
+import (
+	"fmt"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+func (c *defaultControl) PlanShoot(shootObj *gardenv1beta1.Shoot, key string) error {
+	var (
+		shoot       = shootObj.DeepCopy()
+		shootLogger = logger.NewShootLogger(logger.Logger, shoot.Name, shoot.Namespace, "")
+	)
+
+	logger.Logger.Infof("[SHOOT PLAN] %s", key)
+
+	o, err := operation.New(shoot, shootLogger, c.k8sGardenClient, c.k8sGardenInformers, c.identity, c.secrets, c.imageVector, c.config.ShootBackup, c.config.ShootCertificates, c.config.ShootTimeouts)
+	if err != nil {
+		shootLogger.Errorf("Could not initialize a new operation: %s", err.Error())
+		return err
+	}
+
+	botanist, err := botanistpkg.New(o)
+	if err != nil {
+		shootLogger.Errorf("Could not create a botanist: %s", err.Error())
+		return err
+	}
+
+	changes, err := botanist.Plan()
+	if err != nil {
+		message := fmt.Sprintf("Could not compute the changes of a reconciliation: %s", err.Error())
+		shootLogger.Error(message)
+		c.recorder.Event(shoot, corev1.EventTypeWarning, "PlanFailed", message)
+		return err
+	}
+
+	newShoot, err := kutil.TryUpdateShootStatus(c.k8sGardenClient.Garden(), retry.DefaultRetry, shoot.ObjectMeta,
+		func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
+			shoot.Status.Plan = &gardenv1beta1.ShootPlan{
+				ComputedTime: metav1.Now(),
+				Generation:   shoot.Generation,
+				Changes:      changes,
+			}
+			return shoot, nil
+		})
+	if err != nil {
+		return err
+	}
+
+	if _, err := kutil.TryUpdateShootAnnotations(c.k8sGardenClient.Garden(), retry.DefaultRetry, newShoot.ObjectMeta,
+		func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
+			if shoot.Annotations[common.ShootOperation] == common.ShootOperationPlan {
+				delete(shoot.Annotations, common.ShootOperation)
+			}
+			return shoot, nil
+		}); err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("Computed the changes of a reconciliation (%d change(s))", len(changes))
+	shootLogger.Info(message)
+	c.recorder.Event(shoot, corev1.EventTypeNormal, "PlanComputed", message)
+	return nil
+}
@@ -15,6 +15,8 @@
 package shoot
 
 import (
+	"context"
+	"fmt"
 	"time"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
@@ -25,6 +27,7 @@ import (
 	cloudbotanistpkg "github.com/gardener/gardener/pkg/operation/cloudbotanist"
 	"github.com/gardener/gardener/pkg/operation/common"
 	hybridbotanistpkg "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	"github.com/gardener/gardener/pkg/operation/preflight"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -63,6 +66,13 @@ func (c *defaultControl) reconcileShoot(o *operation.Operation, operationType ga
 		return formatError("Failed to check whether all required extensions exist", err)
 	}
 
+	if err := preflight.Run(context.TODO(), o); err != nil {
+		return &gardenv1beta1.LastError{
+			Description: fmt.Sprintf("Pre-flight checks failed (%s)", err.Error()),
+			Codes:       helper.ExtractErrorCodes(err),
+		}
+	}
+
 	var (
 		defaultTimeout                  = 30 * time.Second
 		defaultInterval                 = 5 * time.Second
@@ -71,6 +81,7 @@ func (c *defaultControl) reconcileShoot(o *operation.Operation, operationType ga
 		creationPhase                   = operationType == gardenv1beta1.ShootLastOperationTypeCreate
 		requireInfrastructureDeployment = creationPhase || controllerutils.HasTask(o.Shoot.Info.Annotations, common.ShootTaskDeployInfrastructure)
 		requireKube2IAMDeployment       = creationPhase || controllerutils.HasTask(o.Shoot.Info.Annotations, common.ShootTaskDeployKube2IAMResource)
+		requireControlPlaneRestart      = !creationPhase && controllerutils.HasTask(o.Shoot.Info.Annotations, common.ShootTaskRestartControlPlane)
 
 		g               = flow.NewGraph("Shoot cluster reconciliation")
 		deployNamespace = g.Add(flow.Task{
@@ -161,7 +172,7 @@ func (c *defaultControl) reconcileShoot(o *operation.Operation, operationType ga
 			Fn:           flow.SimpleTaskFn(botanist.InitializeShootClients).RetryUntilTimeout(defaultInterval, 2*time.Minute),
 			Dependencies: flow.NewTaskIDs(waitUntilKubeAPIServerIsReady, deployCloudSpecificControlPlane),
 		})
-		_ = g.Add(flow.Task{
+		deployKubeScheduler = g.Add(flow.Task{
 			Name:         "Deploying Kubernetes scheduler",
 			Fn:           flow.SimpleTaskFn(hybridBotanist.DeployKubeScheduler).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			Dependencies: flow.NewTaskIDs(deploySecrets, deployKubeAPIServer),
@@ -201,6 +212,11 @@ func (c *defaultControl) reconcileShoot(o *operation.Operation, operationType ga
 			Fn:           flow.SimpleTaskFn(hybridBotanist.ReconcileMachines).DoIf(isCloud).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			Dependencies: flow.NewTaskIDs(computeShootOSConfig, deployMachineControllerManager, deployInfrastructure, initializeShootClients),
 		})
+		_ = g.Add(flow.Task{
+			Name:         "Restarting control plane components",
+			Fn:           flow.SimpleTaskFn(botanist.RestartControlPlane).DoIf(requireControlPlaneRestart).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(deployKubeAPIServer, deployKubeScheduler, deployCloudControllerManager, deployKubeControllerManager, deployKubeAddonManager, deployMachineControllerManager),
+		})
 		_ = g.Add(flow.Task{
 			Name:         "Deploying Kube2IAM resources",
 			Fn:           flow.SimpleTaskFn(shootCloudBotanist.DeployKube2IAMResources).DoIf(requireKube2IAMDeployment).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -239,7 +255,8 @@ func (c *defaultControl) reconcileShoot(o *operation.Operation, operationType ga
 		f = g.Compile()
 	)
 
-	err = f.Run(flow.Opts{Logger: o.Logger, ProgressReporter: o.ReportShootProgress})
+	err = f.Run(flow.Opts{Logger: o.Logger, ProgressReporter: o.ReportShootProgress, Recorder: flowRecorder(o, flowNameReconcile)})
+	observeFlowMetrics(o, flowNameReconcile)
 	if err != nil {
 		o.Logger.Errorf("Failed to reconcile Shoot %q: %+v", o.Shoot.Info.Name, err)
 
@@ -284,6 +301,9 @@ func (c *defaultControl) updateShootStatusReconcile(o *operation.Operation, oper
 			if retryCycleStartTime != nil {
 				shoot.Status.RetryCycleStartTime = retryCycleStartTime
 			}
+			if state == gardenv1beta1.ShootLastOperationStateProcessing {
+				startCredentialsRotation(shoot, now)
+			}
 
 			shoot.Status.Gardener = *(o.GardenerInfo)
 			shoot.Status.ObservedGeneration = observedGeneration
@@ -319,10 +339,15 @@ func (c *defaultControl) updateShootStatusReconcileStart(o *operation.Operation,
 }
 
 func (c *defaultControl) updateShootStatusReconcileSuccess(o *operation.Operation, operationType gardenv1beta1.ShootLastOperationType) error {
-	// Remove task list from Shoot annotations since reconciliation was successful.
+	credentials, rotated := common.CredentialsToRotate(o.Shoot.Info.Annotations)
+
+	// Remove task list and completed credentials rotation from Shoot annotations since reconciliation was successful.
 	newShoot, err := kutil.TryUpdateShootAnnotations(c.k8sGardenClient.Garden(), retry.DefaultRetry, o.Shoot.Info.ObjectMeta,
 		func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
 			controllerutils.RemoveAllTasks(shoot.Annotations)
+			if current, ok := common.CredentialsToRotate(shoot.Annotations); rotated && ok && current == credentials {
+				delete(shoot.Annotations, common.ShootOperation)
+			}
 			return shoot, nil
 		})
 
@@ -335,6 +360,9 @@ func (c *defaultControl) updateShootStatusReconcileSuccess(o *operation.Operatio
 			shoot.Status.RetryCycleStartTime = nil
 			shoot.Status.Seed = o.Seed.Info.Name
 			shoot.Status.LastError = nil
+			if rotated {
+				completeCredentialsRotation(shoot, credentials, metav1.Now())
+			}
 			shoot.Status.LastOperation = &gardenv1beta1.LastOperation{
 				Type:           operationType,
 				State:          gardenv1beta1.ShootLastOperationStateSucceeded,
@@ -395,3 +423,33 @@ func (c *defaultControl) updateShootStatusReconcileError(o *operation.Operation,
 
 	return state, err
 }
+
+// startCredentialsRotation marks the rotation of the credentials requested by the operation annotation of the given
+// <shoot> as initiated unless it is already in progress, i.e., a retried reconciliation does not restart it.
+func startCredentialsRotation(shoot *gardenv1beta1.Shoot, now metav1.Time) {
+	credentials, ok := common.CredentialsToRotate(shoot.Annotations)
+	if !ok {
+		return
+	}
+	if rotation := helper.GetCredentialsRotation(shoot.Status.CredentialsRotations, credentials); rotation != nil && rotation.Phase == gardenv1beta1.CredentialsRotationPhaseRotating {
+		return
+	}
+
+	shoot.Status.CredentialsRotations = helper.SetCredentialsRotation(shoot.Status.CredentialsRotations, gardenv1beta1.CredentialsRotation{
+		Credentials:        credentials,
+		Phase:              gardenv1beta1.CredentialsRotationPhaseRotating,
+		LastInitiationTime: &now,
+	})
+}
+
+// completeCredentialsRotation marks the rotation of the given <credentials> of the <shoot> as completed.
+func completeCredentialsRotation(shoot *gardenv1beta1.Shoot, credentials string, now metav1.Time) {
+	rotation := helper.GetCredentialsRotation(shoot.Status.CredentialsRotations, credentials)
+	if rotation == nil || rotation.Phase != gardenv1beta1.CredentialsRotationPhaseRotating {
+		return
+	}
+
+	rotation.Phase = gardenv1beta1.CredentialsRotationPhaseCompleted
+	rotation.LastCompletionTime = &now
+	shoot.Status.CredentialsRotations = helper.SetCredentialsRotation(shoot.Status.CredentialsRotations, *rotation)
+}
@@ -28,6 +28,7 @@ import (
 	gardenlogger "github.com/gardener/gardener/pkg/logger"
 	"github.com/robfig/cron"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 )
 
@@ -120,7 +121,7 @@ func (c *Controller) reconcileShootHibernationKey(key string) error {
 		return err
 	}
 
-	if shoot.DeletionTimestamp != nil {
+	if shoot.DeletionTimestamp != nil || !c.seedFilter.ShootMatches(shoot) {
 		c.deleteShootCron(logger, key)
 		return nil
 	}
@@ -158,8 +159,16 @@ func (c *Controller) reconcileShootHibernation(logger logrus.FieldLogger, key st
 
 	cr := cron.NewWithLocation(time.UTC)
 	for _, schedule := range schedules {
+		location := time.UTC
+		if schedule.Location != nil {
+			var err error
+			if location, err = time.LoadLocation(*schedule.Location); err != nil {
+				return err
+			}
+		}
+
 		if schedule.Start != nil {
-			start, err := cron.ParseStandard(*schedule.Start)
+			start, err := ParseHibernationSchedule(*schedule.Start, location, schedule.ExcludeDates)
 			if err != nil {
 				return err
 			}
@@ -168,7 +177,7 @@ func (c *Controller) reconcileShootHibernation(logger logrus.FieldLogger, key st
 		}
 
 		if schedule.End != nil {
-			end, err := cron.ParseStandard(*schedule.End)
+			end, err := ParseHibernationSchedule(*schedule.End, location, schedule.ExcludeDates)
 			if err != nil {
 				return err
 			}
@@ -183,3 +192,31 @@ func (c *Controller) reconcileShootHibernation(logger logrus.FieldLogger, key st
 
 	return nil
 }
+
+// ParseHibernationSchedule parses the given Cron spec into a schedule which is evaluated in the given location and
+// never fires on the given dates (`YYYY-MM-DD` in the given location).
+func ParseHibernationSchedule(spec string, location *time.Location, excludeDates []string) (cron.Schedule, error) {
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, err
+	}
+	return &hibernationSchedule{schedule, location, sets.NewString(excludeDates...)}, nil
+}
+
+type hibernationSchedule struct {
+	schedule     cron.Schedule
+	location     *time.Location
+	excludeDates sets.String
+}
+
+// Next implements cron.Schedule.
+func (s *hibernationSchedule) Next(t time.Time) time.Time {
+	next := s.schedule.Next(t.In(s.location))
+
+	// Each iteration skips one excluded date, hence, the loop terminates after at most len(excludeDates) iterations.
+	for i := 0; i < s.excludeDates.Len() && !next.IsZero() && s.excludeDates.Has(next.Format("2006-01-02")); i++ {
+		endOfDay := time.Date(next.Year(), next.Month(), next.Day()+1, 0, 0, 0, 0, s.location).Add(-time.Second)
+		next = s.schedule.Next(endOfDay)
+	}
+	return next
+}
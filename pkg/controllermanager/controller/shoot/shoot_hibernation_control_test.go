@@ -0,0 +1,137 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot_test
+
+import (
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Shoot Hibernation", func() {
+	Describe("#ParseHibernationSchedule", func() {
+		var berlin *time.Location
+
+		BeforeEach(func() {
+			var err error
+			berlin, err = time.LoadLocation("Europe/Berlin")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should evaluate the spec in the given location", func() {
+			schedule, err := ParseHibernationSchedule("0 20 * * *", berlin, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			next := schedule.Next(time.Date(2019, 12, 23, 12, 0, 0, 0, time.UTC))
+			Expect(next.Equal(time.Date(2019, 12, 23, 19, 0, 0, 0, time.UTC))).To(BeTrue())
+		})
+
+		It("should skip the excluded dates", func() {
+			schedule, err := ParseHibernationSchedule("0 8 * * 1-5", berlin, []string{"2019-12-24", "2019-12-25", "2019-12-26"})
+			Expect(err).NotTo(HaveOccurred())
+
+			next := schedule.Next(time.Date(2019, 12, 23, 12, 0, 0, 0, time.UTC))
+			Expect(next.Equal(time.Date(2019, 12, 27, 7, 0, 0, 0, time.UTC))).To(BeTrue())
+		})
+
+		It("should not skip dates which are only excluded in another location", func() {
+			schedule, err := ParseHibernationSchedule("30 0 * * *", berlin, []string{"2019-12-23"})
+			Expect(err).NotTo(HaveOccurred())
+
+			// 23:30 UTC on 2019-12-23 is 00:30 on 2019-12-24 in Berlin.
+			next := schedule.Next(time.Date(2019, 12, 23, 12, 0, 0, 0, time.UTC))
+			Expect(next.Equal(time.Date(2019, 12, 23, 23, 30, 0, 0, time.UTC))).To(BeTrue())
+		})
+
+		It("should fail for invalid specs", func() {
+			_, err := ParseHibernationSchedule("foo", time.UTC, nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#UpdateHibernationStatistics", func() {
+		var (
+			shoot *gardenv1beta1.Shoot
+			price = 0.5
+		)
+
+		BeforeEach(func() {
+			shoot = &gardenv1beta1.Shoot{
+				Spec: gardenv1beta1.ShootSpec{
+					Cloud: gardenv1beta1.Cloud{
+						AWS: &gardenv1beta1.AWSCloud{
+							Workers: []gardenv1beta1.AWSWorker{
+								{Worker: gardenv1beta1.Worker{AutoScalerMin: 2}},
+								{Worker: gardenv1beta1.Worker{AutoScalerMin: 1}},
+							},
+						},
+					},
+					Hibernation: &gardenv1beta1.Hibernation{Enabled: true},
+				},
+			}
+		})
+
+		It("should not create statistics for Shoots which have never been hibernated", func() {
+			shoot.Spec.Hibernation = nil
+
+			Expect(UpdateHibernationStatistics(shoot, time.Now(), &price)).To(BeNil())
+		})
+
+		It("should start accounting when the Shoot gets hibernated", func() {
+			now := time.Date(2019, 12, 1, 20, 0, 0, 0, time.UTC)
+
+			Expect(UpdateHibernationStatistics(shoot, now, &price)).To(Equal(&gardenv1beta1.HibernationStatistics{
+				HibernatedSince: &metav1.Time{Time: now},
+			}))
+		})
+
+		It("should account the hibernation in the respective months when the Shoot is woken up", func() {
+			shoot.Spec.Hibernation.Enabled = false
+			shoot.Status.HibernationStatistics = &gardenv1beta1.HibernationStatistics{
+				HibernatedSince: &metav1.Time{Time: time.Date(2019, 11, 30, 20, 0, 0, 0, time.UTC)},
+				Months: []gardenv1beta1.HibernationMonthStatistics{
+					{Month: "2019-11", HibernatedSeconds: 3600, HibernatedNodeSeconds: 3 * 3600, HibernatedHours: "1.00"},
+				},
+			}
+			savingsNovember, savingsDecember := "7.50", "9.00"
+
+			Expect(UpdateHibernationStatistics(shoot, time.Date(2019, 12, 1, 6, 0, 0, 0, time.UTC), &price)).To(Equal(&gardenv1beta1.HibernationStatistics{
+				Months: []gardenv1beta1.HibernationMonthStatistics{
+					{Month: "2019-11", HibernatedSeconds: 5 * 3600, HibernatedNodeSeconds: 15 * 3600, HibernatedHours: "5.00", EstimatedSavings: &savingsNovember},
+					{Month: "2019-12", HibernatedSeconds: 6 * 3600, HibernatedNodeSeconds: 18 * 3600, HibernatedHours: "6.00", EstimatedSavings: &savingsDecember},
+				},
+			}))
+		})
+
+		It("should account the hibernation so far and continue while the Shoot stays hibernated", func() {
+			now := time.Date(2019, 12, 2, 0, 0, 0, 0, time.UTC)
+			shoot.Status.HibernationStatistics = &gardenv1beta1.HibernationStatistics{
+				HibernatedSince: &metav1.Time{Time: now.Add(-30 * time.Minute)},
+			}
+
+			Expect(UpdateHibernationStatistics(shoot, now, nil)).To(Equal(&gardenv1beta1.HibernationStatistics{
+				HibernatedSince: &metav1.Time{Time: now},
+				Months: []gardenv1beta1.HibernationMonthStatistics{
+					{Month: "2019-12", HibernatedSeconds: 1800, HibernatedNodeSeconds: 3 * 1800, HibernatedHours: "0.50"},
+				},
+			}))
+		})
+	})
+})
@@ -0,0 +1,192 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	gardenlogger "github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// hibernationStatisticsSyncPeriod is the interval in which the statistics of hibernated Shoots are updated.
+	hibernationStatisticsSyncPeriod = time.Hour
+	// maxHibernationStatisticsMonths is the maximum number of months for which hibernation statistics are kept.
+	maxHibernationStatisticsMonths = 12
+)
+
+func isShootHibernated(shoot *gardenv1beta1.Shoot) bool {
+	return shoot.Spec.Hibernation != nil && shoot.Spec.Hibernation.Enabled
+}
+
+func (c *Controller) shootHibernationStatisticsAdd(obj interface{}) {
+	shoot := obj.(*gardenv1beta1.Shoot)
+
+	// Shoots which have been woken up while the controller was not running still have to be accounted.
+	if isShootHibernated(shoot) || (shoot.Status.HibernationStatistics != nil && shoot.Status.HibernationStatistics.HibernatedSince != nil) {
+		key, err := cache.MetaNamespaceKeyFunc(obj)
+		if err != nil {
+			gardenlogger.Logger.Errorf("Couldn't get key for object %+v: %v", obj, err)
+			return
+		}
+		c.shootHibernationStatisticsQueue.Add(key)
+	}
+}
+
+func (c *Controller) shootHibernationStatisticsUpdate(oldObj, newObj interface{}) {
+	var (
+		oldShoot = oldObj.(*gardenv1beta1.Shoot)
+		newShoot = newObj.(*gardenv1beta1.Shoot)
+	)
+
+	if isShootHibernated(oldShoot) != isShootHibernated(newShoot) {
+		key, err := cache.MetaNamespaceKeyFunc(newObj)
+		if err != nil {
+			gardenlogger.Logger.Errorf("Couldn't get key for object %+v: %v", newObj, err)
+			return
+		}
+		c.shootHibernationStatisticsQueue.Add(key)
+	}
+}
+
+func (c *Controller) reconcileShootHibernationStatisticsKey(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	shoot, err := c.shootLister.Shoots(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		gardenlogger.Logger.Debugf("[SHOOT HIBERNATION STATISTICS] %s - skipping because Shoot has been deleted", key)
+		return nil
+	}
+	if err != nil {
+		gardenlogger.Logger.Infof("[SHOOT HIBERNATION STATISTICS] %s - unable to retrieve object from store: %v", key, err)
+		return err
+	}
+
+	if shoot.DeletionTimestamp != nil || !c.seedFilter.ShootMatches(shoot) {
+		return nil
+	}
+
+	var pricePerNodeHour *float64
+	if price := c.config.Controllers.ShootHibernation.PricePerNodeHour; price != nil {
+		value, err := strconv.ParseFloat(*price, 64)
+		if err != nil {
+			gardenlogger.Logger.Errorf("[SHOOT HIBERNATION STATISTICS] %s - invalid price per node hour %q, savings are not estimated: %v", key, *price, err)
+		} else {
+			pricePerNodeHour = &value
+		}
+	}
+
+	if _, err := kubernetes.TryUpdateShootStatus(c.k8sGardenClient.Garden(), retry.DefaultBackoff, shoot.ObjectMeta, func(s *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
+		s.Status.HibernationStatistics = UpdateHibernationStatistics(s, time.Now().UTC(), pricePerNodeHour)
+		return s, nil
+	}); err != nil {
+		return err
+	}
+
+	if isShootHibernated(shoot) {
+		c.shootHibernationStatisticsQueue.AddAfter(key, hibernationStatisticsSyncPeriod)
+	}
+	return nil
+}
+
+// UpdateHibernationStatistics accounts the hibernation of the given Shoot until <now> in the statistics of the
+// respective months and returns the updated hibernation statistics. Savings are only estimated if a <pricePerNodeHour>
+// is given. Only the statistics of the last months are kept.
+func UpdateHibernationStatistics(shoot *gardenv1beta1.Shoot, now time.Time, pricePerNodeHour *float64) *gardenv1beta1.HibernationStatistics {
+	statistics := &gardenv1beta1.HibernationStatistics{}
+	if shoot.Status.HibernationStatistics != nil {
+		statistics = shoot.Status.HibernationStatistics.DeepCopy()
+	}
+
+	if statistics.HibernatedSince != nil {
+		accountHibernation(statistics, statistics.HibernatedSince.UTC(), now.UTC(), numberOfMinimumNodes(shoot))
+		statistics.HibernatedSince = nil
+	}
+	if isShootHibernated(shoot) {
+		statistics.HibernatedSince = &metav1.Time{Time: now}
+	}
+
+	for i := range statistics.Months {
+		month := &statistics.Months[i]
+		month.HibernatedHours = fmt.Sprintf("%.2f", float64(month.HibernatedSeconds)/3600)
+		month.EstimatedSavings = nil
+		if pricePerNodeHour != nil {
+			savings := fmt.Sprintf("%.2f", float64(month.HibernatedNodeSeconds)/3600**pricePerNodeHour)
+			month.EstimatedSavings = &savings
+		}
+	}
+	if len(statistics.Months) > maxHibernationStatisticsMonths {
+		statistics.Months = statistics.Months[len(statistics.Months)-maxHibernationStatisticsMonths:]
+	}
+
+	if statistics.HibernatedSince == nil && len(statistics.Months) == 0 {
+		return nil
+	}
+	return statistics
+}
+
+// accountHibernation adds the hibernation between <from> and <to> to the statistics of the respective months.
+func accountHibernation(statistics *gardenv1beta1.HibernationStatistics, from, to time.Time, nodes int) {
+	for from.Before(to) {
+		end := time.Date(from.Year(), from.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		if to.Before(end) {
+			end = to
+		}
+
+		var (
+			name    = from.Format("2006-01")
+			seconds = int64(end.Sub(from).Seconds())
+			month   *gardenv1beta1.HibernationMonthStatistics
+		)
+		if n := len(statistics.Months); n > 0 && statistics.Months[n-1].Month == name {
+			month = &statistics.Months[n-1]
+		} else {
+			statistics.Months = append(statistics.Months, gardenv1beta1.HibernationMonthStatistics{Month: name})
+			month = &statistics.Months[len(statistics.Months)-1]
+		}
+		month.HibernatedSeconds += seconds
+		month.HibernatedNodeSeconds += seconds * int64(nodes)
+
+		from = end
+	}
+}
+
+// numberOfMinimumNodes returns the number of nodes which would be running if the given Shoot was not hibernated,
+// i.e., the sum of the minimum number of nodes of all its worker pools.
+func numberOfMinimumNodes(shoot *gardenv1beta1.Shoot) int {
+	cloudProvider, err := helper.DetermineCloudProviderInShoot(shoot.Spec.Cloud)
+	if err != nil {
+		return 0
+	}
+
+	var nodes int
+	for _, worker := range helper.GetShootCloudProviderWorkers(cloudProvider, shoot) {
+		nodes += worker.AutoScalerMin
+	}
+	return nodes
+}
@@ -16,6 +16,7 @@ package shoot
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
@@ -29,9 +30,14 @@ import (
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/validation/compatibility"
+	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
@@ -60,11 +66,39 @@ func (c *Controller) shootMaintenanceUpdate(oldObj, newObj interface{}) {
 		return
 	}
 
-	if hasMaintainNowAnnotation(newShoot) || !apiequality.Semantic.DeepEqual(oldShoot.Spec.Maintenance.TimeWindow, newShoot.Spec.Maintenance.TimeWindow) {
+	if hasMaintainNowAnnotation(newShoot) || hasPlanMaintenanceAnnotation(newShoot) ||
+		!apiequality.Semantic.DeepEqual(oldShoot.Spec.Maintenance.TimeWindow, newShoot.Spec.Maintenance.TimeWindow) ||
+		!apiequality.Semantic.DeepEqual(getMachineImageVulnerabilitySeverity(oldShoot), getMachineImageVulnerabilitySeverity(newShoot)) {
 		c.shootMaintenanceAdd(newObj)
 	}
 }
 
+func (c *Controller) cloudProfileMaintenanceUpdate(oldObj, newObj interface{}) {
+	newCloudProfile, ok1 := newObj.(*gardenv1beta1.CloudProfile)
+	oldCloudProfile, ok2 := oldObj.(*gardenv1beta1.CloudProfile)
+	if !ok1 || !ok2 {
+		return
+	}
+
+	if apiequality.Semantic.DeepEqual(oldCloudProfile.Status, newCloudProfile.Status) && apiequality.Semantic.DeepEqual(oldCloudProfile.Spec, newCloudProfile.Spec) {
+		return
+	}
+
+	shootList, err := c.shootLister.List(labels.Everything())
+	if err != nil {
+		c.maintenanceLogger.Errorf("[SHOOT MAINTENANCE] Could not list Shoots using CloudProfile %s: %v", newCloudProfile.Name, err)
+		return
+	}
+
+	// Shoots which opted in for forced machine image updates are queued again in order to check whether their
+	// machine image became vulnerable.
+	for _, shoot := range shootList {
+		if shoot.Spec.Cloud.Profile == newCloudProfile.Name && getMachineImageVulnerabilitySeverity(shoot) != nil {
+			c.shootMaintenanceAdd(shoot)
+		}
+	}
+}
+
 func (c *Controller) shootMaintenanceDelete(obj interface{}) {
 	shoot, ok := obj.(*gardenv1beta1.Shoot)
 	if shoot == nil || !ok {
@@ -84,21 +118,21 @@ func (c *Controller) reconcileShootMaintenanceKey(key string) error {
 	}
 	shoot, err := c.shootLister.Shoots(namespace).Get(name)
 	if apierrors.IsNotFound(err) {
-		logger.Logger.Debugf("[SHOOT MAINTENANCE] %s - skipping because Shoot has been deleted", key)
+		c.maintenanceLogger.Debugf("[SHOOT MAINTENANCE] %s - skipping because Shoot has been deleted", key)
 		return nil
 	}
 	if err != nil {
-		logger.Logger.Errorf("[SHOOT MAINTENANCE] %s - unable to retrieve object from store: %v", key, err)
+		c.maintenanceLogger.Errorf("[SHOOT MAINTENANCE] %s - unable to retrieve object from store: %v", key, err)
 		return err
 	}
 	if shoot.DeletionTimestamp != nil {
-		logger.Logger.Debugf("[SHOOT MAINTENANCE] %s - skipping because Shoot is marked as to be deleted", key)
+		c.maintenanceLogger.Debugf("[SHOOT MAINTENANCE] %s - skipping because Shoot is marked as to be deleted", key)
 		return nil
 	}
 
 	maintenanceTimeWindow, err := utils.ParseMaintenanceTimeWindow(shoot.Spec.Maintenance.TimeWindow.Begin, shoot.Spec.Maintenance.TimeWindow.End)
 	if err != nil {
-		logger.Logger.Errorf("[SHOOT MAINTENANCE] %s - invalid time window: %v", key, err)
+		c.maintenanceLogger.Errorf("[SHOOT MAINTENANCE] %s - invalid time window: %v", key, err)
 		return err
 	}
 	maintenanceTimeWindow = maintenanceTimeWindow.WithEnd(maintenanceTimeWindow.End().Add(0, -BestGuessMaintenanceMinutes, 0))
@@ -107,21 +141,100 @@ func (c *Controller) reconcileShootMaintenanceKey(key string) error {
 
 	defer c.shootMaintenanceRequeue(key, maintenanceTimeWindow, now)
 
-	if mustIgnoreShoot(shoot.Annotations, c.config.Controllers.Shoot.RespectSyncPeriodOverwrite) || !mustMaintainNow(shoot, maintenanceTimeWindow, now) {
-		logger.Logger.Infof("[SHOOT MAINTENANCE] %s - skipping because Shoot (it is either marked as 'to-be-ignored' or must not be maintained now).", key)
+	if !c.seedFilter.ShootMatches(shoot) {
+		c.maintenanceLogger.Debugf("[SHOOT MAINTENANCE] %s - skipping because the Seed of the Shoot is not selected by the seed selector", key)
 		return nil
 	}
 
+	if mustIgnoreShoot(shoot.Annotations, c.config.Controllers.Shoot.RespectSyncPeriodOverwrite) {
+		c.maintenanceLogger.Infof("[SHOOT MAINTENANCE] %s - skipping because Shoot is marked as 'to-be-ignored'.", key)
+		return nil
+	}
+
+	// If a plan has been requested then only the changes of a maintenance are computed. The regular maintenance is
+	// still executed within the time window.
+	if hasPlanMaintenanceAnnotation(shoot) {
+		return c.maintenanceControl.PlanMaintenance(shoot, key)
+	}
+
+	if !mustMaintainNow(shoot, maintenanceTimeWindow, now) {
+		cloudProfile, err := c.k8sGardenInformers.Garden().V1beta1().CloudProfiles().Lister().Get(shoot.Spec.Cloud.Profile)
+		if err != nil {
+			c.maintenanceLogger.Errorf("[SHOOT MAINTENANCE] %s - unable to retrieve CloudProfile: %v", key, err)
+			return err
+		}
+
+		mustUpdate, err := mustUpdateVulnerableMachineImage(shoot, cloudProfile)
+		if err != nil {
+			c.maintenanceLogger.Errorf("[SHOOT MAINTENANCE] %s - unable to check the machine image for vulnerabilities: %v", key, err)
+			return err
+		}
+		if !mustUpdate {
+			c.maintenanceLogger.Infof("[SHOOT MAINTENANCE] %s - skipping because Shoot must not be maintained now.", key)
+			return nil
+		}
+		// Only the machine image is updated outside of the time window, the Kubernetes version and the maintenance
+		// operations are left for the regular maintenance.
+		c.maintenanceLogger.Infof("[SHOOT MAINTENANCE] %s - updating the machine image outside of the time window because it is affected by vulnerabilities.", key)
+		return c.maintenanceControl.UpdateMachineImage(shoot, key)
+	}
+
+	if !hasMaintainNowAnnotation(shoot) && maintenanceTimeWindow.Contains(now) {
+		deferMaintenance, err := c.mustDeferMaintenance(shoot)
+		if err != nil {
+			c.maintenanceLogger.Errorf("[SHOOT MAINTENANCE] %s - unable to determine the Shoots reconciled on the Seed: %v", key, err)
+			return err
+		}
+		if deferMaintenance {
+			retryPeriod := c.config.Controllers.ShootMaintenance.RetryPeriod.Duration
+			delay := retryPeriod + time.Duration(utils.RandomFunc(0, retryPeriod.Nanoseconds()))
+			c.maintenanceLogger.Infof("[SHOOT MAINTENANCE] %s - deferring for %s because too many Shoots on the Seed are reconciled at the moment.", key, delay)
+			c.shootMaintenanceQueue.AddAfter(key, delay)
+			return nil
+		}
+	}
+
 	return c.maintenanceControl.Maintain(shoot, key)
 }
 
+// mustDeferMaintenance returns true if the number of Shoots which are reconciled on the Seed of the given Shoot has
+// reached the configured limit.
+func (c *Controller) mustDeferMaintenance(shoot *gardenv1beta1.Shoot) (bool, error) {
+	limit := c.config.Controllers.ShootMaintenance.ConcurrentShootsPerSeed
+	if limit == nil || shoot.Spec.Cloud.Seed == nil {
+		return false, nil
+	}
+
+	shoots, err := controllerutils.ShootsByIndex(c.k8sGardenInformers.Garden().V1beta1().Shoots().Informer().GetIndexer(), controllerutils.ShootSeedName, *shoot.Spec.Cloud.Seed)
+	if err != nil {
+		return false, err
+	}
+	return CountReconcilingShoots(shoots, shoot) >= *limit, nil
+}
+
+// CountReconcilingShoots returns the number of the given <shoots> except for <exclude> which are being reconciled or
+// whose changed specification has not yet been reconciled.
+func CountReconcilingShoots(shoots []*gardenv1beta1.Shoot, exclude *gardenv1beta1.Shoot) int {
+	count := 0
+	for _, shoot := range shoots {
+		if shoot.Namespace == exclude.Namespace && shoot.Name == exclude.Name {
+			continue
+		}
+		if shoot.Generation != shoot.Status.ObservedGeneration ||
+			(shoot.Status.LastOperation != nil && shoot.Status.LastOperation.State == gardenv1beta1.ShootLastOperationStateProcessing) {
+			count++
+		}
+	}
+	return count
+}
+
 // newRandomTimeWindow computes a new random time window either for today or the next day (depending on <today>).
 func (c *Controller) shootMaintenanceRequeue(key string, maintenanceTimeWindow *utils.MaintenanceTimeWindow, now time.Time) {
 	var (
 		duration        = maintenanceTimeWindow.RandomDurationUntilNext(now)
 		nextMaintenance = now.Add(duration)
 	)
-	logger.Logger.Infof("[SHOOT MAINTENANCE] %s - Scheduled maintenance in %s at %s", key, duration, nextMaintenance.UTC())
+	c.maintenanceLogger.Infof("[SHOOT MAINTENANCE] %s - Scheduled maintenance in %s at %s", key, duration, nextMaintenance.UTC())
 	c.shootMaintenanceQueue.AddAfter(key, duration)
 }
 
@@ -129,14 +242,16 @@ func (c *Controller) shootMaintenanceRequeue(key string, maintenanceTimeWindow *
 // for extensions that provide different semantics. Currently, there is only one implementation.
 type MaintenanceControlInterface interface {
 	Maintain(shoot *gardenv1beta1.Shoot, key string) error
+	PlanMaintenance(shoot *gardenv1beta1.Shoot, key string) error
+	UpdateMachineImage(shoot *gardenv1beta1.Shoot, key string) error
 }
 
 // NewDefaultMaintenanceControl returns a new instance of the default implementation MaintenanceControlInterface that
 // implements the documented semantics for maintaining Shoots. updater is the UpdaterInterface used
 // to update the spec of Shoots. You should use an instance returned from NewDefaultMaintenanceControl() for any
 // scenario other than testing.
-func NewDefaultMaintenanceControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.Interface, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, identity *gardenv1beta1.Gardener, recorder record.EventRecorder) MaintenanceControlInterface {
-	return &defaultMaintenanceControl{k8sGardenClient, k8sGardenInformers, secrets, imageVector, identity, recorder}
+func NewDefaultMaintenanceControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.Interface, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, identity *gardenv1beta1.Gardener, recorder record.EventRecorder, maintenanceLogger *logrus.Logger) MaintenanceControlInterface {
+	return &defaultMaintenanceControl{k8sGardenClient, k8sGardenInformers, secrets, imageVector, identity, recorder, maintenanceLogger}
 }
 
 type defaultMaintenanceControl struct {
@@ -146,6 +261,7 @@ type defaultMaintenanceControl struct {
 	imageVector        imagevector.ImageVector
 	identity           *gardenv1beta1.Gardener
 	recorder           record.EventRecorder
+	logger             *logrus.Logger
 }
 
 func (c *defaultMaintenanceControl) Maintain(shootObj *gardenv1beta1.Shoot, key string) error {
@@ -156,7 +272,7 @@ func (c *defaultMaintenanceControl) Maintain(shootObj *gardenv1beta1.Shoot, key
 
 	var (
 		shoot       = shootObj.DeepCopy()
-		shootLogger = logger.NewShootLogger(logger.Logger, shoot.Name, shoot.Namespace, operationID)
+		shootLogger = logger.NewShootLogger(c.logger, shoot.Name, shoot.Namespace, operationID)
 		handleError = func(msg string) {
 			c.recorder.Eventf(shoot, corev1.EventTypeWarning, gardenv1beta1.ShootEventMaintenanceError, "[%s] %s", operationID, msg)
 			shootLogger.Error(msg)
@@ -165,38 +281,29 @@ func (c *defaultMaintenanceControl) Maintain(shootObj *gardenv1beta1.Shoot, key
 
 	shootLogger.Infof("[SHOOT MAINTENANCE] %s", key)
 
-	operation, err := operation.New(shoot, shootLogger, c.k8sGardenClient, c.k8sGardenInformers, c.identity, c.secrets, c.imageVector, nil)
+	operation, err := operation.New(shoot, shootLogger, c.k8sGardenClient, c.k8sGardenInformers, c.identity, c.secrets, c.imageVector, nil, nil, nil)
 	if err != nil {
 		handleError(fmt.Sprintf("Could not initialize a new operation: %s", err.Error()))
 		return nil
 	}
 
-	// Check if the CloudProfile contains another version of the machine image.
-	machineImageFound, machineImage, err := helper.DetermineMachineImage(*operation.Shoot.CloudProfile, operation.Shoot.GetMachineImageName(), shoot.Spec.Cloud.Region)
+	updates, err := determineMaintenanceUpdates(operation, time.Now())
 	if err != nil {
-		handleError(fmt.Sprintf("Failure while determining the machine image in the CloudProfile: %s", err.Error()))
+		handleError(fmt.Sprintf("Failure while determining the updates of the Shoot: %s", err.Error()))
 		return nil
 	}
-
-	var updateMachineImage func(s *gardenv1beta1.Cloud)
-	if machineImageFound {
-		updateMachineImage = helper.UpdateMachineImage(operation.Shoot.CloudProvider, machineImage)
+	for _, msg := range updates.warnings {
+		c.recorder.Eventf(shoot, corev1.EventTypeWarning, gardenv1beta1.ShootEventMaintenanceError, "[%s] %s", operationID, msg)
+		shootLogger.Warn(msg)
 	}
 
-	// Check if the CloudProfile contains a newer Kubernetes patch version.
-	var updateKubernetesVersion func(s *gardenv1beta1.Kubernetes)
-	if shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion {
-		newerPatchVersionFound, latestPatchVersion, err := helper.DetermineLatestKubernetesVersion(*operation.Shoot.CloudProfile, operation.Shoot.Info.Spec.Kubernetes.Version)
-		if err != nil {
-			handleError(fmt.Sprintf("Failure while determining the latest Kubernetes patch version in the CloudProfile: %s", err.Error()))
-			return nil
-		}
-		if newerPatchVersionFound {
-			updateKubernetesVersion = func(s *gardenv1beta1.Kubernetes) { s.Version = latestPatchVersion }
-		}
+	var updateMachineImage func(s *gardenv1beta1.Cloud)
+	if updates.machineImage != nil {
+		updateMachineImage = helper.UpdateMachineImage(operation.Shoot.CloudProvider, updates.machineImage)
 	}
 
 	// Update the Shoot resource object.
+	var executedOperations []string
 	_, err = kutil.TryUpdateShoot(c.k8sGardenClient.Garden(), retry.DefaultBackoff, shoot.ObjectMeta, func(s *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
 		if !apiequality.Semantic.DeepEqual(shootObj.Spec.Maintenance.AutoUpdate, s.Spec.Maintenance.AutoUpdate) {
 			return nil, fmt.Errorf("auto update section of Shoot %s/%s changed mid-air", s.Namespace, s.Name)
@@ -210,8 +317,11 @@ func (c *defaultMaintenanceControl) Maintain(shootObj *gardenv1beta1.Shoot, key
 		if updateMachineImage != nil {
 			updateMachineImage(&s.Spec.Cloud)
 		}
-		if updateKubernetesVersion != nil {
-			updateKubernetesVersion(&s.Spec.Kubernetes)
+		if len(updates.kubernetesVersion) > 0 {
+			s.Spec.Kubernetes.Version = updates.kubernetesVersion
+		}
+		if s.Spec.Maintenance != nil {
+			executedOperations = ApplyMaintenanceOperations(s, s.Spec.Maintenance.Operations)
 		}
 		return s, nil
 	})
@@ -220,17 +330,366 @@ func (c *defaultMaintenanceControl) Maintain(shootObj *gardenv1beta1.Shoot, key
 		return nil
 	}
 	msg := "Completed; updated the Shoot specification successfully."
+	if len(executedOperations) > 0 {
+		msg = fmt.Sprintf("Completed; updated the Shoot specification successfully and triggered the maintenance operations %s.", strings.Join(executedOperations, ", "))
+	}
+	shootLogger.Infof("[SHOOT MAINTENANCE] %s", msg)
+	c.recorder.Eventf(shoot, corev1.EventTypeNormal, gardenv1beta1.ShootEventMaintenanceDone, "[%s] %s", operationID, msg)
+
+	return nil
+}
+
+func (c *defaultMaintenanceControl) UpdateMachineImage(shootObj *gardenv1beta1.Shoot, key string) error {
+	operationID, err := utils.GenerateRandomString(8)
+	if err != nil {
+		return err
+	}
+
+	var (
+		shoot       = shootObj.DeepCopy()
+		shootLogger = logger.NewShootLogger(c.logger, shoot.Name, shoot.Namespace, operationID)
+		handleError = func(msg string) {
+			c.recorder.Eventf(shoot, corev1.EventTypeWarning, gardenv1beta1.ShootEventMaintenanceError, "[%s] %s", operationID, msg)
+			shootLogger.Error(msg)
+		}
+	)
+
+	shootLogger.Infof("[SHOOT MAINTENANCE] %s - updating the machine image", key)
+
+	cloudProfile, err := c.k8sGardenInformers.CloudProfiles().Lister().Get(shoot.Spec.Cloud.Profile)
+	if err != nil {
+		handleError(fmt.Sprintf("Could not retrieve the CloudProfile: %s", err.Error()))
+		return err
+	}
+	cloudProvider, err := helper.DetermineCloudProviderInShoot(shoot.Spec.Cloud)
+	if err != nil {
+		handleError(fmt.Sprintf("Could not determine the cloud provider: %s", err.Error()))
+		return nil
+	}
+
+	machineImageFound, machineImage, err := helper.DetermineMachineImage(*cloudProfile, helper.GetMachineImageNameFromShoot(cloudProvider, shoot), shoot.Spec.Cloud.Region)
+	if err != nil {
+		handleError(fmt.Sprintf("Could not determine the machine image in the CloudProfile: %s", err.Error()))
+		return nil
+	}
+	if !machineImageFound {
+		return nil
+	}
+
+	updateMachineImage := helper.UpdateMachineImage(cloudProvider, machineImage)
+	if _, err := kutil.TryUpdateShoot(c.k8sGardenClient.Garden(), retry.DefaultBackoff, shoot.ObjectMeta, func(s *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
+		updateMachineImage(&s.Spec.Cloud)
+		return s, nil
+	}); err != nil {
+		handleError(fmt.Sprintf("Could not update the machine image of the Shoot: %s", err.Error()))
+		return nil
+	}
+
+	msg := fmt.Sprintf("Completed; updated the vulnerable machine image to %s.", helper.GetMachineImageID(machineImage))
 	shootLogger.Infof("[SHOOT MAINTENANCE] %s", msg)
 	c.recorder.Eventf(shoot, corev1.EventTypeNormal, gardenv1beta1.ShootEventMaintenanceDone, "[%s] %s", operationID, msg)
 
 	return nil
 }
 
+func (c *defaultMaintenanceControl) PlanMaintenance(shootObj *gardenv1beta1.Shoot, key string) error {
+	var (
+		shoot       = shootObj.DeepCopy()
+		shootLogger = logger.NewShootLogger(c.logger, shoot.Name, shoot.Namespace, "")
+	)
+
+	shootLogger.Infof("[SHOOT MAINTENANCE PLAN] %s", key)
+
+	operation, err := operation.New(shoot, shootLogger, c.k8sGardenClient, c.k8sGardenInformers, c.identity, c.secrets, c.imageVector, nil, nil, nil)
+	if err != nil {
+		shootLogger.Errorf("Could not initialize a new operation: %s", err.Error())
+		return err
+	}
+
+	updates, err := determineMaintenanceUpdates(operation, time.Now())
+	if err != nil {
+		message := fmt.Sprintf("Could not compute the changes of a maintenance: %s", err.Error())
+		shootLogger.Error(message)
+		c.recorder.Event(shoot, corev1.EventTypeWarning, "MaintenancePlanFailed", message)
+		return err
+	}
+
+	newShoot, err := kutil.TryUpdateShootStatus(c.k8sGardenClient.Garden(), retry.DefaultRetry, shoot.ObjectMeta,
+		func(s *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
+			s.Status.MaintenancePlan = &gardenv1beta1.ShootPlan{
+				ComputedTime: metav1.Now(),
+				Generation:   s.Generation,
+				Changes:      PlanMaintenanceChanges(shoot, operation.Shoot.CloudProvider, updates.machineImage, updates.kubernetesVersion),
+			}
+			return s, nil
+		})
+	if err != nil {
+		return err
+	}
+
+	_, err = kutil.TryUpdateShootAnnotations(c.k8sGardenClient.Garden(), retry.DefaultRetry, newShoot.ObjectMeta,
+		func(s *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
+			if s.Annotations[common.ShootOperation] == common.ShootOperationPlanMaintenance {
+				delete(s.Annotations, common.ShootOperation)
+			}
+			return s, nil
+		})
+	return err
+}
+
+// maintenanceUpdates contains the updates of the Shoot specification which are applied by a maintenance.
+type maintenanceUpdates struct {
+	// machineImage is the machine image offered in the CloudProfile, or nil if there is none.
+	machineImage interface{}
+	// kubernetesVersion is the Kubernetes version to update to, or an empty string if it is not updated.
+	kubernetesVersion string
+	// warnings are messages about updates which are skipped.
+	warnings []string
+}
+
+func determineMaintenanceUpdates(o *operation.Operation, now time.Time) (*maintenanceUpdates, error) {
+	var (
+		shoot   = o.Shoot.Info
+		updates = &maintenanceUpdates{}
+	)
+
+	// Check if the CloudProfile contains another version of the machine image.
+	machineImageFound, machineImage, err := helper.DetermineMachineImage(*o.Shoot.CloudProfile, o.Shoot.GetMachineImageName(), shoot.Spec.Cloud.Region)
+	if err != nil {
+		return nil, fmt.Errorf("could not determine the machine image in the CloudProfile: %v", err)
+	}
+	if machineImageFound {
+		updates.machineImage = machineImage
+	}
+
+	// Check if the CloudProfile contains a newer Kubernetes version the Shoot shall be updated to.
+	targetVersion, err := DetermineKubernetesVersionUpdate(shoot, *o.Shoot.CloudProfile, now)
+	if err != nil {
+		return nil, fmt.Errorf("could not determine the Kubernetes version to update to in the CloudProfile: %v", err)
+	}
+	if len(targetVersion) > 0 {
+		if err := validateKubernetesVersionCompatibility(shoot.Spec.Kubernetes, targetVersion).ToAggregate(); err != nil {
+			updates.warnings = append(updates.warnings, fmt.Sprintf("Not updating the Kubernetes version to %s because the Shoot's configuration is not supported by it: %s", targetVersion, err.Error()))
+		} else {
+			updates.kubernetesVersion = targetVersion
+		}
+	}
+
+	return updates, nil
+}
+
+// PlanMaintenanceChanges returns the changes which a maintenance of the given Shoot would make if it updated the
+// machine image to the given <machineImage> and the Kubernetes version to the given <kubernetesVersion> (both optional).
+func PlanMaintenanceChanges(shoot *gardenv1beta1.Shoot, cloudProvider gardenv1beta1.CloudProvider, machineImage interface{}, kubernetesVersion string) []gardenv1beta1.PlannedChange {
+	var changes []gardenv1beta1.PlannedChange
+
+	if machineImage != nil {
+		var (
+			currentImageID = helper.GetMachineImageID(helper.GetMachineImageFromShoot(cloudProvider, shoot))
+			desiredImageID = helper.GetMachineImageID(machineImage)
+		)
+		if currentImageID != desiredImageID {
+			changes = append(changes, gardenv1beta1.PlannedChange{
+				Type:    gardenv1beta1.PlannedChangeMachineImage,
+				Target:  string(helper.GetMachineImageNameFromShoot(cloudProvider, shoot)),
+				Current: currentImageID,
+				Desired: desiredImageID,
+				Message: "The machine image would be updated.",
+			})
+		}
+	}
+
+	if len(kubernetesVersion) > 0 && kubernetesVersion != shoot.Spec.Kubernetes.Version {
+		changes = append(changes, gardenv1beta1.PlannedChange{
+			Type:    gardenv1beta1.PlannedChangeKubernetesVersion,
+			Target:  "kubernetes",
+			Current: shoot.Spec.Kubernetes.Version,
+			Desired: kubernetesVersion,
+			Message: "The Kubernetes version would be updated.",
+		})
+	}
+
+	if shoot.Spec.Maintenance != nil {
+		dryRun := shoot.DeepCopy()
+		if dryRun.Annotations == nil {
+			dryRun.Annotations = map[string]string{}
+		}
+		for _, name := range ApplyMaintenanceOperations(dryRun, shoot.Spec.Maintenance.Operations) {
+			changes = append(changes, gardenv1beta1.PlannedChange{
+				Type:    gardenv1beta1.PlannedChangeMaintenanceOperation,
+				Target:  name,
+				Message: "The maintenance operation would be triggered.",
+			})
+		}
+	}
+
+	return changes
+}
+
+// maintenanceOperation prepares the given Shoot such that the reconciliation triggered by its maintenance executes
+// the operation.
+type maintenanceOperation func(shoot *gardenv1beta1.Shoot)
+
+// maintenanceOperations contains the maintenance operations which can be requested in the maintenance section of a Shoot.
+// Credentials rotations are not part of it as their names are derived from the class of the credentials.
+var maintenanceOperations = map[string]maintenanceOperation{
+	common.ShootMaintenanceOperationRestartControlPlane: func(shoot *gardenv1beta1.Shoot) {
+		controllerutils.AddTasks(shoot.Annotations, common.ShootTaskRestartControlPlane)
+	},
+}
+
+func getMaintenanceOperation(name string) (maintenanceOperation, bool) {
+	if operation, ok := maintenanceOperations[name]; ok {
+		return operation, true
+	}
+	if credentials := strings.TrimPrefix(name, common.ShootOperationRotateCredentialsPrefix); credentials != name && common.RotatableShootCredentials.Has(credentials) {
+		// Rotating credentials also triggers a reconciliation, hence it replaces the reconcile operation.
+		return func(shoot *gardenv1beta1.Shoot) {
+			shoot.Annotations[common.ShootOperation] = name
+		}, true
+	}
+	return nil, false
+}
+
+// ApplyMaintenanceOperations applies the given maintenance <operations> in order to the given Shoot, whose annotations
+// must not be nil. Unknown operations are skipped. It returns the names of the applied operations.
+func ApplyMaintenanceOperations(shoot *gardenv1beta1.Shoot, operations []string) []string {
+	var applied []string
+	for _, name := range operations {
+		operation, ok := getMaintenanceOperation(name)
+		if !ok {
+			continue
+		}
+		operation(shoot)
+		applied = append(applied, name)
+	}
+	return applied
+}
+
+// DetermineKubernetesVersionUpdate returns the Kubernetes version offered by the <cloudProfile> to which the given Shoot
+// shall be updated during its maintenance, or an empty string if it shall not be updated. Shoots whose minor version has
+// expired at <now> as well as Shoots with the "minor" version policy are updated to the next minor version. As minor
+// versions must not be skipped, this is at most one minor version per maintenance. Otherwise, Shoots which enabled the
+// automatic update of the Kubernetes version are updated to the latest patch version.
+func DetermineKubernetesVersionUpdate(shoot *gardenv1beta1.Shoot, cloudProfile gardenv1beta1.CloudProfile, now time.Time) (string, error) {
+	var (
+		currentVersion = shoot.Spec.Kubernetes.Version
+		autoUpdate     = shoot.Spec.Maintenance != nil && shoot.Spec.Maintenance.AutoUpdate != nil && shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion
+		minorPolicy    = autoUpdate && shoot.Spec.Maintenance.AutoUpdate.KubernetesVersionPolicy != nil && *shoot.Spec.Maintenance.AutoUpdate.KubernetesVersionPolicy == gardenv1beta1.KubernetesVersionPolicyMinor
+	)
+
+	expired, err := helper.IsKubernetesMinorVersionExpired(cloudProfile, currentVersion, now)
+	if err != nil {
+		return "", err
+	}
+
+	if expired || minorPolicy {
+		nextMinorVersionFound, nextMinorVersion, err := helper.DetermineNextMinorKubernetesVersion(cloudProfile, currentVersion)
+		if err != nil {
+			return "", err
+		}
+		if nextMinorVersionFound {
+			return nextMinorVersion, nil
+		}
+	}
+
+	if autoUpdate {
+		newerPatchVersionFound, latestPatchVersion, err := helper.DetermineLatestKubernetesVersion(cloudProfile, currentVersion)
+		if err != nil {
+			return "", err
+		}
+		if newerPatchVersionFound {
+			return latestPatchVersion, nil
+		}
+	}
+
+	return "", nil
+}
+
+// validateKubernetesVersionCompatibility validates that the feature gates of all components as well as the admission
+// plugins and the runtime configuration of the kube-apiserver of the Shoot are supported by the given Kubernetes version.
+func validateKubernetesVersionCompatibility(kubernetes gardenv1beta1.Kubernetes, version string) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		fldPath = field.NewPath("spec", "kubernetes")
+	)
+
+	if kubeAPIServer := kubernetes.KubeAPIServer; kubeAPIServer != nil {
+		var plugins []string
+		for _, plugin := range kubeAPIServer.AdmissionPlugins {
+			plugins = append(plugins, plugin.Name)
+		}
+
+		allErrs = append(allErrs, compatibility.ValidateFeatureGates(kubeAPIServer.FeatureGates, version, fldPath.Child("kubeAPIServer", "featureGates"))...)
+		allErrs = append(allErrs, compatibility.ValidateAdmissionPlugins(plugins, version, fldPath.Child("kubeAPIServer", "admissionPlugins"))...)
+		allErrs = append(allErrs, compatibility.ValidateRuntimeConfig(kubeAPIServer.RuntimeConfig, version, fldPath.Child("kubeAPIServer", "runtimeConfig"))...)
+	}
+	if cloudControllerManager := kubernetes.CloudControllerManager; cloudControllerManager != nil {
+		allErrs = append(allErrs, compatibility.ValidateFeatureGates(cloudControllerManager.FeatureGates, version, fldPath.Child("cloudControllerManager", "featureGates"))...)
+	}
+	if kubeControllerManager := kubernetes.KubeControllerManager; kubeControllerManager != nil {
+		allErrs = append(allErrs, compatibility.ValidateFeatureGates(kubeControllerManager.FeatureGates, version, fldPath.Child("kubeControllerManager", "featureGates"))...)
+	}
+	if kubeScheduler := kubernetes.KubeScheduler; kubeScheduler != nil {
+		allErrs = append(allErrs, compatibility.ValidateFeatureGates(kubeScheduler.FeatureGates, version, fldPath.Child("kubeScheduler", "featureGates"))...)
+	}
+	if kubeProxy := kubernetes.KubeProxy; kubeProxy != nil {
+		allErrs = append(allErrs, compatibility.ValidateFeatureGates(kubeProxy.FeatureGates, version, fldPath.Child("kubeProxy", "featureGates"))...)
+	}
+	if kubelet := kubernetes.Kubelet; kubelet != nil {
+		allErrs = append(allErrs, compatibility.ValidateFeatureGates(kubelet.FeatureGates, version, fldPath.Child("kubelet", "featureGates"))...)
+	}
+
+	return allErrs
+}
+
 func mustMaintainNow(shoot *gardenv1beta1.Shoot, maintenanceTimeWindow *utils.MaintenanceTimeWindow, now time.Time) bool {
 	return hasMaintainNowAnnotation(shoot) || maintenanceTimeWindow.Contains(now)
 }
 
+// mustUpdateVulnerableMachineImage returns true if the Shoot opted in for forced machine image updates, if its current
+// machine image is affected by a vulnerability with at least the configured severity, and if the CloudProfile offers
+// another image to which it can be updated.
+func mustUpdateVulnerableMachineImage(shoot *gardenv1beta1.Shoot, cloudProfile *gardenv1beta1.CloudProfile) (bool, error) {
+	severity := getMachineImageVulnerabilitySeverity(shoot)
+	if severity == nil {
+		return false, nil
+	}
+
+	cloudProvider, err := helper.DetermineCloudProviderInShoot(shoot.Spec.Cloud)
+	if err != nil {
+		return false, err
+	}
+
+	var (
+		machineImageName = helper.GetMachineImageNameFromShoot(cloudProvider, shoot)
+		currentImageID   = helper.GetMachineImageID(helper.GetMachineImageFromShoot(cloudProvider, shoot))
+	)
+
+	if !helper.HasVulnerabilityWithSeverity(helper.GetMachineImageVulnerabilities(*cloudProfile, machineImageName, currentImageID), *severity) {
+		return false, nil
+	}
+
+	machineImageFound, machineImage, err := helper.DetermineMachineImage(*cloudProfile, machineImageName, shoot.Spec.Cloud.Region)
+	if err != nil || !machineImageFound {
+		return false, err
+	}
+
+	return helper.GetMachineImageID(machineImage) != currentImageID, nil
+}
+
+func getMachineImageVulnerabilitySeverity(shoot *gardenv1beta1.Shoot) *gardenv1beta1.VulnerabilitySeverity {
+	if shoot.Spec.Maintenance == nil || shoot.Spec.Maintenance.AutoUpdate == nil {
+		return nil
+	}
+	return shoot.Spec.Maintenance.AutoUpdate.MachineImageVulnerabilitySeverity
+}
+
 func hasMaintainNowAnnotation(shoot *gardenv1beta1.Shoot) bool {
 	operation, ok := shoot.Annotations[common.ShootOperation]
 	return ok && operation == common.ShootOperationMaintain
 }
+
+func hasPlanMaintenanceAnnotation(shoot *gardenv1beta1.Shoot) bool {
+	return shoot.Annotations[common.ShootOperation] == common.ShootOperationPlanMaintenance
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot_test
+
+import (
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Shoot Maintenance", func() {
+	Describe("#ApplyMaintenanceOperations", func() {
+		var shoot *gardenv1beta1.Shoot
+
+		BeforeEach(func() {
+			shoot = &gardenv1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						common.ShootOperation: common.ShootOperationReconcile,
+						common.ShootTasks:     common.ShootTaskDeployInfrastructure,
+					},
+				},
+			}
+		})
+
+		It("should apply the operations in order", func() {
+			applied := ApplyMaintenanceOperations(shoot, []string{"restart-control-plane", "rotate-ssh-keypair"})
+
+			Expect(applied).To(Equal([]string{"restart-control-plane", "rotate-ssh-keypair"}))
+			Expect(shoot.Annotations).To(Equal(map[string]string{
+				common.ShootOperation: "rotate-ssh-keypair",
+				common.ShootTasks:     common.ShootTaskDeployInfrastructure + "," + common.ShootTaskRestartControlPlane,
+			}))
+		})
+
+		It("should skip unknown operations", func() {
+			applied := ApplyMaintenanceOperations(shoot, []string{"rotate-ca", "reboot-nodes"})
+
+			Expect(applied).To(BeEmpty())
+			Expect(shoot.Annotations).To(Equal(map[string]string{
+				common.ShootOperation: common.ShootOperationReconcile,
+				common.ShootTasks:     common.ShootTaskDeployInfrastructure,
+			}))
+		})
+	})
+
+	Describe("#DetermineKubernetesVersionUpdate", func() {
+		var (
+			now          = time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+			minor        = gardenv1beta1.KubernetesVersionPolicyMinor
+			shoot        *gardenv1beta1.Shoot
+			cloudProfile gardenv1beta1.CloudProfile
+		)
+
+		BeforeEach(func() {
+			shoot = &gardenv1beta1.Shoot{
+				Spec: gardenv1beta1.ShootSpec{
+					Kubernetes: gardenv1beta1.Kubernetes{Version: "1.12.1"},
+					Maintenance: &gardenv1beta1.Maintenance{
+						AutoUpdate: &gardenv1beta1.MaintenanceAutoUpdate{KubernetesVersion: true},
+					},
+				},
+			}
+			cloudProfile = gardenv1beta1.CloudProfile{
+				Spec: gardenv1beta1.CloudProfileSpec{
+					AWS: &gardenv1beta1.AWSProfile{
+						Constraints: gardenv1beta1.AWSConstraints{
+							Kubernetes: gardenv1beta1.KubernetesConstraints{
+								Versions: []string{"1.12.1", "1.12.3", "1.12.9", "1.13.2", "1.13.10", "1.13.4", "1.14.1"},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should update to the latest patch version", func() {
+			Expect(DetermineKubernetesVersionUpdate(shoot, cloudProfile, now)).To(Equal("1.12.9"))
+		})
+
+		It("should not update if the automatic update is disabled", func() {
+			shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = false
+
+			Expect(DetermineKubernetesVersionUpdate(shoot, cloudProfile, now)).To(BeEmpty())
+		})
+
+		It("should update to the latest version of the next minor version with the minor policy", func() {
+			shoot.Spec.Maintenance.AutoUpdate.KubernetesVersionPolicy = &minor
+
+			Expect(DetermineKubernetesVersionUpdate(shoot, cloudProfile, now)).To(Equal("1.13.10"))
+		})
+
+		It("should update to the latest patch version with the minor policy if the next minor version is not offered", func() {
+			shoot.Spec.Maintenance.AutoUpdate.KubernetesVersionPolicy = &minor
+			cloudProfile.Spec.AWS.Constraints.Kubernetes.Versions = []string{"1.12.1", "1.12.3"}
+
+			Expect(DetermineKubernetesVersionUpdate(shoot, cloudProfile, now)).To(Equal("1.12.3"))
+		})
+
+		It("should update expired minor versions even if the automatic update is disabled", func() {
+			shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = false
+			cloudProfile.Spec.AWS.Constraints.Kubernetes.MinorVersionExpirations = []gardenv1beta1.KubernetesMinorVersionExpiration{
+				{Version: "1.12", ExpirationDate: metav1.NewTime(now.Add(-time.Hour))},
+			}
+
+			Expect(DetermineKubernetesVersionUpdate(shoot, cloudProfile, now)).To(Equal("1.13.10"))
+		})
+
+		It("should not update minor versions which expire in the future", func() {
+			shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = false
+			cloudProfile.Spec.AWS.Constraints.Kubernetes.MinorVersionExpirations = []gardenv1beta1.KubernetesMinorVersionExpiration{
+				{Version: "1.12", ExpirationDate: metav1.NewTime(now.Add(time.Hour))},
+			}
+
+			Expect(DetermineKubernetesVersionUpdate(shoot, cloudProfile, now)).To(BeEmpty())
+		})
+	})
+
+	Describe("#PlanMaintenanceChanges", func() {
+		var shoot *gardenv1beta1.Shoot
+
+		BeforeEach(func() {
+			shoot = &gardenv1beta1.Shoot{
+				Spec: gardenv1beta1.ShootSpec{
+					Cloud: gardenv1beta1.Cloud{
+						AWS: &gardenv1beta1.AWSCloud{
+							MachineImage: &gardenv1beta1.AWSMachineImage{Name: gardenv1beta1.MachineImageCoreOS, AMI: "ami-1"},
+						},
+					},
+					Kubernetes: gardenv1beta1.Kubernetes{Version: "1.12.1"},
+					Maintenance: &gardenv1beta1.Maintenance{
+						Operations: []string{"restart-control-plane"},
+					},
+				},
+			}
+		})
+
+		It("should plan the updates of the machine image and the Kubernetes version as well as the operations", func() {
+			machineImage := &gardenv1beta1.AWSMachineImage{Name: gardenv1beta1.MachineImageCoreOS, AMI: "ami-2"}
+
+			Expect(PlanMaintenanceChanges(shoot, gardenv1beta1.CloudProviderAWS, machineImage, "1.12.3")).To(Equal([]gardenv1beta1.PlannedChange{
+				{Type: gardenv1beta1.PlannedChangeMachineImage, Target: "coreos", Current: "ami-1", Desired: "ami-2", Message: "The machine image would be updated."},
+				{Type: gardenv1beta1.PlannedChangeKubernetesVersion, Target: "kubernetes", Current: "1.12.1", Desired: "1.12.3", Message: "The Kubernetes version would be updated."},
+				{Type: gardenv1beta1.PlannedChangeMaintenanceOperation, Target: "restart-control-plane", Message: "The maintenance operation would be triggered."},
+			}))
+			Expect(shoot.Annotations).To(BeEmpty())
+		})
+
+		It("should not plan updates to the current machine image and Kubernetes version", func() {
+			shoot.Spec.Maintenance.Operations = nil
+			machineImage := &gardenv1beta1.AWSMachineImage{Name: gardenv1beta1.MachineImageCoreOS, AMI: "ami-1"}
+
+			Expect(PlanMaintenanceChanges(shoot, gardenv1beta1.CloudProviderAWS, machineImage, "")).To(BeEmpty())
+		})
+	})
+
+	Describe("#CountReconcilingShoots", func() {
+		It("should count the Shoots which are being reconciled or whose specification has changed", func() {
+			var (
+				shoot  = &gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Namespace: "garden-dev", Name: "maintained", Generation: 2}}
+				shoots = []*gardenv1beta1.Shoot{
+					shoot,
+					{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "garden-dev", Name: "processing", Generation: 1},
+						Status: gardenv1beta1.ShootStatus{
+							ObservedGeneration: 1,
+							LastOperation:      &gardenv1beta1.LastOperation{State: gardenv1beta1.ShootLastOperationStateProcessing},
+						},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "garden-dev", Name: "changed", Generation: 2},
+						Status: gardenv1beta1.ShootStatus{
+							ObservedGeneration: 1,
+							LastOperation:      &gardenv1beta1.LastOperation{State: gardenv1beta1.ShootLastOperationStateSucceeded},
+						},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "garden-dev", Name: "succeeded", Generation: 1},
+						Status: gardenv1beta1.ShootStatus{
+							ObservedGeneration: 1,
+							LastOperation:      &gardenv1beta1.LastOperation{State: gardenv1beta1.ShootLastOperationStateSucceeded},
+						},
+					},
+				}
+			)
+
+			Expect(CountReconcilingShoots(shoots, shoot)).To(Equal(2))
+		})
+	})
+})
@@ -1,6 +1,8 @@
 package shoot
 
 import (
+	"fmt"
+	"strconv"
 	"time"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
@@ -8,9 +10,11 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation/common"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 )
 
 func (c *Controller) shootQuotaAdd(obj interface{}) {
@@ -21,6 +25,21 @@ func (c *Controller) shootQuotaAdd(obj interface{}) {
 	c.shootQuotaQueue.Add(key)
 }
 
+func (c *Controller) shootQuotaUpdate(oldObj, newObj interface{}) {
+	oldShoot, ok1 := oldObj.(*gardenv1beta1.Shoot)
+	newShoot, ok2 := newObj.(*gardenv1beta1.Shoot)
+	if !ok1 || !ok2 {
+		return
+	}
+
+	// Lifetime extension requests are processed immediately, all other changes are considered with the next sync.
+	request, ok := newShoot.Annotations[common.ShootLifetimeExtensionRequest]
+	if !ok || request == oldShoot.Annotations[common.ShootLifetimeExtensionRequest] {
+		return
+	}
+	c.shootQuotaAdd(newObj)
+}
+
 func (c *Controller) shootQuotaDelete(obj interface{}) {
 	shoot, ok := obj.(*gardenv1beta1.Shoot)
 	if shoot == nil || !ok {
@@ -49,6 +68,12 @@ func (c *Controller) reconcileShootQuotaKey(key string) error {
 		return err
 	}
 
+	if !c.seedFilter.ShootMatches(shoot) {
+		logger.Logger.Debugf("[SHOOT QUOTA] %s - skipping because the Seed of the Shoot is not selected by the seed selector", key)
+		c.shootQuotaQueue.AddAfter(key, c.config.Controllers.ShootQuota.SyncPeriod.Duration)
+		return nil
+	}
+
 	if err := c.quotaControl.CheckQuota(shoot, key); err != nil {
 		c.shootQuotaQueue.AddAfter(key, 2*time.Minute)
 		return nil
@@ -65,20 +90,22 @@ type QuotaControlInterface interface {
 
 // NewDefaultQuotaControl returns a new instance of the default implementation of QuotaControlInterface
 // which implements the semantics for controlling the quota handling of Shoot resources.
-func NewDefaultQuotaControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.Interface) QuotaControlInterface {
-	return &defaultQuotaControl{k8sGardenClient, k8sGardenInformers}
+func NewDefaultQuotaControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.Interface, recorder record.EventRecorder) QuotaControlInterface {
+	return &defaultQuotaControl{k8sGardenClient, k8sGardenInformers, recorder}
 }
 
 type defaultQuotaControl struct {
 	k8sGardenClient    kubernetes.Interface
 	k8sGardenInformers gardeninformers.Interface
+	recorder           record.EventRecorder
 }
 
 func (c *defaultQuotaControl) CheckQuota(shootObj *gardenv1beta1.Shoot, key string) error {
 	var (
-		clusterLifeTime *int
-		shoot           = shootObj.DeepCopy()
-		shootLogger     = logger.NewShootLogger(logger.Logger, shoot.Name, shoot.Namespace, "")
+		clusterLifeTime  *int
+		maxExtensionDays *int
+		shoot            = shootObj.DeepCopy()
+		shootLogger      = logger.NewShootLogger(logger.Logger, shoot.Name, shoot.Namespace, "")
 	)
 
 	secretBinding, err := c.k8sGardenInformers.SecretBindings().Lister().SecretBindings(shoot.Namespace).Get(shoot.Spec.Cloud.SecretBindingRef.Name)
@@ -97,11 +124,24 @@ func (c *defaultQuotaControl) CheckQuota(shootObj *gardenv1beta1.Shoot, key stri
 		if clusterLifeTime == nil || *quota.Spec.ClusterLifetimeDays < *clusterLifeTime {
 			clusterLifeTime = quota.Spec.ClusterLifetimeDays
 		}
+
+		// Quotas without a maximum extension do not allow any extension of the lifetime.
+		days := 0
+		if quota.Spec.MaxClusterLifetimeExtensionDays != nil {
+			days = *quota.Spec.MaxClusterLifetimeExtensionDays
+		}
+		if maxExtensionDays == nil || days < *maxExtensionDays {
+			maxExtensionDays = &days
+		}
 	}
 
 	// If the Shoot has no Quotas referenced (anymore) or if the referenced Quotas does not have a clusterLifetime,
 	// then we will not check for cluster lifetime expiration, even if the Shoot has a clusterLifetime timestamp already annotated.
 	if clusterLifeTime == nil {
+		if request, ok := shoot.Annotations[common.ShootLifetimeExtensionRequest]; ok {
+			_, _, err := c.processLifetimeExtensionRequest(shoot, request, time.Time{}, nil, 0)
+			return err
+		}
 		return nil
 	}
 
@@ -124,6 +164,13 @@ func (c *defaultQuotaControl) CheckQuota(shootObj *gardenv1beta1.Shoot, key stri
 		return err
 	}
 
+	if request, ok := shoot.Annotations[common.ShootLifetimeExtensionRequest]; ok {
+		shoot, expirationTimeParsed, err = c.processLifetimeExtensionRequest(shoot, request, expirationTimeParsed, clusterLifeTime, *maxExtensionDays)
+		if err != nil {
+			return err
+		}
+	}
+
 	if time.Now().After(expirationTimeParsed) {
 		shootLogger.Info("[SHOOT QUOTA] Shoot cluster lifetime expired. Shoot will be deleted.")
 
@@ -143,3 +190,70 @@ func (c *defaultQuotaControl) CheckQuota(shootObj *gardenv1beta1.Shoot, key stri
 	}
 	return nil
 }
+
+// processLifetimeExtensionRequest removes the lifetime extension <request> from the given Shoot and extends its
+// expiration timestamp if the request is valid. The outcome is reported as event of the Shoot. It returns the updated
+// Shoot and its (possibly extended) expiration time.
+func (c *defaultQuotaControl) processLifetimeExtensionRequest(shoot *gardenv1beta1.Shoot, request string, expirationTime time.Time, clusterLifetimeDays *int, maxExtensionDays int) (*gardenv1beta1.Shoot, time.Time, error) {
+	var (
+		extendedExpirationTime time.Time
+		denialReason           error
+	)
+
+	days, err := strconv.Atoi(request)
+	switch {
+	case err != nil || days <= 0:
+		denialReason = fmt.Errorf("%q is not a positive number of days", request)
+	case clusterLifetimeDays == nil:
+		denialReason = fmt.Errorf("the lifetime of the Shoot is not limited")
+	default:
+		extendedExpirationTime, denialReason = ExtendShootExpirationTime(shoot.CreationTimestamp.Time, expirationTime, days, *clusterLifetimeDays, maxExtensionDays)
+	}
+
+	shootCopy := shoot.DeepCopy()
+	delete(shootCopy.Annotations, common.ShootLifetimeExtensionRequest)
+	if denialReason == nil {
+		shootCopy.Annotations[common.ShootExpirationTimestamp] = extendedExpirationTime.Format(time.RFC3339)
+	}
+
+	shootUpdated, err := c.k8sGardenClient.Garden().GardenV1beta1().Shoots(shoot.Namespace).Update(shootCopy)
+	if err != nil && apierrors.IsForbidden(err) && denialReason == nil {
+		// The extension might be rejected by the admission plugins, e.g. because of the quotas of the project. In this
+		// case the request is denied as well.
+		denialReason = err
+
+		shootCopy = shoot.DeepCopy()
+		delete(shootCopy.Annotations, common.ShootLifetimeExtensionRequest)
+		shootUpdated, err = c.k8sGardenClient.Garden().GardenV1beta1().Shoots(shoot.Namespace).Update(shootCopy)
+	}
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	if denialReason != nil {
+		c.recorder.Eventf(shootUpdated, corev1.EventTypeWarning, gardenv1beta1.ShootEventLifetimeExtensionDenied, "Extension of the lifetime by %s day(s) has been denied: %v", request, denialReason)
+		return shootUpdated, expirationTime, nil
+	}
+
+	c.recorder.Eventf(shootUpdated, corev1.EventTypeNormal, gardenv1beta1.ShootEventLifetimeExtended, "Lifetime has been extended by %d day(s), the Shoot expires at %s", days, extendedExpirationTime.UTC().Format(time.RFC3339))
+	return shootUpdated, extendedExpirationTime, nil
+}
+
+// ExtendShootExpirationTime returns the <expirationTime> of a Shoot created at <creationTime> extended by <days>. Like
+// manual changes of the expiration timestamp, a single extension must not be longer than <clusterLifetimeDays>. In
+// total, the lifetime can be extended by at most <maxExtensionDays> beyond the initial lifetime of <clusterLifetimeDays>.
+func ExtendShootExpirationTime(creationTime, expirationTime time.Time, days, clusterLifetimeDays, maxExtensionDays int) (time.Time, error) {
+	if days > clusterLifetimeDays {
+		return time.Time{}, fmt.Errorf("the lifetime can be extended by at most %d day(s) at once", clusterLifetimeDays)
+	}
+
+	var (
+		extendedExpirationTime = expirationTime.Add(time.Duration(days*24) * time.Hour)
+		maxExpirationTime      = creationTime.Add(time.Duration((clusterLifetimeDays+maxExtensionDays)*24) * time.Hour)
+	)
+
+	if extendedExpirationTime.After(maxExpirationTime) {
+		return time.Time{}, fmt.Errorf("the lifetime can be extended by at most %d day(s) in total, the Shoot must expire until %s", maxExtensionDays, maxExpirationTime.UTC().Format(time.RFC3339))
+	}
+	return extendedExpirationTime, nil
+}
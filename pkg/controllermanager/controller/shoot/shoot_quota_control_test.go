@@ -0,0 +1,66 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot_test
+
+import (
+	"time"
+
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Shoot Quota", func() {
+	Describe("#ExtendShootExpirationTime", func() {
+		var (
+			day            = 24 * time.Hour
+			creationTime   = time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+			expirationTime = creationTime.Add(7 * day)
+		)
+
+		It("should extend the expiration time by the requested days", func() {
+			extendedExpirationTime, err := ExtendShootExpirationTime(creationTime, expirationTime, 3, 7, 14)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(extendedExpirationTime).To(Equal(expirationTime.Add(3 * day)))
+		})
+
+		It("should allow to use up the maximum extension", func() {
+			extendedExpirationTime, err := ExtendShootExpirationTime(creationTime, expirationTime.Add(7*day), 7, 7, 14)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(extendedExpirationTime).To(Equal(creationTime.Add(21 * day)))
+		})
+
+		It("should deny extensions longer than the cluster lifetime", func() {
+			_, err := ExtendShootExpirationTime(creationTime, expirationTime, 8, 7, 14)
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should deny extensions exceeding the maximum extension in total", func() {
+			_, err := ExtendShootExpirationTime(creationTime, expirationTime.Add(10*day), 5, 7, 14)
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should deny any extension if no extension is allowed", func() {
+			_, err := ExtendShootExpirationTime(creationTime, expirationTime, 1, 7, 0)
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,189 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package staleness
+
+import (
+	"fmt"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	gardenmetrics "github.com/gardener/gardener/pkg/controllermanager/metrics"
+	"github.com/gardener/gardener/pkg/logger"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+)
+
+var (
+	// ShootConditionTypes are the types of the Shoot conditions which are periodically updated by the ShootCare
+	// controller and hence can become stale.
+	ShootConditionTypes = []gardenv1beta1.ConditionType{
+		gardenv1beta1.ShootAPIServerAvailable,
+		gardenv1beta1.ShootControlPlaneHealthy,
+		gardenv1beta1.ShootEveryNodeReady,
+		gardenv1beta1.ShootSystemComponentsHealthy,
+	}
+	// SeedConditionTypes are the types of the Seed conditions which are periodically updated by the Seed controller
+	// and hence can become stale.
+	SeedConditionTypes = []gardenv1beta1.ConditionType{
+		gardenv1beta1.SeedAvailable,
+	}
+)
+
+// Now returns the current time. It can be overwritten in tests.
+var Now = time.Now
+
+// StaleConditions returns the types of the given <conditions> of which the last update is longer ago than the
+// <threshold>. Only conditions of the given <conditionTypes> are considered.
+func StaleConditions(conditions []gardenv1beta1.Condition, conditionTypes []gardenv1beta1.ConditionType, threshold time.Duration, now time.Time) []gardenv1beta1.ConditionType {
+	var out []gardenv1beta1.ConditionType
+	for _, conditionType := range conditionTypes {
+		if condition := helper.GetCondition(conditions, conditionType); condition != nil && now.Sub(condition.LastUpdateTime.Time) > threshold {
+			out = append(out, conditionType)
+		}
+	}
+	return out
+}
+
+// MarkStaleConditions returns a copy of the given <conditions> in which the stale conditions (see StaleConditions)
+// are set to Unknown with reason ConditionStale. The last update time of the conditions is kept so that they are only
+// considered up-to-date again once their controller updates them. It returns whether any condition has been changed.
+func MarkStaleConditions(conditions []gardenv1beta1.Condition, conditionTypes []gardenv1beta1.ConditionType, threshold time.Duration, now time.Time) ([]gardenv1beta1.Condition, bool) {
+	var (
+		out     = make([]gardenv1beta1.Condition, 0, len(conditions))
+		stale   = make(map[gardenv1beta1.ConditionType]bool)
+		changed bool
+	)
+
+	for _, conditionType := range StaleConditions(conditions, conditionTypes, threshold, now) {
+		stale[conditionType] = true
+	}
+
+	for _, condition := range conditions {
+		if stale[condition.Type] && (condition.Status != gardenv1beta1.ConditionUnknown || condition.Reason != gardenv1beta1.ConditionStale) {
+			if condition.Status != gardenv1beta1.ConditionUnknown {
+				condition.LastTransitionTime = metav1.NewTime(now)
+			}
+			condition.Status = gardenv1beta1.ConditionUnknown
+			condition.Reason = gardenv1beta1.ConditionStale
+			condition.Message = fmt.Sprintf("The condition has not been updated since %s.", condition.LastUpdateTime.UTC().Format(time.RFC3339))
+			changed = true
+		}
+		out = append(out, condition)
+	}
+
+	return out, changed
+}
+
+// enqueueAll adds all Shoots and Seeds to the queues and updates the metric about the stale conditions.
+func (c *Controller) enqueueAll() {
+	var (
+		threshold = c.config.Threshold.Duration
+		now       = Now()
+	)
+
+	shoots, err := c.shootLister.List(labels.Everything())
+	if err != nil {
+		logger.Logger.Errorf("[CONDITION STALENESS] Could not list Shoots: %v", err)
+		return
+	}
+	seeds, err := c.seedLister.List(labels.Everything())
+	if err != nil {
+		logger.Logger.Errorf("[CONDITION STALENESS] Could not list Seeds: %v", err)
+		return
+	}
+
+	gardenmetrics.StaleConditions.Reset()
+
+	for _, shoot := range shoots {
+		var seedName string
+		if shoot.Spec.Cloud.Seed != nil {
+			seedName = *shoot.Spec.Cloud.Seed
+		}
+		for _, conditionType := range StaleConditions(shoot.Status.Conditions, ShootConditionTypes, threshold, now) {
+			gardenmetrics.StaleConditions.WithLabelValues("shoot", seedName, string(conditionType)).Inc()
+		}
+
+		if key, err := cache.MetaNamespaceKeyFunc(shoot); err == nil {
+			c.shootQueue.Add(key)
+		}
+	}
+
+	for _, seed := range seeds {
+		for _, conditionType := range StaleConditions(seed.Status.Conditions, SeedConditionTypes, threshold, now) {
+			gardenmetrics.StaleConditions.WithLabelValues("seed", seed.Name, string(conditionType)).Inc()
+		}
+
+		if key, err := cache.MetaNamespaceKeyFunc(seed); err == nil {
+			c.seedQueue.Add(key)
+		}
+	}
+}
+
+func (c *Controller) reconcileShootKey(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	shoot, err := c.shootLister.Shoots(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if _, changed := MarkStaleConditions(shoot.Status.Conditions, ShootConditionTypes, c.config.Threshold.Duration, Now()); !changed {
+		return nil
+	}
+
+	logger.Logger.Infof("[CONDITION STALENESS] Marking stale conditions of Shoot %s as Unknown", key)
+	_, err = kutil.TryUpdateShootConditions(c.k8sGardenClient.Garden(), retry.DefaultBackoff, shoot.ObjectMeta,
+		func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
+			shoot.Status.Conditions, _ = MarkStaleConditions(shoot.Status.Conditions, ShootConditionTypes, c.config.Threshold.Duration, Now())
+			return shoot, nil
+		})
+	return err
+}
+
+func (c *Controller) reconcileSeedKey(key string) error {
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	seed, err := c.seedLister.Get(name)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	conditions, changed := MarkStaleConditions(seed.Status.Conditions, SeedConditionTypes, c.config.Threshold.Duration, Now())
+	if !changed {
+		return nil
+	}
+
+	logger.Logger.Infof("[CONDITION STALENESS] Marking stale conditions of Seed %s as Unknown", key)
+	seed = seed.DeepCopy()
+	seed.Status.Conditions = conditions
+	_, err = c.k8sGardenClient.Garden().GardenV1beta1().Seeds().UpdateStatus(seed)
+	return err
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package staleness_test
+
+import (
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/staleness"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Staleness", func() {
+	var (
+		now       = time.Date(2019, 3, 1, 12, 0, 0, 0, time.UTC)
+		threshold = 10 * time.Minute
+
+		recent     = metav1.NewTime(now.Add(-time.Minute))
+		stale      = metav1.NewTime(now.Add(-time.Hour))
+		transition = metav1.NewTime(now.Add(-24 * time.Hour))
+
+		conditions []gardenv1beta1.Condition
+	)
+
+	BeforeEach(func() {
+		conditions = []gardenv1beta1.Condition{
+			{Type: gardenv1beta1.ShootAPIServerAvailable, Status: gardenv1beta1.ConditionTrue, LastTransitionTime: transition, LastUpdateTime: recent, Reason: "HealthzRequestSucceeded"},
+			{Type: gardenv1beta1.ShootEveryNodeReady, Status: gardenv1beta1.ConditionTrue, LastTransitionTime: transition, LastUpdateTime: stale, Reason: "EveryNodeReady"},
+			{Type: gardenv1beta1.ShootCompliant, Status: gardenv1beta1.ConditionTrue, LastTransitionTime: transition, LastUpdateTime: stale, Reason: "Compliant"},
+		}
+	})
+
+	Describe("#StaleConditions", func() {
+		It("should return the stale conditions of the given types", func() {
+			Expect(StaleConditions(conditions, ShootConditionTypes, threshold, now)).To(Equal([]gardenv1beta1.ConditionType{gardenv1beta1.ShootEveryNodeReady}))
+		})
+
+		It("should return nothing if no condition is stale", func() {
+			Expect(StaleConditions(conditions, ShootConditionTypes, 2*time.Hour, now)).To(BeEmpty())
+		})
+	})
+
+	Describe("#MarkStaleConditions", func() {
+		It("should mark the stale conditions as Unknown and keep their last update time", func() {
+			marked, changed := MarkStaleConditions(conditions, ShootConditionTypes, threshold, now)
+
+			Expect(changed).To(BeTrue())
+			Expect(marked).To(HaveLen(3))
+			Expect(marked[0]).To(Equal(conditions[0]))
+			Expect(marked[1]).To(Equal(gardenv1beta1.Condition{
+				Type:               gardenv1beta1.ShootEveryNodeReady,
+				Status:             gardenv1beta1.ConditionUnknown,
+				LastTransitionTime: metav1.NewTime(now),
+				LastUpdateTime:     stale,
+				Reason:             gardenv1beta1.ConditionStale,
+				Message:            "The condition has not been updated since 2019-03-01T11:00:00Z.",
+			}))
+			Expect(marked[2]).To(Equal(conditions[2]))
+			Expect(conditions[1].Status).To(Equal(gardenv1beta1.ConditionTrue), "input must not be modified")
+		})
+
+		It("should not change conditions which are already marked as stale", func() {
+			marked, _ := MarkStaleConditions(conditions, ShootConditionTypes, threshold, now)
+
+			markedAgain, changed := MarkStaleConditions(marked, ShootConditionTypes, threshold, now.Add(time.Minute))
+			Expect(changed).To(BeFalse())
+			Expect(markedAgain).To(Equal(marked))
+		})
+
+		It("should keep the last transition time of Unknown conditions", func() {
+			conditions[1].Status = gardenv1beta1.ConditionUnknown
+			conditions[1].Reason = gardenv1beta1.ConditionCheckError
+
+			marked, changed := MarkStaleConditions(conditions, ShootConditionTypes, threshold, now)
+			Expect(changed).To(BeTrue())
+			Expect(marked[1].Reason).To(Equal(gardenv1beta1.ConditionStale))
+			Expect(marked[1].LastTransitionTime).To(Equal(transition))
+		})
+	})
+})
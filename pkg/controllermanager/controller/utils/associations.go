@@ -21,6 +21,7 @@ import (
 	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/logger"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
 )
 
 // DetermineShootAssociations gets a <shootLister> to determine the Shoots resources which are associated
@@ -92,3 +93,20 @@ func DetermineSecretBindingAssociations(quota *gardenv1beta1.Quota, bindingListe
 	}
 	return associatedBindings, nil
 }
+
+// DetermineShootAssociationsByIndex gets a <shootIndexer> which must contain the ShootSeedName index to determine the
+// Shoots which are scheduled to the Seed with the given <seedName>. Contrary to DetermineShootAssociations, it does
+// not iterate over all Shoots.
+func DetermineShootAssociationsByIndex(seedName string, shootIndexer cache.Indexer) ([]string, error) {
+	shoots, err := ShootsByIndex(shootIndexer, ShootSeedName, seedName)
+	if err != nil {
+		logger.Logger.Info(err.Error())
+		return nil, err
+	}
+
+	var associatedShoots []string
+	for _, shoot := range shoots {
+		associatedShoots = append(associatedShoots, fmt.Sprintf("%s/%s", shoot.Namespace, shoot.Name))
+	}
+	return associatedShoots, nil
+}
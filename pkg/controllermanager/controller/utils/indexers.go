@@ -0,0 +1,108 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package utils
+
+import (
+	"fmt"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// ControllerInstallationSeedRefName is the name of the index of ControllerInstallations by the name of the
+	// referenced Seed.
+	ControllerInstallationSeedRefName = "spec.seedRef.name"
+	// ControllerInstallationRegistrationRefName is the name of the index of ControllerInstallations by the name of the
+	// referenced ControllerRegistration.
+	ControllerInstallationRegistrationRefName = "spec.registrationRef.name"
+	// ShootSeedName is the name of the index of Shoots by the name of the Seed they are scheduled to.
+	ShootSeedName = "spec.cloud.seed"
+)
+
+// AddControllerInstallationIndexers adds the ControllerInstallation indexes to the given informer. It must be called
+// before the informer is started.
+func AddControllerInstallationIndexers(informer cache.SharedIndexInformer) error {
+	return informer.AddIndexers(cache.Indexers{
+		ControllerInstallationSeedRefName: func(obj interface{}) ([]string, error) {
+			controllerInstallation, ok := obj.(*gardencorev1alpha1.ControllerInstallation)
+			if !ok {
+				return nil, fmt.Errorf("expected *gardencorev1alpha1.ControllerInstallation but got %T", obj)
+			}
+			return []string{controllerInstallation.Spec.SeedRef.Name}, nil
+		},
+		ControllerInstallationRegistrationRefName: func(obj interface{}) ([]string, error) {
+			controllerInstallation, ok := obj.(*gardencorev1alpha1.ControllerInstallation)
+			if !ok {
+				return nil, fmt.Errorf("expected *gardencorev1alpha1.ControllerInstallation but got %T", obj)
+			}
+			return []string{controllerInstallation.Spec.RegistrationRef.Name}, nil
+		},
+	})
+}
+
+// AddShootIndexers adds the Shoot indexes to the given informer. It must be called before the informer is started.
+func AddShootIndexers(informer cache.SharedIndexInformer) error {
+	return informer.AddIndexers(cache.Indexers{
+		ShootSeedName: func(obj interface{}) ([]string, error) {
+			shoot, ok := obj.(*gardenv1beta1.Shoot)
+			if !ok {
+				return nil, fmt.Errorf("expected *gardenv1beta1.Shoot but got %T", obj)
+			}
+			if shoot.Spec.Cloud.Seed == nil {
+				return nil, nil
+			}
+			return []string{*shoot.Spec.Cloud.Seed}, nil
+		},
+	})
+}
+
+// ControllerInstallationsByIndex returns the ControllerInstallations in the given <indexer> whose <index> value is
+// <value>.
+func ControllerInstallationsByIndex(indexer cache.Indexer, index, value string) ([]*gardencorev1alpha1.ControllerInstallation, error) {
+	objs, err := indexer.ByIndex(index, value)
+	if err != nil {
+		return nil, err
+	}
+
+	controllerInstallations := make([]*gardencorev1alpha1.ControllerInstallation, 0, len(objs))
+	for _, obj := range objs {
+		controllerInstallation, ok := obj.(*gardencorev1alpha1.ControllerInstallation)
+		if !ok {
+			return nil, fmt.Errorf("expected *gardencorev1alpha1.ControllerInstallation but got %T", obj)
+		}
+		controllerInstallations = append(controllerInstallations, controllerInstallation)
+	}
+	return controllerInstallations, nil
+}
+
+// ShootsByIndex returns the Shoots in the given <indexer> whose <index> value is <value>.
+func ShootsByIndex(indexer cache.Indexer, index, value string) ([]*gardenv1beta1.Shoot, error) {
+	objs, err := indexer.ByIndex(index, value)
+	if err != nil {
+		return nil, err
+	}
+
+	shoots := make([]*gardenv1beta1.Shoot, 0, len(objs))
+	for _, obj := range objs {
+		shoot, ok := obj.(*gardenv1beta1.Shoot)
+		if !ok {
+			return nil, fmt.Errorf("expected *gardenv1beta1.Shoot but got %T", obj)
+		}
+		shoots = append(shoots, shoot)
+	}
+	return shoots, nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package utils_test
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+var _ = Describe("indexers", func() {
+	It("should index ControllerInstallations by Seed and ControllerRegistration", func() {
+		informer := cache.NewSharedIndexInformer(&cache.ListWatch{}, &gardencorev1alpha1.ControllerInstallation{}, 0, cache.Indexers{})
+		Expect(AddControllerInstallationIndexers(informer)).To(Succeed())
+
+		for _, name := range []string{"a", "b"} {
+			Expect(informer.GetIndexer().Add(&gardencorev1alpha1.ControllerInstallation{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: gardencorev1alpha1.ControllerInstallationSpec{
+					SeedRef:         corev1.ObjectReference{Name: "seed-" + name},
+					RegistrationRef: corev1.ObjectReference{Name: "registration"},
+				},
+			})).To(Succeed())
+		}
+
+		bySeed, err := ControllerInstallationsByIndex(informer.GetIndexer(), ControllerInstallationSeedRefName, "seed-a")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bySeed).To(HaveLen(1))
+		Expect(bySeed[0].Name).To(Equal("a"))
+
+		byRegistration, err := ControllerInstallationsByIndex(informer.GetIndexer(), ControllerInstallationRegistrationRefName, "registration")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(byRegistration).To(HaveLen(2))
+	})
+
+	It("should index Shoots by Seed", func() {
+		informer := cache.NewSharedIndexInformer(&cache.ListWatch{}, &gardenv1beta1.Shoot{}, 0, cache.Indexers{})
+		Expect(AddShootIndexers(informer)).To(Succeed())
+
+		seed := "seed"
+		Expect(informer.GetIndexer().Add(&gardenv1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "garden", Name: "scheduled"},
+			Spec:       gardenv1beta1.ShootSpec{Cloud: gardenv1beta1.Cloud{Seed: &seed}},
+		})).To(Succeed())
+		Expect(informer.GetIndexer().Add(&gardenv1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "garden", Name: "unscheduled"},
+		})).To(Succeed())
+
+		shoots, err := ShootsByIndex(informer.GetIndexer(), ShootSeedName, seed)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(shoots).To(HaveLen(1))
+		Expect(shoots[0].Name).To(Equal("scheduled"))
+
+		associatedShoots, err := DetermineShootAssociationsByIndex(seed, informer.GetIndexer())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(associatedShoots).To(ConsistOf("garden/scheduled"))
+	})
+})
@@ -0,0 +1,77 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package utils
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// MapFunc returns the keys of the objects which must be reconciled because of a change of the given object.
+type MapFunc func(obj interface{}) ([]string, error)
+
+// RateLimitEnqueueMapped returns a function which adds the keys returned by <mapFunc> for a given object to the given
+// queue immediately at most once per <interval> for the same object. Further changes of the object within the interval
+// are not dropped but their keys are added at the end of the interval, so that the last change is always processed.
+// This is useful for mappings which enqueue many objects, e.g., all ControllerRegistrations for a Seed, and which are
+// triggered by frequent (status) updates.
+func RateLimitEnqueueMapped(queue workqueue.DelayingInterface, mapFunc MapFunc, interval time.Duration) func(obj interface{}) error {
+	var (
+		lastMapped = map[string]time.Time{}
+		lock       sync.Mutex
+	)
+
+	return func(obj interface{}) error {
+		key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+		if err != nil {
+			return err
+		}
+
+		lock.Lock()
+		now := time.Now()
+		for k, last := range lastMapped {
+			if now.Sub(last) >= interval {
+				delete(lastMapped, k)
+			}
+		}
+		last, limited := lastMapped[key]
+		if !limited {
+			lastMapped[key] = now
+		}
+		lock.Unlock()
+
+		keys, err := mapFunc(obj)
+		if err != nil {
+			if !limited {
+				lock.Lock()
+				delete(lastMapped, key)
+				lock.Unlock()
+			}
+			return err
+		}
+
+		for _, k := range keys {
+			if limited {
+				// The delaying queue only keeps the earliest of multiple delayed adds of the same key.
+				queue.AddAfter(k, interval-now.Sub(last))
+				continue
+			}
+			queue.Add(k)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package utils_test
+
+import (
+	"errors"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+)
+
+var _ = Describe("mapper", func() {
+	Describe("#RateLimitEnqueueMapped", func() {
+		var (
+			calls   int
+			mapErr  error
+			queue   workqueue.DelayingInterface
+			enqueue func(obj interface{}) error
+
+			seed  = &gardenv1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed"}}
+			other = &gardenv1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "other"}}
+		)
+
+		BeforeEach(func() {
+			calls, mapErr = 0, nil
+			queue = workqueue.NewDelayingQueue()
+			enqueue = RateLimitEnqueueMapped(queue, func(obj interface{}) ([]string, error) {
+				calls++
+				return []string{obj.(*gardenv1beta1.Seed).Name}, mapErr
+			}, 100*time.Millisecond)
+		})
+
+		AfterEach(func() {
+			queue.ShutDown()
+		})
+
+		It("should enqueue the same object immediately at most once per interval", func() {
+			Expect(enqueue(seed)).To(Succeed())
+			Expect(enqueue(other)).To(Succeed())
+			Expect(queue.Len()).To(Equal(2))
+
+			key, _ := queue.Get()
+			queue.Done(key)
+			Expect(enqueue(seed)).To(Succeed())
+			Expect(queue.Len()).To(Equal(1))
+		})
+
+		It("should enqueue the keys of rate limited changes at the end of the interval", func() {
+			Expect(enqueue(seed)).To(Succeed())
+			key, _ := queue.Get()
+			queue.Done(key)
+
+			Expect(enqueue(seed)).To(Succeed())
+			Expect(enqueue(seed)).To(Succeed())
+			Expect(queue.Len()).To(Equal(0))
+
+			Eventually(queue.Len).Should(Equal(1))
+			Consistently(queue.Len, 200*time.Millisecond).Should(Equal(1))
+		})
+
+		It("should not rate limit after failed mappings", func() {
+			mapErr = errors.New("error")
+			Expect(enqueue(seed)).NotTo(Succeed())
+
+			mapErr = nil
+			Expect(enqueue(seed)).To(Succeed())
+			Expect(queue.Len()).To(Equal(1))
+			Expect(calls).To(Equal(2))
+		})
+	})
+})
@@ -0,0 +1,266 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package utils
+
+import (
+	"sync"
+	"time"
+
+	gardenmetrics "github.com/gardener/gardener/pkg/controllermanager/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/client-go/util/workqueue"
+)
+
+const (
+	// PriorityHigh is the priority of items which are expedited.
+	PriorityHigh = "high"
+	// PriorityNormal is the priority of all other items.
+	PriorityNormal = "normal"
+
+	priorityQueueMetricsUpdatePeriod = 5 * time.Second
+	// maxConsecutiveHighPriorityItems is the number of items with high priority which are handed out in a row while
+	// items with normal priority are waiting. Afterwards, one item with normal priority is handed out so that these
+	// items are not starved by constantly expedited items.
+	maxConsecutiveHighPriorityItems = 10
+)
+
+// ExpediteFunc determines whether the given item must be processed before all items with normal priority.
+type ExpediteFunc func(item interface{}) bool
+
+// PriorityQueue is a rate limiting workqueue with two priorities. Items for which the ExpediteFunc returns true when
+// they are added are handed out before all items with normal priority, though after a number of consecutive
+// expedited items, one item with normal priority is handed out in between. Apart from that it has the same semantics as
+// the queues of the workqueue package, i.e., items are deduplicated, and an item is never processed concurrently.
+// The depth, latency, and age of the oldest item are exposed as metrics per priority.
+type PriorityQueue struct {
+	name        string
+	expedite    ExpediteFunc
+	rateLimiter workqueue.RateLimiter
+
+	cond         *sync.Cond
+	queues       map[string][]interface{}
+	priorities   map[interface{}]string
+	addedAt      map[interface{}]time.Time
+	processing   map[interface{}]struct{}
+	shuttingDown bool
+
+	// consecutiveHighPriorityItems counts the items with high priority which have been handed out in a row while
+	// items with normal priority were waiting.
+	consecutiveHighPriorityItems int
+
+	now func() time.Time
+}
+
+var _ workqueue.RateLimitingInterface = &PriorityQueue{}
+
+// NewPriorityQueue creates a new PriorityQueue with the given <name> (used for the metrics) which uses the given
+// <rateLimiter> for AddRateLimited and the given <expedite> function to determine the priority of added items.
+func NewPriorityQueue(rateLimiter workqueue.RateLimiter, name string, expedite ExpediteFunc) *PriorityQueue {
+	q := &PriorityQueue{
+		name:        name,
+		expedite:    expedite,
+		rateLimiter: rateLimiter,
+
+		cond:       sync.NewCond(&sync.Mutex{}),
+		queues:     map[string][]interface{}{PriorityHigh: nil, PriorityNormal: nil},
+		priorities: map[interface{}]string{},
+		addedAt:    map[interface{}]time.Time{},
+		processing: map[interface{}]struct{}{},
+
+		now: time.Now,
+	}
+	go q.updateMetricsLoop()
+	return q
+}
+
+// Add marks the given item as needing processing. If the item is already waiting with normal priority but must be
+// expedited now, then it is moved to the high priority.
+func (q *PriorityQueue) Add(item interface{}) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+
+	if q.shuttingDown {
+		return
+	}
+
+	priority := PriorityNormal
+	if q.expedite != nil && q.expedite(item) {
+		priority = PriorityHigh
+	}
+
+	if current, ok := q.priorities[item]; ok {
+		if current == PriorityNormal && priority == PriorityHigh {
+			q.priorities[item] = PriorityHigh
+			if _, ok := q.processing[item]; !ok {
+				q.remove(PriorityNormal, item)
+				q.queues[PriorityHigh] = append(q.queues[PriorityHigh], item)
+			}
+		}
+		return
+	}
+
+	q.priorities[item] = priority
+	q.addedAt[item] = q.now()
+	if _, ok := q.processing[item]; ok {
+		return
+	}
+	q.queues[priority] = append(q.queues[priority], item)
+	q.cond.Signal()
+}
+
+// Len returns the number of items waiting to be processed.
+func (q *PriorityQueue) Len() int {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+
+	return len(q.queues[PriorityHigh]) + len(q.queues[PriorityNormal])
+}
+
+// Get blocks until it can return an item to be processed, items with high priority first (see PriorityQueue). If shutdown is true then
+// the caller should end their goroutine. Done must be called with the item when it has been processed.
+func (q *PriorityQueue) Get() (interface{}, bool) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+
+	for len(q.queues[PriorityHigh]) == 0 && len(q.queues[PriorityNormal]) == 0 && !q.shuttingDown {
+		q.cond.Wait()
+	}
+
+	priorities := []string{PriorityHigh, PriorityNormal}
+	if q.consecutiveHighPriorityItems >= maxConsecutiveHighPriorityItems {
+		priorities = []string{PriorityNormal, PriorityHigh}
+	}
+
+	for _, priority := range priorities {
+		if len(q.queues[priority]) == 0 {
+			continue
+		}
+
+		if priority == PriorityHigh && len(q.queues[PriorityNormal]) > 0 {
+			q.consecutiveHighPriorityItems++
+		} else {
+			q.consecutiveHighPriorityItems = 0
+		}
+
+		item := q.queues[priority][0]
+		q.queues[priority] = q.queues[priority][1:]
+
+		gardenmetrics.PriorityWorkqueueLatency.With(q.labels(priority)).Observe(float64(q.now().Sub(q.addedAt[item]) / time.Millisecond))
+		delete(q.priorities, item)
+		delete(q.addedAt, item)
+		q.processing[item] = struct{}{}
+		return item, false
+	}
+
+	// The queue is shutting down.
+	return nil, true
+}
+
+// Done marks the given item as processed. If it has been added again while it was processed, then it is re-queued.
+func (q *PriorityQueue) Done(item interface{}) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+
+	delete(q.processing, item)
+	if priority, ok := q.priorities[item]; ok {
+		q.queues[priority] = append(q.queues[priority], item)
+		q.cond.Signal()
+	}
+}
+
+// ShutDown makes the queue ignore all new items and lets the workers terminate once the queue is drained.
+func (q *PriorityQueue) ShutDown() {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+
+	q.shuttingDown = true
+	q.cond.Broadcast()
+}
+
+// ShuttingDown returns whether the queue is shutting down.
+func (q *PriorityQueue) ShuttingDown() bool {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+
+	return q.shuttingDown
+}
+
+// AddAfter adds the given item after the given <duration> has passed.
+func (q *PriorityQueue) AddAfter(item interface{}, duration time.Duration) {
+	if duration <= 0 {
+		q.Add(item)
+		return
+	}
+	time.AfterFunc(duration, func() { q.Add(item) })
+}
+
+// AddRateLimited adds the given item after the rate limiter says it is ok.
+func (q *PriorityQueue) AddRateLimited(item interface{}) {
+	q.AddAfter(item, q.rateLimiter.When(item))
+}
+
+// Forget indicates that the given item is finished being retried.
+func (q *PriorityQueue) Forget(item interface{}) {
+	q.rateLimiter.Forget(item)
+}
+
+// NumRequeues returns how many times the given item was requeued.
+func (q *PriorityQueue) NumRequeues(item interface{}) int {
+	return q.rateLimiter.NumRequeues(item)
+}
+
+func (q *PriorityQueue) remove(priority string, item interface{}) {
+	items := q.queues[priority]
+	for i, existing := range items {
+		if existing == item {
+			q.queues[priority] = append(items[:i:i], items[i+1:]...)
+			return
+		}
+	}
+}
+
+func (q *PriorityQueue) labels(priority string) prometheus.Labels {
+	return prometheus.Labels{"queue": q.name, "priority": priority}
+}
+
+func (q *PriorityQueue) updateMetricsLoop() {
+	ticker := time.NewTicker(priorityQueueMetricsUpdatePeriod)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if q.updateMetrics() {
+			return
+		}
+	}
+}
+
+// updateMetrics updates the depth and oldest item age metrics. It returns true if the queue is shutting down.
+func (q *PriorityQueue) updateMetrics() bool {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+
+	now := q.now()
+	for priority, items := range q.queues {
+		var oldest time.Duration
+		for _, item := range items {
+			if age := now.Sub(q.addedAt[item]); age > oldest {
+				oldest = age
+			}
+		}
+		gardenmetrics.PriorityWorkqueueLength.With(q.labels(priority)).Set(float64(len(items)))
+		gardenmetrics.PriorityWorkqueueOldestItemAge.With(q.labels(priority)).Set(oldest.Seconds())
+	}
+	return q.shuttingDown
+}
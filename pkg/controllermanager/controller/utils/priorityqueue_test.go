@@ -0,0 +1,124 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package utils_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/util/workqueue"
+)
+
+var _ = Describe("PriorityQueue", func() {
+	var (
+		expedited map[string]bool
+		queue     *PriorityQueue
+
+		get = func() interface{} {
+			item, quit := queue.Get()
+			Expect(quit).To(BeFalse())
+			return item
+		}
+	)
+
+	BeforeEach(func() {
+		expedited = map[string]bool{}
+		queue = NewPriorityQueue(workqueue.DefaultControllerRateLimiter(), "test", func(item interface{}) bool {
+			return expedited[item.(string)]
+		})
+	})
+
+	AfterEach(func() {
+		queue.ShutDown()
+	})
+
+	It("should hand out expedited items first", func() {
+		expedited["c"] = true
+
+		queue.Add("a")
+		queue.Add("b")
+		queue.Add("c")
+
+		Expect(queue.Len()).To(Equal(3))
+		Expect(get()).To(Equal("c"))
+		Expect(get()).To(Equal("a"))
+		Expect(get()).To(Equal("b"))
+	})
+
+	It("should not starve items with normal priority", func() {
+		queue.Add("normal")
+		for i := 0; i < 12; i++ {
+			item := fmt.Sprintf("expedited-%d", i)
+			expedited[item] = true
+			queue.Add(item)
+		}
+
+		for i := 0; i < 10; i++ {
+			Expect(get()).To(Equal(fmt.Sprintf("expedited-%d", i)))
+		}
+		Expect(get()).To(Equal("normal"))
+		Expect(get()).To(Equal("expedited-10"))
+		Expect(get()).To(Equal("expedited-11"))
+	})
+
+	It("should deduplicate items", func() {
+		queue.Add("a")
+		queue.Add("a")
+
+		Expect(queue.Len()).To(Equal(1))
+	})
+
+	It("should expedite waiting items", func() {
+		queue.Add("a")
+		queue.Add("b")
+		expedited["b"] = true
+		queue.Add("b")
+
+		Expect(queue.Len()).To(Equal(2))
+		Expect(get()).To(Equal("b"))
+		Expect(get()).To(Equal("a"))
+	})
+
+	It("should not hand out items which are being processed", func() {
+		queue.Add("a")
+		Expect(get()).To(Equal("a"))
+
+		queue.Add("a")
+		Expect(queue.Len()).To(BeZero())
+
+		queue.Done("a")
+		Expect(queue.Len()).To(Equal(1))
+		Expect(get()).To(Equal("a"))
+	})
+
+	It("should add items after the given duration", func() {
+		queue.AddAfter("a", 10*time.Millisecond)
+
+		Expect(queue.Len()).To(BeZero())
+		Eventually(queue.Len).Should(Equal(1))
+	})
+
+	It("should let workers quit once it is shutting down", func() {
+		queue.ShutDown()
+		queue.Add("a")
+
+		_, quit := queue.Get()
+		Expect(quit).To(BeTrue())
+		Expect(queue.ShuttingDown()).To(BeTrue())
+	})
+})
@@ -0,0 +1,73 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// SeedFilter decides whether objects belong to the Seeds managed by this controller manager, i.e., to the Seeds
+// matching the configured seed selector.
+type SeedFilter struct {
+	seedLister gardenlisters.SeedLister
+	selector   labels.Selector
+}
+
+// NewSeedFilter creates a new SeedFilter for the given <seedSelector>. If the selector is nil, all Seeds match.
+func NewSeedFilter(seedLister gardenlisters.SeedLister, seedSelector *metav1.LabelSelector) (*SeedFilter, error) {
+	selector := labels.Everything()
+	if seedSelector != nil {
+		var err error
+		if selector, err = metav1.LabelSelectorAsSelector(seedSelector); err != nil {
+			return nil, err
+		}
+	}
+
+	return &SeedFilter{seedLister: seedLister, selector: selector}, nil
+}
+
+// SeedMatches returns true if the given <seed> matches the seed selector.
+func (f *SeedFilter) SeedMatches(seed *gardenv1beta1.Seed) bool {
+	return f.selector.Matches(labels.Set(seed.Labels))
+}
+
+// ShootMatches returns true if the given <shoot> is scheduled to a Seed matching the seed selector.
+func (f *SeedFilter) ShootMatches(shoot *gardenv1beta1.Shoot) bool {
+	if f.selector.Empty() {
+		return true
+	}
+	if shoot.Spec.Cloud.Seed == nil {
+		return false
+	}
+	return f.SeedNameMatches(*shoot.Spec.Cloud.Seed)
+}
+
+// SeedNameMatches returns true if the Seed with the given <name> matches the seed selector. Unknown Seeds only match
+// if all Seeds are selected.
+func (f *SeedFilter) SeedNameMatches(name string) bool {
+	if f.selector.Empty() {
+		return true
+	}
+
+	seed, err := f.seedLister.Get(name)
+	if err != nil {
+		return false
+	}
+	return f.SeedMatches(seed)
+}
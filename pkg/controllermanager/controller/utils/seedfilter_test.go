@@ -0,0 +1,75 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils_test
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+var _ = Describe("SeedFilter", func() {
+	var (
+		seedLister gardenlisters.SeedLister
+
+		euSeed = &gardenv1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "eu", Labels: map[string]string{"group": "eu"}}}
+		usSeed = &gardenv1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "us", Labels: map[string]string{"group": "us"}}}
+
+		shootOn = func(seedName *string) *gardenv1beta1.Shoot {
+			return &gardenv1beta1.Shoot{Spec: gardenv1beta1.ShootSpec{Cloud: gardenv1beta1.Cloud{Seed: seedName}}}
+		}
+		stringPtr = func(s string) *string { return &s }
+	)
+
+	BeforeEach(func() {
+		indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+		Expect(indexer.Add(euSeed)).To(Succeed())
+		Expect(indexer.Add(usSeed)).To(Succeed())
+		seedLister = gardenlisters.NewSeedLister(indexer)
+	})
+
+	It("should select everything if no selector is given", func() {
+		filter, err := NewSeedFilter(seedLister, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(filter.SeedMatches(euSeed)).To(BeTrue())
+		Expect(filter.SeedNameMatches("unknown")).To(BeTrue())
+		Expect(filter.ShootMatches(shootOn(nil))).To(BeTrue())
+	})
+
+	It("should only select the Seeds matching the selector and the objects on them", func() {
+		filter, err := NewSeedFilter(seedLister, &metav1.LabelSelector{MatchLabels: map[string]string{"group": "eu"}})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(filter.SeedMatches(euSeed)).To(BeTrue())
+		Expect(filter.SeedMatches(usSeed)).To(BeFalse())
+		Expect(filter.SeedNameMatches("eu")).To(BeTrue())
+		Expect(filter.SeedNameMatches("us")).To(BeFalse())
+		Expect(filter.SeedNameMatches("unknown")).To(BeFalse())
+		Expect(filter.ShootMatches(shootOn(stringPtr("eu")))).To(BeTrue())
+		Expect(filter.ShootMatches(shootOn(stringPtr("us")))).To(BeFalse())
+		Expect(filter.ShootMatches(shootOn(nil))).To(BeFalse())
+	})
+
+	It("should fail for an invalid selector", func() {
+		_, err := NewSeedFilter(seedLister, &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "group", Operator: "Invalid"}}})
+		Expect(err).To(HaveOccurred())
+	})
+})
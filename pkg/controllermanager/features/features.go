@@ -32,3 +32,12 @@ var (
 func RegisterFeatureGates() {
 	FeatureGate.Add(featureGates)
 }
+
+// States returns the states of all feature gates of the Gardener Controller Manager, keyed by their names.
+func States() map[string]bool {
+	states := make(map[string]bool, len(featureGates))
+	for feature := range featureGates {
+		states[string(feature)] = FeatureGate.Enabled(feature)
+	}
+	return states
+}
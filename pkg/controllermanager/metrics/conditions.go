@@ -0,0 +1,31 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// StaleConditions is a metric which tracks the number of conditions of Shoots and Seeds which have not been updated
+// within the staleness threshold.
+var StaleConditions = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "garden_cm_stale_conditions",
+	Help: "Number of conditions which have not been updated within the staleness threshold, grouped by kind, seed and condition type.",
+}, []string{"kind", "seed", "condition"})
+
+// RegisterConditionMetrics registers the metrics about the conditions of Shoots and Seeds.
+func RegisterConditionMetrics() {
+	prometheus.MustRegister(StaleConditions)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// shootOperationBuckets range from 1 minute to roughly 2 hours, which covers both quick reconciliations of existing
+	// Shoots and creations with a slow infrastructure.
+	shootOperationBuckets = prometheus.ExponentialBuckets(60, 1.5, 12)
+	// shootTaskBuckets range from 1 second to roughly 1 hour.
+	shootTaskBuckets = prometheus.ExponentialBuckets(1, 2, 13)
+	// shootAPIServerProbeBuckets range from 10 milliseconds to roughly 20 seconds.
+	shootAPIServerProbeBuckets = prometheus.ExponentialBuckets(0.01, 2, 12)
+
+	// ShootOperationDuration is a metric which tracks the duration of Shoot flows (e.g., reconcile or delete).
+	ShootOperationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "garden_cm_shoot_operation_duration_seconds",
+		Help:    "Duration in seconds of Shoot flows, grouped by flow, result, purpose and seed.",
+		Buckets: shootOperationBuckets,
+	}, []string{"flow", "result", "purpose", "seed"})
+
+	// ShootFlowTaskDuration is a metric which tracks the duration of the single tasks of Shoot flows.
+	ShootFlowTaskDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "garden_cm_shoot_flow_task_duration_seconds",
+		Help:    "Duration in seconds of the tasks of Shoot flows, grouped by flow, task, result, purpose and seed.",
+		Buckets: shootTaskBuckets,
+	}, []string{"flow", "task", "result", "purpose", "seed"})
+
+	// ShootExtensionWaitDuration is a metric which tracks the time waited for extension resources of Shoots to become
+	// ready.
+	ShootExtensionWaitDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "garden_cm_shoot_extension_wait_duration_seconds",
+		Help:    "Time in seconds waited for extension resources of Shoots to become ready, grouped by kind, type, purpose and seed.",
+		Buckets: shootTaskBuckets,
+	}, []string{"kind", "type", "purpose", "seed"})
+
+	// ShootAPIServerProbeDuration is a metric which tracks the duration of the probes of the API servers of Shoots per
+	// vantage point and endpoint.
+	ShootAPIServerProbeDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "garden_cm_shoot_apiserver_probe_duration_seconds",
+		Help:    "Duration in seconds of the probes of the API servers of Shoots, grouped by vantage point, endpoint, result and seed.",
+		Buckets: shootAPIServerProbeBuckets,
+	}, []string{"vantage", "endpoint", "result", "seed"})
+)
+
+// RegisterShootMetrics registers the metrics about the performance of Shoot operations.
+func RegisterShootMetrics() {
+	prometheus.MustRegister(ShootOperationDuration)
+	prometheus.MustRegister(ShootFlowTaskDuration)
+	prometheus.MustRegister(ShootExtensionWaitDuration)
+	prometheus.MustRegister(ShootAPIServerProbeDuration)
+}
@@ -56,6 +56,27 @@ var (
 		Name: "garden_cm_workqueue_longest_running_processor_microseconds",
 		Help: "Longest running processor in microseconds.",
 	}, workqueueLabels)
+
+	priorityWorkqueueLabels = []string{"queue", "priority"}
+
+	// PriorityWorkqueueLength is a metric which tracks the current count of items per priority in priority workqueues.
+	PriorityWorkqueueLength = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "garden_cm_priority_workqueue_items",
+		Help: "Current count of items per priority in the priority workqueue.",
+	}, priorityWorkqueueLabels)
+
+	// PriorityWorkqueueLatency is a metric which tracks the time items remain in priority workqueues per priority.
+	PriorityWorkqueueLatency = prometheus.NewSummaryVec(prometheus.SummaryOpts{
+		Name: "garden_cm_priority_workqueue_latency_milliseconds",
+		Help: "Time in milliseconds an item remains in the priority workqueue before it gets processed.",
+	}, priorityWorkqueueLabels)
+
+	// PriorityWorkqueueOldestItemAge is a metric which tracks the age of the oldest waiting item per priority in
+	// priority workqueues.
+	PriorityWorkqueueOldestItemAge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "garden_cm_priority_workqueue_oldest_item_age_seconds",
+		Help: "Age in seconds of the oldest item per priority waiting in the priority workqueue.",
+	}, priorityWorkqueueLabels)
 )
 
 type workqueueMetricProvider struct{}
@@ -99,5 +120,8 @@ func RegisterWorkqueMetrics() {
 	prometheus.MustRegister(workqueueRetries)
 	prometheus.MustRegister(workqueueUnfinishedWork)
 	prometheus.MustRegister(workqueueLongestRunningProcessorMicroseconds)
+	prometheus.MustRegister(PriorityWorkqueueLength)
+	prometheus.MustRegister(PriorityWorkqueueLatency)
+	prometheus.MustRegister(PriorityWorkqueueOldestItemAge)
 	workqueue.SetProvider(workqueueMetricProvider{})
 }
@@ -0,0 +1,99 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var (
+	flowsMutex    sync.Mutex
+	flowRecorders = map[string]*flow.Recorder{}
+)
+
+// FlowRecorder returns the flow.Recorder for the given key (e.g., the namespace/name of a Shoot and the name of
+// the flow). The record of the latest flow execution using the recorder is served on the /debug/flows endpoint if
+// the debug handlers are enabled.
+func FlowRecorder(key string) *flow.Recorder {
+	flowsMutex.Lock()
+	defer flowsMutex.Unlock()
+
+	recorder, ok := flowRecorders[key]
+	if !ok {
+		recorder = flow.NewRecorder()
+		flowRecorders[key] = recorder
+	}
+	return recorder
+}
+
+// ForgetFlowRecorder removes the flow.Recorder for the given key, e.g. once the Shoot has been deleted.
+func ForgetFlowRecorder(key string) {
+	flowsMutex.Lock()
+	defer flowsMutex.Unlock()
+
+	delete(flowRecorders, key)
+}
+
+// Flows is a HTTP handler for the /debug/flows endpoint. Without the `key` query parameter it responds with the
+// list of keys for which flow records exist. Otherwise, it responds with the record of the latest flow execution
+// for the given key, either in JSON format or (if the `format` query parameter is `dot`) as DOT graph.
+func Flows(w http.ResponseWriter, r *http.Request) {
+	key := r.URL.Query().Get("key")
+	if len(key) == 0 {
+		flowsMutex.Lock()
+		keys := make([]string, 0, len(flowRecorders))
+		for key := range flowRecorders {
+			keys = append(keys, key)
+		}
+		flowsMutex.Unlock()
+
+		sort.Strings(keys)
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(keys); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	flowsMutex.Lock()
+	recorder, ok := flowRecorders[key]
+	flowsMutex.Unlock()
+
+	var record *flow.Record
+	if ok {
+		record = recorder.Record()
+	}
+	if record == nil {
+		http.Error(w, "no flow record found", http.StatusNotFound)
+		return
+	}
+
+	var err error
+	if r.URL.Query().Get("format") == "dot" {
+		w.Header().Set("Content-Type", "text/vnd.graphviz")
+		err = record.WriteDOT(w)
+	} else {
+		w.Header().Set("Content-Type", "application/json")
+		err = record.WriteJSON(w)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
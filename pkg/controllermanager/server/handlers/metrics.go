@@ -21,6 +21,7 @@ import (
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -29,6 +30,7 @@ import (
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/client/kubernetes/cached"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation/common"
 )
@@ -73,13 +75,21 @@ func (m metrics) initShootMetrics() {
 	prometheus.Register(metricShootStateConditions)
 
 	m.collect(func() {
-		shoots, err := m.k8sGardenClient.Garden().GardenV1beta1().Shoots(metav1.NamespaceAll).List(metav1.ListOptions{})
+		// The shoots are listed once per scrape interval anyway, so they are not cached but only paginated.
+		shoots, err := cached.ListAll(func(opts metav1.ListOptions) (runtime.Object, error) {
+			return m.k8sGardenClient.Garden().GardenV1beta1().Shoots(metav1.NamespaceAll).List(opts)
+		}, cached.DefaultPageSize)
 		if err != nil {
 			logger.Logger.Info("Unable to fetch shoots. skip shoot metric set...")
 			return
 		}
 
-		for _, shoot := range shoots.Items {
+		for _, obj := range shoots {
+			shoot, ok := obj.(*gardenv1beta1.Shoot)
+			if !ok {
+				continue
+			}
+
 			var (
 				mailTo         string
 				nodeCount      int
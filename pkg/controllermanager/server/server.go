@@ -48,6 +48,10 @@ func Serve(ctx context.Context, k8sGardenClient kubernetes.Interface, k8sGardenI
 	// Add handlers to HTTP server and start it.
 	serverMuxHTTP.Handle("/metrics", promhttp.Handler())
 	serverMuxHTTP.HandleFunc("/healthz", handlers.Healthz)
+	if serverConfig.EnableDebugHandlers {
+		serverMuxHTTP.HandleFunc("/debug/flows", handlers.Flows)
+		serverMuxHTTP.HandleFunc("/debug/loglevel", logger.LevelHandler)
+	}
 
 	go func() {
 		logger.Logger.Infof("Starting HTTP server on %s", listenAddressHTTP)
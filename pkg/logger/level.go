@@ -0,0 +1,127 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	controllerLoggers     = map[string]*logrus.Logger{}
+	controllerLoggersLock sync.RWMutex
+)
+
+// NewControllerLogger returns the logger for the controller with the given <name>. It writes to the same output and
+// uses the same formatter as the standard Logger, but it has its own log level which can be changed at runtime
+// with SetControllerLevel. The level is initialized with the one of the standard Logger. Subsequent calls with the
+// same <name> return the same logger.
+func NewControllerLogger(name string) *logrus.Logger {
+	controllerLoggersLock.Lock()
+	defer controllerLoggersLock.Unlock()
+
+	if controllerLogger, ok := controllerLoggers[name]; ok {
+		return controllerLogger
+	}
+
+	controllerLogger := &logrus.Logger{
+		Out:   os.Stderr,
+		Level: logrus.InfoLevel,
+		Formatter: &logrus.TextFormatter{
+			DisableColors: true,
+		},
+		Hooks: make(logrus.LevelHooks),
+	}
+	if Logger != nil {
+		controllerLogger.Out = Logger.Out
+		controllerLogger.Formatter = Logger.Formatter
+		controllerLogger.SetLevel(Logger.GetLevel())
+	}
+
+	controllerLoggers[name] = controllerLogger
+	return controllerLogger
+}
+
+// SetControllerLevel sets the log level of the controller logger with the given <name> to <logLevel>.
+func SetControllerLevel(name, logLevel string) error {
+	if len(logLevel) == 0 {
+		return fmt.Errorf("log level must not be empty")
+	}
+	level, err := parseLevel(logLevel)
+	if err != nil {
+		return err
+	}
+
+	controllerLoggersLock.RLock()
+	defer controllerLoggersLock.RUnlock()
+
+	controllerLogger, ok := controllerLoggers[name]
+	if !ok {
+		return fmt.Errorf("unknown controller %q", name)
+	}
+	controllerLogger.SetLevel(level)
+	return nil
+}
+
+// ControllerLevels returns a map from the names of all controller loggers to their current log levels.
+func ControllerLevels() map[string]string {
+	controllerLoggersLock.RLock()
+	defer controllerLoggersLock.RUnlock()
+
+	out := make(map[string]string, len(controllerLoggers))
+	for name, controllerLogger := range controllerLoggers {
+		out[name] = controllerLogger.GetLevel().String()
+	}
+	return out
+}
+
+// LevelHandler is an HTTP handler for inspecting and changing the log levels of the controller loggers at runtime.
+// A GET request returns the current levels as JSON. A PUT request changes the level of the controller given by the
+// `controller` query parameter to the one given by the `level` query parameter, e.g.
+// `PUT /debug/loglevel?controller=shoot-care&level=debug`.
+func LevelHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		if err := SetControllerLevel(r.URL.Query().Get("controller"), r.URL.Query().Get("level")); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, PUT")
+		http.Error(w, fmt.Sprintf("method %s is not allowed", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(ControllerLevels())
+}
+
+func parseLevel(logLevel string) (logrus.Level, error) {
+	switch logLevel {
+	case "debug":
+		return logrus.DebugLevel, nil
+	case "", "info":
+		return logrus.InfoLevel, nil
+	case "error":
+		return logrus.ErrorLevel, nil
+	default:
+		return 0, fmt.Errorf("log level %q is not supported", logLevel)
+	}
+}
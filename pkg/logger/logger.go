@@ -31,16 +31,8 @@ var Logger *logrus.Logger
 // to set the log level.
 // Example output: time="2017-06-08T13:00:28+02:00" level=info msg="gardener started successfully".
 func NewLogger(logLevel string) *logrus.Logger {
-	var level logrus.Level
-
-	switch logLevel {
-	case "debug":
-		level = logrus.DebugLevel
-	case "", "info":
-		level = logrus.InfoLevel
-	case "error":
-		level = logrus.ErrorLevel
-	default:
+	level, err := parseLevel(logLevel)
+	if err != nil {
 		panic("The specified log level is not supported.")
 	}
 
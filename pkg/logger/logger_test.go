@@ -16,6 +16,8 @@ package logger_test
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 
 	. "github.com/gardener/gardener/pkg/logger"
@@ -102,4 +104,91 @@ var _ = Describe("logger", func() {
 		})
 
 	})
+
+	Describe("controller loggers", func() {
+		BeforeEach(func() {
+			NewLogger("info")
+		})
+
+		AfterEach(func() {
+			Logger = nil
+		})
+
+		Describe("#NewControllerLogger", func() {
+			It("should inherit the output and level of the standard logger", func() {
+				Logger.Level = logrus.ErrorLevel
+
+				controllerLogger := NewControllerLogger("test-inherit")
+
+				Expect(controllerLogger.Out).To(Equal(Logger.Out))
+				Expect(controllerLogger.Level).To(Equal(logrus.ErrorLevel))
+				Expect(controllerLogger).NotTo(BeIdenticalTo(Logger))
+			})
+
+			It("should return the same logger for the same name", func() {
+				Expect(NewControllerLogger("test-same")).To(BeIdenticalTo(NewControllerLogger("test-same")))
+			})
+		})
+
+		Describe("#SetControllerLevel", func() {
+			It("should only change the level of the given controller", func() {
+				var (
+					controllerLogger = NewControllerLogger("test-set")
+					otherLogger      = NewControllerLogger("test-other")
+				)
+
+				Expect(SetControllerLevel("test-set", "debug")).To(Succeed())
+
+				Expect(controllerLogger.GetLevel()).To(Equal(logrus.DebugLevel))
+				Expect(otherLogger.GetLevel()).To(Equal(logrus.InfoLevel))
+				Expect(Logger.GetLevel()).To(Equal(logrus.InfoLevel))
+				Expect(ControllerLevels()).To(HaveKeyWithValue("test-set", "debug"))
+			})
+
+			It("should fail for unknown controllers", func() {
+				Expect(SetControllerLevel("test-unknown", "debug")).NotTo(Succeed())
+			})
+
+			It("should fail for unsupported levels", func() {
+				NewControllerLogger("test-unsupported")
+
+				Expect(SetControllerLevel("test-unsupported", "trace")).NotTo(Succeed())
+			})
+
+			It("should fail for empty levels", func() {
+				controllerLogger := NewControllerLogger("test-empty")
+				Expect(SetControllerLevel("test-empty", "debug")).To(Succeed())
+
+				Expect(SetControllerLevel("test-empty", "")).NotTo(Succeed())
+				Expect(controllerLogger.GetLevel()).To(Equal(logrus.DebugLevel))
+			})
+		})
+
+		Describe("#LevelHandler", func() {
+			It("should change the level of the given controller", func() {
+				controllerLogger := NewControllerLogger("test-handler")
+
+				recorder := httptest.NewRecorder()
+				LevelHandler(recorder, httptest.NewRequest(http.MethodPut, "/debug/loglevel?controller=test-handler&level=error", nil))
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				Expect(controllerLogger.GetLevel()).To(Equal(logrus.ErrorLevel))
+				Expect(recorder.Body.String()).To(ContainSubstring(`"test-handler":"error"`))
+			})
+
+			It("should reject invalid requests", func() {
+				recorder := httptest.NewRecorder()
+				LevelHandler(recorder, httptest.NewRequest(http.MethodPut, "/debug/loglevel?controller=test-unknown&level=error", nil))
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+
+				recorder = httptest.NewRecorder()
+				LevelHandler(recorder, httptest.NewRequest(http.MethodPut, "/debug/loglevel?controller=test-handler", nil))
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+
+				recorder = httptest.NewRecorder()
+				LevelHandler(recorder, httptest.NewRequest(http.MethodPost, "/debug/loglevel", nil))
+				Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+			})
+		})
+	})
 })
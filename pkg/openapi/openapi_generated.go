@@ -34,388 +34,403 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition":                      schema_pkg_apis_core_v1alpha1_Condition(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerDeployment":           schema_pkg_apis_core_v1alpha1_ControllerDeployment(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallation":         schema_pkg_apis_core_v1alpha1_ControllerInstallation(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallationList":     schema_pkg_apis_core_v1alpha1_ControllerInstallationList(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallationSpec":     schema_pkg_apis_core_v1alpha1_ControllerInstallationSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallationStatus":   schema_pkg_apis_core_v1alpha1_ControllerInstallationStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistration":         schema_pkg_apis_core_v1alpha1_ControllerRegistration(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistrationList":     schema_pkg_apis_core_v1alpha1_ControllerRegistrationList(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistrationSpec":     schema_pkg_apis_core_v1alpha1_ControllerRegistrationSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerResource":             schema_pkg_apis_core_v1alpha1_ControllerResource(ref),
-		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig":                 schema_pkg_apis_core_v1alpha1_ProviderConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSCloud":                      schema_pkg_apis_garden_v1beta1_AWSCloud(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSConstraints":                schema_pkg_apis_garden_v1beta1_AWSConstraints(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSMachineImage":               schema_pkg_apis_garden_v1beta1_AWSMachineImage(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSMachineImageMapping":        schema_pkg_apis_garden_v1beta1_AWSMachineImageMapping(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSNetworks":                   schema_pkg_apis_garden_v1beta1_AWSNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSProfile":                    schema_pkg_apis_garden_v1beta1_AWSProfile(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSRegionalMachineImage":       schema_pkg_apis_garden_v1beta1_AWSRegionalMachineImage(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSVPC":                        schema_pkg_apis_garden_v1beta1_AWSVPC(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSWorker":                     schema_pkg_apis_garden_v1beta1_AWSWorker(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addon":                         schema_pkg_apis_garden_v1beta1_Addon(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons":                        schema_pkg_apis_garden_v1beta1_Addons(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AdmissionPlugin":               schema_pkg_apis_garden_v1beta1_AdmissionPlugin(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Alicloud":                      schema_pkg_apis_garden_v1beta1_Alicloud(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudConstraints":           schema_pkg_apis_garden_v1beta1_AlicloudConstraints(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudMachineImage":          schema_pkg_apis_garden_v1beta1_AlicloudMachineImage(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudMachineType":           schema_pkg_apis_garden_v1beta1_AlicloudMachineType(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudNetworks":              schema_pkg_apis_garden_v1beta1_AlicloudNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudProfile":               schema_pkg_apis_garden_v1beta1_AlicloudProfile(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudVPC":                   schema_pkg_apis_garden_v1beta1_AlicloudVPC(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudVolumeType":            schema_pkg_apis_garden_v1beta1_AlicloudVolumeType(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudWorker":                schema_pkg_apis_garden_v1beta1_AlicloudWorker(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditConfig":                   schema_pkg_apis_garden_v1beta1_AuditConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditPolicy":                   schema_pkg_apis_garden_v1beta1_AuditPolicy(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureCloud":                    schema_pkg_apis_garden_v1beta1_AzureCloud(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureConstraints":              schema_pkg_apis_garden_v1beta1_AzureConstraints(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureDomainCount":              schema_pkg_apis_garden_v1beta1_AzureDomainCount(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureMachineImage":             schema_pkg_apis_garden_v1beta1_AzureMachineImage(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureNetworks":                 schema_pkg_apis_garden_v1beta1_AzureNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureProfile":                  schema_pkg_apis_garden_v1beta1_AzureProfile(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureResourceGroup":            schema_pkg_apis_garden_v1beta1_AzureResourceGroup(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureVNet":                     schema_pkg_apis_garden_v1beta1_AzureVNet(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureWorker":                   schema_pkg_apis_garden_v1beta1_AzureWorker(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Backup":                        schema_pkg_apis_garden_v1beta1_Backup(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructure":          schema_pkg_apis_garden_v1beta1_BackupInfrastructure(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureList":      schema_pkg_apis_garden_v1beta1_BackupInfrastructureList(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureSpec":      schema_pkg_apis_garden_v1beta1_BackupInfrastructureSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureStatus":    schema_pkg_apis_garden_v1beta1_BackupInfrastructureStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud":                         schema_pkg_apis_garden_v1beta1_Cloud(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudControllerManagerConfig":  schema_pkg_apis_garden_v1beta1_CloudControllerManagerConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfile":                  schema_pkg_apis_garden_v1beta1_CloudProfile(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileList":              schema_pkg_apis_garden_v1beta1_CloudProfileList(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileSpec":              schema_pkg_apis_garden_v1beta1_CloudProfileSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ClusterAutoscaler":             schema_pkg_apis_garden_v1beta1_ClusterAutoscaler(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Condition":                     schema_pkg_apis_garden_v1beta1_Condition(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS":                           schema_pkg_apis_garden_v1beta1_DNS(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint":         schema_pkg_apis_garden_v1beta1_DNSProviderConstraint(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPCloud":                      schema_pkg_apis_garden_v1beta1_GCPCloud(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPConstraints":                schema_pkg_apis_garden_v1beta1_GCPConstraints(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPMachineImage":               schema_pkg_apis_garden_v1beta1_GCPMachineImage(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPNetworks":                   schema_pkg_apis_garden_v1beta1_GCPNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPProfile":                    schema_pkg_apis_garden_v1beta1_GCPProfile(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPVPC":                        schema_pkg_apis_garden_v1beta1_GCPVPC(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPWorker":                     schema_pkg_apis_garden_v1beta1_GCPWorker(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener":                      schema_pkg_apis_garden_v1beta1_Gardener(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration":              schema_pkg_apis_garden_v1beta1_GardenerDuration(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Heapster":                      schema_pkg_apis_garden_v1beta1_Heapster(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HelmTiller":                    schema_pkg_apis_garden_v1beta1_HelmTiller(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation":                   schema_pkg_apis_garden_v1beta1_Hibernation(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HibernationSchedule":           schema_pkg_apis_garden_v1beta1_HibernationSchedule(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HorizontalPodAutoscalerConfig": schema_pkg_apis_garden_v1beta1_HorizontalPodAutoscalerConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.K8SNetworks":                   schema_pkg_apis_garden_v1beta1_K8SNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAM":                      schema_pkg_apis_garden_v1beta1_Kube2IAM(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAMRole":                  schema_pkg_apis_garden_v1beta1_Kube2IAMRole(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeAPIServerConfig":           schema_pkg_apis_garden_v1beta1_KubeAPIServerConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeControllerManagerConfig":   schema_pkg_apis_garden_v1beta1_KubeControllerManagerConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeLego":                      schema_pkg_apis_garden_v1beta1_KubeLego(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeProxyConfig":               schema_pkg_apis_garden_v1beta1_KubeProxyConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeSchedulerConfig":           schema_pkg_apis_garden_v1beta1_KubeSchedulerConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig":                 schema_pkg_apis_garden_v1beta1_KubeletConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes":                    schema_pkg_apis_garden_v1beta1_Kubernetes(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConfig":              schema_pkg_apis_garden_v1beta1_KubernetesConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints":         schema_pkg_apis_garden_v1beta1_KubernetesConstraints(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesDashboard":           schema_pkg_apis_garden_v1beta1_KubernetesDashboard(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LastError":                     schema_pkg_apis_garden_v1beta1_LastError(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LastOperation":                 schema_pkg_apis_garden_v1beta1_LastOperation(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Local":                         schema_pkg_apis_garden_v1beta1_Local(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalConstraints":              schema_pkg_apis_garden_v1beta1_LocalConstraints(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalNetworks":                 schema_pkg_apis_garden_v1beta1_LocalNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalProfile":                  schema_pkg_apis_garden_v1beta1_LocalProfile(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType":                   schema_pkg_apis_garden_v1beta1_MachineType(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance":                   schema_pkg_apis_garden_v1beta1_Maintenance(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceAutoUpdate":         schema_pkg_apis_garden_v1beta1_MaintenanceAutoUpdate(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceTimeWindow":         schema_pkg_apis_garden_v1beta1_MaintenanceTimeWindow(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monocular":                     schema_pkg_apis_garden_v1beta1_Monocular(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.NginxIngress":                  schema_pkg_apis_garden_v1beta1_NginxIngress(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OIDCConfig":                    schema_pkg_apis_garden_v1beta1_OIDCConfig(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackCloud":                schema_pkg_apis_garden_v1beta1_OpenStackCloud(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackConstraints":          schema_pkg_apis_garden_v1beta1_OpenStackConstraints(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackFloatingPool":         schema_pkg_apis_garden_v1beta1_OpenStackFloatingPool(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackLoadBalancerProvider": schema_pkg_apis_garden_v1beta1_OpenStackLoadBalancerProvider(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineImage":         schema_pkg_apis_garden_v1beta1_OpenStackMachineImage(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineType":          schema_pkg_apis_garden_v1beta1_OpenStackMachineType(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackNetworks":             schema_pkg_apis_garden_v1beta1_OpenStackNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackProfile":              schema_pkg_apis_garden_v1beta1_OpenStackProfile(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackRouter":               schema_pkg_apis_garden_v1beta1_OpenStackRouter(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackWorker":               schema_pkg_apis_garden_v1beta1_OpenStackWorker(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Project":                       schema_pkg_apis_garden_v1beta1_Project(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectList":                   schema_pkg_apis_garden_v1beta1_ProjectList(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectSpec":                   schema_pkg_apis_garden_v1beta1_ProjectSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectStatus":                 schema_pkg_apis_garden_v1beta1_ProjectStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Quota":                         schema_pkg_apis_garden_v1beta1_Quota(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaList":                     schema_pkg_apis_garden_v1beta1_QuotaList(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaSpec":                     schema_pkg_apis_garden_v1beta1_QuotaSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBinding":                 schema_pkg_apis_garden_v1beta1_SecretBinding(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBindingList":             schema_pkg_apis_garden_v1beta1_SecretBindingList(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Seed":                          schema_pkg_apis_garden_v1beta1_Seed(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedCloud":                     schema_pkg_apis_garden_v1beta1_SeedCloud(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedList":                      schema_pkg_apis_garden_v1beta1_SeedList(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedNetworks":                  schema_pkg_apis_garden_v1beta1_SeedNetworks(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSpec":                      schema_pkg_apis_garden_v1beta1_SeedSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedStatus":                    schema_pkg_apis_garden_v1beta1_SeedStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Shoot":                         schema_pkg_apis_garden_v1beta1_Shoot(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootList":                     schema_pkg_apis_garden_v1beta1_ShootList(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootSpec":                     schema_pkg_apis_garden_v1beta1_ShootSpec(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootStatus":                   schema_pkg_apis_garden_v1beta1_ShootStatus(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType":                    schema_pkg_apis_garden_v1beta1_VolumeType(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Worker":                        schema_pkg_apis_garden_v1beta1_Worker(ref),
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone":                          schema_pkg_apis_garden_v1beta1_Zone(ref),
-		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
-		"k8s.io/api/core/v1.Affinity":                                                        schema_k8sio_api_core_v1_Affinity(ref),
-		"k8s.io/api/core/v1.AttachedVolume":                                                  schema_k8sio_api_core_v1_AttachedVolume(ref),
-		"k8s.io/api/core/v1.AvoidPods":                                                       schema_k8sio_api_core_v1_AvoidPods(ref),
-		"k8s.io/api/core/v1.AzureDiskVolumeSource":                                           schema_k8sio_api_core_v1_AzureDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.AzureFilePersistentVolumeSource":                                 schema_k8sio_api_core_v1_AzureFilePersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.AzureFileVolumeSource":                                           schema_k8sio_api_core_v1_AzureFileVolumeSource(ref),
-		"k8s.io/api/core/v1.Binding":                                                         schema_k8sio_api_core_v1_Binding(ref),
-		"k8s.io/api/core/v1.CSIPersistentVolumeSource":                                       schema_k8sio_api_core_v1_CSIPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.Capabilities":                                                    schema_k8sio_api_core_v1_Capabilities(ref),
-		"k8s.io/api/core/v1.CephFSPersistentVolumeSource":                                    schema_k8sio_api_core_v1_CephFSPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.CephFSVolumeSource":                                              schema_k8sio_api_core_v1_CephFSVolumeSource(ref),
-		"k8s.io/api/core/v1.CinderPersistentVolumeSource":                                    schema_k8sio_api_core_v1_CinderPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.CinderVolumeSource":                                              schema_k8sio_api_core_v1_CinderVolumeSource(ref),
-		"k8s.io/api/core/v1.ClientIPConfig":                                                  schema_k8sio_api_core_v1_ClientIPConfig(ref),
-		"k8s.io/api/core/v1.ComponentCondition":                                              schema_k8sio_api_core_v1_ComponentCondition(ref),
-		"k8s.io/api/core/v1.ComponentStatus":                                                 schema_k8sio_api_core_v1_ComponentStatus(ref),
-		"k8s.io/api/core/v1.ComponentStatusList":                                             schema_k8sio_api_core_v1_ComponentStatusList(ref),
-		"k8s.io/api/core/v1.ConfigMap":                                                       schema_k8sio_api_core_v1_ConfigMap(ref),
-		"k8s.io/api/core/v1.ConfigMapEnvSource":                                              schema_k8sio_api_core_v1_ConfigMapEnvSource(ref),
-		"k8s.io/api/core/v1.ConfigMapKeySelector":                                            schema_k8sio_api_core_v1_ConfigMapKeySelector(ref),
-		"k8s.io/api/core/v1.ConfigMapList":                                                   schema_k8sio_api_core_v1_ConfigMapList(ref),
-		"k8s.io/api/core/v1.ConfigMapNodeConfigSource":                                       schema_k8sio_api_core_v1_ConfigMapNodeConfigSource(ref),
-		"k8s.io/api/core/v1.ConfigMapProjection":                                             schema_k8sio_api_core_v1_ConfigMapProjection(ref),
-		"k8s.io/api/core/v1.ConfigMapVolumeSource":                                           schema_k8sio_api_core_v1_ConfigMapVolumeSource(ref),
-		"k8s.io/api/core/v1.Container":                                                       schema_k8sio_api_core_v1_Container(ref),
-		"k8s.io/api/core/v1.ContainerImage":                                                  schema_k8sio_api_core_v1_ContainerImage(ref),
-		"k8s.io/api/core/v1.ContainerPort":                                                   schema_k8sio_api_core_v1_ContainerPort(ref),
-		"k8s.io/api/core/v1.ContainerState":                                                  schema_k8sio_api_core_v1_ContainerState(ref),
-		"k8s.io/api/core/v1.ContainerStateRunning":                                           schema_k8sio_api_core_v1_ContainerStateRunning(ref),
-		"k8s.io/api/core/v1.ContainerStateTerminated":                                        schema_k8sio_api_core_v1_ContainerStateTerminated(ref),
-		"k8s.io/api/core/v1.ContainerStateWaiting":                                           schema_k8sio_api_core_v1_ContainerStateWaiting(ref),
-		"k8s.io/api/core/v1.ContainerStatus":                                                 schema_k8sio_api_core_v1_ContainerStatus(ref),
-		"k8s.io/api/core/v1.DaemonEndpoint":                                                  schema_k8sio_api_core_v1_DaemonEndpoint(ref),
-		"k8s.io/api/core/v1.DownwardAPIProjection":                                           schema_k8sio_api_core_v1_DownwardAPIProjection(ref),
-		"k8s.io/api/core/v1.DownwardAPIVolumeFile":                                           schema_k8sio_api_core_v1_DownwardAPIVolumeFile(ref),
-		"k8s.io/api/core/v1.DownwardAPIVolumeSource":                                         schema_k8sio_api_core_v1_DownwardAPIVolumeSource(ref),
-		"k8s.io/api/core/v1.EmptyDirVolumeSource":                                            schema_k8sio_api_core_v1_EmptyDirVolumeSource(ref),
-		"k8s.io/api/core/v1.EndpointAddress":                                                 schema_k8sio_api_core_v1_EndpointAddress(ref),
-		"k8s.io/api/core/v1.EndpointPort":                                                    schema_k8sio_api_core_v1_EndpointPort(ref),
-		"k8s.io/api/core/v1.EndpointSubset":                                                  schema_k8sio_api_core_v1_EndpointSubset(ref),
-		"k8s.io/api/core/v1.Endpoints":                                                       schema_k8sio_api_core_v1_Endpoints(ref),
-		"k8s.io/api/core/v1.EndpointsList":                                                   schema_k8sio_api_core_v1_EndpointsList(ref),
-		"k8s.io/api/core/v1.EnvFromSource":                                                   schema_k8sio_api_core_v1_EnvFromSource(ref),
-		"k8s.io/api/core/v1.EnvVar":                                                          schema_k8sio_api_core_v1_EnvVar(ref),
-		"k8s.io/api/core/v1.EnvVarSource":                                                    schema_k8sio_api_core_v1_EnvVarSource(ref),
-		"k8s.io/api/core/v1.Event":                                                           schema_k8sio_api_core_v1_Event(ref),
-		"k8s.io/api/core/v1.EventList":                                                       schema_k8sio_api_core_v1_EventList(ref),
-		"k8s.io/api/core/v1.EventSeries":                                                     schema_k8sio_api_core_v1_EventSeries(ref),
-		"k8s.io/api/core/v1.EventSource":                                                     schema_k8sio_api_core_v1_EventSource(ref),
-		"k8s.io/api/core/v1.ExecAction":                                                      schema_k8sio_api_core_v1_ExecAction(ref),
-		"k8s.io/api/core/v1.FCVolumeSource":                                                  schema_k8sio_api_core_v1_FCVolumeSource(ref),
-		"k8s.io/api/core/v1.FlexPersistentVolumeSource":                                      schema_k8sio_api_core_v1_FlexPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.FlexVolumeSource":                                                schema_k8sio_api_core_v1_FlexVolumeSource(ref),
-		"k8s.io/api/core/v1.FlockerVolumeSource":                                             schema_k8sio_api_core_v1_FlockerVolumeSource(ref),
-		"k8s.io/api/core/v1.GCEPersistentDiskVolumeSource":                                   schema_k8sio_api_core_v1_GCEPersistentDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.GitRepoVolumeSource":                                             schema_k8sio_api_core_v1_GitRepoVolumeSource(ref),
-		"k8s.io/api/core/v1.GlusterfsPersistentVolumeSource":                                 schema_k8sio_api_core_v1_GlusterfsPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.GlusterfsVolumeSource":                                           schema_k8sio_api_core_v1_GlusterfsVolumeSource(ref),
-		"k8s.io/api/core/v1.HTTPGetAction":                                                   schema_k8sio_api_core_v1_HTTPGetAction(ref),
-		"k8s.io/api/core/v1.HTTPHeader":                                                      schema_k8sio_api_core_v1_HTTPHeader(ref),
-		"k8s.io/api/core/v1.Handler":                                                         schema_k8sio_api_core_v1_Handler(ref),
-		"k8s.io/api/core/v1.HostAlias":                                                       schema_k8sio_api_core_v1_HostAlias(ref),
-		"k8s.io/api/core/v1.HostPathVolumeSource":                                            schema_k8sio_api_core_v1_HostPathVolumeSource(ref),
-		"k8s.io/api/core/v1.ISCSIPersistentVolumeSource":                                     schema_k8sio_api_core_v1_ISCSIPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.ISCSIVolumeSource":                                               schema_k8sio_api_core_v1_ISCSIVolumeSource(ref),
-		"k8s.io/api/core/v1.KeyToPath":                                                       schema_k8sio_api_core_v1_KeyToPath(ref),
-		"k8s.io/api/core/v1.Lifecycle":                                                       schema_k8sio_api_core_v1_Lifecycle(ref),
-		"k8s.io/api/core/v1.LimitRange":                                                      schema_k8sio_api_core_v1_LimitRange(ref),
-		"k8s.io/api/core/v1.LimitRangeItem":                                                  schema_k8sio_api_core_v1_LimitRangeItem(ref),
-		"k8s.io/api/core/v1.LimitRangeList":                                                  schema_k8sio_api_core_v1_LimitRangeList(ref),
-		"k8s.io/api/core/v1.LimitRangeSpec":                                                  schema_k8sio_api_core_v1_LimitRangeSpec(ref),
-		"k8s.io/api/core/v1.List":                                                            schema_k8sio_api_core_v1_List(ref),
-		"k8s.io/api/core/v1.LoadBalancerIngress":                                             schema_k8sio_api_core_v1_LoadBalancerIngress(ref),
-		"k8s.io/api/core/v1.LoadBalancerStatus":                                              schema_k8sio_api_core_v1_LoadBalancerStatus(ref),
-		"k8s.io/api/core/v1.LocalObjectReference":                                            schema_k8sio_api_core_v1_LocalObjectReference(ref),
-		"k8s.io/api/core/v1.LocalVolumeSource":                                               schema_k8sio_api_core_v1_LocalVolumeSource(ref),
-		"k8s.io/api/core/v1.NFSVolumeSource":                                                 schema_k8sio_api_core_v1_NFSVolumeSource(ref),
-		"k8s.io/api/core/v1.Namespace":                                                       schema_k8sio_api_core_v1_Namespace(ref),
-		"k8s.io/api/core/v1.NamespaceList":                                                   schema_k8sio_api_core_v1_NamespaceList(ref),
-		"k8s.io/api/core/v1.NamespaceSpec":                                                   schema_k8sio_api_core_v1_NamespaceSpec(ref),
-		"k8s.io/api/core/v1.NamespaceStatus":                                                 schema_k8sio_api_core_v1_NamespaceStatus(ref),
-		"k8s.io/api/core/v1.Node":                                                            schema_k8sio_api_core_v1_Node(ref),
-		"k8s.io/api/core/v1.NodeAddress":                                                     schema_k8sio_api_core_v1_NodeAddress(ref),
-		"k8s.io/api/core/v1.NodeAffinity":                                                    schema_k8sio_api_core_v1_NodeAffinity(ref),
-		"k8s.io/api/core/v1.NodeCondition":                                                   schema_k8sio_api_core_v1_NodeCondition(ref),
-		"k8s.io/api/core/v1.NodeConfigSource":                                                schema_k8sio_api_core_v1_NodeConfigSource(ref),
-		"k8s.io/api/core/v1.NodeConfigStatus":                                                schema_k8sio_api_core_v1_NodeConfigStatus(ref),
-		"k8s.io/api/core/v1.NodeDaemonEndpoints":                                             schema_k8sio_api_core_v1_NodeDaemonEndpoints(ref),
-		"k8s.io/api/core/v1.NodeList":                                                        schema_k8sio_api_core_v1_NodeList(ref),
-		"k8s.io/api/core/v1.NodeProxyOptions":                                                schema_k8sio_api_core_v1_NodeProxyOptions(ref),
-		"k8s.io/api/core/v1.NodeResources":                                                   schema_k8sio_api_core_v1_NodeResources(ref),
-		"k8s.io/api/core/v1.NodeSelector":                                                    schema_k8sio_api_core_v1_NodeSelector(ref),
-		"k8s.io/api/core/v1.NodeSelectorRequirement":                                         schema_k8sio_api_core_v1_NodeSelectorRequirement(ref),
-		"k8s.io/api/core/v1.NodeSelectorTerm":                                                schema_k8sio_api_core_v1_NodeSelectorTerm(ref),
-		"k8s.io/api/core/v1.NodeSpec":                                                        schema_k8sio_api_core_v1_NodeSpec(ref),
-		"k8s.io/api/core/v1.NodeStatus":                                                      schema_k8sio_api_core_v1_NodeStatus(ref),
-		"k8s.io/api/core/v1.NodeSystemInfo":                                                  schema_k8sio_api_core_v1_NodeSystemInfo(ref),
-		"k8s.io/api/core/v1.ObjectFieldSelector":                                             schema_k8sio_api_core_v1_ObjectFieldSelector(ref),
-		"k8s.io/api/core/v1.ObjectReference":                                                 schema_k8sio_api_core_v1_ObjectReference(ref),
-		"k8s.io/api/core/v1.PersistentVolume":                                                schema_k8sio_api_core_v1_PersistentVolume(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaim":                                           schema_k8sio_api_core_v1_PersistentVolumeClaim(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimCondition":                                  schema_k8sio_api_core_v1_PersistentVolumeClaimCondition(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimList":                                       schema_k8sio_api_core_v1_PersistentVolumeClaimList(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimSpec":                                       schema_k8sio_api_core_v1_PersistentVolumeClaimSpec(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimStatus":                                     schema_k8sio_api_core_v1_PersistentVolumeClaimStatus(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource":                               schema_k8sio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
-		"k8s.io/api/core/v1.PersistentVolumeList":                                            schema_k8sio_api_core_v1_PersistentVolumeList(ref),
-		"k8s.io/api/core/v1.PersistentVolumeSource":                                          schema_k8sio_api_core_v1_PersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.PersistentVolumeSpec":                                            schema_k8sio_api_core_v1_PersistentVolumeSpec(ref),
-		"k8s.io/api/core/v1.PersistentVolumeStatus":                                          schema_k8sio_api_core_v1_PersistentVolumeStatus(ref),
-		"k8s.io/api/core/v1.PhotonPersistentDiskVolumeSource":                                schema_k8sio_api_core_v1_PhotonPersistentDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.Pod":                                                             schema_k8sio_api_core_v1_Pod(ref),
-		"k8s.io/api/core/v1.PodAffinity":                                                     schema_k8sio_api_core_v1_PodAffinity(ref),
-		"k8s.io/api/core/v1.PodAffinityTerm":                                                 schema_k8sio_api_core_v1_PodAffinityTerm(ref),
-		"k8s.io/api/core/v1.PodAntiAffinity":                                                 schema_k8sio_api_core_v1_PodAntiAffinity(ref),
-		"k8s.io/api/core/v1.PodAttachOptions":                                                schema_k8sio_api_core_v1_PodAttachOptions(ref),
-		"k8s.io/api/core/v1.PodCondition":                                                    schema_k8sio_api_core_v1_PodCondition(ref),
-		"k8s.io/api/core/v1.PodDNSConfig":                                                    schema_k8sio_api_core_v1_PodDNSConfig(ref),
-		"k8s.io/api/core/v1.PodDNSConfigOption":                                              schema_k8sio_api_core_v1_PodDNSConfigOption(ref),
-		"k8s.io/api/core/v1.PodExecOptions":                                                  schema_k8sio_api_core_v1_PodExecOptions(ref),
-		"k8s.io/api/core/v1.PodList":                                                         schema_k8sio_api_core_v1_PodList(ref),
-		"k8s.io/api/core/v1.PodLogOptions":                                                   schema_k8sio_api_core_v1_PodLogOptions(ref),
-		"k8s.io/api/core/v1.PodPortForwardOptions":                                           schema_k8sio_api_core_v1_PodPortForwardOptions(ref),
-		"k8s.io/api/core/v1.PodProxyOptions":                                                 schema_k8sio_api_core_v1_PodProxyOptions(ref),
-		"k8s.io/api/core/v1.PodReadinessGate":                                                schema_k8sio_api_core_v1_PodReadinessGate(ref),
-		"k8s.io/api/core/v1.PodSecurityContext":                                              schema_k8sio_api_core_v1_PodSecurityContext(ref),
-		"k8s.io/api/core/v1.PodSignature":                                                    schema_k8sio_api_core_v1_PodSignature(ref),
-		"k8s.io/api/core/v1.PodSpec":                                                         schema_k8sio_api_core_v1_PodSpec(ref),
-		"k8s.io/api/core/v1.PodStatus":                                                       schema_k8sio_api_core_v1_PodStatus(ref),
-		"k8s.io/api/core/v1.PodStatusResult":                                                 schema_k8sio_api_core_v1_PodStatusResult(ref),
-		"k8s.io/api/core/v1.PodTemplate":                                                     schema_k8sio_api_core_v1_PodTemplate(ref),
-		"k8s.io/api/core/v1.PodTemplateList":                                                 schema_k8sio_api_core_v1_PodTemplateList(ref),
-		"k8s.io/api/core/v1.PodTemplateSpec":                                                 schema_k8sio_api_core_v1_PodTemplateSpec(ref),
-		"k8s.io/api/core/v1.PortworxVolumeSource":                                            schema_k8sio_api_core_v1_PortworxVolumeSource(ref),
-		"k8s.io/api/core/v1.PreferAvoidPodsEntry":                                            schema_k8sio_api_core_v1_PreferAvoidPodsEntry(ref),
-		"k8s.io/api/core/v1.PreferredSchedulingTerm":                                         schema_k8sio_api_core_v1_PreferredSchedulingTerm(ref),
-		"k8s.io/api/core/v1.Probe":                                                           schema_k8sio_api_core_v1_Probe(ref),
-		"k8s.io/api/core/v1.ProjectedVolumeSource":                                           schema_k8sio_api_core_v1_ProjectedVolumeSource(ref),
-		"k8s.io/api/core/v1.QuobyteVolumeSource":                                             schema_k8sio_api_core_v1_QuobyteVolumeSource(ref),
-		"k8s.io/api/core/v1.RBDPersistentVolumeSource":                                       schema_k8sio_api_core_v1_RBDPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.RBDVolumeSource":                                                 schema_k8sio_api_core_v1_RBDVolumeSource(ref),
-		"k8s.io/api/core/v1.RangeAllocation":                                                 schema_k8sio_api_core_v1_RangeAllocation(ref),
-		"k8s.io/api/core/v1.ReplicationController":                                           schema_k8sio_api_core_v1_ReplicationController(ref),
-		"k8s.io/api/core/v1.ReplicationControllerCondition":                                  schema_k8sio_api_core_v1_ReplicationControllerCondition(ref),
-		"k8s.io/api/core/v1.ReplicationControllerList":                                       schema_k8sio_api_core_v1_ReplicationControllerList(ref),
-		"k8s.io/api/core/v1.ReplicationControllerSpec":                                       schema_k8sio_api_core_v1_ReplicationControllerSpec(ref),
-		"k8s.io/api/core/v1.ReplicationControllerStatus":                                     schema_k8sio_api_core_v1_ReplicationControllerStatus(ref),
-		"k8s.io/api/core/v1.ResourceFieldSelector":                                           schema_k8sio_api_core_v1_ResourceFieldSelector(ref),
-		"k8s.io/api/core/v1.ResourceQuota":                                                   schema_k8sio_api_core_v1_ResourceQuota(ref),
-		"k8s.io/api/core/v1.ResourceQuotaList":                                               schema_k8sio_api_core_v1_ResourceQuotaList(ref),
-		"k8s.io/api/core/v1.ResourceQuotaSpec":                                               schema_k8sio_api_core_v1_ResourceQuotaSpec(ref),
-		"k8s.io/api/core/v1.ResourceQuotaStatus":                                             schema_k8sio_api_core_v1_ResourceQuotaStatus(ref),
-		"k8s.io/api/core/v1.ResourceRequirements":                                            schema_k8sio_api_core_v1_ResourceRequirements(ref),
-		"k8s.io/api/core/v1.SELinuxOptions":                                                  schema_k8sio_api_core_v1_SELinuxOptions(ref),
-		"k8s.io/api/core/v1.ScaleIOPersistentVolumeSource":                                   schema_k8sio_api_core_v1_ScaleIOPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.ScaleIOVolumeSource":                                             schema_k8sio_api_core_v1_ScaleIOVolumeSource(ref),
-		"k8s.io/api/core/v1.ScopeSelector":                                                   schema_k8sio_api_core_v1_ScopeSelector(ref),
-		"k8s.io/api/core/v1.ScopedResourceSelectorRequirement":                               schema_k8sio_api_core_v1_ScopedResourceSelectorRequirement(ref),
-		"k8s.io/api/core/v1.Secret":                                                          schema_k8sio_api_core_v1_Secret(ref),
-		"k8s.io/api/core/v1.SecretEnvSource":                                                 schema_k8sio_api_core_v1_SecretEnvSource(ref),
-		"k8s.io/api/core/v1.SecretKeySelector":                                               schema_k8sio_api_core_v1_SecretKeySelector(ref),
-		"k8s.io/api/core/v1.SecretList":                                                      schema_k8sio_api_core_v1_SecretList(ref),
-		"k8s.io/api/core/v1.SecretProjection":                                                schema_k8sio_api_core_v1_SecretProjection(ref),
-		"k8s.io/api/core/v1.SecretReference":                                                 schema_k8sio_api_core_v1_SecretReference(ref),
-		"k8s.io/api/core/v1.SecretVolumeSource":                                              schema_k8sio_api_core_v1_SecretVolumeSource(ref),
-		"k8s.io/api/core/v1.SecurityContext":                                                 schema_k8sio_api_core_v1_SecurityContext(ref),
-		"k8s.io/api/core/v1.SerializedReference":                                             schema_k8sio_api_core_v1_SerializedReference(ref),
-		"k8s.io/api/core/v1.Service":                                                         schema_k8sio_api_core_v1_Service(ref),
-		"k8s.io/api/core/v1.ServiceAccount":                                                  schema_k8sio_api_core_v1_ServiceAccount(ref),
-		"k8s.io/api/core/v1.ServiceAccountList":                                              schema_k8sio_api_core_v1_ServiceAccountList(ref),
-		"k8s.io/api/core/v1.ServiceAccountTokenProjection":                                   schema_k8sio_api_core_v1_ServiceAccountTokenProjection(ref),
-		"k8s.io/api/core/v1.ServiceList":                                                     schema_k8sio_api_core_v1_ServiceList(ref),
-		"k8s.io/api/core/v1.ServicePort":                                                     schema_k8sio_api_core_v1_ServicePort(ref),
-		"k8s.io/api/core/v1.ServiceProxyOptions":                                             schema_k8sio_api_core_v1_ServiceProxyOptions(ref),
-		"k8s.io/api/core/v1.ServiceSpec":                                                     schema_k8sio_api_core_v1_ServiceSpec(ref),
-		"k8s.io/api/core/v1.ServiceStatus":                                                   schema_k8sio_api_core_v1_ServiceStatus(ref),
-		"k8s.io/api/core/v1.SessionAffinityConfig":                                           schema_k8sio_api_core_v1_SessionAffinityConfig(ref),
-		"k8s.io/api/core/v1.StorageOSPersistentVolumeSource":                                 schema_k8sio_api_core_v1_StorageOSPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.StorageOSVolumeSource":                                           schema_k8sio_api_core_v1_StorageOSVolumeSource(ref),
-		"k8s.io/api/core/v1.Sysctl":                                                          schema_k8sio_api_core_v1_Sysctl(ref),
-		"k8s.io/api/core/v1.TCPSocketAction":                                                 schema_k8sio_api_core_v1_TCPSocketAction(ref),
-		"k8s.io/api/core/v1.Taint":                                                           schema_k8sio_api_core_v1_Taint(ref),
-		"k8s.io/api/core/v1.Toleration":                                                      schema_k8sio_api_core_v1_Toleration(ref),
-		"k8s.io/api/core/v1.TopologySelectorLabelRequirement":                                schema_k8sio_api_core_v1_TopologySelectorLabelRequirement(ref),
-		"k8s.io/api/core/v1.TopologySelectorTerm":                                            schema_k8sio_api_core_v1_TopologySelectorTerm(ref),
-		"k8s.io/api/core/v1.TypedLocalObjectReference":                                       schema_k8sio_api_core_v1_TypedLocalObjectReference(ref),
-		"k8s.io/api/core/v1.Volume":                                                          schema_k8sio_api_core_v1_Volume(ref),
-		"k8s.io/api/core/v1.VolumeDevice":                                                    schema_k8sio_api_core_v1_VolumeDevice(ref),
-		"k8s.io/api/core/v1.VolumeMount":                                                     schema_k8sio_api_core_v1_VolumeMount(ref),
-		"k8s.io/api/core/v1.VolumeNodeAffinity":                                              schema_k8sio_api_core_v1_VolumeNodeAffinity(ref),
-		"k8s.io/api/core/v1.VolumeProjection":                                                schema_k8sio_api_core_v1_VolumeProjection(ref),
-		"k8s.io/api/core/v1.VolumeSource":                                                    schema_k8sio_api_core_v1_VolumeSource(ref),
-		"k8s.io/api/core/v1.VsphereVirtualDiskVolumeSource":                                  schema_k8sio_api_core_v1_VsphereVirtualDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.WeightedPodAffinityTerm":                                         schema_k8sio_api_core_v1_WeightedPodAffinityTerm(ref),
-		"k8s.io/api/rbac/v1.AggregationRule":                                                 schema_k8sio_api_rbac_v1_AggregationRule(ref),
-		"k8s.io/api/rbac/v1.ClusterRole":                                                     schema_k8sio_api_rbac_v1_ClusterRole(ref),
-		"k8s.io/api/rbac/v1.ClusterRoleBinding":                                              schema_k8sio_api_rbac_v1_ClusterRoleBinding(ref),
-		"k8s.io/api/rbac/v1.ClusterRoleBindingList":                                          schema_k8sio_api_rbac_v1_ClusterRoleBindingList(ref),
-		"k8s.io/api/rbac/v1.ClusterRoleList":                                                 schema_k8sio_api_rbac_v1_ClusterRoleList(ref),
-		"k8s.io/api/rbac/v1.PolicyRule":                                                      schema_k8sio_api_rbac_v1_PolicyRule(ref),
-		"k8s.io/api/rbac/v1.Role":                                                            schema_k8sio_api_rbac_v1_Role(ref),
-		"k8s.io/api/rbac/v1.RoleBinding":                                                     schema_k8sio_api_rbac_v1_RoleBinding(ref),
-		"k8s.io/api/rbac/v1.RoleBindingList":                                                 schema_k8sio_api_rbac_v1_RoleBindingList(ref),
-		"k8s.io/api/rbac/v1.RoleList":                                                        schema_k8sio_api_rbac_v1_RoleList(ref),
-		"k8s.io/api/rbac/v1.RoleRef":                                                         schema_k8sio_api_rbac_v1_RoleRef(ref),
-		"k8s.io/api/rbac/v1.Subject":                                                         schema_k8sio_api_rbac_v1_Subject(ref),
-		"k8s.io/apimachinery/pkg/api/resource.Quantity":                                      schema_apimachinery_pkg_api_resource_Quantity(ref),
-		"k8s.io/apimachinery/pkg/api/resource.int64Amount":                                   schema_apimachinery_pkg_api_resource_int64Amount(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                      schema_pkg_apis_meta_v1_APIGroup(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                                  schema_pkg_apis_meta_v1_APIGroupList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                                   schema_pkg_apis_meta_v1_APIResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                               schema_pkg_apis_meta_v1_APIResourceList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                                   schema_pkg_apis_meta_v1_APIVersions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                                 schema_pkg_apis_meta_v1_CreateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                                 schema_pkg_apis_meta_v1_DeleteOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                      schema_pkg_apis_meta_v1_Duration(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ExportOptions":                                 schema_pkg_apis_meta_v1_ExportOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                    schema_pkg_apis_meta_v1_GetOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                     schema_pkg_apis_meta_v1_GroupKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                                 schema_pkg_apis_meta_v1_GroupResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                                  schema_pkg_apis_meta_v1_GroupVersion(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                      schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                              schema_pkg_apis_meta_v1_GroupVersionKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                          schema_pkg_apis_meta_v1_GroupVersionResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Initializer":                                   schema_pkg_apis_meta_v1_Initializer(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Initializers":                                  schema_pkg_apis_meta_v1_Initializers(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                                 schema_pkg_apis_meta_v1_InternalEvent(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                                 schema_pkg_apis_meta_v1_LabelSelector(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                      schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                          schema_pkg_apis_meta_v1_List(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                      schema_pkg_apis_meta_v1_ListMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                                   schema_pkg_apis_meta_v1_ListOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                     schema_pkg_apis_meta_v1_MicroTime(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                    schema_pkg_apis_meta_v1_ObjectMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                                schema_pkg_apis_meta_v1_OwnerReference(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                         schema_pkg_apis_meta_v1_Patch(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                                 schema_pkg_apis_meta_v1_Preconditions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                     schema_pkg_apis_meta_v1_RootPaths(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                     schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                        schema_pkg_apis_meta_v1_Status(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                                   schema_pkg_apis_meta_v1_StatusCause(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                                 schema_pkg_apis_meta_v1_StatusDetails(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                          schema_pkg_apis_meta_v1_Time(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                     schema_pkg_apis_meta_v1_Timestamp(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                      schema_pkg_apis_meta_v1_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                                 schema_pkg_apis_meta_v1_UpdateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                    schema_pkg_apis_meta_v1_WatchEvent(ref),
-		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                       schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
-		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                           schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/runtime.Unknown":                                            schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
-		"k8s.io/apimachinery/pkg/util/intstr.IntOrString":                                    schema_apimachinery_pkg_util_intstr_IntOrString(ref),
-		"k8s.io/apimachinery/pkg/version.Info":                                               schema_k8sio_apimachinery_pkg_version_Info(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition":                         schema_pkg_apis_core_v1alpha1_Condition(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerDeployment":              schema_pkg_apis_core_v1alpha1_ControllerDeployment(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerDeploymentStrategy":      schema_pkg_apis_core_v1alpha1_ControllerDeploymentStrategy(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallation":            schema_pkg_apis_core_v1alpha1_ControllerInstallation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallationList":        schema_pkg_apis_core_v1alpha1_ControllerInstallationList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallationSpec":        schema_pkg_apis_core_v1alpha1_ControllerInstallationSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallationStatus":      schema_pkg_apis_core_v1alpha1_ControllerInstallationStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistration":            schema_pkg_apis_core_v1alpha1_ControllerRegistration(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistrationList":        schema_pkg_apis_core_v1alpha1_ControllerRegistrationList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerRegistrationSpec":        schema_pkg_apis_core_v1alpha1_ControllerRegistrationSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerResource":                schema_pkg_apis_core_v1alpha1_ControllerResource(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig":                    schema_pkg_apis_core_v1alpha1_ProviderConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSCloud":                         schema_pkg_apis_garden_v1beta1_AWSCloud(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSConstraints":                   schema_pkg_apis_garden_v1beta1_AWSConstraints(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSMachineImage":                  schema_pkg_apis_garden_v1beta1_AWSMachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSMachineImageMapping":           schema_pkg_apis_garden_v1beta1_AWSMachineImageMapping(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSNetworks":                      schema_pkg_apis_garden_v1beta1_AWSNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSProfile":                       schema_pkg_apis_garden_v1beta1_AWSProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSRegionalMachineImage":          schema_pkg_apis_garden_v1beta1_AWSRegionalMachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSVPC":                           schema_pkg_apis_garden_v1beta1_AWSVPC(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSWorker":                        schema_pkg_apis_garden_v1beta1_AWSWorker(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addon":                            schema_pkg_apis_garden_v1beta1_Addon(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons":                           schema_pkg_apis_garden_v1beta1_Addons(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AdmissionPlugin":                  schema_pkg_apis_garden_v1beta1_AdmissionPlugin(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Alicloud":                         schema_pkg_apis_garden_v1beta1_Alicloud(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudConstraints":              schema_pkg_apis_garden_v1beta1_AlicloudConstraints(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudMachineImage":             schema_pkg_apis_garden_v1beta1_AlicloudMachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudMachineType":              schema_pkg_apis_garden_v1beta1_AlicloudMachineType(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudNetworks":                 schema_pkg_apis_garden_v1beta1_AlicloudNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudProfile":                  schema_pkg_apis_garden_v1beta1_AlicloudProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudVPC":                      schema_pkg_apis_garden_v1beta1_AlicloudVPC(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudVolumeType":               schema_pkg_apis_garden_v1beta1_AlicloudVolumeType(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudWorker":                   schema_pkg_apis_garden_v1beta1_AlicloudWorker(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditConfig":                      schema_pkg_apis_garden_v1beta1_AuditConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditPolicy":                      schema_pkg_apis_garden_v1beta1_AuditPolicy(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureCloud":                       schema_pkg_apis_garden_v1beta1_AzureCloud(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureConstraints":                 schema_pkg_apis_garden_v1beta1_AzureConstraints(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureDomainCount":                 schema_pkg_apis_garden_v1beta1_AzureDomainCount(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureMachineImage":                schema_pkg_apis_garden_v1beta1_AzureMachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureNetworks":                    schema_pkg_apis_garden_v1beta1_AzureNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureProfile":                     schema_pkg_apis_garden_v1beta1_AzureProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureResourceGroup":               schema_pkg_apis_garden_v1beta1_AzureResourceGroup(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureVNet":                        schema_pkg_apis_garden_v1beta1_AzureVNet(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureWorker":                      schema_pkg_apis_garden_v1beta1_AzureWorker(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Backup":                           schema_pkg_apis_garden_v1beta1_Backup(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructure":             schema_pkg_apis_garden_v1beta1_BackupInfrastructure(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureList":         schema_pkg_apis_garden_v1beta1_BackupInfrastructureList(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureSpec":         schema_pkg_apis_garden_v1beta1_BackupInfrastructureSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureStatus":       schema_pkg_apis_garden_v1beta1_BackupInfrastructureStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud":                            schema_pkg_apis_garden_v1beta1_Cloud(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudControllerManagerConfig":     schema_pkg_apis_garden_v1beta1_CloudControllerManagerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfile":                     schema_pkg_apis_garden_v1beta1_CloudProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileList":                 schema_pkg_apis_garden_v1beta1_CloudProfileList(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileSpec":                 schema_pkg_apis_garden_v1beta1_CloudProfileSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileStatus":               schema_pkg_apis_garden_v1beta1_CloudProfileStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ClusterAutoscaler":                schema_pkg_apis_garden_v1beta1_ClusterAutoscaler(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Condition":                        schema_pkg_apis_garden_v1beta1_Condition(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CredentialsRotation":              schema_pkg_apis_garden_v1beta1_CredentialsRotation(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS":                              schema_pkg_apis_garden_v1beta1_DNS(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint":            schema_pkg_apis_garden_v1beta1_DNSProviderConstraint(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPCloud":                         schema_pkg_apis_garden_v1beta1_GCPCloud(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPConstraints":                   schema_pkg_apis_garden_v1beta1_GCPConstraints(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPMachineImage":                  schema_pkg_apis_garden_v1beta1_GCPMachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPNetworks":                      schema_pkg_apis_garden_v1beta1_GCPNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPProfile":                       schema_pkg_apis_garden_v1beta1_GCPProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPVPC":                           schema_pkg_apis_garden_v1beta1_GCPVPC(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPWorker":                        schema_pkg_apis_garden_v1beta1_GCPWorker(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener":                         schema_pkg_apis_garden_v1beta1_Gardener(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration":                 schema_pkg_apis_garden_v1beta1_GardenerDuration(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Heapster":                         schema_pkg_apis_garden_v1beta1_Heapster(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HelmTiller":                       schema_pkg_apis_garden_v1beta1_HelmTiller(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation":                      schema_pkg_apis_garden_v1beta1_Hibernation(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HibernationMonthStatistics":       schema_pkg_apis_garden_v1beta1_HibernationMonthStatistics(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HibernationSchedule":              schema_pkg_apis_garden_v1beta1_HibernationSchedule(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HibernationStatistics":            schema_pkg_apis_garden_v1beta1_HibernationStatistics(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HorizontalPodAutoscalerConfig":    schema_pkg_apis_garden_v1beta1_HorizontalPodAutoscalerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.K8SNetworks":                      schema_pkg_apis_garden_v1beta1_K8SNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAM":                         schema_pkg_apis_garden_v1beta1_Kube2IAM(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAMRole":                     schema_pkg_apis_garden_v1beta1_Kube2IAMRole(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeAPIServerConfig":              schema_pkg_apis_garden_v1beta1_KubeAPIServerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeControllerManagerConfig":      schema_pkg_apis_garden_v1beta1_KubeControllerManagerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeLego":                         schema_pkg_apis_garden_v1beta1_KubeLego(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeProxyConfig":                  schema_pkg_apis_garden_v1beta1_KubeProxyConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeSchedulerConfig":              schema_pkg_apis_garden_v1beta1_KubeSchedulerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig":                    schema_pkg_apis_garden_v1beta1_KubeletConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes":                       schema_pkg_apis_garden_v1beta1_Kubernetes(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConfig":                 schema_pkg_apis_garden_v1beta1_KubernetesConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints":            schema_pkg_apis_garden_v1beta1_KubernetesConstraints(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesDashboard":              schema_pkg_apis_garden_v1beta1_KubernetesDashboard(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesMinorVersionExpiration": schema_pkg_apis_garden_v1beta1_KubernetesMinorVersionExpiration(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LastError":                        schema_pkg_apis_garden_v1beta1_LastError(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LastOperation":                    schema_pkg_apis_garden_v1beta1_LastOperation(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Local":                            schema_pkg_apis_garden_v1beta1_Local(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalConstraints":                 schema_pkg_apis_garden_v1beta1_LocalConstraints(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalNetworks":                    schema_pkg_apis_garden_v1beta1_LocalNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalProfile":                     schema_pkg_apis_garden_v1beta1_LocalProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImageStatus":               schema_pkg_apis_garden_v1beta1_MachineImageStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImageVulnerability":        schema_pkg_apis_garden_v1beta1_MachineImageVulnerability(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType":                      schema_pkg_apis_garden_v1beta1_MachineType(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance":                      schema_pkg_apis_garden_v1beta1_Maintenance(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceAutoUpdate":            schema_pkg_apis_garden_v1beta1_MaintenanceAutoUpdate(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceTimeWindow":            schema_pkg_apis_garden_v1beta1_MaintenanceTimeWindow(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monocular":                        schema_pkg_apis_garden_v1beta1_Monocular(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.NginxIngress":                     schema_pkg_apis_garden_v1beta1_NginxIngress(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OIDCConfig":                       schema_pkg_apis_garden_v1beta1_OIDCConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackCloud":                   schema_pkg_apis_garden_v1beta1_OpenStackCloud(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackConstraints":             schema_pkg_apis_garden_v1beta1_OpenStackConstraints(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackFloatingPool":            schema_pkg_apis_garden_v1beta1_OpenStackFloatingPool(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackLoadBalancerProvider":    schema_pkg_apis_garden_v1beta1_OpenStackLoadBalancerProvider(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineImage":            schema_pkg_apis_garden_v1beta1_OpenStackMachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineType":             schema_pkg_apis_garden_v1beta1_OpenStackMachineType(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackNetworks":                schema_pkg_apis_garden_v1beta1_OpenStackNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackProfile":                 schema_pkg_apis_garden_v1beta1_OpenStackProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackRouter":                  schema_pkg_apis_garden_v1beta1_OpenStackRouter(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackWorker":                  schema_pkg_apis_garden_v1beta1_OpenStackWorker(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PlannedChange":                    schema_pkg_apis_garden_v1beta1_PlannedChange(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Project":                          schema_pkg_apis_garden_v1beta1_Project(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectList":                      schema_pkg_apis_garden_v1beta1_ProjectList(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectMember":                    schema_pkg_apis_garden_v1beta1_ProjectMember(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectQuotaStatus":               schema_pkg_apis_garden_v1beta1_ProjectQuotaStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectSpec":                      schema_pkg_apis_garden_v1beta1_ProjectSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectStatus":                    schema_pkg_apis_garden_v1beta1_ProjectStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Quota":                            schema_pkg_apis_garden_v1beta1_Quota(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaList":                        schema_pkg_apis_garden_v1beta1_QuotaList(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaSpec":                        schema_pkg_apis_garden_v1beta1_QuotaSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.RegionAffinityGroup":              schema_pkg_apis_garden_v1beta1_RegionAffinityGroup(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBinding":                    schema_pkg_apis_garden_v1beta1_SecretBinding(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBindingList":                schema_pkg_apis_garden_v1beta1_SecretBindingList(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Seed":                             schema_pkg_apis_garden_v1beta1_Seed(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedCloud":                        schema_pkg_apis_garden_v1beta1_SeedCloud(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedExtension":                    schema_pkg_apis_garden_v1beta1_SeedExtension(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedList":                         schema_pkg_apis_garden_v1beta1_SeedList(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedNetworks":                     schema_pkg_apis_garden_v1beta1_SeedNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSpec":                         schema_pkg_apis_garden_v1beta1_SeedSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedStatus":                       schema_pkg_apis_garden_v1beta1_SeedStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedUtilization":                  schema_pkg_apis_garden_v1beta1_SeedUtilization(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Shoot":                            schema_pkg_apis_garden_v1beta1_Shoot(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootList":                        schema_pkg_apis_garden_v1beta1_ShootList(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootPlan":                        schema_pkg_apis_garden_v1beta1_ShootPlan(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootSpec":                        schema_pkg_apis_garden_v1beta1_ShootSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootStatus":                      schema_pkg_apis_garden_v1beta1_ShootStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType":                       schema_pkg_apis_garden_v1beta1_VolumeType(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Worker":                           schema_pkg_apis_garden_v1beta1_Worker(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone":                             schema_pkg_apis_garden_v1beta1_Zone(ref),
+		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                   schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
+		"k8s.io/api/core/v1.Affinity":                                                           schema_k8sio_api_core_v1_Affinity(ref),
+		"k8s.io/api/core/v1.AttachedVolume":                                                     schema_k8sio_api_core_v1_AttachedVolume(ref),
+		"k8s.io/api/core/v1.AvoidPods":                                                          schema_k8sio_api_core_v1_AvoidPods(ref),
+		"k8s.io/api/core/v1.AzureDiskVolumeSource":                                              schema_k8sio_api_core_v1_AzureDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.AzureFilePersistentVolumeSource":                                    schema_k8sio_api_core_v1_AzureFilePersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.AzureFileVolumeSource":                                              schema_k8sio_api_core_v1_AzureFileVolumeSource(ref),
+		"k8s.io/api/core/v1.Binding":                                                            schema_k8sio_api_core_v1_Binding(ref),
+		"k8s.io/api/core/v1.CSIPersistentVolumeSource":                                          schema_k8sio_api_core_v1_CSIPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.Capabilities":                                                       schema_k8sio_api_core_v1_Capabilities(ref),
+		"k8s.io/api/core/v1.CephFSPersistentVolumeSource":                                       schema_k8sio_api_core_v1_CephFSPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.CephFSVolumeSource":                                                 schema_k8sio_api_core_v1_CephFSVolumeSource(ref),
+		"k8s.io/api/core/v1.CinderPersistentVolumeSource":                                       schema_k8sio_api_core_v1_CinderPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.CinderVolumeSource":                                                 schema_k8sio_api_core_v1_CinderVolumeSource(ref),
+		"k8s.io/api/core/v1.ClientIPConfig":                                                     schema_k8sio_api_core_v1_ClientIPConfig(ref),
+		"k8s.io/api/core/v1.ComponentCondition":                                                 schema_k8sio_api_core_v1_ComponentCondition(ref),
+		"k8s.io/api/core/v1.ComponentStatus":                                                    schema_k8sio_api_core_v1_ComponentStatus(ref),
+		"k8s.io/api/core/v1.ComponentStatusList":                                                schema_k8sio_api_core_v1_ComponentStatusList(ref),
+		"k8s.io/api/core/v1.ConfigMap":                                                          schema_k8sio_api_core_v1_ConfigMap(ref),
+		"k8s.io/api/core/v1.ConfigMapEnvSource":                                                 schema_k8sio_api_core_v1_ConfigMapEnvSource(ref),
+		"k8s.io/api/core/v1.ConfigMapKeySelector":                                               schema_k8sio_api_core_v1_ConfigMapKeySelector(ref),
+		"k8s.io/api/core/v1.ConfigMapList":                                                      schema_k8sio_api_core_v1_ConfigMapList(ref),
+		"k8s.io/api/core/v1.ConfigMapNodeConfigSource":                                          schema_k8sio_api_core_v1_ConfigMapNodeConfigSource(ref),
+		"k8s.io/api/core/v1.ConfigMapProjection":                                                schema_k8sio_api_core_v1_ConfigMapProjection(ref),
+		"k8s.io/api/core/v1.ConfigMapVolumeSource":                                              schema_k8sio_api_core_v1_ConfigMapVolumeSource(ref),
+		"k8s.io/api/core/v1.Container":                                                          schema_k8sio_api_core_v1_Container(ref),
+		"k8s.io/api/core/v1.ContainerImage":                                                     schema_k8sio_api_core_v1_ContainerImage(ref),
+		"k8s.io/api/core/v1.ContainerPort":                                                      schema_k8sio_api_core_v1_ContainerPort(ref),
+		"k8s.io/api/core/v1.ContainerState":                                                     schema_k8sio_api_core_v1_ContainerState(ref),
+		"k8s.io/api/core/v1.ContainerStateRunning":                                              schema_k8sio_api_core_v1_ContainerStateRunning(ref),
+		"k8s.io/api/core/v1.ContainerStateTerminated":                                           schema_k8sio_api_core_v1_ContainerStateTerminated(ref),
+		"k8s.io/api/core/v1.ContainerStateWaiting":                                              schema_k8sio_api_core_v1_ContainerStateWaiting(ref),
+		"k8s.io/api/core/v1.ContainerStatus":                                                    schema_k8sio_api_core_v1_ContainerStatus(ref),
+		"k8s.io/api/core/v1.DaemonEndpoint":                                                     schema_k8sio_api_core_v1_DaemonEndpoint(ref),
+		"k8s.io/api/core/v1.DownwardAPIProjection":                                              schema_k8sio_api_core_v1_DownwardAPIProjection(ref),
+		"k8s.io/api/core/v1.DownwardAPIVolumeFile":                                              schema_k8sio_api_core_v1_DownwardAPIVolumeFile(ref),
+		"k8s.io/api/core/v1.DownwardAPIVolumeSource":                                            schema_k8sio_api_core_v1_DownwardAPIVolumeSource(ref),
+		"k8s.io/api/core/v1.EmptyDirVolumeSource":                                               schema_k8sio_api_core_v1_EmptyDirVolumeSource(ref),
+		"k8s.io/api/core/v1.EndpointAddress":                                                    schema_k8sio_api_core_v1_EndpointAddress(ref),
+		"k8s.io/api/core/v1.EndpointPort":                                                       schema_k8sio_api_core_v1_EndpointPort(ref),
+		"k8s.io/api/core/v1.EndpointSubset":                                                     schema_k8sio_api_core_v1_EndpointSubset(ref),
+		"k8s.io/api/core/v1.Endpoints":                                                          schema_k8sio_api_core_v1_Endpoints(ref),
+		"k8s.io/api/core/v1.EndpointsList":                                                      schema_k8sio_api_core_v1_EndpointsList(ref),
+		"k8s.io/api/core/v1.EnvFromSource":                                                      schema_k8sio_api_core_v1_EnvFromSource(ref),
+		"k8s.io/api/core/v1.EnvVar":                                                             schema_k8sio_api_core_v1_EnvVar(ref),
+		"k8s.io/api/core/v1.EnvVarSource":                                                       schema_k8sio_api_core_v1_EnvVarSource(ref),
+		"k8s.io/api/core/v1.Event":                                                              schema_k8sio_api_core_v1_Event(ref),
+		"k8s.io/api/core/v1.EventList":                                                          schema_k8sio_api_core_v1_EventList(ref),
+		"k8s.io/api/core/v1.EventSeries":                                                        schema_k8sio_api_core_v1_EventSeries(ref),
+		"k8s.io/api/core/v1.EventSource":                                                        schema_k8sio_api_core_v1_EventSource(ref),
+		"k8s.io/api/core/v1.ExecAction":                                                         schema_k8sio_api_core_v1_ExecAction(ref),
+		"k8s.io/api/core/v1.FCVolumeSource":                                                     schema_k8sio_api_core_v1_FCVolumeSource(ref),
+		"k8s.io/api/core/v1.FlexPersistentVolumeSource":                                         schema_k8sio_api_core_v1_FlexPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.FlexVolumeSource":                                                   schema_k8sio_api_core_v1_FlexVolumeSource(ref),
+		"k8s.io/api/core/v1.FlockerVolumeSource":                                                schema_k8sio_api_core_v1_FlockerVolumeSource(ref),
+		"k8s.io/api/core/v1.GCEPersistentDiskVolumeSource":                                      schema_k8sio_api_core_v1_GCEPersistentDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.GitRepoVolumeSource":                                                schema_k8sio_api_core_v1_GitRepoVolumeSource(ref),
+		"k8s.io/api/core/v1.GlusterfsPersistentVolumeSource":                                    schema_k8sio_api_core_v1_GlusterfsPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.GlusterfsVolumeSource":                                              schema_k8sio_api_core_v1_GlusterfsVolumeSource(ref),
+		"k8s.io/api/core/v1.HTTPGetAction":                                                      schema_k8sio_api_core_v1_HTTPGetAction(ref),
+		"k8s.io/api/core/v1.HTTPHeader":                                                         schema_k8sio_api_core_v1_HTTPHeader(ref),
+		"k8s.io/api/core/v1.Handler":                                                            schema_k8sio_api_core_v1_Handler(ref),
+		"k8s.io/api/core/v1.HostAlias":                                                          schema_k8sio_api_core_v1_HostAlias(ref),
+		"k8s.io/api/core/v1.HostPathVolumeSource":                                               schema_k8sio_api_core_v1_HostPathVolumeSource(ref),
+		"k8s.io/api/core/v1.ISCSIPersistentVolumeSource":                                        schema_k8sio_api_core_v1_ISCSIPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.ISCSIVolumeSource":                                                  schema_k8sio_api_core_v1_ISCSIVolumeSource(ref),
+		"k8s.io/api/core/v1.KeyToPath":                                                          schema_k8sio_api_core_v1_KeyToPath(ref),
+		"k8s.io/api/core/v1.Lifecycle":                                                          schema_k8sio_api_core_v1_Lifecycle(ref),
+		"k8s.io/api/core/v1.LimitRange":                                                         schema_k8sio_api_core_v1_LimitRange(ref),
+		"k8s.io/api/core/v1.LimitRangeItem":                                                     schema_k8sio_api_core_v1_LimitRangeItem(ref),
+		"k8s.io/api/core/v1.LimitRangeList":                                                     schema_k8sio_api_core_v1_LimitRangeList(ref),
+		"k8s.io/api/core/v1.LimitRangeSpec":                                                     schema_k8sio_api_core_v1_LimitRangeSpec(ref),
+		"k8s.io/api/core/v1.List":                                                               schema_k8sio_api_core_v1_List(ref),
+		"k8s.io/api/core/v1.LoadBalancerIngress":                                                schema_k8sio_api_core_v1_LoadBalancerIngress(ref),
+		"k8s.io/api/core/v1.LoadBalancerStatus":                                                 schema_k8sio_api_core_v1_LoadBalancerStatus(ref),
+		"k8s.io/api/core/v1.LocalObjectReference":                                               schema_k8sio_api_core_v1_LocalObjectReference(ref),
+		"k8s.io/api/core/v1.LocalVolumeSource":                                                  schema_k8sio_api_core_v1_LocalVolumeSource(ref),
+		"k8s.io/api/core/v1.NFSVolumeSource":                                                    schema_k8sio_api_core_v1_NFSVolumeSource(ref),
+		"k8s.io/api/core/v1.Namespace":                                                          schema_k8sio_api_core_v1_Namespace(ref),
+		"k8s.io/api/core/v1.NamespaceList":                                                      schema_k8sio_api_core_v1_NamespaceList(ref),
+		"k8s.io/api/core/v1.NamespaceSpec":                                                      schema_k8sio_api_core_v1_NamespaceSpec(ref),
+		"k8s.io/api/core/v1.NamespaceStatus":                                                    schema_k8sio_api_core_v1_NamespaceStatus(ref),
+		"k8s.io/api/core/v1.Node":                                                               schema_k8sio_api_core_v1_Node(ref),
+		"k8s.io/api/core/v1.NodeAddress":                                                        schema_k8sio_api_core_v1_NodeAddress(ref),
+		"k8s.io/api/core/v1.NodeAffinity":                                                       schema_k8sio_api_core_v1_NodeAffinity(ref),
+		"k8s.io/api/core/v1.NodeCondition":                                                      schema_k8sio_api_core_v1_NodeCondition(ref),
+		"k8s.io/api/core/v1.NodeConfigSource":                                                   schema_k8sio_api_core_v1_NodeConfigSource(ref),
+		"k8s.io/api/core/v1.NodeConfigStatus":                                                   schema_k8sio_api_core_v1_NodeConfigStatus(ref),
+		"k8s.io/api/core/v1.NodeDaemonEndpoints":                                                schema_k8sio_api_core_v1_NodeDaemonEndpoints(ref),
+		"k8s.io/api/core/v1.NodeList":                                                           schema_k8sio_api_core_v1_NodeList(ref),
+		"k8s.io/api/core/v1.NodeProxyOptions":                                                   schema_k8sio_api_core_v1_NodeProxyOptions(ref),
+		"k8s.io/api/core/v1.NodeResources":                                                      schema_k8sio_api_core_v1_NodeResources(ref),
+		"k8s.io/api/core/v1.NodeSelector":                                                       schema_k8sio_api_core_v1_NodeSelector(ref),
+		"k8s.io/api/core/v1.NodeSelectorRequirement":                                            schema_k8sio_api_core_v1_NodeSelectorRequirement(ref),
+		"k8s.io/api/core/v1.NodeSelectorTerm":                                                   schema_k8sio_api_core_v1_NodeSelectorTerm(ref),
+		"k8s.io/api/core/v1.NodeSpec":                                                           schema_k8sio_api_core_v1_NodeSpec(ref),
+		"k8s.io/api/core/v1.NodeStatus":                                                         schema_k8sio_api_core_v1_NodeStatus(ref),
+		"k8s.io/api/core/v1.NodeSystemInfo":                                                     schema_k8sio_api_core_v1_NodeSystemInfo(ref),
+		"k8s.io/api/core/v1.ObjectFieldSelector":                                                schema_k8sio_api_core_v1_ObjectFieldSelector(ref),
+		"k8s.io/api/core/v1.ObjectReference":                                                    schema_k8sio_api_core_v1_ObjectReference(ref),
+		"k8s.io/api/core/v1.PersistentVolume":                                                   schema_k8sio_api_core_v1_PersistentVolume(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaim":                                              schema_k8sio_api_core_v1_PersistentVolumeClaim(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimCondition":                                     schema_k8sio_api_core_v1_PersistentVolumeClaimCondition(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimList":                                          schema_k8sio_api_core_v1_PersistentVolumeClaimList(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimSpec":                                          schema_k8sio_api_core_v1_PersistentVolumeClaimSpec(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimStatus":                                        schema_k8sio_api_core_v1_PersistentVolumeClaimStatus(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource":                                  schema_k8sio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
+		"k8s.io/api/core/v1.PersistentVolumeList":                                               schema_k8sio_api_core_v1_PersistentVolumeList(ref),
+		"k8s.io/api/core/v1.PersistentVolumeSource":                                             schema_k8sio_api_core_v1_PersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.PersistentVolumeSpec":                                               schema_k8sio_api_core_v1_PersistentVolumeSpec(ref),
+		"k8s.io/api/core/v1.PersistentVolumeStatus":                                             schema_k8sio_api_core_v1_PersistentVolumeStatus(ref),
+		"k8s.io/api/core/v1.PhotonPersistentDiskVolumeSource":                                   schema_k8sio_api_core_v1_PhotonPersistentDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.Pod":                                                                schema_k8sio_api_core_v1_Pod(ref),
+		"k8s.io/api/core/v1.PodAffinity":                                                        schema_k8sio_api_core_v1_PodAffinity(ref),
+		"k8s.io/api/core/v1.PodAffinityTerm":                                                    schema_k8sio_api_core_v1_PodAffinityTerm(ref),
+		"k8s.io/api/core/v1.PodAntiAffinity":                                                    schema_k8sio_api_core_v1_PodAntiAffinity(ref),
+		"k8s.io/api/core/v1.PodAttachOptions":                                                   schema_k8sio_api_core_v1_PodAttachOptions(ref),
+		"k8s.io/api/core/v1.PodCondition":                                                       schema_k8sio_api_core_v1_PodCondition(ref),
+		"k8s.io/api/core/v1.PodDNSConfig":                                                       schema_k8sio_api_core_v1_PodDNSConfig(ref),
+		"k8s.io/api/core/v1.PodDNSConfigOption":                                                 schema_k8sio_api_core_v1_PodDNSConfigOption(ref),
+		"k8s.io/api/core/v1.PodExecOptions":                                                     schema_k8sio_api_core_v1_PodExecOptions(ref),
+		"k8s.io/api/core/v1.PodList":                                                            schema_k8sio_api_core_v1_PodList(ref),
+		"k8s.io/api/core/v1.PodLogOptions":                                                      schema_k8sio_api_core_v1_PodLogOptions(ref),
+		"k8s.io/api/core/v1.PodPortForwardOptions":                                              schema_k8sio_api_core_v1_PodPortForwardOptions(ref),
+		"k8s.io/api/core/v1.PodProxyOptions":                                                    schema_k8sio_api_core_v1_PodProxyOptions(ref),
+		"k8s.io/api/core/v1.PodReadinessGate":                                                   schema_k8sio_api_core_v1_PodReadinessGate(ref),
+		"k8s.io/api/core/v1.PodSecurityContext":                                                 schema_k8sio_api_core_v1_PodSecurityContext(ref),
+		"k8s.io/api/core/v1.PodSignature":                                                       schema_k8sio_api_core_v1_PodSignature(ref),
+		"k8s.io/api/core/v1.PodSpec":                                                            schema_k8sio_api_core_v1_PodSpec(ref),
+		"k8s.io/api/core/v1.PodStatus":                                                          schema_k8sio_api_core_v1_PodStatus(ref),
+		"k8s.io/api/core/v1.PodStatusResult":                                                    schema_k8sio_api_core_v1_PodStatusResult(ref),
+		"k8s.io/api/core/v1.PodTemplate":                                                        schema_k8sio_api_core_v1_PodTemplate(ref),
+		"k8s.io/api/core/v1.PodTemplateList":                                                    schema_k8sio_api_core_v1_PodTemplateList(ref),
+		"k8s.io/api/core/v1.PodTemplateSpec":                                                    schema_k8sio_api_core_v1_PodTemplateSpec(ref),
+		"k8s.io/api/core/v1.PortworxVolumeSource":                                               schema_k8sio_api_core_v1_PortworxVolumeSource(ref),
+		"k8s.io/api/core/v1.PreferAvoidPodsEntry":                                               schema_k8sio_api_core_v1_PreferAvoidPodsEntry(ref),
+		"k8s.io/api/core/v1.PreferredSchedulingTerm":                                            schema_k8sio_api_core_v1_PreferredSchedulingTerm(ref),
+		"k8s.io/api/core/v1.Probe":                                                              schema_k8sio_api_core_v1_Probe(ref),
+		"k8s.io/api/core/v1.ProjectedVolumeSource":                                              schema_k8sio_api_core_v1_ProjectedVolumeSource(ref),
+		"k8s.io/api/core/v1.QuobyteVolumeSource":                                                schema_k8sio_api_core_v1_QuobyteVolumeSource(ref),
+		"k8s.io/api/core/v1.RBDPersistentVolumeSource":                                          schema_k8sio_api_core_v1_RBDPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.RBDVolumeSource":                                                    schema_k8sio_api_core_v1_RBDVolumeSource(ref),
+		"k8s.io/api/core/v1.RangeAllocation":                                                    schema_k8sio_api_core_v1_RangeAllocation(ref),
+		"k8s.io/api/core/v1.ReplicationController":                                              schema_k8sio_api_core_v1_ReplicationController(ref),
+		"k8s.io/api/core/v1.ReplicationControllerCondition":                                     schema_k8sio_api_core_v1_ReplicationControllerCondition(ref),
+		"k8s.io/api/core/v1.ReplicationControllerList":                                          schema_k8sio_api_core_v1_ReplicationControllerList(ref),
+		"k8s.io/api/core/v1.ReplicationControllerSpec":                                          schema_k8sio_api_core_v1_ReplicationControllerSpec(ref),
+		"k8s.io/api/core/v1.ReplicationControllerStatus":                                        schema_k8sio_api_core_v1_ReplicationControllerStatus(ref),
+		"k8s.io/api/core/v1.ResourceFieldSelector":                                              schema_k8sio_api_core_v1_ResourceFieldSelector(ref),
+		"k8s.io/api/core/v1.ResourceQuota":                                                      schema_k8sio_api_core_v1_ResourceQuota(ref),
+		"k8s.io/api/core/v1.ResourceQuotaList":                                                  schema_k8sio_api_core_v1_ResourceQuotaList(ref),
+		"k8s.io/api/core/v1.ResourceQuotaSpec":                                                  schema_k8sio_api_core_v1_ResourceQuotaSpec(ref),
+		"k8s.io/api/core/v1.ResourceQuotaStatus":                                                schema_k8sio_api_core_v1_ResourceQuotaStatus(ref),
+		"k8s.io/api/core/v1.ResourceRequirements":                                               schema_k8sio_api_core_v1_ResourceRequirements(ref),
+		"k8s.io/api/core/v1.SELinuxOptions":                                                     schema_k8sio_api_core_v1_SELinuxOptions(ref),
+		"k8s.io/api/core/v1.ScaleIOPersistentVolumeSource":                                      schema_k8sio_api_core_v1_ScaleIOPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.ScaleIOVolumeSource":                                                schema_k8sio_api_core_v1_ScaleIOVolumeSource(ref),
+		"k8s.io/api/core/v1.ScopeSelector":                                                      schema_k8sio_api_core_v1_ScopeSelector(ref),
+		"k8s.io/api/core/v1.ScopedResourceSelectorRequirement":                                  schema_k8sio_api_core_v1_ScopedResourceSelectorRequirement(ref),
+		"k8s.io/api/core/v1.Secret":                                                             schema_k8sio_api_core_v1_Secret(ref),
+		"k8s.io/api/core/v1.SecretEnvSource":                                                    schema_k8sio_api_core_v1_SecretEnvSource(ref),
+		"k8s.io/api/core/v1.SecretKeySelector":                                                  schema_k8sio_api_core_v1_SecretKeySelector(ref),
+		"k8s.io/api/core/v1.SecretList":                                                         schema_k8sio_api_core_v1_SecretList(ref),
+		"k8s.io/api/core/v1.SecretProjection":                                                   schema_k8sio_api_core_v1_SecretProjection(ref),
+		"k8s.io/api/core/v1.SecretReference":                                                    schema_k8sio_api_core_v1_SecretReference(ref),
+		"k8s.io/api/core/v1.SecretVolumeSource":                                                 schema_k8sio_api_core_v1_SecretVolumeSource(ref),
+		"k8s.io/api/core/v1.SecurityContext":                                                    schema_k8sio_api_core_v1_SecurityContext(ref),
+		"k8s.io/api/core/v1.SerializedReference":                                                schema_k8sio_api_core_v1_SerializedReference(ref),
+		"k8s.io/api/core/v1.Service":                                                            schema_k8sio_api_core_v1_Service(ref),
+		"k8s.io/api/core/v1.ServiceAccount":                                                     schema_k8sio_api_core_v1_ServiceAccount(ref),
+		"k8s.io/api/core/v1.ServiceAccountList":                                                 schema_k8sio_api_core_v1_ServiceAccountList(ref),
+		"k8s.io/api/core/v1.ServiceAccountTokenProjection":                                      schema_k8sio_api_core_v1_ServiceAccountTokenProjection(ref),
+		"k8s.io/api/core/v1.ServiceList":                                                        schema_k8sio_api_core_v1_ServiceList(ref),
+		"k8s.io/api/core/v1.ServicePort":                                                        schema_k8sio_api_core_v1_ServicePort(ref),
+		"k8s.io/api/core/v1.ServiceProxyOptions":                                                schema_k8sio_api_core_v1_ServiceProxyOptions(ref),
+		"k8s.io/api/core/v1.ServiceSpec":                                                        schema_k8sio_api_core_v1_ServiceSpec(ref),
+		"k8s.io/api/core/v1.ServiceStatus":                                                      schema_k8sio_api_core_v1_ServiceStatus(ref),
+		"k8s.io/api/core/v1.SessionAffinityConfig":                                              schema_k8sio_api_core_v1_SessionAffinityConfig(ref),
+		"k8s.io/api/core/v1.StorageOSPersistentVolumeSource":                                    schema_k8sio_api_core_v1_StorageOSPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.StorageOSVolumeSource":                                              schema_k8sio_api_core_v1_StorageOSVolumeSource(ref),
+		"k8s.io/api/core/v1.Sysctl":                                                             schema_k8sio_api_core_v1_Sysctl(ref),
+		"k8s.io/api/core/v1.TCPSocketAction":                                                    schema_k8sio_api_core_v1_TCPSocketAction(ref),
+		"k8s.io/api/core/v1.Taint":                                                              schema_k8sio_api_core_v1_Taint(ref),
+		"k8s.io/api/core/v1.Toleration":                                                         schema_k8sio_api_core_v1_Toleration(ref),
+		"k8s.io/api/core/v1.TopologySelectorLabelRequirement":                                   schema_k8sio_api_core_v1_TopologySelectorLabelRequirement(ref),
+		"k8s.io/api/core/v1.TopologySelectorTerm":                                               schema_k8sio_api_core_v1_TopologySelectorTerm(ref),
+		"k8s.io/api/core/v1.TypedLocalObjectReference":                                          schema_k8sio_api_core_v1_TypedLocalObjectReference(ref),
+		"k8s.io/api/core/v1.Volume":                                                             schema_k8sio_api_core_v1_Volume(ref),
+		"k8s.io/api/core/v1.VolumeDevice":                                                       schema_k8sio_api_core_v1_VolumeDevice(ref),
+		"k8s.io/api/core/v1.VolumeMount":                                                        schema_k8sio_api_core_v1_VolumeMount(ref),
+		"k8s.io/api/core/v1.VolumeNodeAffinity":                                                 schema_k8sio_api_core_v1_VolumeNodeAffinity(ref),
+		"k8s.io/api/core/v1.VolumeProjection":                                                   schema_k8sio_api_core_v1_VolumeProjection(ref),
+		"k8s.io/api/core/v1.VolumeSource":                                                       schema_k8sio_api_core_v1_VolumeSource(ref),
+		"k8s.io/api/core/v1.VsphereVirtualDiskVolumeSource":                                     schema_k8sio_api_core_v1_VsphereVirtualDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.WeightedPodAffinityTerm":                                            schema_k8sio_api_core_v1_WeightedPodAffinityTerm(ref),
+		"k8s.io/api/rbac/v1.AggregationRule":                                                    schema_k8sio_api_rbac_v1_AggregationRule(ref),
+		"k8s.io/api/rbac/v1.ClusterRole":                                                        schema_k8sio_api_rbac_v1_ClusterRole(ref),
+		"k8s.io/api/rbac/v1.ClusterRoleBinding":                                                 schema_k8sio_api_rbac_v1_ClusterRoleBinding(ref),
+		"k8s.io/api/rbac/v1.ClusterRoleBindingList":                                             schema_k8sio_api_rbac_v1_ClusterRoleBindingList(ref),
+		"k8s.io/api/rbac/v1.ClusterRoleList":                                                    schema_k8sio_api_rbac_v1_ClusterRoleList(ref),
+		"k8s.io/api/rbac/v1.PolicyRule":                                                         schema_k8sio_api_rbac_v1_PolicyRule(ref),
+		"k8s.io/api/rbac/v1.Role":                                                               schema_k8sio_api_rbac_v1_Role(ref),
+		"k8s.io/api/rbac/v1.RoleBinding":                                                        schema_k8sio_api_rbac_v1_RoleBinding(ref),
+		"k8s.io/api/rbac/v1.RoleBindingList":                                                    schema_k8sio_api_rbac_v1_RoleBindingList(ref),
+		"k8s.io/api/rbac/v1.RoleList":                                                           schema_k8sio_api_rbac_v1_RoleList(ref),
+		"k8s.io/api/rbac/v1.RoleRef":                                                            schema_k8sio_api_rbac_v1_RoleRef(ref),
+		"k8s.io/api/rbac/v1.Subject":                                                            schema_k8sio_api_rbac_v1_Subject(ref),
+		"k8s.io/apimachinery/pkg/api/resource.Quantity":                                         schema_apimachinery_pkg_api_resource_Quantity(ref),
+		"k8s.io/apimachinery/pkg/api/resource.int64Amount":                                      schema_apimachinery_pkg_api_resource_int64Amount(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                         schema_pkg_apis_meta_v1_APIGroup(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                                     schema_pkg_apis_meta_v1_APIGroupList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                                      schema_pkg_apis_meta_v1_APIResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                                  schema_pkg_apis_meta_v1_APIResourceList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                                      schema_pkg_apis_meta_v1_APIVersions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                                    schema_pkg_apis_meta_v1_CreateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                                    schema_pkg_apis_meta_v1_DeleteOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                         schema_pkg_apis_meta_v1_Duration(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ExportOptions":                                    schema_pkg_apis_meta_v1_ExportOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                       schema_pkg_apis_meta_v1_GetOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                        schema_pkg_apis_meta_v1_GroupKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                                    schema_pkg_apis_meta_v1_GroupResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                                     schema_pkg_apis_meta_v1_GroupVersion(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                         schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                                 schema_pkg_apis_meta_v1_GroupVersionKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                             schema_pkg_apis_meta_v1_GroupVersionResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Initializer":                                      schema_pkg_apis_meta_v1_Initializer(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Initializers":                                     schema_pkg_apis_meta_v1_Initializers(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                                    schema_pkg_apis_meta_v1_InternalEvent(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                                    schema_pkg_apis_meta_v1_LabelSelector(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                         schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                             schema_pkg_apis_meta_v1_List(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                         schema_pkg_apis_meta_v1_ListMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                                      schema_pkg_apis_meta_v1_ListOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                        schema_pkg_apis_meta_v1_MicroTime(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                       schema_pkg_apis_meta_v1_ObjectMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                                   schema_pkg_apis_meta_v1_OwnerReference(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                            schema_pkg_apis_meta_v1_Patch(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                                    schema_pkg_apis_meta_v1_Preconditions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                        schema_pkg_apis_meta_v1_RootPaths(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                        schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                           schema_pkg_apis_meta_v1_Status(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                                      schema_pkg_apis_meta_v1_StatusCause(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                                    schema_pkg_apis_meta_v1_StatusDetails(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                             schema_pkg_apis_meta_v1_Time(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                        schema_pkg_apis_meta_v1_Timestamp(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                         schema_pkg_apis_meta_v1_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                                    schema_pkg_apis_meta_v1_UpdateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                       schema_pkg_apis_meta_v1_WatchEvent(ref),
+		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                          schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
+		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                              schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/runtime.Unknown":                                               schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
+		"k8s.io/apimachinery/pkg/util/intstr.IntOrString":                                       schema_apimachinery_pkg_util_intstr_IntOrString(ref),
+		"k8s.io/apimachinery/pkg/version.Info":                                                  schema_k8sio_apimachinery_pkg_version_Info(ref),
 	}
 }
 
@@ -493,12 +508,45 @@ func schema_pkg_apis_core_v1alpha1_ControllerDeployment(ref common.ReferenceCall
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
 						},
 					},
+					"strategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Strategy configures how changes of this registration are rolled out to the seeds. If not set, they are rolled out to all seeds at once.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerDeploymentStrategy"),
+						},
+					},
 				},
 				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerDeploymentStrategy", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_ControllerDeploymentStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControllerDeploymentStrategy configures how changes of a ControllerRegistration are rolled out to the seeds.",
+				Properties: map[string]spec.Schema{
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnavailable is the maximum number of seeds to which a change is rolled out at the same time. A seed counts as unavailable until the change has been installed successfully to it. If not set, changes are rolled out to all seeds at once.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"orderLabel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OrderLabel is the key of a label of the seeds whose values determine the order in which the change is rolled out to them (ascending, seeds without the label come last). Seeds with the same value are ordered by name.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{},
 	}
 }
 
@@ -1559,6 +1607,12 @@ func schema_pkg_apis_garden_v1beta1_AlicloudMachineType(ref common.ReferenceCall
 							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
+					"price": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Price is the price of running one machine of this type for one hour. It is used for cost estimations.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
 					"zones": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
@@ -1712,6 +1766,12 @@ func schema_pkg_apis_garden_v1beta1_AlicloudVolumeType(ref common.ReferenceCallb
 							Format:      "",
 						},
 					},
+					"price": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Price is the price of one GiB of storage of this type for one month. It is used for cost estimations.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
 					"zones": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
@@ -1729,7 +1789,8 @@ func schema_pkg_apis_garden_v1beta1_AlicloudVolumeType(ref common.ReferenceCallb
 				Required: []string{"name", "class", "zones"},
 			},
 		},
-		Dependencies: []string{},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -2576,11 +2637,17 @@ func schema_pkg_apis_garden_v1beta1_CloudProfile(ref common.ReferenceCallback) c
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileSpec"),
 						},
 					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains information about the vulnerabilities of the offered machine images.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileSpec", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -2681,11 +2748,51 @@ func schema_pkg_apis_garden_v1beta1_CloudProfileSpec(ref common.ReferenceCallbac
 							Format:      "",
 						},
 					},
+					"regionAffinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RegionAffinity groups the regions of the cloud profile into geographical or legal boundaries (e.g. EU, US). The control plane of a Shoot in a region of a group is never placed in a Seed outside of this group.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.RegionAffinityGroup"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.RegionAffinityGroup"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_CloudProfileStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CloudProfileStatus holds the most recently observed status of the cloud profile.",
+				Properties: map[string]spec.Schema{
+					"machineImages": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineImages contains the advisories reported for the machine images offered in the CloudProfile. It is populated by external vulnerability scanners via the status subresource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImageStatus"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackProfile"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImageStatus"},
 	}
 }
 
@@ -2765,6 +2872,47 @@ func schema_pkg_apis_garden_v1beta1_Condition(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_CredentialsRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CredentialsRotation contains information about the rotation of a single class of Shoot credentials.",
+				Properties: map[string]spec.Schema{
+					"credentials": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Credentials is the class of the rotated credentials, i.e. \"observability-credentials\", \"ssh-keypair\", or the name of a certificate authority.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase of the rotation, one of Rotating, Completed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastInitiationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastInitiationTime is the most recent time when the rotation was initiated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastCompletionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCompletionTime is the most recent time when the rotation was successfully completed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"credentials", "phase"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_DNS(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3294,6 +3442,55 @@ func schema_pkg_apis_garden_v1beta1_Hibernation(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_HibernationMonthStatistics(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HibernationMonthStatistics contains statistics about the hibernation of a Shoot in a month.",
+				Properties: map[string]spec.Schema{
+					"month": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Month is the month (`YYYY-MM` in UTC).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hibernatedSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HibernatedSeconds is the number of seconds the Shoot has been hibernated in the month.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"hibernatedNodeSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HibernatedNodeSeconds is the sum of the hibernated seconds multiplied by the number of nodes which would have been running otherwise (the minimum number of nodes of all worker pools at that time).",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"hibernatedHours": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HibernatedHours is the number of hours the Shoot has been hibernated in the month.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"estimatedSavings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EstimatedSavings is the estimated cost which has been saved by the hibernation in the month, based on the hibernated node seconds and the price per node and hour configured for the controller manager.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"month", "hibernatedSeconds", "hibernatedNodeSeconds", "hibernatedHours"},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_HibernationSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3314,6 +3511,27 @@ func schema_pkg_apis_garden_v1beta1_HibernationSchedule(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"location": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Location is the time zone (e.g., `Europe/Berlin`) in which the Cron specs are evaluated. Defaults to UTC.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"excludeDates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExcludeDates are dates (`YYYY-MM-DD` in the given location, e.g., public holidays) on which the Shoot is neither hibernated nor woken up by this schedule.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -3321,6 +3539,39 @@ func schema_pkg_apis_garden_v1beta1_HibernationSchedule(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_HibernationStatistics(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HibernationStatistics contains statistics about the hibernation of a Shoot.",
+				Properties: map[string]spec.Schema{
+					"hibernatedSince": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HibernatedSince is the time since which the hibernation of the Shoot has not been accounted in the statistics of the months yet. It is only set while the Shoot is hibernated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"months": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Months are the statistics of the current and the previous months, ordered from the oldest to the newest.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.HibernationMonthStatistics"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HibernationMonthStatistics", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_HorizontalPodAutoscalerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3804,11 +4055,25 @@ func schema_pkg_apis_garden_v1beta1_KubernetesConstraints(ref common.ReferenceCa
 							},
 						},
 					},
+					"minorVersionExpirations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinorVersionExpirations is a list of minor Kubernetes versions (e.g., 1.10) together with the date after which they expire. Shoots using an expired minor version are updated to the next minor version during their maintenance.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesMinorVersionExpiration"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"versions"},
 			},
 		},
-		Dependencies: []string{},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesMinorVersionExpiration"},
 	}
 }
 
@@ -3833,6 +4098,34 @@ func schema_pkg_apis_garden_v1beta1_KubernetesDashboard(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_KubernetesMinorVersionExpiration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubernetesMinorVersionExpiration contains the expiration date of a minor Kubernetes version.",
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the minor Kubernetes version (e.g., 1.10).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expirationDate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationDate is the date after which the minor Kubernetes version expires.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"version", "expirationDate"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_LastError(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4043,64 +4336,154 @@ func schema_pkg_apis_garden_v1beta1_LocalProfile(ref common.ReferenceCallback) c
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_MachineType(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_MachineImageStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MachineType contains certain properties of a machine type.",
+				Description: "MachineImageStatus contains the advisories reported for a technical machine image.",
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the machine type.",
+							Description: "Name is the name of the image.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"usable": {
+					"image": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Usable defines if the machine type can be used for shoot clusters.",
-							Type:        []string{"boolean"},
+							Description: "Image is the technical identifier of the image, i.e., the AMI (AWS), the version (Azure), the image (GCP and OpenStack), or the ID (Alicloud). If not present, the advisories apply to all images with the given name.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"cpu": {
-						SchemaProps: spec.SchemaProps{
-							Description: "CPU is the number of CPUs for this machine type.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-						},
-					},
-					"gpu": {
-						SchemaProps: spec.SchemaProps{
-							Description: "GPU is the number of GPUs for this machine type.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-						},
-					},
-					"memory": {
+					"vulnerabilities": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Memory is the amount of memory for this machine type.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							Description: "Vulnerabilities is a list of known vulnerabilities of the image.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImageVulnerability"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name", "cpu", "gpu", "memory"},
+				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImageVulnerability"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Maintenance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_MachineImageVulnerability(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Maintenance contains information about the time window for maintenance operations and which operations should be performed.",
+				Description: "MachineImageVulnerability contains information about a known vulnerability of a machine image.",
 				Properties: map[string]spec.Schema{
-					"autoUpdate": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutoUpdate contains information about which constraints should be automatically updated.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceAutoUpdate"),
-						},
+							Description: "ID is the identifier of the vulnerability or advisory, e.g. \"CVE-2019-5736\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"severity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Severity is the severity of the vulnerability.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is a human-readable summary of the vulnerability.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is a link to further information about the vulnerability.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"id", "severity"},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_MachineType(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineType contains certain properties of a machine type.",
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the machine type.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"usable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Usable defines if the machine type can be used for shoot clusters.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPU is the number of CPUs for this machine type.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"gpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GPU is the number of GPUs for this machine type.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory is the amount of memory for this machine type.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"price": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Price is the price of running one machine of this type for one hour. It is used for cost estimations.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+				Required: []string{"name", "cpu", "gpu", "memory"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_Maintenance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Maintenance contains information about the time window for maintenance operations and which operations should be performed.",
+				Properties: map[string]spec.Schema{
+					"autoUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoUpdate contains information about which constraints should be automatically updated.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceAutoUpdate"),
+						},
 					},
 					"timeWindow": {
 						SchemaProps: spec.SchemaProps{
@@ -4108,6 +4491,20 @@ func schema_pkg_apis_garden_v1beta1_Maintenance(ref common.ReferenceCallback) co
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceTimeWindow"),
 						},
 					},
+					"operations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operations is a list of additional operations which are executed in the given order when the Shoot is maintained, e.g. \"restart-control-plane\" or \"rotate-ssh-keypair\".",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -4129,6 +4526,20 @@ func schema_pkg_apis_garden_v1beta1_MaintenanceAutoUpdate(ref common.ReferenceCa
 							Format:      "",
 						},
 					},
+					"machineImageVulnerabilitySeverity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineImageVulnerabilitySeverity is the minimum severity of a vulnerability of the currently used machine image which causes the machine image to be updated immediately, i.e., outside of the maintenance time window, as soon as the CloudProfile offers another image. If not present, machine images are only updated within the maintenance time window.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kubernetesVersionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubernetesVersionPolicy defines which Kubernetes version updates are applied if KubernetesVersion is true. Defaults to \"patch\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"kubernetesVersion"},
 			},
@@ -4586,6 +4997,12 @@ func schema_pkg_apis_garden_v1beta1_OpenStackMachineType(ref common.ReferenceCal
 							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
+					"price": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Price is the price of running one machine of this type for one hour. It is used for cost estimations.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
 					"volumeType": {
 						SchemaProps: spec.SchemaProps{
 							Description: "VolumeType is the type of that volume.",
@@ -4796,6 +5213,55 @@ func schema_pkg_apis_garden_v1beta1_OpenStackWorker(ref common.ReferenceCallback
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_PlannedChange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PlannedChange is a change which a reconciliation or a maintenance of the Shoot would make.",
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the change.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target is the name of the affected object, e.g. the name of a deployment or a secret.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"current": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Current is the current value of the target (e.g., the deployed image), if any.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"desired": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Desired is the value of the target after the reconciliation, if any.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human readable message describing the change.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "target", "message"},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_Project(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4890,6 +5356,95 @@ func schema_pkg_apis_garden_v1beta1_ProjectList(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_ProjectMember(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectMember is a member of a project.",
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind of object being referenced. Values defined by this API group are \"User\", \"Group\", and \"ServiceAccount\". If the Authorizer does not recognized the kind value, the Authorizer should report an error.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIGroup holds the API group of the referenced subject. Defaults to \"\" for ServiceAccount subjects. Defaults to \"rbac.authorization.k8s.io\" for User and Group subjects.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the object being referenced.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the referenced object.  If the object kind is non-namespace, such as \"User\" or \"Group\", and this value is not empty the Authorizer should report an error.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expirationTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationTimestamp is the time after which the member is removed from the project.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"kind", "name"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_ProjectQuotaStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectQuotaStatus contains the limits of the quotas referenced by a project and the resources used by its Shoots.",
+				Properties: map[string]spec.Schema{
+					"hard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hard is the most restrictive limit of all referenced quotas for each metric.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"used": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Used is the amount of resources of the metrics in Hard which are allocated by the Shoots of the project.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_ProjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4924,12 +5479,12 @@ func schema_pkg_apis_garden_v1beta1_ProjectSpec(ref common.ReferenceCallback) co
 					},
 					"members": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Members is a list of subjects representing a user name, an email address, or any other identifier of a user that should be part of this project.",
+							Description: "Members is a list of subjects representing a user name, an email address, or any other identifier of a user that should be part of this project. Members with an expiration timestamp are removed once it has passed.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/rbac/v1.Subject"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectMember"),
 									},
 								},
 							},
@@ -4942,11 +5497,24 @@ func schema_pkg_apis_garden_v1beta1_ProjectSpec(ref common.ReferenceCallback) co
 							Format:      "",
 						},
 					},
+					"quotas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Quotas is a list of references to Quota objects of scope 'project' which limit the resources of all Shoots of the project.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.ObjectReference"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/rbac/v1.Subject"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectMember", "k8s.io/api/core/v1.ObjectReference", "k8s.io/api/rbac/v1.Subject"},
 	}
 }
 
@@ -4970,10 +5538,29 @@ func schema_pkg_apis_garden_v1beta1_ProjectStatus(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"quota": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Quota contains the limits of the quotas referenced by the project and the resources used by its Shoots.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectQuotaStatus"),
+						},
+					},
+					"lastActivityTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastActivityTimestamp is the time of the last observed activity in the project, e.g. a change of its members or of the secrets in its namespace.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"staleSinceTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StaleSinceTimestamp is the time since when the project has been stale, i.e. it has had no Shoots and no activity for the configured period.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 			},
 		},
-		Dependencies: []string{},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectQuotaStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -5077,6 +5664,13 @@ func schema_pkg_apis_garden_v1beta1_QuotaSpec(ref common.ReferenceCallback) comm
 							Format:      "int32",
 						},
 					},
+					"maxClusterLifetimeExtensionDays": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxClusterLifetimeExtensionDays is the number of days by which the lifetime of a Shoot cluster can be extended in total via lifetime extension requests. Extension requests are denied if it is not set.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"metrics": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Metrics is a list of resources which will be put under constraints.",
@@ -5106,6 +5700,41 @@ func schema_pkg_apis_garden_v1beta1_QuotaSpec(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_RegionAffinityGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RegionAffinityGroup is a group of regions which Shoot control planes must not leave.",
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the group.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"regions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Regions are the names of the regions belonging to the group.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "regions"},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_SecretBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5286,6 +5915,48 @@ func schema_pkg_apis_garden_v1beta1_SeedCloud(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_SeedExtension(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedExtension contains information about an extension installed on a Seed.",
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the ControllerRegistration of the extension.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version of the chart the extension has been installed with.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"healthy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Healthy indicates whether the installation of the extension is valid and has succeeded.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"lastHeartbeatTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastHeartbeatTime is the last time the installation of the extension has been reconciled.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"name", "healthy"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_SeedList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5442,11 +6113,92 @@ func schema_pkg_apis_garden_v1beta1_SeedStatus(ref common.ReferenceCallback) com
 							},
 						},
 					},
+					"extensions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Extensions contains the names, versions, and health of the extensions installed on the Seed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedExtension"),
+									},
+								},
+							},
+						},
+					},
+					"utilization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Utilization summarizes the Shoots scheduled to the Seed and the resources of the Seed cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedUtilization"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Condition"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedExtension", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedUtilization"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_SeedUtilization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedUtilization summarizes the Shoots scheduled to a Seed and the resources of the Seed cluster.",
+				Properties: map[string]spec.Schema{
+					"shoots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Shoots is the number of Shoots scheduled to the Seed.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"shootsByPurpose": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootsByPurpose maps the purposes of the Shoots scheduled to the Seed to their number.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"integer"},
+										Format: "int32",
+									},
+								},
+							},
+						},
+					},
+					"allocatable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Allocatable is the sum of the allocatable resources of the nodes of the Seed cluster.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"requested": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Requested is the sum of the resources requested by the pods running in the Seed cluster.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"shoots"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -5548,6 +6300,47 @@ func schema_pkg_apis_garden_v1beta1_ShootList(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_garden_v1beta1_ShootPlan(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootPlan contains the changes which a reconciliation or a maintenance of the Shoot would make, computed without applying them.",
+				Properties: map[string]spec.Schema{
+					"computedTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComputedTime is the time at which the plan has been computed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"generation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Generation is the generation of the Shoot for which the plan has been computed.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"changes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Changes are the changes which a reconciliation or a maintenance would make.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PlannedChange"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"computedTime", "generation"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PlannedChange", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_garden_v1beta1_ShootSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5675,12 +6468,43 @@ func schema_pkg_apis_garden_v1beta1_ShootStatus(ref common.ReferenceCallback) co
 							Format:      "",
 						},
 					},
+					"credentialsRotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialsRotations holds information about the rotations of single classes of Shoot credentials which have been requested via the operation annotation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CredentialsRotation"),
+									},
+								},
+							},
+						},
+					},
+					"plan": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Plan contains the changes which a reconciliation of the Shoot would make. It is only computed on request via the operation annotation.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootPlan"),
+						},
+					},
+					"maintenancePlan": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenancePlan contains the changes which the next maintenance of the Shoot would make. It is only computed on request via the operation annotation.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootPlan"),
+						},
+					},
+					"hibernationStatistics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HibernationStatistics contains statistics about the hibernation of the Shoot in the current and the previous months.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.HibernationStatistics"),
+						},
+					},
 				},
 				Required: []string{"gardener", "technicalID", "uid"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.LastOperation", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.CredentialsRotation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.HibernationStatistics", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootPlan", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -5711,11 +6535,18 @@ func schema_pkg_apis_garden_v1beta1_VolumeType(ref common.ReferenceCallback) com
 							Format:      "",
 						},
 					},
+					"price": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Price is the price of one GiB of storage of this type for one month. It is used for cost estimations.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
 				},
 				Required: []string{"name", "class"},
 			},
 		},
-		Dependencies: []string{},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
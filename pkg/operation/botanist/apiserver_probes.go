@@ -0,0 +1,294 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	gardenmetrics "github.com/gardener/gardener/pkg/controllermanager/metrics"
+
+	"github.com/prometheus/common/expfmt"
+	prometheusmodel "github.com/prometheus/common/model"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// APIServerProbeVantageGarden is the vantage point of the probes executed by the Gardener controller manager.
+	APIServerProbeVantageGarden = "garden"
+	// APIServerProbeVantageSeed is the vantage point of the probes executed by the blackbox exporter of the Shoot
+	// Prometheus in the Seed cluster.
+	APIServerProbeVantageSeed = "seed"
+
+	// APIServerProbeEndpointInternal is the endpoint of the API server via the internal domain of the Shoot.
+	APIServerProbeEndpointInternal = "internal"
+	// APIServerProbeEndpointExternal is the endpoint of the API server via the external domain of the Shoot.
+	APIServerProbeEndpointExternal = "external"
+
+	// seedAPIServerProbeJob is the name of the Prometheus job probing the API server from the Seed cluster.
+	seedAPIServerProbeJob = "blackbox-apiserver"
+)
+
+// APIServerProbeResult is the result of a probe of the API server of a Shoot from a vantage point via an endpoint.
+type APIServerProbeResult struct {
+	// Vantage is the vantage point the probe was executed from.
+	Vantage string
+	// Endpoint is the endpoint of the API server which was probed.
+	Endpoint string
+	// Duration is the duration of the probe.
+	Duration time.Duration
+	// Err is the reason why the probe failed, or nil if it succeeded.
+	Err error
+}
+
+// Path returns the path of the probe, i.e. its vantage point and endpoint.
+func (r APIServerProbeResult) Path() string {
+	return fmt.Sprintf("%s/%s", r.Vantage, r.Endpoint)
+}
+
+// CheckAPIServerProbes checks the results of the additional probes of the API server of a Shoot. If the API server is
+// available but one of the probes failed, the condition is failed. Otherwise, the durations of the probes are appended
+// to its message.
+func (b *HealthChecker) CheckAPIServerProbes(condition *gardenv1beta1.Condition, results []APIServerProbeResult) *gardenv1beta1.Condition {
+	if condition.Status != gardenv1beta1.ConditionTrue || len(results) == 0 {
+		return condition
+	}
+
+	var failed, durations []string
+	for _, result := range results {
+		if result.Err != nil {
+			failed = append(failed, fmt.Sprintf("%s (%s)", result.Path(), result.Err.Error()))
+			continue
+		}
+		durations = append(durations, fmt.Sprintf("[%s:%dms]", result.Path(), result.Duration.Nanoseconds()/time.Millisecond.Nanoseconds()))
+	}
+
+	if len(failed) > 0 {
+		message := fmt.Sprintf("Shoot API server is not reachable from all vantage points. Failed probes: %s", strings.Join(failed, ", "))
+		return b.FailedCondition(condition, "APIServerProbesFailed", message)
+	}
+	message := fmt.Sprintf("%s Probes: %s", condition.Message, strings.Join(durations, " "))
+	return helper.UpdatedCondition(condition, gardenv1beta1.ConditionTrue, condition.Reason, message)
+}
+
+// probeAPIServer probes the API server of the Shoot from all vantage points via all endpoints, except from the
+// Gardener controller manager via the internal domain which is checked by checkAPIServerAvailability.
+func (b *Botanist) probeAPIServer(probes *config.ShootCareAPIServerProbes) []APIServerProbeResult {
+	ctx, cancel := context.WithTimeout(context.Background(), probes.Timeout.Duration)
+	defer cancel()
+
+	domains := map[string]string{APIServerProbeEndpointInternal: b.Shoot.InternalClusterDomain}
+	if externalDomain := b.Shoot.ComputeAPIServerURL(false, false); externalDomain != b.Shoot.InternalClusterDomain {
+		domains[APIServerProbeEndpointExternal] = externalDomain
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mutex   sync.Mutex
+		results []APIServerProbeResult
+		add     = func(newResults ...APIServerProbeResult) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			results = append(results, newResults...)
+		}
+	)
+
+	if externalDomain, ok := domains[APIServerProbeEndpointExternal]; ok {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			add(b.probeAPIServerFromGarden(ctx, APIServerProbeEndpointExternal, externalDomain))
+		}()
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		add(b.probeAPIServerFromSeed(ctx)...)
+	}()
+
+	for _, prober := range probes.ExternalProbers {
+		for endpoint, domain := range domains {
+			wg.Add(1)
+			go func(prober config.ExternalAPIServerProber, endpoint, domain string) {
+				defer wg.Done()
+				add(ProbeAPIServerWithBlackboxExporter(ctx, http.DefaultClient, prober, endpoint, domain))
+			}(prober, endpoint, domain)
+		}
+	}
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Path() < results[j].Path() })
+	for _, result := range results {
+		b.recordAPIServerProbe(result)
+	}
+	return results
+}
+
+// probeAPIServerFromGarden probes the /healthz endpoint of the API server via the given domain with the credentials
+// of the Shoot client.
+func (b *Botanist) probeAPIServerFromGarden(ctx context.Context, endpoint, domain string) APIServerProbeResult {
+	result := APIServerProbeResult{Vantage: APIServerProbeVantageGarden, Endpoint: endpoint}
+
+	restConfig := rest.CopyConfig(b.K8sShootClient.RESTConfig())
+	restConfig.Host = fmt.Sprintf("https://%s", domain)
+	transport, err := rest.TransportFor(restConfig)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	request, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/healthz", restConfig.Host), nil)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	start := Now()
+	response, err := (&http.Client{Transport: transport}).Do(request.WithContext(ctx))
+	result.Duration = Now().Sub(start)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		result.Err = fmt.Errorf("/healthz endpoint returned status code %d", response.StatusCode)
+	}
+	return result
+}
+
+// probeAPIServerFromSeed reads the results of the probes of the API server by the blackbox exporter of the Shoot
+// Prometheus in the Seed cluster. If the Shoot Prometheus cannot be queried, no results are returned as this is not a
+// problem of the API server.
+func (b *Botanist) probeAPIServerFromSeed(ctx context.Context) []APIServerProbeResult {
+	if err := b.InitializeMonitoringClient(); err != nil {
+		b.Logger.Debugf("Could not initialize Shoot monitoring API client to read API server probes: %+v", err)
+		return nil
+	}
+
+	successes, err := b.MonitoringClient.Query(ctx, fmt.Sprintf("probe_success{job=%q}", seedAPIServerProbeJob), Now())
+	if err != nil {
+		b.Logger.Debugf("Could not query API server probes from Shoot Prometheus: %+v", err)
+		return nil
+	}
+	durations, err := b.MonitoringClient.Query(ctx, fmt.Sprintf("probe_duration_seconds{job=%q}", seedAPIServerProbeJob), Now())
+	if err != nil {
+		b.Logger.Debugf("Could not query API server probes from Shoot Prometheus: %+v", err)
+		return nil
+	}
+
+	successVector, ok := successes.(prometheusmodel.Vector)
+	if !ok {
+		return nil
+	}
+	durationVector, ok := durations.(prometheusmodel.Vector)
+	if !ok {
+		return nil
+	}
+	return SeedAPIServerProbeResults(successVector, durationVector)
+}
+
+// SeedAPIServerProbeResults computes the results of the probes of the API server from the Seed cluster based on the
+// `probe_success` and `probe_duration_seconds` samples of the blackbox exporter of the Shoot Prometheus.
+func SeedAPIServerProbeResults(successes, durations prometheusmodel.Vector) []APIServerProbeResult {
+	durationsByEndpoint := make(map[string]time.Duration, len(durations))
+	for _, sample := range durations {
+		durationsByEndpoint[string(sample.Metric["endpoint"])] = time.Duration(float64(sample.Value) * float64(time.Second))
+	}
+
+	var results []APIServerProbeResult
+	for _, sample := range successes {
+		endpoint := string(sample.Metric["endpoint"])
+		if len(endpoint) == 0 {
+			continue
+		}
+
+		result := APIServerProbeResult{
+			Vantage:  APIServerProbeVantageSeed,
+			Endpoint: endpoint,
+			Duration: durationsByEndpoint[endpoint],
+		}
+		if sample.Value != 1 {
+			result.Err = fmt.Errorf("probe of blackbox exporter in Seed failed")
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// ProbeAPIServerWithBlackboxExporter lets the given external blackbox exporter probe the API server via the given
+// domain and returns the result.
+func ProbeAPIServerWithBlackboxExporter(ctx context.Context, client *http.Client, prober config.ExternalAPIServerProber, endpoint, domain string) APIServerProbeResult {
+	result := APIServerProbeResult{Vantage: prober.Name, Endpoint: endpoint}
+
+	query := url.Values{}
+	query.Set("module", prober.Module)
+	query.Set("target", fmt.Sprintf("%s:443", domain))
+	request, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/probe?%s", strings.TrimSuffix(prober.URL, "/"), query.Encode()), nil)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	response, err := client.Do(request.WithContext(ctx))
+	if err != nil {
+		result.Err = fmt.Errorf("blackbox exporter could not be reached: %v", err)
+		return result
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		result.Err = fmt.Errorf("blackbox exporter returned status code %d", response.StatusCode)
+		return result
+	}
+
+	var parser expfmt.TextParser
+	metricFamilies, err := parser.TextToMetricFamilies(response.Body)
+	if err != nil {
+		result.Err = fmt.Errorf("response of blackbox exporter could not be parsed: %v", err)
+		return result
+	}
+
+	if family, ok := metricFamilies["probe_duration_seconds"]; ok && len(family.Metric) > 0 {
+		result.Duration = time.Duration(family.Metric[0].GetGauge().GetValue() * float64(time.Second))
+	}
+	family, ok := metricFamilies["probe_success"]
+	if !ok || len(family.Metric) == 0 {
+		result.Err = fmt.Errorf("response of blackbox exporter does not contain probe_success")
+		return result
+	}
+	if family.Metric[0].GetGauge().GetValue() != 1 {
+		result.Err = fmt.Errorf("probe of blackbox exporter failed")
+	}
+	return result
+}
+
+// recordAPIServerProbe records the duration of the given probe of the API server.
+func (b *Botanist) recordAPIServerProbe(result APIServerProbeResult) {
+	outcome := "success"
+	if result.Err != nil {
+		outcome = "failure"
+	}
+	gardenmetrics.ShootAPIServerProbeDuration.WithLabelValues(result.Vantage, result.Endpoint, outcome, b.Seed.Info.Name).Observe(result.Duration.Seconds())
+}
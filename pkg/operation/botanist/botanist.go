@@ -27,6 +27,7 @@ import (
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -163,31 +164,33 @@ func (b *Botanist) UnregisterAsSeed() error {
 
 // RequiredExtensionsExist checks whether all required extensions needed for an shoot operation exist.
 func (b *Botanist) RequiredExtensionsExist(shoot *gardenv1beta1.Shoot) error {
-	controllerInstallationList := &corev1alpha1.ControllerInstallationList{}
-	if err := b.K8sGardenClient.Client().List(context.TODO(), nil, controllerInstallationList); err != nil {
-		return err
-	}
-
 	requiredExtensions := map[string]string{
 		// At the moment we do only support `OperatingSystemConfig` resources, so we check for that:
 		extensionsv1alpha1.OperatingSystemConfigResource: string(b.Shoot.GetMachineImageName()),
 	}
 
-	for _, controllerInstallation := range controllerInstallationList.Items {
-		if controllerInstallation.Spec.SeedRef.Name != b.Seed.Info.Name {
-			continue
-		}
+	// The ControllerInstallations are listed in pages as there is one per ControllerRegistration and Seed.
+	controllerInstallationList := &corev1alpha1.ControllerInstallationList{}
+	if err := kutil.ListPaged(context.TODO(), b.K8sGardenClient.Client(), nil, controllerInstallationList, kutil.DefaultListPageSize, func() error {
+		for _, controllerInstallation := range controllerInstallationList.Items {
+			if controllerInstallation.Spec.SeedRef.Name != b.Seed.Info.Name {
+				continue
+			}
 
-		controllerRegistration := &corev1alpha1.ControllerRegistration{}
-		if err := b.K8sGardenClient.Client().Get(context.TODO(), client.ObjectKey{Name: controllerInstallation.Spec.RegistrationRef.Name}, controllerRegistration); err != nil {
-			return err
-		}
+			controllerRegistration := &corev1alpha1.ControllerRegistration{}
+			if err := b.K8sGardenClient.Client().Get(context.TODO(), client.ObjectKey{Name: controllerInstallation.Spec.RegistrationRef.Name}, controllerRegistration); err != nil {
+				return err
+			}
 
-		for extensionKind, extensionType := range requiredExtensions {
-			if helper.IsResourceSupported(controllerRegistration.Spec.Resources, extensionKind, extensionType) && helper.IsControllerInstallationSuccessful(controllerInstallation) {
-				delete(requiredExtensions, extensionKind)
+			for extensionKind, extensionType := range requiredExtensions {
+				if helper.IsResourceSupported(controllerRegistration.Spec.Resources, extensionKind, extensionType) && helper.IsControllerInstallationSuccessful(controllerInstallation) {
+					delete(requiredExtensions, extensionKind)
+				}
 			}
 		}
+		return nil
+	}); err != nil {
+		return err
 	}
 
 	if len(requiredExtensions) > 0 {
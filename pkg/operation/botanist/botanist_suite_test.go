@@ -15,10 +15,16 @@
 package botanist_test
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"time"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/operation/botanist"
 	"github.com/gardener/gardener/pkg/operation/common"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -28,6 +34,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	"github.com/onsi/gomega/types"
+	prometheusmodel "github.com/prometheus/common/model"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -100,6 +107,20 @@ func newDeployment(namespace, name, role string, healthy bool) *appsv1.Deploymen
 	return deployment
 }
 
+func newRollingOutDeployment(namespace, name, role string) *appsv1.Deployment {
+	deployment := newDeployment(namespace, name, role, false)
+	deployment.Status = appsv1.DeploymentStatus{
+		Replicas:          2,
+		UpdatedReplicas:   1,
+		AvailableReplicas: 0,
+		Conditions: []appsv1.DeploymentCondition{{
+			Type:   appsv1.DeploymentProgressing,
+			Status: corev1.ConditionTrue,
+		}},
+	}
+	return deployment
+}
+
 func newStatefulSet(namespace, name, role string, healthy bool) *appsv1.StatefulSet {
 	statefulSet := &appsv1.StatefulSet{
 		ObjectMeta: metav1.ObjectMeta{
@@ -115,6 +136,17 @@ func newStatefulSet(namespace, name, role string, healthy bool) *appsv1.Stateful
 	return statefulSet
 }
 
+func newRollingOutStatefulSet(namespace, name, role string) *appsv1.StatefulSet {
+	statefulSet := newStatefulSet(namespace, name, role, false)
+	statefulSet.Generation = 1
+	statefulSet.Status = appsv1.StatefulSetStatus{
+		ObservedGeneration: 1,
+		CurrentRevision:    "1",
+		UpdateRevision:     "2",
+	}
+	return statefulSet
+}
+
 func newDaemonSet(namespace, name, role string, healthy bool) *appsv1.DaemonSet {
 	daemonSet := &appsv1.DaemonSet{
 		ObjectMeta: metav1.ObjectMeta{
@@ -369,6 +401,19 @@ var _ = Describe("health check", func() {
 			},
 			requiredControlPlaneStatefulSets,
 			beConditionWithStatus(gardenv1beta1.ConditionFalse)),
+		Entry("required deployment rolling out without threshold",
+			gcpShoot,
+			gardenv1beta1.CloudProviderGCP,
+			[]*appsv1.Deployment{
+				cloudControllerManagerDeployment,
+				newRollingOutDeployment(kubeAddonManagerDeployment.Namespace, kubeAddonManagerDeployment.Name, roleOf(kubeAddonManagerDeployment)),
+				kubeAPIServerDeployment,
+				kubeControllerManagerDeployment,
+				kubeSchedulerDeployment,
+				machineControllerManagerDeployment,
+			},
+			requiredControlPlaneStatefulSets,
+			beConditionWithStatus(gardenv1beta1.ConditionFalse)),
 		Entry("missing required stateful set",
 			gcpShoot,
 			gardenv1beta1.CloudProviderGCP,
@@ -387,6 +432,80 @@ var _ = Describe("health check", func() {
 			},
 			beConditionWithStatus(gardenv1beta1.ConditionFalse)))
 
+	DescribeTable("#CheckControlPlane with rollouts",
+		func(deployments []*appsv1.Deployment, statefulSets []*appsv1.StatefulSet, status gardenv1beta1.ConditionStatus, now time.Time, expectedStatus gardenv1beta1.ConditionStatus) {
+			var (
+				deploymentLister  = constDeploymentLister(deployments)
+				statefulSetLister = constStatefulSetLister(statefulSets)
+				checker           = botanist.NewHealthChecker(map[gardenv1beta1.ConditionType]time.Duration{
+					gardenv1beta1.ShootControlPlaneHealthy: time.Minute,
+				})
+				condition = &gardenv1beta1.Condition{
+					Type:   gardenv1beta1.ShootControlPlaneHealthy,
+					Status: status,
+				}
+			)
+
+			tmp1, tmp2 := botanist.Now, helper.Now
+			defer func() {
+				botanist.Now, helper.Now = tmp1, tmp2
+			}()
+			botanist.Now, helper.Now = func() time.Time {
+				return now
+			}, func() metav1.Time {
+				return zeroMetaTime
+			}
+
+			exitCondition, err := checker.CheckControlPlane(gcpShoot, seedNamespace, gardenv1beta1.CloudProviderGCP, condition, deploymentLister, statefulSetLister)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exitCondition).To(beConditionWithStatus(expectedStatus))
+		},
+		Entry("deployment rolling out within threshold",
+			[]*appsv1.Deployment{
+				cloudControllerManagerDeployment,
+				newRollingOutDeployment(kubeAddonManagerDeployment.Namespace, kubeAddonManagerDeployment.Name, roleOf(kubeAddonManagerDeployment)),
+				kubeAPIServerDeployment,
+				kubeControllerManagerDeployment,
+				kubeSchedulerDeployment,
+				machineControllerManagerDeployment,
+			},
+			requiredControlPlaneStatefulSets,
+			gardenv1beta1.ConditionTrue,
+			zeroTime,
+			gardenv1beta1.ConditionProgressing),
+		Entry("deployment rolling out longer than threshold",
+			[]*appsv1.Deployment{
+				cloudControllerManagerDeployment,
+				newRollingOutDeployment(kubeAddonManagerDeployment.Namespace, kubeAddonManagerDeployment.Name, roleOf(kubeAddonManagerDeployment)),
+				kubeAPIServerDeployment,
+				kubeControllerManagerDeployment,
+				kubeSchedulerDeployment,
+				machineControllerManagerDeployment,
+			},
+			requiredControlPlaneStatefulSets,
+			gardenv1beta1.ConditionProgressing,
+			zeroTime.Add(time.Minute+time.Second),
+			gardenv1beta1.ConditionFalse),
+		Entry("stateful set rolling out within threshold",
+			requiredControlPlaneDeployments,
+			[]*appsv1.StatefulSet{
+				newRollingOutStatefulSet(etcdMainStatefulSet.Namespace, etcdMainStatefulSet.Name, roleOf(etcdMainStatefulSet)),
+				etcdEventsStatefulSet,
+			},
+			gardenv1beta1.ConditionProgressing,
+			zeroTime.Add(time.Minute-time.Second),
+			gardenv1beta1.ConditionProgressing),
+		Entry("stateful set rolling out longer than threshold",
+			requiredControlPlaneDeployments,
+			[]*appsv1.StatefulSet{
+				newRollingOutStatefulSet(etcdMainStatefulSet.Namespace, etcdMainStatefulSet.Name, roleOf(etcdMainStatefulSet)),
+				etcdEventsStatefulSet,
+			},
+			gardenv1beta1.ConditionProgressing,
+			zeroTime.Add(time.Minute+time.Second),
+			gardenv1beta1.ConditionFalse),
+	)
+
 	DescribeTable("#CheckSystemComponents",
 		func(deployments []*appsv1.Deployment, daemonSets []*appsv1.DaemonSet, conditionMatcher types.GomegaMatcher) {
 			var (
@@ -691,3 +810,171 @@ var _ = Describe("health check", func() {
 			}))),
 	)
 })
+
+var _ = Describe("etcd backup", func() {
+	var (
+		gzip          = common.ETCDBackupCompressionGzip
+		defaultValues = map[string]interface{}{
+			"schedule":                   "0 */24 * * *",
+			"deltaSnapshotPeriodSeconds": 300,
+			"compression":                map[string]interface{}{"enabled": false},
+		}
+	)
+
+	DescribeTable("#ETCDBackupValues",
+		func(backupConfig *config.ShootBackup, seedAnnotations, shootAnnotations map[string]string, matcher types.GomegaMatcher) {
+			values, err := botanist.ETCDBackupValues(backupConfig, seedAnnotations, shootAnnotations)
+			if matcher == nil {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(matcher)
+		},
+		Entry("no configuration", nil, nil, nil, Equal(defaultValues)),
+		Entry("configuration of the Garden",
+			&config.ShootBackup{Schedule: "0 */12 * * *", DeltaSnapshotPeriod: &metav1.Duration{Duration: time.Minute}, Compression: &gzip},
+			nil,
+			nil,
+			Equal(map[string]interface{}{
+				"schedule":                   "0 */12 * * *",
+				"deltaSnapshotPeriodSeconds": 60,
+				"compression":                map[string]interface{}{"enabled": true, "policy": "gzip"},
+			})),
+		Entry("annotations of the Seed and the Shoot",
+			&config.ShootBackup{Schedule: "0 */12 * * *", Compression: &gzip},
+			map[string]string{
+				common.SeedETCDBackupSchedule:            "0 */6 * * *",
+				common.SeedETCDBackupDeltaSnapshotPeriod: "2m",
+				common.SeedETCDBackupCompression:         "gzip",
+			},
+			map[string]string{
+				common.ShootETCDBackupCompression: "none",
+			},
+			Equal(map[string]interface{}{
+				"schedule":                   "0 */6 * * *",
+				"deltaSnapshotPeriodSeconds": 120,
+				"compression":                map[string]interface{}{"enabled": false},
+			})),
+		Entry("invalid schedule", nil, nil, map[string]string{common.ShootETCDBackupSchedule: "daily"}, nil),
+		Entry("invalid delta snapshot period", nil, map[string]string{common.SeedETCDBackupDeltaSnapshotPeriod: "-1m"}, nil, nil),
+		Entry("unsupported compression", nil, nil, map[string]string{common.ShootETCDBackupCompression: "zstd"}, nil),
+	)
+})
+
+var _ = Describe("API server probes", func() {
+	var (
+		available = &gardenv1beta1.Condition{
+			Type:    gardenv1beta1.ShootAPIServerAvailable,
+			Status:  gardenv1beta1.ConditionTrue,
+			Reason:  "HealthzRequestSucceeded",
+			Message: "Shoot API server /healthz endpoint responded with success status code. [response_time:5ms]",
+		}
+		unavailable = &gardenv1beta1.Condition{
+			Type:   gardenv1beta1.ShootAPIServerAvailable,
+			Status: gardenv1beta1.ConditionFalse,
+			Reason: "HealthzRequestFailed",
+		}
+		succeeded = botanist.APIServerProbeResult{Vantage: botanist.APIServerProbeVantageSeed, Endpoint: botanist.APIServerProbeEndpointExternal, Duration: 20 * time.Millisecond}
+		failed    = botanist.APIServerProbeResult{Vantage: "eu", Endpoint: botanist.APIServerProbeEndpointExternal, Err: fmt.Errorf("probe of blackbox exporter failed")}
+	)
+
+	DescribeTable("#CheckAPIServerProbes",
+		func(condition *gardenv1beta1.Condition, results []botanist.APIServerProbeResult, matcher types.GomegaMatcher) {
+			checker := botanist.NewHealthChecker(map[gardenv1beta1.ConditionType]time.Duration{})
+			Expect(checker.CheckAPIServerProbes(condition.DeepCopy(), results)).To(matcher)
+		},
+		Entry("no probes", available, nil, PointTo(Equal(*available))),
+		Entry("unavailable API server", unavailable, []botanist.APIServerProbeResult{succeeded}, PointTo(Equal(*unavailable))),
+		Entry("succeeded probes", available, []botanist.APIServerProbeResult{succeeded}, PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(gardenv1beta1.ConditionTrue),
+			"Reason":  Equal("HealthzRequestSucceeded"),
+			"Message": HaveSuffix("[response_time:5ms] Probes: [seed/external:20ms]"),
+		}))),
+		Entry("failed probe", available, []botanist.APIServerProbeResult{succeeded, failed}, PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(gardenv1beta1.ConditionFalse),
+			"Reason":  Equal("APIServerProbesFailed"),
+			"Message": ContainSubstring("eu/external (probe of blackbox exporter failed)"),
+		}))),
+	)
+
+	Describe("#SeedAPIServerProbeResults", func() {
+		It("should compute the results per endpoint", func() {
+			sample := func(endpoint string, value float64) *prometheusmodel.Sample {
+				return &prometheusmodel.Sample{
+					Metric: prometheusmodel.Metric{"job": "blackbox-apiserver", "endpoint": prometheusmodel.LabelValue(endpoint)},
+					Value:  prometheusmodel.SampleValue(value),
+				}
+			}
+
+			results := botanist.SeedAPIServerProbeResults(
+				prometheusmodel.Vector{sample("internal", 1), sample("external", 0), sample("", 1)},
+				prometheusmodel.Vector{sample("internal", 0.25), sample("external", 10)},
+			)
+
+			Expect(results).To(ConsistOf(
+				MatchFields(IgnoreExtras, Fields{
+					"Vantage":  Equal(botanist.APIServerProbeVantageSeed),
+					"Endpoint": Equal(botanist.APIServerProbeEndpointInternal),
+					"Duration": Equal(250 * time.Millisecond),
+					"Err":      BeNil(),
+				}),
+				MatchFields(IgnoreExtras, Fields{
+					"Vantage":  Equal(botanist.APIServerProbeVantageSeed),
+					"Endpoint": Equal(botanist.APIServerProbeEndpointExternal),
+					"Duration": Equal(10 * time.Second),
+					"Err":      HaveOccurred(),
+				}),
+			))
+		})
+	})
+
+	Describe("#ProbeAPIServerWithBlackboxExporter", func() {
+		var (
+			server   *httptest.Server
+			response string
+			query    url.Values
+		)
+
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query = r.URL.Query()
+				fmt.Fprint(w, response)
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should return the result of a successful probe", func() {
+			response = "# TYPE probe_duration_seconds gauge\nprobe_duration_seconds 0.5\n# TYPE probe_success gauge\nprobe_success 1\n"
+			prober := config.ExternalAPIServerProber{Name: "eu", URL: server.URL + "/", Module: "tcp_connect"}
+
+			result := botanist.ProbeAPIServerWithBlackboxExporter(context.TODO(), server.Client(), prober, botanist.APIServerProbeEndpointExternal, "api.shoot.example.com")
+
+			Expect(result).To(Equal(botanist.APIServerProbeResult{Vantage: "eu", Endpoint: botanist.APIServerProbeEndpointExternal, Duration: 500 * time.Millisecond}))
+			Expect(query.Get("module")).To(Equal("tcp_connect"))
+			Expect(query.Get("target")).To(Equal("api.shoot.example.com:443"))
+		})
+
+		It("should return an error for a failed probe", func() {
+			response = "# TYPE probe_duration_seconds gauge\nprobe_duration_seconds 5\n# TYPE probe_success gauge\nprobe_success 0\n"
+			prober := config.ExternalAPIServerProber{Name: "eu", URL: server.URL, Module: "tcp_connect"}
+
+			result := botanist.ProbeAPIServerWithBlackboxExporter(context.TODO(), server.Client(), prober, botanist.APIServerProbeEndpointInternal, "api.internal.example.com")
+
+			Expect(result.Err).To(HaveOccurred())
+			Expect(result.Duration).To(Equal(5 * time.Second))
+		})
+
+		It("should return an error if the response does not contain the probe result", func() {
+			response = "# TYPE probe_duration_seconds gauge\nprobe_duration_seconds 5\n"
+			prober := config.ExternalAPIServerProber{Name: "eu", URL: server.URL, Module: "tcp_connect"}
+
+			result := botanist.ProbeAPIServerWithBlackboxExporter(context.TODO(), server.Client(), prober, botanist.APIServerProbeEndpointInternal, "api.internal.example.com")
+
+			Expect(result.Err).To(HaveOccurred())
+		})
+	})
+})
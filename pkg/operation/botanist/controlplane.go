@@ -19,13 +19,18 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	controllermanagerfeatures "github.com/gardener/gardener/pkg/controllermanager/features"
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/operation/certmanagement"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/utils"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
+	"github.com/robfig/cron"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -95,8 +100,11 @@ func (b *Botanist) DeleteBackupNamespace() error {
 }
 
 func (b *Botanist) deleteNamespace(name string) error {
-	err := b.K8sSeedClient.DeleteNamespace(name)
-	if apierrors.IsNotFound(err) || apierrors.IsConflict(err) {
+	gracePeriodSeconds := int64(1)
+	err := kutil.DeleteObject(func(opts *metav1.DeleteOptions) error {
+		return b.K8sSeedClient.Kubernetes().CoreV1().Namespaces().Delete(name, opts)
+	}, metav1.DeletePropagationForeground, &gracePeriodSeconds)
+	if apierrors.IsConflict(err) {
 		return nil
 	}
 	return err
@@ -110,11 +118,7 @@ func (b *Botanist) DeployCloudMetadataServiceNetworkPolicy() error {
 
 // DeleteKubeAPIServer deletes the kube-apiserver deployment in the Seed cluster which holds the Shoot's control plane.
 func (b *Botanist) DeleteKubeAPIServer() error {
-	err := b.K8sSeedClient.DeleteDeployment(b.Shoot.SeedNamespace, common.KubeAPIServerDeploymentName)
-	if apierrors.IsNotFound(err) {
-		return nil
-	}
-	return err
+	return b.deleteDeployment(common.KubeAPIServerDeploymentName)
 }
 
 // RefreshCloudControllerManagerChecksums updates the cloud provider checksum in the cloud-controller-manager pod spec template.
@@ -139,6 +143,32 @@ func (b *Botanist) RefreshKubeControllerManagerChecksums() error {
 	return b.patchDeploymentCloudProviderChecksums(common.KubeControllerManagerDeploymentName)
 }
 
+// RestartControlPlane restarts the pods of the control plane deployments of the Shoot by changing the restart marker
+// in their pod spec templates.
+func (b *Botanist) RestartControlPlane() error {
+	body, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]string{
+						kubernetes.AnnotationRestartedAt: time.Now().UTC().Format(time.RFC3339),
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, name := range common.RequiredControlPlaneDeployments.List() {
+		if _, err := b.K8sSeedClient.Kubernetes().AppsV1().Deployments(b.Shoot.SeedNamespace).Patch(name, types.MergePatchType, body); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
 // DeployBackupInfrastructure creates a BackupInfrastructure resource into the project namespace of shoot on garden cluster.
 // BackupInfrastructure controller acting on resource will actually create required cloud resources and updates the status.
 func (b *Botanist) DeployBackupInfrastructure() error {
@@ -170,11 +200,15 @@ func (b *Botanist) DeleteBackupInfrastructure() error {
 // needs to be deleted before trying to remove any resources in the Shoot cluster, otherwise it will automatically recreate
 // them and block the infrastructure deletion.
 func (b *Botanist) DeleteKubeAddonManager() error {
-	err := b.K8sSeedClient.DeleteDeployment(b.Shoot.SeedNamespace, common.KubeAddonManagerDeploymentName)
-	if apierrors.IsNotFound(err) {
-		return nil
-	}
-	return err
+	return b.deleteDeployment(common.KubeAddonManagerDeploymentName)
+}
+
+// deleteDeployment deletes the deployment with the given <name> in the Shoot namespace in the Seed cluster. The
+// deletion is propagated in the foreground, i.e., the deployment is only gone once all of its pods are gone.
+func (b *Botanist) deleteDeployment(name string) error {
+	return kutil.DeleteObject(func(opts *metav1.DeleteOptions) error {
+		return b.K8sSeedClient.Kubernetes().AppsV1().Deployments(b.Shoot.SeedNamespace).Delete(name, opts)
+	}, metav1.DeletePropagationForeground, nil)
 }
 
 // DeployMachineControllerManager deploys the machine-controller-manager into the Shoot namespace in the Seed cluster. It is responsible
@@ -336,6 +370,12 @@ func (b *Botanist) DeploySeedMonitoring() error {
 			"replicas": b.Shoot.GetReplicas(1),
 		}
 	)
+
+	// The blackbox exporter of Prometheus probes the API server via the external domain as well, if the Shoot has one.
+	if externalDomain := b.Shoot.ComputeAPIServerURL(false, false); externalDomain != b.Shoot.InternalClusterDomain {
+		prometheusConfig["shoot"].(map[string]interface{})["apiserverExternal"] = fmt.Sprintf("https://%s", externalDomain)
+	}
+
 	alertManager, err := b.InjectImages(alertManagerConfig, b.SeedVersion(), b.ShootVersion(), common.AlertManagerImageName, common.ConfigMapReloaderImageName)
 	if err != nil {
 		return err
@@ -600,3 +640,79 @@ func (b *Botanist) DeleteCertBroker() error {
 	}
 	return nil
 }
+
+// ETCDBackupValues returns the chart values for the schedule of the full snapshots, the interval of the delta snapshots,
+// and the compression of the etcd backups of the Shoot.
+func (b *Botanist) ETCDBackupValues() (map[string]interface{}, error) {
+	return ETCDBackupValues(b.ShootBackup, b.Seed.Info.Annotations, b.Shoot.Info.Annotations)
+}
+
+// ETCDBackupValues returns the chart values for the schedule of the full snapshots, the interval of the delta snapshots,
+// and the compression of etcd backups. The values configured for the Garden may be overwritten per Seed and, with
+// precedence, per Shoot by annotations.
+func ETCDBackupValues(backupConfig *config.ShootBackup, seedAnnotations, shootAnnotations map[string]string) (map[string]interface{}, error) {
+	var (
+		schedule            = "0 */24 * * *"
+		deltaSnapshotPeriod = 5 * time.Minute
+		compression         = common.ETCDBackupCompressionNone
+	)
+
+	if backupConfig != nil {
+		if len(backupConfig.Schedule) > 0 {
+			schedule = backupConfig.Schedule
+		}
+		if backupConfig.DeltaSnapshotPeriod != nil {
+			deltaSnapshotPeriod = backupConfig.DeltaSnapshotPeriod.Duration
+		}
+		if backupConfig.Compression != nil {
+			compression = *backupConfig.Compression
+		}
+	}
+
+	for _, annotations := range []map[string]string{
+		{
+			common.ShootETCDBackupSchedule:            seedAnnotations[common.SeedETCDBackupSchedule],
+			common.ShootETCDBackupDeltaSnapshotPeriod: seedAnnotations[common.SeedETCDBackupDeltaSnapshotPeriod],
+			common.ShootETCDBackupCompression:         seedAnnotations[common.SeedETCDBackupCompression],
+		},
+		shootAnnotations,
+	} {
+		if value := annotations[common.ShootETCDBackupSchedule]; len(value) > 0 {
+			schedule = value
+		}
+		if value := annotations[common.ShootETCDBackupDeltaSnapshotPeriod]; len(value) > 0 {
+			parsed, err := time.ParseDuration(value)
+			if err != nil || parsed <= 0 {
+				return nil, fmt.Errorf("invalid etcd backup delta snapshot period %q, must be a positive duration", value)
+			}
+			deltaSnapshotPeriod = parsed
+		}
+		if value := annotations[common.ShootETCDBackupCompression]; len(value) > 0 {
+			compression = value
+		}
+	}
+
+	if _, err := cron.ParseStandard(schedule); err != nil {
+		return nil, fmt.Errorf("invalid etcd backup schedule %q: %v", schedule, err)
+	}
+	if deltaSnapshotPeriod < time.Second {
+		return nil, fmt.Errorf("etcd backup delta snapshot period %s must be at least 1s", deltaSnapshotPeriod)
+	}
+
+	compressionValues := map[string]interface{}{
+		"enabled": compression != common.ETCDBackupCompressionNone,
+	}
+	switch compression {
+	case common.ETCDBackupCompressionNone:
+	case common.ETCDBackupCompressionGzip:
+		compressionValues["policy"] = compression
+	default:
+		return nil, fmt.Errorf("unsupported etcd backup compression %q, must be %s or %s", compression, common.ETCDBackupCompressionGzip, common.ETCDBackupCompressionNone)
+	}
+
+	return map[string]interface{}{
+		"schedule":                   schedule,
+		"deltaSnapshotPeriodSeconds": int(deltaSnapshotPeriod / time.Second),
+		"compression":                compressionValues,
+	}, nil
+}
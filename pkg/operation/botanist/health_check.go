@@ -25,6 +25,7 @@ import (
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	machine "github.com/gardener/gardener/pkg/client/machine/clientset/versioned"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	controllermanagerfeatures "github.com/gardener/gardener/pkg/controllermanager/features"
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/operation/common"
@@ -92,6 +93,12 @@ func (b *HealthChecker) checkRequiredDeployments(condition *gardenv1beta1.Condit
 func (b *HealthChecker) checkDeployments(condition *gardenv1beta1.Condition, objects []*appsv1.Deployment) *gardenv1beta1.Condition {
 	for _, object := range objects {
 		if err := health.CheckDeployment(object); err != nil {
+			if progressing, message := health.IsDeploymentProgressing(object); progressing {
+				return b.FailedCondition(
+					condition,
+					"DeploymentRollingOut",
+					fmt.Sprintf("Deployment %s is rolling out: %s", object.Name, message))
+			}
 			return b.FailedCondition(
 				condition,
 				"DeploymentUnhealthy",
@@ -121,6 +128,12 @@ func (b *HealthChecker) checkRequiredStatefulSets(condition *gardenv1beta1.Condi
 func (b *HealthChecker) checkStatefulSets(condition *gardenv1beta1.Condition, objects []*appsv1.StatefulSet) *gardenv1beta1.Condition {
 	for _, object := range objects {
 		if err := health.CheckStatefulSet(object); err != nil {
+			if progressing, message := health.IsStatefulSetProgressing(object); progressing {
+				return b.FailedCondition(
+					condition,
+					"StatefulSetRollingOut",
+					fmt.Sprintf("Stateful set %s is rolling out: %s", object.Name, message))
+			}
 			return b.FailedCondition(
 				condition,
 				"StatefulSetUnhealthy",
@@ -326,8 +339,11 @@ func (b *Botanist) checkAPIServerAvailability(checker *HealthChecker, condition
 	// Try to reach the Shoot API server and measure the response time.
 	now := Now()
 	response := b.K8sShootClient.RESTClient().Get().AbsPath("/healthz").Do()
-	responseDurationText := fmt.Sprintf("[response_time:%dms]", Now().Sub(now).Nanoseconds()/time.Millisecond.Nanoseconds())
+	result := APIServerProbeResult{Vantage: APIServerProbeVantageGarden, Endpoint: APIServerProbeEndpointInternal, Duration: Now().Sub(now)}
+	responseDurationText := fmt.Sprintf("[response_time:%dms]", result.Duration.Nanoseconds()/time.Millisecond.Nanoseconds())
 	if response.Error() != nil {
+		result.Err = response.Error()
+		b.recordAPIServerProbe(result)
 		message := fmt.Sprintf("Request to Shoot API server /healthz endpoint failed. %s (%s)", responseDurationText, response.Error().Error())
 		return checker.FailedCondition(condition, "HealthzRequestFailed", message)
 	}
@@ -345,9 +361,12 @@ func (b *Botanist) checkAPIServerAvailability(checker *HealthChecker, condition
 			body = string(bodyRaw)
 		}
 		message := fmt.Sprintf("Shoot API server /healthz endpoint endpoint check returned a non ok status code %d. %s (%s)", statusCode, responseDurationText, body)
+		result.Err = fmt.Errorf("/healthz endpoint returned status code %d", statusCode)
+		b.recordAPIServerProbe(result)
 		return checker.FailedCondition(condition, "HealthzRequestError", message)
 	}
 
+	b.recordAPIServerProbe(result)
 	message := fmt.Sprintf("Shoot API server /healthz endpoint responded with success status code. %s", responseDurationText)
 	return helper.UpdatedCondition(condition, gardenv1beta1.ConditionTrue, "HealthzRequestSucceeded", message)
 }
@@ -804,7 +823,7 @@ func NewHealthChecker(conditionThresholds map[gardenv1beta1.ConditionType]time.D
 }
 
 // HealthChecks conducts the health checks on all the given conditions.
-func (b *Botanist) HealthChecks(initializeShootClients func() error, thresholdMappings map[gardenv1beta1.ConditionType]time.Duration, apiserverAvailability, controlPlane, nodes, systemComponents *gardenv1beta1.Condition) (*gardenv1beta1.Condition, *gardenv1beta1.Condition, *gardenv1beta1.Condition, *gardenv1beta1.Condition) {
+func (b *Botanist) HealthChecks(initializeShootClients func() error, thresholdMappings map[gardenv1beta1.ConditionType]time.Duration, apiServerProbes *config.ShootCareAPIServerProbes, apiserverAvailability, controlPlane, nodes, systemComponents *gardenv1beta1.Condition) (*gardenv1beta1.Condition, *gardenv1beta1.Condition, *gardenv1beta1.Condition, *gardenv1beta1.Condition) {
 	if b.Shoot.IsHibernated {
 		return shootHibernatedCondition(apiserverAvailability), shootHibernatedCondition(controlPlane), shootHibernatedCondition(nodes), shootHibernatedCondition(systemComponents)
 	}
@@ -841,6 +860,9 @@ func (b *Botanist) HealthChecks(initializeShootClients func() error, thresholdMa
 	go func() {
 		defer wg.Done()
 		apiserverAvailability = b.checkAPIServerAvailability(checker, apiserverAvailability)
+		if apiServerProbes != nil {
+			apiserverAvailability = checker.CheckAPIServerProbes(apiserverAvailability, b.probeAPIServer(apiServerProbes))
+		}
 	}()
 	go func() {
 		defer wg.Done()
@@ -0,0 +1,168 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package botanist
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// plannedComponents maps the names of the control plane deployments in the Seed to the names of their images in the
+// image vector. The container of a deployment has the same name as the deployment.
+var plannedComponents = []struct {
+	deploymentName string
+	imageName      string
+}{
+	{common.KubeAPIServerDeploymentName, common.HyperkubeImageName},
+	{common.KubeControllerManagerDeploymentName, common.HyperkubeImageName},
+	{common.KubeSchedulerDeploymentName, common.HyperkubeImageName},
+	{common.CloudControllerManagerDeploymentName, common.HyperkubeImageName},
+	{common.MachineControllerManagerDeploymentName, common.MachineControllerManagerImageName},
+	{common.KubeAddonManagerDeploymentName, common.KubeAddonManagerImageName},
+}
+
+// Plan computes the changes which a reconciliation of the Shoot would make without applying them. It reports pending
+// changes of the specification, control plane components which would be rolled out with another image, and
+// certificates which would be renewed.
+func (b *Botanist) Plan() ([]gardenv1beta1.PlannedChange, error) {
+	var changes []gardenv1beta1.PlannedChange
+
+	if shoot := b.Shoot.Info; shoot.Generation != shoot.Status.ObservedGeneration {
+		changes = append(changes, gardenv1beta1.PlannedChange{
+			Type:    gardenv1beta1.PlannedChangeSpecification,
+			Target:  shoot.Name,
+			Current: fmt.Sprintf("%d", shoot.Status.ObservedGeneration),
+			Desired: fmt.Sprintf("%d", shoot.Generation),
+			Message: "The specification of the Shoot has been changed since the last reconciliation.",
+		})
+	}
+
+	componentChanges, err := b.planComponentVersions()
+	if err != nil {
+		return nil, err
+	}
+	changes = append(changes, componentChanges...)
+
+	secretChanges, err := b.planSecretRotations()
+	if err != nil {
+		return nil, err
+	}
+	changes = append(changes, secretChanges...)
+
+	return changes, nil
+}
+
+func (b *Botanist) planComponentVersions() ([]gardenv1beta1.PlannedChange, error) {
+	var changes []gardenv1beta1.PlannedChange
+
+	for _, component := range plannedComponents {
+		deployment, err := b.K8sSeedClient.GetDeployment(b.Shoot.SeedNamespace, component.deploymentName)
+		if apierrors.IsNotFound(err) {
+			// The component is not used by this Shoot or it has not been deployed yet.
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		image, err := b.ImageVector.FindImage(component.imageName, b.SeedVersion(), b.ShootVersion())
+		if err != nil {
+			return nil, err
+		}
+		desired := image.String()
+
+		for _, container := range deployment.Spec.Template.Spec.Containers {
+			if container.Name != component.deploymentName || container.Image == desired {
+				continue
+			}
+			changes = append(changes, gardenv1beta1.PlannedChange{
+				Type:    gardenv1beta1.PlannedChangeComponentVersion,
+				Target:  component.deploymentName,
+				Current: container.Image,
+				Desired: desired,
+				Message: fmt.Sprintf("The deployment %s would be rolled out with another image.", component.deploymentName),
+			})
+		}
+	}
+
+	return changes, nil
+}
+
+func (b *Botanist) planSecretRotations() ([]gardenv1beta1.PlannedChange, error) {
+	_, _, renewalThreshold, err := b.certificateValidities()
+	if err != nil {
+		return nil, err
+	}
+	if renewalThreshold <= 0 {
+		return nil, nil
+	}
+	var renewalJitter time.Duration
+	if b.ShootCertificates != nil && b.ShootCertificates.RenewalJitter != nil {
+		renewalJitter = b.ShootCertificates.RenewalJitter.Duration
+	}
+
+	existingSecretsMap, err := b.fetchExistingSecrets()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(existingSecretsMap))
+	for name := range existingSecretsMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var changes []gardenv1beta1.PlannedChange
+	for _, name := range names {
+		if notAfter, ok := certificateToRenew(existingSecretsMap[name], renewalThreshold, renewalJitter); ok {
+			changes = append(changes, gardenv1beta1.PlannedChange{
+				Type:    gardenv1beta1.PlannedChangeSecretRotation,
+				Target:  name,
+				Current: notAfter.UTC().Format(time.RFC3339),
+				Message: fmt.Sprintf("The certificate in secret %s expires within the renewal threshold of %s and would be renewed.", name, renewalThreshold),
+			})
+		}
+	}
+
+	return changes, nil
+}
+
+// certificateToRenew checks whether the given <secret> contains a certificate (which is not a certificate authority)
+// whose remaining validity has fallen below the <renewalThreshold>. It returns the expiration time of the certificate.
+func certificateToRenew(secret *corev1.Secret, renewalThreshold, renewalJitter time.Duration) (time.Time, bool) {
+	for key, data := range secret.Data {
+		if !strings.HasSuffix(key, ".crt") || key == secrets.DataKeyCertificateCA {
+			continue
+		}
+
+		certificate, err := utils.DecodeCertificate(data)
+		if err != nil || certificate.IsCA {
+			continue
+		}
+		if secrets.CertificateNeedsRenewal(certificate, renewalThreshold, renewalJitter) {
+			return certificate.NotAfter, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -20,9 +20,11 @@ import (
 	"fmt"
 	"net"
 	"os/exec"
+	"time"
 
 	"github.com/gardener/gardener/pkg/apis/garden"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	controllermanagerfeatures "github.com/gardener/gardener/pkg/controllermanager/features"
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/operation/common"
@@ -658,6 +660,32 @@ func (b *Botanist) generateWantedSecrets(basicAuthAPIServer *secrets.BasicAuth,
 			})
 	}
 
+	keyAlgorithm, err := b.certificateKeyAlgorithm()
+	if err != nil {
+		return nil, err
+	}
+	_, validity, renewalThreshold, err := b.certificateValidities()
+	if err != nil {
+		return nil, err
+	}
+	for _, secret := range secretList {
+		var config *secrets.CertificateSecretConfig
+		switch c := secret.(type) {
+		case *secrets.CertificateSecretConfig:
+			config = c
+		case *secrets.ControlPlaneSecretConfig:
+			config = c.CertificateSecretConfig
+		default:
+			continue
+		}
+		config.KeyAlgorithm = keyAlgorithm
+		config.Validity = validity
+		config.RenewalThreshold = renewalThreshold
+		if b.ShootCertificates != nil && b.ShootCertificates.RenewalJitter != nil {
+			config.RenewalJitter = b.ShootCertificates.RenewalJitter.Duration
+		}
+	}
+
 	return secretList, nil
 }
 
@@ -676,6 +704,10 @@ func (b *Botanist) DeploySecrets() error {
 		return err
 	}
 
+	if err := b.deleteRotatedSecrets(existingSecretsMap); err != nil {
+		return err
+	}
+
 	certificateAuthorities, err := b.generateCertificateAuthorities(existingSecretsMap)
 	if err != nil {
 		return err
@@ -733,6 +765,12 @@ func (b *Botanist) DeployCloudProviderSecret() error {
 	return nil
 }
 
+// ComputeExtensionCredentialsChecksum computes a checksum of the credentials Gardener provides to extensions, i.e.,
+// the CA of the Shoot, the admin kubeconfig, and the cloud provider secret. It changes whenever one of them is rotated.
+func (b *Botanist) ComputeExtensionCredentialsChecksum() string {
+	return utils.ComputeSHA256Hex([]byte(b.CheckSums[caCluster] + b.CheckSums["kubecfg"] + b.CheckSums[common.CloudProviderSecretName]))
+}
+
 // DeleteGardenSecrets deletes the Shoot-specific secrets from the project namespace in the Garden cluster.
 // TODO: https://github.com/gardener/gardener/pull/353: This can be removed in a future version as we are now using owner
 // references for the Garden secrets (also remove the actual invocation of the function in the deletion flow of a Shoot).
@@ -796,8 +834,65 @@ func (b *Botanist) deleteOldETCDServerCertificate(existingSecretsMap map[string]
 	return nil
 }
 
+// credentialsRotation returns the class of credentials whose rotation has been requested for the Shoot and the time
+// at which it has been initiated. Secrets of this class which have been created before that time must be generated
+// again. If no rotation is in progress then an empty class is returned.
+func (b *Botanist) credentialsRotation() (string, time.Time) {
+	credentials, ok := common.CredentialsToRotate(b.Shoot.Info.Annotations)
+	if !ok {
+		return "", time.Time{}
+	}
+
+	rotation := helper.GetCredentialsRotation(b.Shoot.Info.Status.CredentialsRotations, credentials)
+	if rotation == nil || rotation.Phase != gardenv1beta1.CredentialsRotationPhaseRotating || rotation.LastInitiationTime == nil {
+		return "", time.Time{}
+	}
+	return credentials, rotation.LastInitiationTime.Time
+}
+
+// deleteRotatedSecrets deletes the secrets of the class of credentials whose rotation is in progress so that they are
+// generated again.
+func (b *Botanist) deleteRotatedSecrets(existingSecretsMap map[string]*corev1.Secret) error {
+	credentials, initiationTime := b.credentialsRotation()
+
+	var secretNames []string
+	switch credentials {
+	case "":
+		return nil
+	case common.ShootCredentialsObservability:
+		secretNames = []string{"monitoring-ingress-credentials", "logging-ingress-credentials"}
+	case common.ShootCredentialsSSHKeypair:
+		secretNames = []string{"ssh-keypair"}
+	default:
+		return fmt.Errorf("rotation of credentials %q is not supported", credentials)
+	}
+
+	return b.deleteSecretsCreatedBefore(existingSecretsMap, initiationTime, secretNames...)
+}
+
+func (b *Botanist) deleteSecretsCreatedBefore(existingSecretsMap map[string]*corev1.Secret, t time.Time, secretNames ...string) error {
+	for _, name := range secretNames {
+		secret, ok := existingSecretsMap[name]
+		if !ok || !secret.CreationTimestamp.Time.Before(t) {
+			continue
+		}
+
+		b.Logger.Infof("Will recreate secret %s for credentials rotation", name)
+		if err := b.K8sSeedClient.DeleteSecret(b.Shoot.SeedNamespace, name); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		delete(existingSecretsMap, name)
+	}
+	return nil
+}
+
 func (b *Botanist) generateCertificateAuthorities(existingSecretsMap map[string]*corev1.Secret) (map[string]*secrets.Certificate, error) {
-	generatedSecrets, certificateAuthorities, err := secrets.GenerateCertificateAuthorities(b.K8sSeedClient, existingSecretsMap, wantedCertificateAuthorities, b.Shoot.SeedNamespace)
+	certificateAuthorityConfigs, err := b.certificateAuthorityConfigs()
+	if err != nil {
+		return nil, err
+	}
+
+	generatedSecrets, certificateAuthorities, err := secrets.GenerateCertificateAuthorities(b.K8sSeedClient, existingSecretsMap, certificateAuthorityConfigs, b.Shoot.SeedNamespace)
 	if err != nil {
 		return nil, err
 	}
@@ -809,6 +904,93 @@ func (b *Botanist) generateCertificateAuthorities(existingSecretsMap map[string]
 	return certificateAuthorities, nil
 }
 
+// certificateAuthorityConfigs returns the configurations of the wanted certificate authorities.
+func (b *Botanist) certificateAuthorityConfigs() (map[string]*secrets.CertificateSecretConfig, error) {
+	keyAlgorithm, err := b.certificateKeyAlgorithm()
+	if err != nil {
+		return nil, err
+	}
+	caValidity, _, _, err := b.certificateValidities()
+	if err != nil {
+		return nil, err
+	}
+
+	configs := make(map[string]*secrets.CertificateSecretConfig, len(wantedCertificateAuthorities))
+	for name, config := range wantedCertificateAuthorities {
+		configCopy := *config
+		configCopy.KeyAlgorithm = keyAlgorithm
+		configCopy.Validity = caValidity
+		configs[name] = &configCopy
+	}
+	return configs, nil
+}
+
+// certificateKeyAlgorithm returns the key algorithm for newly generated certificate authorities and certificates.
+// The key algorithm configured for the Garden may be overwritten per Shoot by annotation.
+func (b *Botanist) certificateKeyAlgorithm() (secrets.KeyAlgorithm, error) {
+	if value, ok := b.Shoot.Info.Annotations[common.ShootCertificateKeyAlgorithm]; ok {
+		keyAlgorithm, err := secrets.ParseKeyAlgorithm(value)
+		if err != nil {
+			return "", fmt.Errorf("invalid value of annotation %q: %v", common.ShootCertificateKeyAlgorithm, err)
+		}
+		return keyAlgorithm, nil
+	}
+
+	var value string
+	if config := b.ShootCertificates; config != nil && config.KeyAlgorithm != nil {
+		value = *config.KeyAlgorithm
+	}
+	return secrets.ParseKeyAlgorithm(value)
+}
+
+// certificateValidities returns the validity of newly generated certificate authorities and certificates as well as
+// the remaining validity below which certificates are renewed. The values configured for the Garden may be overwritten
+// per Shoot by annotations. Zero values mean that the defaults of the secrets package apply.
+func (b *Botanist) certificateValidities() (time.Duration, time.Duration, time.Duration, error) {
+	var caValidity, validity, renewalThreshold time.Duration
+
+	if config := b.ShootCertificates; config != nil {
+		if config.CAValidity != nil {
+			caValidity = config.CAValidity.Duration
+		}
+		if config.Validity != nil {
+			validity = config.Validity.Duration
+		}
+		if config.RenewalThreshold != nil {
+			renewalThreshold = config.RenewalThreshold.Duration
+		}
+	}
+
+	for annotation, duration := range map[string]*time.Duration{
+		common.ShootCACertificateValidity:       &caValidity,
+		common.ShootCertificateValidity:         &validity,
+		common.ShootCertificateRenewalThreshold: &renewalThreshold,
+	} {
+		value, ok := b.Shoot.Info.Annotations[annotation]
+		if !ok {
+			continue
+		}
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			return 0, 0, 0, fmt.Errorf("invalid value %q of annotation %q, must be a positive duration", value, annotation)
+		}
+		*duration = parsed
+	}
+
+	effectiveValidity := validity
+	if effectiveValidity == 0 {
+		effectiveValidity = secrets.DefaultCertificateValidity
+	}
+	if renewalThreshold >= effectiveValidity {
+		return 0, 0, 0, fmt.Errorf("certificate renewal threshold %s must be less than the certificate validity %s", renewalThreshold, effectiveValidity)
+	}
+	if config := b.ShootCertificates; config != nil && config.RenewalJitter != nil && renewalThreshold > 0 && renewalThreshold+config.RenewalJitter.Duration >= effectiveValidity {
+		return 0, 0, 0, fmt.Errorf("certificate renewal threshold %s plus jitter %s must be less than the certificate validity %s", renewalThreshold, config.RenewalJitter.Duration, effectiveValidity)
+	}
+
+	return caValidity, validity, renewalThreshold, nil
+}
+
 func (b *Botanist) generateBasicAuthAPIServer(existingSecretsMap map[string]*corev1.Secret) (*secrets.BasicAuth, error) {
 	basicAuthSecretAPIServer := &secrets.BasicAuthSecretConfig{
 		Name:           "kube-apiserver-basic-auth",
@@ -23,6 +23,7 @@ import (
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/utils"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -186,47 +187,39 @@ func (b *Botanist) WaitUntilBackupNamespaceDeleted() error {
 	return b.waitUntilNamespaceDeleted(common.GenerateBackupNamespaceName(b.BackupInfrastructure.Name))
 }
 
-// WaitUntilNamespaceDeleted waits until the <namespace> within the Seed cluster is deleted.
+// WaitUntilNamespaceDeleted waits until the <namespace> within the Seed cluster is deleted. If it is still present
+// after the timeout then the returned error reports the finalizers blocking its deletion.
 func (b *Botanist) waitUntilNamespaceDeleted(namespace string) error {
-	return wait.PollImmediate(5*time.Second, 900*time.Second, func() (bool, error) {
-		if _, err := b.K8sSeedClient.GetNamespace(namespace); err != nil {
-			if apierrors.IsNotFound(err) {
-				return true, nil
-			}
-			return false, err
+	return kutil.WaitUntilDeleted("namespace", 5*time.Second, 900*time.Second, func() (metav1.Object, error) {
+		ns, err := b.K8sSeedClient.GetNamespace(namespace)
+		if err != nil {
+			return nil, err
 		}
 		b.Logger.Infof("Waiting until the namespace '%s' has been cleaned up and deleted in the Seed cluster...", namespace)
-		return false, nil
+		return ns, nil
 	})
 }
 
 // WaitUntilKubeAddonManagerDeleted waits until the kube-addon-manager deployment within the Seed cluster has
 // been deleted.
 func (b *Botanist) WaitUntilKubeAddonManagerDeleted() error {
-	return wait.PollImmediate(5*time.Second, 600*time.Second, func() (bool, error) {
-		if _, err := b.K8sSeedClient.GetDeployment(b.Shoot.SeedNamespace, common.KubeAddonManagerDeploymentName); err != nil {
-			if apierrors.IsNotFound(err) {
-				return true, nil
-			}
-			return false, err
-		}
-		b.Logger.Infof("Waiting until the %s has been deleted in the Seed cluster...", common.KubeAddonManagerDeploymentName)
-		return false, nil
-	})
+	return b.waitUntilDeploymentDeleted(common.KubeAddonManagerDeploymentName)
 }
 
 // WaitUntilClusterAutoscalerDeleted waits until the cluster-autoscaler deployment within the Seed cluster has
 // been deleted.
 func (b *Botanist) WaitUntilClusterAutoscalerDeleted() error {
-	return wait.PollImmediate(5*time.Second, 600*time.Second, func() (bool, error) {
-		if _, err := b.K8sSeedClient.GetDeployment(b.Shoot.SeedNamespace, common.ClusterAutoscalerDeploymentName); err != nil {
-			if apierrors.IsNotFound(err) {
-				return true, nil
-			}
-			return false, err
+	return b.waitUntilDeploymentDeleted(common.ClusterAutoscalerDeploymentName)
+}
+
+func (b *Botanist) waitUntilDeploymentDeleted(name string) error {
+	return kutil.WaitUntilDeleted("deployment", 5*time.Second, 600*time.Second, func() (metav1.Object, error) {
+		deployment, err := b.K8sSeedClient.GetDeployment(b.Shoot.SeedNamespace, name)
+		if err != nil {
+			return nil, err
 		}
-		b.Logger.Infof("Waiting until the %s has been deleted in the Seed cluster...", common.ClusterAutoscalerDeploymentName)
-		return false, nil
+		b.Logger.Infof("Waiting until the %s has been deleted in the Seed cluster...", name)
+		return deployment, nil
 	})
 }
 
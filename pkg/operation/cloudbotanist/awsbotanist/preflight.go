@@ -0,0 +1,87 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awsbotanist
+
+import (
+	"context"
+	"fmt"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/operation/preflight"
+)
+
+func init() {
+	if err := preflight.Register(
+		preflight.Check{Name: "aws-credentials", CloudProvider: gardenv1beta1.CloudProviderAWS, Fn: checkCredentials},
+		preflight.Check{Name: "aws-instance-quota", CloudProvider: gardenv1beta1.CloudProviderAWS, Fn: checkInstanceQuota},
+	); err != nil {
+		panic(err)
+	}
+}
+
+// checkCredentials checks whether the AWS credentials of the Shoot are valid.
+func checkCredentials(_ context.Context, o *operation.Operation) error {
+	botanist, err := New(o, common.CloudPurposeShoot)
+	if err != nil {
+		return err
+	}
+
+	if _, err := botanist.AWSClient.GetAccountID(); err != nil {
+		return fmt.Errorf("could not authenticate with the AWS credentials: %v", err)
+	}
+	return nil
+}
+
+// checkInstanceQuota checks whether the AWS account of the Shoot has enough instances left for the Shoot.
+func checkInstanceQuota(_ context.Context, o *operation.Operation) error {
+	if o.Shoot.IsHibernated {
+		return nil
+	}
+
+	botanist, err := New(o, common.CloudPurposeShoot)
+	if err != nil {
+		return err
+	}
+
+	maxInstances, err := botanist.AWSClient.GetMaxInstances()
+	if err != nil {
+		return err
+	}
+	instances, err := botanist.AWSClient.CountInstances()
+	if err != nil {
+		return err
+	}
+
+	if required := RequiredInstances(o.Shoot.Info, o.Shoot.GetWorkers()); instances+required > maxInstances {
+		return common.NewErrorWithCode(gardenv1beta1.ErrorInfraQuotaExceeded, fmt.Sprintf("the AWS account runs %d of at most %d instances in region %s, but %d more are required", instances, maxInstances, o.Shoot.Info.Spec.Cloud.Region, required))
+	}
+	return nil
+}
+
+// RequiredInstances returns the number of additional instances the reconciliation of the given Shoot requires. A new
+// Shoot requires the minimum number of machines of all its workers, an existing Shoot requires one additional
+// machine per worker for rolling updates.
+func RequiredInstances(shoot *gardenv1beta1.Shoot, workers []gardenv1beta1.Worker) int {
+	if shoot.Status.LastOperation == nil || shoot.Status.LastOperation.Type == gardenv1beta1.ShootLastOperationTypeCreate {
+		required := 0
+		for _, worker := range workers {
+			required += worker.AutoScalerMin
+		}
+		return required
+	}
+	return len(workers)
+}
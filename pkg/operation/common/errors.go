@@ -0,0 +1,72 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package common
+
+import (
+	"regexp"
+	"sync"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+)
+
+// ErrorClassifier determines the Garden error code for the given error message. It returns an empty code if it
+// cannot classify the message.
+type ErrorClassifier func(message string) gardenv1beta1.ErrorCode
+
+// NewRegexpErrorClassifier returns an ErrorClassifier which classifies all messages matching the given <regexp> with
+// the given <code>.
+func NewRegexpErrorClassifier(code gardenv1beta1.ErrorCode, regexp *regexp.Regexp) ErrorClassifier {
+	return func(message string) gardenv1beta1.ErrorCode {
+		if regexp.MatchString(message) {
+			return code
+		}
+		return ""
+	}
+}
+
+var (
+	errorClassifiers     []ErrorClassifier
+	errorClassifiersLock sync.RWMutex
+
+	defaultErrorClassifiers = []ErrorClassifier{
+		NewRegexpErrorClassifier(gardenv1beta1.ErrorInfraUnauthorized, regexp.MustCompile(`(?i)(Unauthorized|InvalidClientTokenId|SignatureDoesNotMatch|Authentication failed|AuthFailure|AuthorizationFailed|invalid character|invalid_grant|invalid_client|Authorization Profile was not found|cannot fetch token|no active subscriptions)`)),
+		NewRegexpErrorClassifier(gardenv1beta1.ErrorInfraQuotaExceeded, regexp.MustCompile(`(?i)(LimitExceeded|Quota)`)),
+		NewRegexpErrorClassifier(gardenv1beta1.ErrorInfraInsufficientPrivileges, regexp.MustCompile(`(?i)(AccessDenied|Forbidden|deny|denied)`)),
+		NewRegexpErrorClassifier(gardenv1beta1.ErrorInfraDependencies, regexp.MustCompile(`(?i)(PendingVerification|Access Not Configured|accessNotConfigured|DependencyViolation|OptInRequired|DeleteConflict|Conflict)`)),
+	}
+)
+
+// RegisterErrorClassifier registers the given <classifier> for DetermineError. This allows providers to map their
+// specific cloud errors to Garden error codes. Registered classifiers are consulted in the order of their
+// registration and before the default ones, i.e., the first code returned by any of them wins.
+func RegisterErrorClassifier(classifier ErrorClassifier) {
+	errorClassifiersLock.Lock()
+	defer errorClassifiersLock.Unlock()
+
+	errorClassifiers = append(errorClassifiers, classifier)
+}
+
+func determineErrorCode(message string) gardenv1beta1.ErrorCode {
+	errorClassifiersLock.RLock()
+	defer errorClassifiersLock.RUnlock()
+
+	for _, classifiers := range [][]ErrorClassifier{errorClassifiers, defaultErrorClassifiers} {
+		for _, classifier := range classifiers {
+			if code := classifier(message); code != "" {
+				return code
+			}
+		}
+	}
+	return ""
+}
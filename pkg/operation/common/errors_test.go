@@ -0,0 +1,52 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package common_test
+
+import (
+	"regexp"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/operation/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("errors", func() {
+	AfterEach(func() {
+		ResetErrorClassifiers()
+	})
+
+	Describe("#RegisterErrorClassifier", func() {
+		It("should classify messages unknown to the default classifiers", func() {
+			RegisterErrorClassifier(NewRegexpErrorClassifier(gardenv1beta1.ErrorInfraQuotaExceeded, regexp.MustCompile(`InsufficientInstanceCapacity`)))
+
+			Expect(DetermineError("InsufficientInstanceCapacity: no capacity left")).To(Equal(NewErrorWithCode(gardenv1beta1.ErrorInfraQuotaExceeded, "InsufficientInstanceCapacity: no capacity left")))
+		})
+
+		It("should consult the registered classifiers before the default ones", func() {
+			RegisterErrorClassifier(NewRegexpErrorClassifier(gardenv1beta1.ErrorInfraDependencies, regexp.MustCompile(`QuotaDependency`)))
+
+			Expect(DetermineError("QuotaDependency")).To(Equal(NewErrorWithCode(gardenv1beta1.ErrorInfraDependencies, "QuotaDependency")))
+		})
+
+		It("should consult the registered classifiers in the order of their registration", func() {
+			RegisterErrorClassifier(func(string) gardenv1beta1.ErrorCode { return "" })
+			RegisterErrorClassifier(func(string) gardenv1beta1.ErrorCode { return gardenv1beta1.ErrorInfraUnauthorized })
+			RegisterErrorClassifier(func(string) gardenv1beta1.ErrorCode { return gardenv1beta1.ErrorInfraDependencies })
+
+			Expect(DetermineError("foo")).To(Equal(NewErrorWithCode(gardenv1beta1.ErrorInfraUnauthorized, "foo")))
+		})
+	})
+})
@@ -143,6 +143,9 @@ const (
 	// GardenRoleCertificateManagement is the value of GardenRole key indicating type 'certificate-management'.
 	GardenRoleCertificateManagement = "certificate-management"
 
+	// GardenRoleCloudProfileCapabilities is the value of GardenRole key indicating type 'cloudprofile-capabilities'.
+	GardenRoleCloudProfileCapabilities = "cloudprofile-capabilities"
+
 	// GardenCreatedBy is the key for an annotation of a Shoot cluster whose value indicates contains the username
 	// of the user that created the resource.
 	GardenCreatedBy = "garden.sapcloud.io/createdBy"
@@ -154,6 +157,19 @@ const (
 	// GardenPurpose is a key for a label describing the purpose of the respective object.
 	GardenPurpose = "garden.sapcloud.io/purpose"
 
+	// ShootPurposeEvaluation is the purpose of Shoots which are used for evaluation.
+	ShootPurposeEvaluation = "evaluation"
+	// ShootPurposeTesting is the purpose of Shoots which are used for testing.
+	ShootPurposeTesting = "testing"
+	// ShootPurposeDevelopment is the purpose of Shoots which are used for development.
+	ShootPurposeDevelopment = "development"
+	// ShootPurposeProduction is the purpose of Shoots which are used for production.
+	ShootPurposeProduction = "production"
+	// ShootPurposeOther is the purpose reported for Shoots whose purpose annotation has an unknown value.
+	ShootPurposeOther = "other"
+	// ShootPurposeUnknown is the purpose reported for Shoots without purpose annotation.
+	ShootPurposeUnknown = "unknown"
+
 	// IngressPrefix is the part of a FQDN which will be used to construct the domain name for an ingress controller of
 	// a Shoot cluster. For example, when a Shoot specifies domain 'cluster.example.com', the ingress domain would be
 	// '*.<IngressPrefix>.cluster.example.com'.
@@ -309,10 +325,65 @@ const (
 	// of referenced quotas.
 	ShootExpirationTimestamp = "shoot.garden.sapcloud.io/expirationTimestamp"
 
+	// ShootLifetimeExtensionRequest is an annotation on a Shoot resource whose value is the number of days by which the
+	// Shoot lifetime shall be extended. The request is processed and removed by the Gardener controller manager.
+	ShootLifetimeExtensionRequest = "shoot.garden.sapcloud.io/lifetimeExtensionRequest"
+
+	// ShootEstimatedMonthlyCost is an annotation on a Shoot resource whose value is the estimated monthly cost of the Shoot's
+	// worker machines and volumes. It is maintained by the ShootCostEstimator admission plugin.
+	ShootEstimatedMonthlyCost = "shoot.garden.sapcloud.io/estimated-monthly-cost"
+
 	// ShootUseAsSeed is a constant for an annotation on a Shoot resource indicating that the Shoot shall be registered as Seed in the
 	// Garden cluster once successfully created.
 	ShootUseAsSeed = "shoot.garden.sapcloud.io/use-as-seed"
 
+	// SeedOperation is a constant for an annotation on a Seed indicating that an operation shall be performed.
+	SeedOperation = "seed.garden.sapcloud.io/operation"
+
+	// SeedOperationReadopt is a constant for an annotation on a Seed indicating that the shoot namespaces in the Seed
+	// cluster shall be re-adopted, i.e., the state of the Shoots referencing the Seed shall be rebuilt from them.
+	SeedOperationReadopt = "readopt"
+
+	// SeedMaintenance is a constant for an annotation on a Seed indicating that the Seed is currently under maintenance
+	// (e.g. being upgraded) if its value is `true`.
+	SeedMaintenance = "seed.garden.sapcloud.io/maintenance"
+
+	// SeedMaintenanceTimeWindow is a constant for an annotation on a Seed containing the begin and the end of its daily
+	// maintenance time window in the maintenance time format, separated by a comma (e.g. `220000+0000,230000+0000`).
+	SeedMaintenanceTimeWindow = "seed.garden.sapcloud.io/maintenance-time-window"
+
+	// SeedCordoned is a constant for an annotation on a Seed indicating that no new Shoots shall be scheduled to the
+	// Seed if its value is `true` (set by operators) or `auto` (set by the SeedCordon controller).
+	SeedCordoned = "seed.garden.sapcloud.io/cordoned"
+
+	// SeedCordonedAutomatically is the value of the SeedCordoned annotation for Seeds which have been cordoned by the
+	// SeedCordon controller. Only those are uncordoned by the controller again.
+	SeedCordonedAutomatically = "auto"
+
+	// SeedETCDBackupSchedule is a constant for an annotation on a Seed which may be used to overwrite the cron schedule
+	// of the full snapshots of the etcds of all Shoots on the Seed (e.g. `0 */12 * * *`).
+	SeedETCDBackupSchedule = "seed.garden.sapcloud.io/etcd-backup-schedule"
+
+	// SeedETCDBackupDeltaSnapshotPeriod is a constant for an annotation on a Seed which may be used to overwrite the
+	// interval of the delta snapshots of the etcds of all Shoots on the Seed (a duration, e.g. `1m`).
+	SeedETCDBackupDeltaSnapshotPeriod = "seed.garden.sapcloud.io/etcd-backup-delta-snapshot-period"
+
+	// SeedETCDBackupCompression is a constant for an annotation on a Seed which may be used to overwrite the
+	// compression of the snapshots of the etcds of all Shoots on the Seed (`gzip` or `none`).
+	SeedETCDBackupCompression = "seed.garden.sapcloud.io/etcd-backup-compression"
+
+	// SeedExtensionVersionPrefix is a prefix for annotations on a Seed which may be used to pin the Helm chart of the
+	// extension registered by the ControllerRegistration with the given name to a specific version (a tag and/or a
+	// digest in the OCI repository referenced by the ControllerRegistration), e.g.
+	// `extensions.seed.garden.sapcloud.io/os-coreos=1.0.0`.
+	SeedExtensionVersionPrefix = "extensions.seed.garden.sapcloud.io/"
+
+	// ETCDBackupCompressionGzip is the value of the etcd backup compression for gzip compressed snapshots.
+	ETCDBackupCompressionGzip = "gzip"
+
+	// ETCDBackupCompressionNone is the value of the etcd backup compression for uncompressed snapshots.
+	ETCDBackupCompressionNone = "none"
+
 	// ShootStatus is a constant for a label on a Shoot resource indicating that the Shoot's health.
 	// Shoot Care controller and can be used to easily identify Shoot clusters with certain states.
 	ShootStatus = "shoot.garden.sapcloud.io/status"
@@ -338,12 +409,37 @@ const (
 	// ShootTaskDeployKube2IAMResource is a name for a Shoot's Kube2IAM Resource deployment task.
 	ShootTaskDeployKube2IAMResource = "deployKube2IAMResource"
 
+	// ShootTaskRestartControlPlane is a name for a Shoot's task to restart the control plane components in the Seed.
+	ShootTaskRestartControlPlane = "restartControlPlane"
+
+	// ShootMaintenanceOperationRestartControlPlane is a maintenance operation of a Shoot which restarts the control plane
+	// components of the Shoot in the Seed.
+	ShootMaintenanceOperationRestartControlPlane = "restart-control-plane"
+
 	// ShootOperationRetry is a constant for an annotation on a Shoot indicating that a failed Shoot reconciliation shall be retried.
 	ShootOperationRetry = "retry"
 
 	// ShootOperationReconcile is a constant for an annotation on a Shoot indicating that a Shoot reconciliation shall be triggered.
 	ShootOperationReconcile = "reconcile"
 
+	// ShootOperationPlan is a constant for an annotation on a Shoot indicating that the changes which a reconciliation
+	// would make shall be computed (without applying them) and written into the status of the Shoot.
+	ShootOperationPlan = "plan"
+
+	// ShootOperationPlanMaintenance is a constant for an annotation on a Shoot indicating that the changes which the next
+	// maintenance would make shall be computed (without applying them) and written into the status of the Shoot.
+	ShootOperationPlanMaintenance = "plan-maintenance"
+
+	// ShootOperationRotateCredentialsPrefix is the prefix of a value of the operation annotation on a Shoot indicating that a single
+	// class of credentials shall be rotated. The class follows the prefix, e.g. "rotate-ssh-keypair" or "rotate-observability-credentials".
+	ShootOperationRotateCredentialsPrefix = "rotate-"
+
+	// ShootCredentialsObservability is the class of the basic authentication credentials of the monitoring and logging ingresses.
+	ShootCredentialsObservability = "observability-credentials"
+
+	// ShootCredentialsSSHKeypair is the class of the SSH keypair which is authorized on the Shoot's nodes.
+	ShootCredentialsSSHKeypair = "ssh-keypair"
+
 	// ShootSyncPeriod is a constant for an annotation on a Shoot which may be used to overwrite the global Shoot controller sync period.
 	// The value must be a duration. It can also be used to disable the reconciliation at all by setting it to 0m. Disabling the reconciliation
 	// does only mean that the period reconciliation is disabled. However, when the Gardener is restarted/redeployed or the specification is
@@ -355,6 +451,35 @@ const (
 	// delete)).
 	ShootIgnore = "shoot.garden.sapcloud.io/ignore"
 
+	// ShootCertificateKeyAlgorithm is a constant for an annotation on a Shoot which may be used to select the algorithm
+	// (and size) of the private keys of newly generated certificate authorities and certificates, e.g. `ECDSA-P256`.
+	// If it is not set then 2048-bit RSA keys are generated.
+	ShootCertificateKeyAlgorithm = "shoot.garden.sapcloud.io/certificate-key-algorithm"
+
+	// ShootCACertificateValidity is a constant for an annotation on a Shoot which may be used to overwrite the validity
+	// of newly generated certificate authorities (a duration, e.g. `87600h`).
+	ShootCACertificateValidity = "shoot.garden.sapcloud.io/ca-certificate-validity"
+
+	// ShootCertificateValidity is a constant for an annotation on a Shoot which may be used to overwrite the validity
+	// of newly generated certificates which are not certificate authorities (a duration, e.g. `8760h`).
+	ShootCertificateValidity = "shoot.garden.sapcloud.io/certificate-validity"
+
+	// ShootCertificateRenewalThreshold is a constant for an annotation on a Shoot which may be used to overwrite the
+	// remaining validity below which certificates are renewed (a duration, e.g. `720h`).
+	ShootCertificateRenewalThreshold = "shoot.garden.sapcloud.io/certificate-renewal-threshold"
+
+	// ShootETCDBackupSchedule is a constant for an annotation on a Shoot which may be used to overwrite the cron schedule
+	// of the full snapshots of its etcd (e.g. `0 */12 * * *`).
+	ShootETCDBackupSchedule = "shoot.garden.sapcloud.io/etcd-backup-schedule"
+
+	// ShootETCDBackupDeltaSnapshotPeriod is a constant for an annotation on a Shoot which may be used to overwrite the
+	// interval of the delta snapshots of its etcd (a duration, e.g. `1m`).
+	ShootETCDBackupDeltaSnapshotPeriod = "shoot.garden.sapcloud.io/etcd-backup-delta-snapshot-period"
+
+	// ShootETCDBackupCompression is a constant for an annotation on a Shoot which may be used to overwrite the
+	// compression of the snapshots of its etcd (`gzip` or `none`).
+	ShootETCDBackupCompression = "shoot.garden.sapcloud.io/etcd-backup-compression"
+
 	// ShootUID is an annotation key for the shoot namespace in the seed cluster,
 	// which value will be the value of `shoot.status.uid`
 	ShootUID = "shoot.garden.sapcloud.io/uid"
@@ -577,6 +702,14 @@ var (
 	RequiredLoggingDeployments = sets.NewString(
 		KibanaDeploymentName,
 	)
+
+	// RotatableShootCredentials is a set of the classes of Shoot credentials which can be rotated separately. Certificate
+	// authorities are not part of it as they cannot be replaced by a single reconciliation without a phase in which both
+	// the old and the new certificate authority are trusted (e.g., by the kubelets which got the CA via the cloud-config).
+	RotatableShootCredentials = sets.NewString(
+		ShootCredentialsObservability,
+		ShootCredentialsSSHKeypair,
+	)
 )
 
 // CloudConfigUserDataConfig is a struct containing cloud-specific configuration required to
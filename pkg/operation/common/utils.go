@@ -291,29 +291,8 @@ func (e *errorWithCode) Error() string {
 	return e.message
 }
 
-var (
-	unauthorizedRegexp           = regexp.MustCompile(`(?i)(Unauthorized|InvalidClientTokenId|SignatureDoesNotMatch|Authentication failed|AuthFailure|AuthorizationFailed|invalid character|invalid_grant|invalid_client|Authorization Profile was not found|cannot fetch token|no active subscriptions)`)
-	quotaExceededRegexp          = regexp.MustCompile(`(?i)(LimitExceeded|Quota)`)
-	insufficientPrivilegesRegexp = regexp.MustCompile(`(?i)(AccessDenied|Forbidden|deny|denied)`)
-	dependenciesRegexp           = regexp.MustCompile(`(?i)(PendingVerification|Access Not Configured|accessNotConfigured|DependencyViolation|OptInRequired|DeleteConflict|Conflict)`)
-)
-
-func determineErrorCode(message string) gardenv1beta1.ErrorCode {
-	switch {
-	case unauthorizedRegexp.MatchString(message):
-		return gardenv1beta1.ErrorInfraUnauthorized
-	case quotaExceededRegexp.MatchString(message):
-		return gardenv1beta1.ErrorInfraQuotaExceeded
-	case insufficientPrivilegesRegexp.MatchString(message):
-		return gardenv1beta1.ErrorInfraInsufficientPrivileges
-	case dependenciesRegexp.MatchString(message):
-		return gardenv1beta1.ErrorInfraDependencies
-	default:
-		return ""
-	}
-}
-
-// DetermineError determines the Garden error code for the given error message.
+// DetermineError determines the Garden error code for the given error message by consulting the registered error
+// classifiers (see RegisterErrorClassifier) before the default ones.
 func DetermineError(message string) error {
 	code := determineErrorCode(message)
 	if code == "" {
@@ -427,6 +406,16 @@ func ReadLeaderElectionRecord(k8sClient kubernetes.Interface, lock, namespace, n
 	return &leaderElectionRecord, nil
 }
 
+// CredentialsToRotate returns the class of Shoot credentials whose rotation is requested by the operation annotation
+// in the given <annotations>, and whether a rotation is requested at all.
+func CredentialsToRotate(annotations map[string]string) (string, bool) {
+	operation := annotations[ShootOperation]
+	if !strings.HasPrefix(operation, ShootOperationRotateCredentialsPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(operation, ShootOperationRotateCredentialsPrefix), true
+}
+
 // GardenerDeletionGracePeriod is the default grace period for Gardener's force deletion methods.
 var GardenerDeletionGracePeriod = 5 * time.Minute
 
@@ -218,6 +218,21 @@ var _ = Describe("common", func() {
 		})
 	})
 
+	Describe("#CredentialsToRotate", func() {
+		It("should return the class of credentials to rotate", func() {
+			credentials, ok := CredentialsToRotate(map[string]string{ShootOperation: "rotate-observability-credentials"})
+
+			Expect(ok).To(BeTrue())
+			Expect(credentials).To(Equal(ShootCredentialsObservability))
+		})
+
+		It("should return false if no rotation is requested", func() {
+			_, ok := CredentialsToRotate(map[string]string{ShootOperation: ShootOperationReconcile})
+
+			Expect(ok).To(BeFalse())
+		})
+	})
+
 	Describe("#MergeOwnerReferences", func() {
 		It("should merge the new references into the list of existing references", func() {
 			var (
@@ -24,6 +24,7 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
+	gardenmetrics "github.com/gardener/gardener/pkg/controllermanager/metrics"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/operation/shoot"
 	"github.com/gardener/gardener/pkg/utils"
@@ -172,12 +173,18 @@ func (b *HybridBotanist) computeOperatingSystemConfigsForWorker(machineTypes []g
 		secretName                                               = b.Shoot.ComputeCloudConfigSecretName(worker.Name)
 	)
 
+	annotations := map[string]interface{}{
+		extensionsv1alpha1.CredentialsChecksumAnnotation: b.Botanist.ComputeExtensionCredentialsChecksum(),
+	}
+
 	downloaderConfig["secretName"] = secretName
+	downloaderConfig["annotations"] = annotations
 	originalConfig["osc"] = map[string]interface{}{
 		"type":                 machineImageName,
 		"purpose":              extensionsv1alpha1.OperatingSystemConfigPurposeReconcile,
 		"reloadConfigFilePath": common.CloudConfigFilePath,
 		"secretName":           secretName,
+		"annotations":          annotations,
 	}
 	originalConfig["worker"] = map[string]interface{}{
 		"name":                        worker.Name,
@@ -204,11 +211,17 @@ func (b *HybridBotanist) applyAndWaitForShootOperatingSystemConfig(chartPath, na
 		return nil, err
 	}
 
-	return result, wait.PollImmediate(time.Second, 30*time.Second, func() (bool, error) {
+	var (
+		start   = time.Now()
+		oscType string
+	)
+
+	if err := wait.PollImmediate(time.Second, b.Timeouts().ExtensionReadiness, func() (bool, error) {
 		var osc extensionsv1alpha1.OperatingSystemConfig
 		if err := b.K8sSeedClient.Client().Get(context.TODO(), client.ObjectKey{Name: name, Namespace: b.Shoot.SeedNamespace}, &osc); err != nil {
 			return false, err
 		}
+		oscType = osc.Spec.Type
 
 		if osc.Status.ObservedGeneration == osc.Generation && osc.Status.LastOperation.State == extensionsv1alpha1.LastOperationStateSucceeded && osc.Status.CloudConfig != nil {
 			var secret corev1.Secret
@@ -223,7 +236,12 @@ func (b *HybridBotanist) applyAndWaitForShootOperatingSystemConfig(chartPath, na
 			return true, nil
 		}
 		return false, nil
-	})
+	}); err != nil {
+		return nil, err
+	}
+
+	gardenmetrics.ShootExtensionWaitDuration.WithLabelValues(extensionsv1alpha1.OperatingSystemConfigResource, oscType, b.Shoot.GetPurpose(), b.Seed.Info.Name).Observe(time.Since(start).Seconds())
+	return result, nil
 }
 
 // generateCloudConfigExecutionChart renders the kube-addon-manager configuration for the cloud config user data.
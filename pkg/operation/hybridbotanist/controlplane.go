@@ -125,6 +125,13 @@ func (b *HybridBotanist) DeployETCD() error {
 
 	// Some cloud botanists do not yet support backup and won't return backup config data.
 	if backupConfigData != nil {
+		backupValues, err := b.Botanist.ETCDBackupValues()
+		if err != nil {
+			return err
+		}
+		for key, value := range backupValues {
+			backupConfigData[key] = value
+		}
 		etcdConfig["backup"] = backupConfigData
 		etcdConfig["podAnnotations"].(map[string]interface{})["checksum/secret-etcd-backup"] = utils.HashForMap(backupConfigData)
 	}
@@ -298,10 +298,11 @@ func (b *HybridBotanist) markMachineForcefulDeletion(machine machinev1alpha1.Mac
 	return err
 }
 
-// waitUntilMachineDeploymentsAvailable waits for a maximum of 30 minutes until all the desired <machineDeployments>
-// were marked as healthy/available by the machine-controller-manager. It polls the status every 5 seconds.
+// waitUntilMachineDeploymentsAvailable waits for a maximum of the configured worker rollout timeout (30 minutes by
+// default) until all the desired <machineDeployments> were marked as healthy/available by the machine-controller-manager.
+// It polls the status every 5 seconds.
 func (b *HybridBotanist) waitUntilMachineDeploymentsAvailable(wantedMachineDeployments operation.MachineDeployments) error {
-	return wait.Poll(5*time.Second, 30*time.Minute, func() (bool, error) {
+	return wait.Poll(5*time.Second, b.Timeouts().WorkerRollout, func() (bool, error) {
 		var numHealthyDeployments, numUpdated, numDesired, numberOfAwakeMachines int32
 
 		// Get the list of all existing machine deployments
@@ -50,13 +50,13 @@ import (
 )
 
 // New creates a new operation object with a Shoot resource object.
-func New(shoot *gardenv1beta1.Shoot, logger *logrus.Entry, k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.Interface, gardenerInfo *gardenv1beta1.Gardener, secretsMap map[string]*corev1.Secret, imageVector imagevector.ImageVector, shootBackup *config.ShootBackup) (*Operation, error) {
-	return newOperation(logger, k8sGardenClient, k8sGardenInformers, gardenerInfo, secretsMap, imageVector, shoot.Namespace, *(shoot.Spec.Cloud.Seed), shoot, nil, shootBackup)
+func New(shoot *gardenv1beta1.Shoot, logger *logrus.Entry, k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.Interface, gardenerInfo *gardenv1beta1.Gardener, secretsMap map[string]*corev1.Secret, imageVector imagevector.ImageVector, shootBackup *config.ShootBackup, shootCertificates *config.ShootCertificates, shootTimeouts *config.ShootTimeouts) (*Operation, error) {
+	return newOperation(logger, k8sGardenClient, k8sGardenInformers, gardenerInfo, secretsMap, imageVector, shoot.Namespace, *(shoot.Spec.Cloud.Seed), shoot, nil, shootBackup, shootCertificates, shootTimeouts)
 }
 
 // NewWithBackupInfrastructure creates a new operation object without a Shoot resource object but the BackupInfrastructure resource.
 func NewWithBackupInfrastructure(backupInfrastructure *gardenv1beta1.BackupInfrastructure, logger *logrus.Entry, k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.Interface, gardenerInfo *gardenv1beta1.Gardener, secretsMap map[string]*corev1.Secret, imageVector imagevector.ImageVector) (*Operation, error) {
-	return newOperation(logger, k8sGardenClient, k8sGardenInformers, gardenerInfo, secretsMap, imageVector, backupInfrastructure.Namespace, backupInfrastructure.Spec.Seed, nil, backupInfrastructure, nil)
+	return newOperation(logger, k8sGardenClient, k8sGardenInformers, gardenerInfo, secretsMap, imageVector, backupInfrastructure.Namespace, backupInfrastructure.Spec.Seed, nil, backupInfrastructure, nil, nil, nil)
 }
 
 func newOperation(
@@ -71,6 +71,8 @@ func newOperation(
 	shoot *gardenv1beta1.Shoot,
 	backupInfrastructure *gardenv1beta1.BackupInfrastructure,
 	shootBackup *config.ShootBackup,
+	shootCertificates *config.ShootCertificates,
+	shootTimeouts *config.ShootTimeouts,
 ) (*Operation, error) {
 
 	secrets := make(map[string]*corev1.Secret)
@@ -105,6 +107,8 @@ func newOperation(
 		ChartGardenRenderer:  chartRenderer,
 		BackupInfrastructure: backupInfrastructure,
 		ShootBackup:          shootBackup,
+		ShootCertificates:    shootCertificates,
+		ShootTimeouts:        shootTimeouts,
 		MachineDeployments:   MachineDeployments{},
 	}
 
@@ -353,7 +357,15 @@ func (o *Operation) NewBackupInfrastructureTerraformer() (*terraformer.Terraform
 
 // NewShootTerraformer creates a new Terraformer for the current shoot with the given purpose.
 func (o *Operation) NewShootTerraformer(purpose string) (*terraformer.Terraformer, error) {
-	return o.newTerraformer(purpose, o.Shoot.SeedNamespace, o.Shoot.Info.Name)
+	tf, err := o.newTerraformer(purpose, o.Shoot.SeedNamespace, o.Shoot.Info.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if purpose == common.TerraformerPurposeInfra {
+		tf.SetJobTimeout(o.Timeouts().Infrastructure)
+	}
+	return tf, nil
 }
 
 // ChartInitializer initializes a terraformer based on the given chart and values.
@@ -0,0 +1,161 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package preflight provides checks which are executed before the shoot reconciliation flow is started. They detect
+// problems like invalid cloud provider credentials or exhausted quotas early and fail with an error code instead of
+// letting the flow time out midway.
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+// Func is the function of a check. It is called with the operation of the shoot that is about to be reconciled.
+// Errors implementing the helper.Coder interface keep their code, the code of all other errors is determined by
+// common.DetermineError.
+type Func func(ctx context.Context, o *operation.Operation) error
+
+// Check is a function which is executed before the shoot reconciliation flow is started.
+type Check struct {
+	// Name is the unique name of the check.
+	Name string
+	// CloudProvider is the cloud provider the check is executed for. If it is empty, the check is executed for all
+	// cloud providers.
+	CloudProvider gardenv1beta1.CloudProvider
+	// Fn is the function that is called.
+	Fn Func
+}
+
+// Registry stores checks. Checks are executed in the order of their registration.
+type Registry struct {
+	lock   sync.RWMutex
+	checks []Check
+	names  map[string]struct{}
+}
+
+// NewRegistry returns a new empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{names: make(map[string]struct{})}
+}
+
+// DefaultRegistry is the registry the shoot controller takes the checks from. It contains the checks of this package,
+// providers register their specific checks (e.g. for their quotas) in addition.
+var DefaultRegistry = NewRegistry()
+
+func init() {
+	if err := Register(
+		Check{Name: "seed-api-server", Fn: CheckSeedAPIServer},
+		Check{Name: "cloud-provider-secret", Fn: CheckCloudProviderSecret},
+	); err != nil {
+		panic(err)
+	}
+}
+
+// Register registers the given checks in the DefaultRegistry.
+func Register(checks ...Check) error {
+	return DefaultRegistry.Register(checks...)
+}
+
+// Run executes the checks of the DefaultRegistry for the given operation.
+func Run(ctx context.Context, o *operation.Operation) error {
+	return DefaultRegistry.Run(ctx, o)
+}
+
+// Register registers the given checks. It returns an error if a check is invalid or if a check with the same name
+// has already been registered.
+func (r *Registry) Register(checks ...Check) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, check := range checks {
+		if len(check.Name) == 0 {
+			return fmt.Errorf("check must have a name")
+		}
+		if _, ok := r.names[check.Name]; ok {
+			return fmt.Errorf("check %q is already registered", check.Name)
+		}
+		if check.Fn == nil {
+			return fmt.Errorf("check %q has no function", check.Name)
+		}
+
+		r.names[check.Name] = struct{}{}
+		r.checks = append(r.checks, check)
+	}
+	return nil
+}
+
+// Checks returns all checks registered for the given cloud provider.
+func (r *Registry) Checks(cloudProvider gardenv1beta1.CloudProvider) []Check {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	var out []Check
+	for _, check := range r.checks {
+		if len(check.CloudProvider) == 0 || check.CloudProvider == cloudProvider {
+			out = append(out, check)
+		}
+	}
+	return out
+}
+
+// Run executes all checks registered for the cloud provider of the shoot of the given operation. It returns an
+// aggregated error of all failed checks, each of them carrying an error code if it could be determined.
+func (r *Registry) Run(ctx context.Context, o *operation.Operation) error {
+	var result error
+	for _, check := range r.Checks(o.Shoot.CloudProvider) {
+		if err := check.Fn(ctx, o); err != nil {
+			result = multierror.Append(result, checkError(check.Name, err))
+		}
+	}
+	return result
+}
+
+func checkError(name string, err error) error {
+	message := fmt.Sprintf("pre-flight check %q failed: %v", name, err)
+	if coder, ok := err.(helper.Coder); ok {
+		return common.NewErrorWithCode(coder.Code(), message)
+	}
+	return common.DetermineError(message)
+}
+
+// CheckSeedAPIServer checks whether the API server of the Seed cluster is reachable.
+func CheckSeedAPIServer(_ context.Context, o *operation.Operation) error {
+	if _, err := o.K8sSeedClient.Kubernetes().Discovery().ServerVersion(); err != nil {
+		return fmt.Errorf("the API server of seed %s is not reachable: %v", o.Seed.Info.Name, err)
+	}
+	return nil
+}
+
+// CheckCloudProviderSecret checks whether the cloud provider secret of the Shoot contains credentials.
+func CheckCloudProviderSecret(_ context.Context, o *operation.Operation) error {
+	secret := o.Shoot.Secret
+	if secret == nil || len(secret.Data) == 0 {
+		return common.NewErrorWithCode(gardenv1beta1.ErrorInfraUnauthorized, "the cloud provider secret does not contain any credentials")
+	}
+	for key, value := range secret.Data {
+		if len(value) == 0 {
+			return common.NewErrorWithCode(gardenv1beta1.ErrorInfraUnauthorized, fmt.Sprintf("the cloud provider secret %s/%s contains an empty value for key %q", secret.Namespace, secret.Name, key))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preflight_test
+
+import (
+	"context"
+	"errors"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/pkg/operation/preflight"
+	"github.com/gardener/gardener/pkg/operation/shoot"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Preflight", func() {
+	var (
+		ctx = context.TODO()
+		o   *operation.Operation
+	)
+
+	BeforeEach(func() {
+		o = &operation.Operation{
+			Shoot: &shoot.Shoot{
+				CloudProvider: gardenv1beta1.CloudProviderAWS,
+				Secret: &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "garden-foo", Name: "aws"},
+					Data:       map[string][]byte{"accessKeyID": []byte("foo"), "secretAccessKey": []byte("bar")},
+				},
+			},
+		}
+	})
+
+	Describe("Registry", func() {
+		var (
+			registry *Registry
+			succeed  = func(context.Context, *operation.Operation) error { return nil }
+		)
+
+		BeforeEach(func() {
+			registry = NewRegistry()
+		})
+
+		Describe("#Register", func() {
+			It("should reject invalid checks", func() {
+				Expect(registry.Register(Check{Fn: succeed})).NotTo(Succeed())
+				Expect(registry.Register(Check{Name: "foo"})).NotTo(Succeed())
+			})
+
+			It("should reject checks with duplicate names", func() {
+				Expect(registry.Register(Check{Name: "foo", Fn: succeed})).To(Succeed())
+				Expect(registry.Register(Check{Name: "foo", Fn: succeed})).NotTo(Succeed())
+			})
+		})
+
+		Describe("#Checks", func() {
+			It("should return the checks for all and for the given cloud provider", func() {
+				Expect(registry.Register(
+					Check{Name: "all", Fn: succeed},
+					Check{Name: "aws", CloudProvider: gardenv1beta1.CloudProviderAWS, Fn: succeed},
+					Check{Name: "gcp", CloudProvider: gardenv1beta1.CloudProviderGCP, Fn: succeed},
+				)).To(Succeed())
+
+				var names []string
+				for _, check := range registry.Checks(gardenv1beta1.CloudProviderAWS) {
+					names = append(names, check.Name)
+				}
+				Expect(names).To(Equal([]string{"all", "aws"}))
+			})
+		})
+
+		Describe("#Run", func() {
+			It("should succeed if all checks succeed", func() {
+				Expect(registry.Register(Check{Name: "foo", Fn: succeed})).To(Succeed())
+
+				Expect(registry.Run(ctx, o)).To(Succeed())
+			})
+
+			It("should return the errors of all failed checks with their codes", func() {
+				Expect(registry.Register(
+					Check{Name: "coded", Fn: func(context.Context, *operation.Operation) error {
+						return common.NewErrorWithCode(gardenv1beta1.ErrorInfraQuotaExceeded, "no instances left")
+					}},
+					Check{Name: "classified", Fn: func(context.Context, *operation.Operation) error {
+						return errors.New("AuthFailure: AWS was not able to validate the provided access credentials")
+					}},
+					Check{Name: "succeeding", Fn: succeed},
+					Check{Name: "other-provider", CloudProvider: gardenv1beta1.CloudProviderGCP, Fn: func(context.Context, *operation.Operation) error {
+						return errors.New("should not be executed")
+					}},
+				)).To(Succeed())
+
+				err := registry.Run(ctx, o)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(`pre-flight check "coded" failed: no instances left`))
+				Expect(err.Error()).NotTo(ContainSubstring("should not be executed"))
+				Expect(helper.ExtractErrorCodes(err)).To(Equal([]gardenv1beta1.ErrorCode{gardenv1beta1.ErrorInfraQuotaExceeded, gardenv1beta1.ErrorInfraUnauthorized}))
+			})
+		})
+	})
+
+	Describe("#CheckCloudProviderSecret", func() {
+		It("should succeed if the secret contains credentials", func() {
+			Expect(CheckCloudProviderSecret(ctx, o)).To(Succeed())
+		})
+
+		It("should fail if the secret does not contain credentials", func() {
+			o.Shoot.Secret.Data = nil
+
+			err := CheckCloudProviderSecret(ctx, o)
+			Expect(err).To(HaveOccurred())
+			Expect(helper.ExtractErrorCodes(err)).To(Equal([]gardenv1beta1.ErrorCode{gardenv1beta1.ErrorInfraUnauthorized}))
+		})
+
+		It("should fail if the secret contains empty values", func() {
+			o.Shoot.Secret.Data["secretAccessKey"] = nil
+
+			Expect(CheckCloudProviderSecret(ctx, o)).To(MatchError(ContainSubstring(`empty value for key "secretAccessKey"`)))
+		})
+	})
+})
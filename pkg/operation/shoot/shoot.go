@@ -27,6 +27,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 // New takes a <k8sGardenClient>, the <k8sGardenInformers> and a <shoot> manifest, and creates a new Shoot representation.
@@ -178,6 +179,32 @@ func (s *Shoot) GetMachineImageName() gardenv1beta1.MachineImageName {
 	return helper.GetMachineImageNameFromShoot(s.CloudProvider, s.Info)
 }
 
+// GetPurpose returns the purpose of the Shoot (see PurposeOf).
+func (s *Shoot) GetPurpose() string {
+	return PurposeOf(s.Info)
+}
+
+// PurposeOf returns the purpose of the given Shoot as given by its purpose annotation. As the annotation can be set
+// freely by users, values other than the known purposes are returned as "other" (and a missing annotation as
+// "unknown"), so that the purpose can safely be used as metric label.
+func PurposeOf(shoot *gardenv1beta1.Shoot) string {
+	purpose, ok := shoot.Annotations[common.GardenPurpose]
+	if !ok || len(purpose) == 0 {
+		return common.ShootPurposeUnknown
+	}
+	if !knownPurposes.Has(purpose) {
+		return common.ShootPurposeOther
+	}
+	return purpose
+}
+
+var knownPurposes = sets.NewString(
+	common.ShootPurposeEvaluation,
+	common.ShootPurposeTesting,
+	common.ShootPurposeDevelopment,
+	common.ShootPurposeProduction,
+)
+
 // ClusterAutoscalerEnabled returns true if the cluster-autoscaler addon is enabled in the Shoot manifest.
 func (s *Shoot) ClusterAutoscalerEnabled() bool {
 	return s.Info.Spec.Addons != nil && s.Info.Spec.Addons.ClusterAutoscaler != nil && s.Info.Spec.Addons.ClusterAutoscaler.Enabled
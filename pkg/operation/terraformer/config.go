@@ -17,6 +17,7 @@ package terraformer
 import (
 	"context"
 	"errors"
+	"time"
 
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	corev1 "k8s.io/api/core/v1"
@@ -42,6 +43,12 @@ func (t *Terraformer) SetVariablesEnvironment(tfvarsEnvironment map[string]strin
 	return t
 }
 
+// SetJobTimeout sets the time the Terraformer waits for the Terraform Job to be completed.
+func (t *Terraformer) SetJobTimeout(timeout time.Duration) *Terraformer {
+	t.jobTimeout = timeout
+	return t
+}
+
 // InitializerConfig is the configuration about the location and naming of the resources the
 // Terraformer expects.
 type InitializerConfig struct {
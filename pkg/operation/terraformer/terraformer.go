@@ -98,6 +98,7 @@ func New(
 		stateName:     prefix + common.TerraformerStateSuffix,
 		podName:       fmt.Sprintf("%s-%s", prefix+common.TerraformerPodSuffix, podSuffix),
 		jobName:       prefix + common.TerraformerJobSuffix,
+		jobTimeout:    DefaultJobTimeout,
 	}
 }
 
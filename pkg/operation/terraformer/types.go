@@ -15,6 +15,8 @@
 package terraformer
 
 import (
+	"time"
+
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -35,6 +37,7 @@ import (
 //   with TF_VAR_).
 // * configurationDefined indicates whether the required configuration ConfigMaps/Secrets have been
 //   successfully defined.
+// * jobTimeout is the time to wait for the Job to be completed.
 type Terraformer struct {
 	logger       logrus.FieldLogger
 	client       client.Client
@@ -51,6 +54,12 @@ type Terraformer struct {
 	jobName              string
 	variablesEnvironment map[string]string
 	configurationDefined bool
+	jobTimeout           time.Duration
 }
 
-const numberOfConfigResources = 3
+const (
+	numberOfConfigResources = 3
+
+	// DefaultJobTimeout is the default time the Terraformer waits for a Terraform Job to be completed.
+	DefaultJobTimeout = time.Hour
+)
@@ -0,0 +1,98 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operation
+
+import (
+	"time"
+
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// DefaultInfrastructureTimeout is the default time to wait for the infrastructure of a Shoot to be created.
+	DefaultInfrastructureTimeout = time.Hour
+	// DefaultWorkerRolloutTimeout is the default time to wait for the machine deployments of a Shoot to become available.
+	DefaultWorkerRolloutTimeout = 30 * time.Minute
+	// DefaultExtensionReadinessTimeout is the default time to wait for extension resources of a Shoot to become ready.
+	DefaultExtensionReadinessTimeout = 30 * time.Second
+)
+
+// Timeouts contains the timeouts of long-running operations for a Shoot.
+type Timeouts struct {
+	Infrastructure     time.Duration
+	WorkerRollout      time.Duration
+	ExtensionReadiness time.Duration
+}
+
+// Timeouts computes the timeouts for the Shoot of the operation. Every timeout is taken from the first profile
+// matching the Shoot, or from the default timeouts of the configuration if the profile does not set it. Timeouts
+// which are not configured at all fall back to the built-in defaults.
+func (o *Operation) Timeouts() Timeouts {
+	timeouts := Timeouts{
+		Infrastructure:     DefaultInfrastructureTimeout,
+		WorkerRollout:      DefaultWorkerRolloutTimeout,
+		ExtensionReadiness: DefaultExtensionReadinessTimeout,
+	}
+
+	if o.ShootTimeouts == nil {
+		return timeouts
+	}
+
+	applyOperationTimeouts(&timeouts, o.ShootTimeouts.Default)
+	if o.Shoot != nil && o.Shoot.Info != nil {
+		if profile := findShootTimeoutProfile(o.ShootTimeouts.Profiles, string(o.Shoot.CloudProvider), o.Shoot.Info.Labels); profile != nil {
+			applyOperationTimeouts(&timeouts, &profile.Timeouts)
+		}
+	}
+
+	return timeouts
+}
+
+func findShootTimeoutProfile(profiles []config.ShootTimeoutProfile, cloudProvider string, shootLabels map[string]string) *config.ShootTimeoutProfile {
+	for i, profile := range profiles {
+		if profile.CloudProvider != nil && *profile.CloudProvider != cloudProvider {
+			continue
+		}
+
+		if profile.ShootSelector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(profile.ShootSelector)
+			if err != nil || !selector.Matches(labels.Set(shootLabels)) {
+				continue
+			}
+		}
+
+		return &profiles[i]
+	}
+	return nil
+}
+
+func applyOperationTimeouts(timeouts *Timeouts, operationTimeouts *config.OperationTimeouts) {
+	if operationTimeouts == nil {
+		return
+	}
+
+	if operationTimeouts.Infrastructure != nil {
+		timeouts.Infrastructure = operationTimeouts.Infrastructure.Duration
+	}
+	if operationTimeouts.WorkerRollout != nil {
+		timeouts.WorkerRollout = operationTimeouts.WorkerRollout.Duration
+	}
+	if operationTimeouts.ExtensionReadiness != nil {
+		timeouts.ExtensionReadiness = operationTimeouts.ExtensionReadiness.Duration
+	}
+}
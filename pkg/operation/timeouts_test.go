@@ -0,0 +1,115 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operation_test
+
+import (
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/shoot"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Timeouts", func() {
+	var (
+		op *Operation
+
+		duration = func(d time.Duration) *metav1.Duration { return &metav1.Duration{Duration: d} }
+		azure    = "azure"
+	)
+
+	BeforeEach(func() {
+		op = &Operation{
+			Shoot: &shoot.Shoot{
+				CloudProvider: gardenv1beta1.CloudProviderAWS,
+				Info: &gardenv1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{"size": "large"},
+					},
+				},
+			},
+		}
+	})
+
+	It("should return the built-in defaults if nothing is configured", func() {
+		Expect(op.Timeouts()).To(Equal(Timeouts{
+			Infrastructure:     DefaultInfrastructureTimeout,
+			WorkerRollout:      DefaultWorkerRolloutTimeout,
+			ExtensionReadiness: DefaultExtensionReadinessTimeout,
+		}))
+	})
+
+	It("should apply the configured default timeouts", func() {
+		op.ShootTimeouts = &config.ShootTimeouts{
+			Default: &config.OperationTimeouts{WorkerRollout: duration(time.Hour)},
+		}
+
+		Expect(op.Timeouts()).To(Equal(Timeouts{
+			Infrastructure:     DefaultInfrastructureTimeout,
+			WorkerRollout:      time.Hour,
+			ExtensionReadiness: DefaultExtensionReadinessTimeout,
+		}))
+	})
+
+	It("should apply the first matching profile on top of the default timeouts", func() {
+		op.ShootTimeouts = &config.ShootTimeouts{
+			Default: &config.OperationTimeouts{
+				WorkerRollout:      duration(time.Hour),
+				ExtensionReadiness: duration(time.Minute),
+			},
+			Profiles: []config.ShootTimeoutProfile{
+				{
+					Name:          "azure",
+					CloudProvider: &azure,
+					Timeouts:      config.OperationTimeouts{Infrastructure: duration(3 * time.Hour)},
+				},
+				{
+					Name:          "large",
+					ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"size": "large"}},
+					Timeouts:      config.OperationTimeouts{Infrastructure: duration(2 * time.Hour)},
+				},
+				{
+					Name:     "all",
+					Timeouts: config.OperationTimeouts{WorkerRollout: duration(2 * time.Hour)},
+				},
+			},
+		}
+
+		Expect(op.Timeouts()).To(Equal(Timeouts{
+			Infrastructure:     2 * time.Hour,
+			WorkerRollout:      time.Hour,
+			ExtensionReadiness: time.Minute,
+		}))
+	})
+
+	It("should skip profiles whose selector does not match the Shoot", func() {
+		op.ShootTimeouts = &config.ShootTimeouts{
+			Profiles: []config.ShootTimeoutProfile{
+				{
+					Name:          "small",
+					ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"size": "small"}},
+					Timeouts:      config.OperationTimeouts{WorkerRollout: duration(time.Minute)},
+				},
+			},
+		}
+
+		Expect(op.Timeouts().WorkerRollout).To(Equal(DefaultWorkerRolloutTimeout))
+	})
+})
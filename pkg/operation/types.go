@@ -59,6 +59,8 @@ type Operation struct {
 	SeedNamespaceObject  *corev1.Namespace
 	BackupInfrastructure *gardenv1beta1.BackupInfrastructure
 	ShootBackup          *config.ShootBackup
+	ShootCertificates    *config.ShootCertificates
+	ShootTimeouts        *config.ShootTimeouts
 	MachineDeployments   MachineDeployments
 	MonitoringClient     prometheusclient.API
 }
@@ -42,6 +42,8 @@ func (cloudProfileStrategy) NamespaceScoped() bool {
 func (cloudProfileStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
 	cloudprofile := obj.(*garden.CloudProfile)
 
+	cloudprofile.Status = garden.CloudProfileStatus{}
+
 	finalizers := sets.NewString(cloudprofile.Finalizers...)
 	if !finalizers.Has(gardenv1beta1.GardenerName) {
 		finalizers.Insert(gardenv1beta1.GardenerName)
@@ -62,8 +64,9 @@ func (cloudProfileStrategy) AllowCreateOnUpdate() bool {
 }
 
 func (cloudProfileStrategy) PrepareForUpdate(ctx context.Context, newObj, oldObj runtime.Object) {
-	_ = oldObj.(*garden.CloudProfile)
-	_ = newObj.(*garden.CloudProfile)
+	oldProfile := oldObj.(*garden.CloudProfile)
+	newProfile := newObj.(*garden.CloudProfile)
+	newProfile.Status = oldProfile.Status
 }
 
 func (cloudProfileStrategy) AllowUnconditionalUpdate() bool {
@@ -74,3 +77,20 @@ func (cloudProfileStrategy) ValidateUpdate(ctx context.Context, newObj, oldObj r
 	oldProfile, newProfile := oldObj.(*garden.CloudProfile), newObj.(*garden.CloudProfile)
 	return validation.ValidateCloudProfileUpdate(newProfile, oldProfile)
 }
+
+type cloudProfileStatusStrategy struct {
+	cloudProfileStrategy
+}
+
+// StatusStrategy defines the storage strategy for the status subresource of CloudProfiles.
+var StatusStrategy = cloudProfileStatusStrategy{Strategy}
+
+func (cloudProfileStatusStrategy) PrepareForUpdate(ctx context.Context, newObj, oldObj runtime.Object) {
+	oldProfile := oldObj.(*garden.CloudProfile)
+	newProfile := newObj.(*garden.CloudProfile)
+	newProfile.Spec = oldProfile.Spec
+}
+
+func (cloudProfileStatusStrategy) ValidateUpdate(ctx context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	return validation.ValidateCloudProfileStatusUpdate(newObj.(*garden.CloudProfile), oldObj.(*garden.CloudProfile))
+}
@@ -80,6 +80,14 @@ func mustIncreaseGeneration(oldShoot, newShoot *garden.Shoot) bool {
 		return true
 	}
 
+	// The rotation of a class of credentials is requested. The annotation is kept as it is removed by the Shoot controller
+	// after the rotation has been completed.
+	if credentials, ok := common.CredentialsToRotate(newShoot.Annotations); ok {
+		if oldCredentials, oldOk := common.CredentialsToRotate(oldShoot.Annotations); !oldOk || oldCredentials != credentials {
+			return true
+		}
+	}
+
 	if lastOperation := newShoot.Status.LastOperation; lastOperation != nil {
 		mustIncrease := false
 
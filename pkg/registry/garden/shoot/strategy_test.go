@@ -15,6 +15,7 @@
 package shoot_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/gardener/gardener/pkg/apis/garden"
@@ -88,6 +89,33 @@ var _ = Describe("MatchShoot", func() {
 	})
 })
 
+var _ = Describe("PrepareForUpdate", func() {
+	It("should increase the generation when a credentials rotation is requested", func() {
+		oldShoot := newShoot("foo")
+		oldShoot.Generation = 1
+		oldShoot.Status.LastOperation = &garden.LastOperation{State: garden.ShootLastOperationStateSucceeded}
+		newShoot := oldShoot.DeepCopy()
+		newShoot.Annotations = map[string]string{"shoot.garden.sapcloud.io/operation": "rotate-ssh-keypair"}
+
+		strategy.Strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+
+		Expect(newShoot.Generation).To(Equal(int64(2)))
+		Expect(newShoot.Annotations).To(HaveKeyWithValue("shoot.garden.sapcloud.io/operation", "rotate-ssh-keypair"))
+	})
+
+	It("should not increase the generation again while the same credentials rotation is requested", func() {
+		oldShoot := newShoot("foo")
+		oldShoot.Generation = 2
+		oldShoot.Annotations = map[string]string{"shoot.garden.sapcloud.io/operation": "rotate-ssh-keypair"}
+		newShoot := oldShoot.DeepCopy()
+		newShoot.Labels["baz"] = "qux"
+
+		strategy.Strategy.PrepareForUpdate(context.TODO(), newShoot, oldShoot)
+
+		Expect(newShoot.Generation).To(Equal(int64(2)))
+	})
+})
+
 func newShoot(seedName string) *garden.Shoot {
 	return &garden.Shoot{
 		ObjectMeta: metav1.ObjectMeta{
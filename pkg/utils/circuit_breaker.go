@@ -0,0 +1,191 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// CircuitBreakerState is the state of a CircuitBreaker.
+type CircuitBreakerState string
+
+const (
+	// CircuitBreakerClosed is the state of a CircuitBreaker in which calls to the protected dependency are allowed.
+	CircuitBreakerClosed CircuitBreakerState = "Closed"
+	// CircuitBreakerOpen is the state of a CircuitBreaker which has been tripped. Calls to the protected dependency
+	// are rejected until the cool-down period has passed.
+	CircuitBreakerOpen CircuitBreakerState = "Open"
+	// CircuitBreakerHalfOpen is the state of a CircuitBreaker whose cool-down period has passed. A single trial call
+	// to the protected dependency is allowed which decides whether the breaker is closed or opened again.
+	CircuitBreakerHalfOpen CircuitBreakerState = "HalfOpen"
+)
+
+// CircuitBreaker protects a dependency (e.g., a cloud provider API or a webhook endpoint) from being called over
+// and over again while it is down. It trips after <failureThreshold> consecutive failures and then rejects all
+// calls until the <coolDown> period has passed. A CircuitBreaker is safe for concurrent use and is meant to be
+// shared by all callers of the same dependency.
+type CircuitBreaker struct {
+	failureThreshold int
+	coolDown         time.Duration
+
+	lock                sync.Mutex
+	state               CircuitBreakerState
+	consecutiveFailures int
+	openedAt            time.Time
+	trialRunning        bool
+}
+
+// NewCircuitBreaker returns a new closed CircuitBreaker which trips after <failureThreshold> consecutive failures
+// and stays open for the given <coolDown> period.
+func NewCircuitBreaker(failureThreshold int, coolDown time.Duration) *CircuitBreaker {
+	if failureThreshold < 1 {
+		failureThreshold = 1
+	}
+	return &CircuitBreaker{
+		failureThreshold: failureThreshold,
+		coolDown:         coolDown,
+		state:            CircuitBreakerClosed,
+	}
+}
+
+// State returns the current state of the CircuitBreaker.
+func (c *CircuitBreaker) State() CircuitBreakerState {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.updateState()
+	return c.state
+}
+
+// Allow reports whether a call to the protected dependency is allowed. If it returns true, the caller must report
+// the outcome of the call via RecordSuccess or RecordFailure.
+func (c *CircuitBreaker) Allow() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.updateState()
+	switch c.state {
+	case CircuitBreakerOpen:
+		return false
+	case CircuitBreakerHalfOpen:
+		if c.trialRunning {
+			return false
+		}
+		c.trialRunning = true
+	}
+	return true
+}
+
+// RecordSuccess records a successful call to the protected dependency and closes the CircuitBreaker.
+func (c *CircuitBreaker) RecordSuccess() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.state = CircuitBreakerClosed
+	c.consecutiveFailures = 0
+	c.trialRunning = false
+}
+
+// RecordFailure records a failed call to the protected dependency. The CircuitBreaker is opened if the failure
+// threshold has been reached or if the trial call in the half-open state failed.
+func (c *CircuitBreaker) RecordFailure() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.consecutiveFailures++
+	if c.state == CircuitBreakerHalfOpen || c.consecutiveFailures >= c.failureThreshold {
+		c.state = CircuitBreakerOpen
+		c.openedAt = time.Now()
+	}
+	c.trialRunning = false
+}
+
+// Wrap returns a ConditionFunc that only calls <f> if the CircuitBreaker allows it and records its outcome. Errors
+// are recorded as failures, all other outcomes as successes (the dependency has responded). If the call is rejected,
+// a non-severe error is returned (see IsCircuitOpen) so that a retrying computation does not call the dependency
+// before the cool-down period has passed.
+func (c *CircuitBreaker) Wrap(f ConditionFunc) ConditionFunc {
+	return func() (bool, bool, error) {
+		if !c.Allow() {
+			return false, false, &circuitOpen{c.coolDown}
+		}
+
+		ok, severe, err := f()
+		if err != nil {
+			c.RecordFailure()
+		} else {
+			c.RecordSuccess()
+		}
+		return ok, severe, err
+	}
+}
+
+// updateState moves an open CircuitBreaker to the half-open state once its cool-down period has passed.
+// It must be called with the lock being held.
+func (c *CircuitBreaker) updateState() {
+	if c.state == CircuitBreakerOpen && time.Since(c.openedAt) >= c.coolDown {
+		c.state = CircuitBreakerHalfOpen
+		c.trialRunning = false
+	}
+}
+
+type circuitOpen struct {
+	coolDown time.Duration
+}
+
+func (c *circuitOpen) Error() string {
+	return fmt.Sprintf("circuit breaker is open, calls are rejected for up to %s", c.coolDown)
+}
+
+// IsCircuitOpen determines whether the given error has been returned because a CircuitBreaker rejected the call.
+func IsCircuitOpen(err error) bool {
+	_, ok := err.(*circuitOpen)
+	return ok
+}
+
+// CircuitBreakers is a set of CircuitBreakers with the same settings, one per endpoint (e.g., the URL of a
+// webhook or the region of a cloud provider API). It is safe for concurrent use.
+type CircuitBreakers struct {
+	failureThreshold int
+	coolDown         time.Duration
+
+	lock     sync.Mutex
+	breakers map[string]*CircuitBreaker
+}
+
+// NewCircuitBreakers returns a new set of CircuitBreakers which trip after <failureThreshold> consecutive failures
+// and stay open for the given <coolDown> period.
+func NewCircuitBreakers(failureThreshold int, coolDown time.Duration) *CircuitBreakers {
+	return &CircuitBreakers{
+		failureThreshold: failureThreshold,
+		coolDown:         coolDown,
+		breakers:         make(map[string]*CircuitBreaker),
+	}
+}
+
+// For returns the CircuitBreaker for the given endpoint. It is created if it does not exist yet.
+func (c *CircuitBreakers) For(endpoint string) *CircuitBreaker {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	breaker, ok := c.breakers[endpoint]
+	if !ok {
+		breaker = NewCircuitBreaker(c.failureThreshold, c.coolDown)
+		c.breakers[endpoint] = breaker
+	}
+	return breaker
+}
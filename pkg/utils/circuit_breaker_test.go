@@ -0,0 +1,120 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/gardener/gardener/pkg/utils"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CircuitBreaker", func() {
+	var (
+		coolDown = 20 * time.Millisecond
+		breaker  *CircuitBreaker
+		failing  = func() (bool, bool, error) { return false, false, errors.New("unavailable") }
+	)
+
+	BeforeEach(func() {
+		breaker = NewCircuitBreaker(2, coolDown)
+	})
+
+	It("should trip after the given number of consecutive failures", func() {
+		Expect(breaker.State()).To(Equal(CircuitBreakerClosed))
+
+		breaker.RecordFailure()
+		Expect(breaker.Allow()).To(BeTrue())
+		breaker.RecordFailure()
+
+		Expect(breaker.State()).To(Equal(CircuitBreakerOpen))
+		Expect(breaker.Allow()).To(BeFalse())
+	})
+
+	It("should reset the failure count on success", func() {
+		breaker.RecordFailure()
+		breaker.RecordSuccess()
+		breaker.RecordFailure()
+
+		Expect(breaker.State()).To(Equal(CircuitBreakerClosed))
+	})
+
+	It("should allow a single trial call after the cool-down period", func() {
+		breaker.RecordFailure()
+		breaker.RecordFailure()
+		time.Sleep(coolDown)
+
+		Expect(breaker.State()).To(Equal(CircuitBreakerHalfOpen))
+		Expect(breaker.Allow()).To(BeTrue())
+		Expect(breaker.Allow()).To(BeFalse())
+
+		breaker.RecordSuccess()
+		Expect(breaker.State()).To(Equal(CircuitBreakerClosed))
+	})
+
+	It("should open again if the trial call fails", func() {
+		breaker.RecordFailure()
+		breaker.RecordFailure()
+		time.Sleep(coolDown)
+
+		Expect(breaker.Allow()).To(BeTrue())
+		breaker.RecordFailure()
+		Expect(breaker.State()).To(Equal(CircuitBreakerOpen))
+	})
+
+	Describe("#Wrap", func() {
+		It("should stop calling the dependency once the breaker has tripped", func() {
+			calls := 0
+			ctx, cancel := context.WithTimeout(context.Background(), coolDown/2)
+			defer cancel()
+
+			err := RetryUntil(ctx, time.Millisecond, breaker.Wrap(func() (bool, bool, error) {
+				calls++
+				return failing()
+			}))
+
+			Expect(IsTimedOut(err)).To(BeTrue())
+			Expect(IsCircuitOpen(LastErrorOfTimedOutWithError(err))).To(BeTrue())
+			Expect(calls).To(Equal(2))
+		})
+
+		It("should record successful calls", func() {
+			breaker.RecordFailure()
+
+			ok, severe, err := breaker.Wrap(func() (bool, bool, error) { return true, false, nil })()
+			Expect(ok).To(BeTrue())
+			Expect(severe).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+
+			breaker.RecordFailure()
+			Expect(breaker.State()).To(Equal(CircuitBreakerClosed))
+		})
+	})
+
+	Describe("CircuitBreakers", func() {
+		It("should share one breaker per endpoint", func() {
+			breakers := NewCircuitBreakers(1, coolDown)
+
+			breakers.For("a").RecordFailure()
+
+			Expect(breakers.For("a").State()).To(Equal(CircuitBreakerOpen))
+			Expect(breakers.For("b").State()).To(Equal(CircuitBreakerClosed))
+		})
+	})
+})
@@ -97,6 +97,7 @@ func (n *node) addTargets(taskIDs ...TaskID) {
 type Opts struct {
 	Logger           logrus.FieldLogger
 	ProgressReporter func(stats *Stats)
+	Recorder         *Recorder
 	Context          context.Context
 }
 
@@ -107,7 +108,7 @@ func (f *Flow) Run(opts Opts) error {
 	if ctx == nil {
 		ctx = context.Background()
 	}
-	return newExecution(f, opts.Logger, opts.ProgressReporter).run(ctx)
+	return newExecution(f, opts.Logger, opts.ProgressReporter, opts.Recorder).run(ctx)
 }
 
 type nodeResult struct {
@@ -158,7 +159,7 @@ func newNopLogger() logrus.FieldLogger {
 	return logger
 }
 
-func newExecution(flow *Flow, logger logrus.FieldLogger, reporter ProgressReporter) *execution {
+func newExecution(flow *Flow, logger logrus.FieldLogger, reporter ProgressReporter, recorder *Recorder) *execution {
 	all := NewTaskIDs()
 
 	for name := range flow.nodes {
@@ -176,6 +177,7 @@ func newExecution(flow *Flow, logger logrus.FieldLogger, reporter ProgressReport
 		nil,
 		logger,
 		reporter,
+		recorder,
 		make(chan *nodeResult),
 		make(map[TaskID]int),
 	}
@@ -189,6 +191,7 @@ type execution struct {
 
 	log              logrus.FieldLogger
 	progressReporter ProgressReporter
+	recorder         *Recorder
 
 	done          chan *nodeResult
 	triggerCounts map[TaskID]int
@@ -206,9 +209,15 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 
 		start := time.Now().UTC()
 		log.Debugf("Started at %s", start)
+		if e.recorder != nil {
+			e.recorder.taskStarted(id, start)
+		}
 		err := e.flow.nodes[id].fn(ctx)
 		end := time.Now().UTC()
 		log.Debugf("Finished at %s and took %s", end, end.Sub(start))
+		if e.recorder != nil {
+			e.recorder.taskFinished(id, end, err)
+		}
 
 		if err != nil {
 			log.Errorf("Failure: %+v", err)
@@ -250,6 +259,9 @@ func (e *execution) reportProgress() {
 func (e *execution) run(ctx context.Context) error {
 	defer close(e.done)
 	e.log.Infof("Starting flow")
+	if e.recorder != nil {
+		e.recorder.flowStarted(e.flow)
+	}
 	e.reportProgress()
 
 	var (
@@ -278,7 +290,11 @@ func (e *execution) run(ctx context.Context) error {
 	}
 
 	e.log.Infof("Finished flow")
-	return e.result(cancelErr)
+	err := e.result(cancelErr)
+	if e.recorder != nil {
+		e.recorder.flowFinished(err)
+	}
+	return err
 }
 
 func (e *execution) result(cancelErr error) error {
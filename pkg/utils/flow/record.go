@@ -0,0 +1,186 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flow
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TaskState is the state of a Task in a recorded Flow execution.
+type TaskState string
+
+const (
+	// TaskStatePending is the state of a Task that has not been started.
+	TaskStatePending TaskState = "Pending"
+	// TaskStateRunning is the state of a Task that has been started but not finished yet.
+	TaskStateRunning TaskState = "Running"
+	// TaskStateSucceeded is the state of a Task that has finished successfully.
+	TaskStateSucceeded TaskState = "Succeeded"
+	// TaskStateFailed is the state of a Task that has finished with an error.
+	TaskStateFailed TaskState = "Failed"
+)
+
+// Record is the record of a Flow execution. It contains the graph of the Flow as well as the
+// durations and results of its Tasks.
+type Record struct {
+	Name     string        `json:"name"`
+	Start    time.Time     `json:"start"`
+	End      *time.Time    `json:"end,omitempty"`
+	Duration time.Duration `json:"duration"`
+	Error    string        `json:"error,omitempty"`
+	Tasks    []TaskRecord  `json:"tasks"`
+}
+
+// TaskRecord is the record of a Task in a Flow execution.
+type TaskRecord struct {
+	ID           TaskID        `json:"id"`
+	Dependencies []TaskID      `json:"dependencies,omitempty"`
+	State        TaskState     `json:"state"`
+	Start        *time.Time    `json:"start,omitempty"`
+	End          *time.Time    `json:"end,omitempty"`
+	Duration     time.Duration `json:"duration"`
+	Error        string        `json:"error,omitempty"`
+}
+
+// Recorder records a Flow execution. It can be passed to a Flow execution via the Opts.
+// A Recorder may be reused for several executions, it always holds the record of the latest one.
+type Recorder struct {
+	lock   sync.Mutex
+	record *Record
+	tasks  map[TaskID]*TaskRecord
+}
+
+// NewRecorder returns a new Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Record returns a copy of the record of the latest Flow execution, or nil if no Flow has been executed.
+func (r *Recorder) Record() *Record {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.record == nil {
+		return nil
+	}
+
+	record := *r.record
+	record.Tasks = make([]TaskRecord, 0, len(r.tasks))
+	for _, task := range r.tasks {
+		record.Tasks = append(record.Tasks, *task)
+	}
+	sort.Slice(record.Tasks, func(i, j int) bool { return record.Tasks[i].ID < record.Tasks[j].ID })
+	return &record
+}
+
+func (r *Recorder) flowStarted(flow *Flow) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	dependencies := make(map[TaskID][]TaskID, len(flow.nodes))
+	for id, node := range flow.nodes {
+		for target := range node.targetIDs {
+			dependencies[target] = append(dependencies[target], id)
+		}
+	}
+
+	r.record = &Record{Name: flow.name, Start: time.Now().UTC()}
+	r.tasks = make(map[TaskID]*TaskRecord, len(flow.nodes))
+	for id := range flow.nodes {
+		sort.Slice(dependencies[id], func(i, j int) bool { return dependencies[id][i] < dependencies[id][j] })
+		r.tasks[id] = &TaskRecord{ID: id, Dependencies: dependencies[id], State: TaskStatePending}
+	}
+}
+
+func (r *Recorder) flowFinished(err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	end := time.Now().UTC()
+	r.record.End = &end
+	r.record.Duration = end.Sub(r.record.Start)
+	if err != nil {
+		r.record.Error = err.Error()
+	}
+}
+
+func (r *Recorder) taskStarted(id TaskID, start time.Time) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	task := r.tasks[id]
+	task.State = TaskStateRunning
+	task.Start = &start
+}
+
+func (r *Recorder) taskFinished(id TaskID, end time.Time, err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	task := r.tasks[id]
+	task.State = TaskStateSucceeded
+	task.End = &end
+	task.Duration = end.Sub(*task.Start)
+	if err != nil {
+		task.State = TaskStateFailed
+		task.Error = err.Error()
+	}
+}
+
+// WriteJSON writes the Record in JSON format to the given writer.
+func (r *Record) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+var dotColors = map[TaskState]string{
+	TaskStatePending:   "gray",
+	TaskStateRunning:   "blue",
+	TaskStateSucceeded: "green",
+	TaskStateFailed:    "red",
+}
+
+// WriteDOT writes the Record in the DOT graph description language (Graphviz) to the given writer.
+// Every Task is labeled with its duration and colored according to its state.
+func (r *Record) WriteDOT(w io.Writer) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "digraph %q {\n", r.Name)
+	fmt.Fprintf(&b, "  label=%q;\n", fmt.Sprintf("%s (%s)", r.Name, r.Duration))
+	b.WriteString("  node [shape=box];\n")
+	for _, task := range r.Tasks {
+		label := string(task.ID)
+		if task.Start != nil && task.End != nil {
+			label = fmt.Sprintf("%s\n%s", task.ID, task.Duration)
+		}
+		fmt.Fprintf(&b, "  %q [label=%q, color=%s];\n", task.ID, label, dotColors[task.State])
+	}
+	for _, task := range r.Tasks {
+		for _, dependency := range task.Dependencies {
+			fmt.Fprintf(&b, "  %q -> %q;\n", dependency, task.ID)
+		}
+	}
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
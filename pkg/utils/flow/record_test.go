@@ -0,0 +1,92 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flow_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Recorder", func() {
+	var (
+		recorder *flow.Recorder
+		f        *flow.Flow
+	)
+
+	BeforeEach(func() {
+		recorder = flow.NewRecorder()
+
+		g := flow.NewGraph("foo")
+		x := g.Add(flow.Task{Name: "x", Fn: func(ctx context.Context) error { return nil }})
+		y := g.Add(flow.Task{Name: "y", Fn: func(ctx context.Context) error { return errors.New("err") }, Dependencies: flow.NewTaskIDs(x)})
+		_ = g.Add(flow.Task{Name: "z", Fn: func(ctx context.Context) error { return nil }, Dependencies: flow.NewTaskIDs(x, y)})
+		f = g.Compile()
+	})
+
+	It("should not have a record before the flow has been executed", func() {
+		Expect(recorder.Record()).To(BeNil())
+	})
+
+	It("should record the graph and the results of the execution", func() {
+		Expect(f.Run(flow.Opts{Recorder: recorder})).To(HaveOccurred())
+
+		record := recorder.Record()
+		Expect(record.Name).To(Equal("foo"))
+		Expect(record.End).NotTo(BeNil())
+		Expect(record.Error).NotTo(BeEmpty())
+		Expect(record.Tasks).To(HaveLen(3))
+
+		x, y, z := record.Tasks[0], record.Tasks[1], record.Tasks[2]
+		Expect(x.ID).To(Equal(flow.TaskID("x")))
+		Expect(x.State).To(Equal(flow.TaskStateSucceeded))
+		Expect(x.Dependencies).To(BeEmpty())
+		Expect(x.Start).NotTo(BeNil())
+		Expect(x.End).NotTo(BeNil())
+
+		Expect(y.State).To(Equal(flow.TaskStateFailed))
+		Expect(y.Error).To(Equal("err"))
+		Expect(y.Dependencies).To(Equal([]flow.TaskID{"x"}))
+
+		Expect(z.State).To(Equal(flow.TaskStatePending))
+		Expect(z.Dependencies).To(Equal([]flow.TaskID{"x", "y"}))
+		Expect(z.Start).To(BeNil())
+	})
+
+	It("should export the record as JSON and DOT", func() {
+		Expect(f.Run(flow.Opts{Recorder: recorder})).To(HaveOccurred())
+		record := recorder.Record()
+
+		var buf bytes.Buffer
+		Expect(record.WriteJSON(&buf)).To(Succeed())
+		decoded := &flow.Record{}
+		Expect(json.Unmarshal(buf.Bytes(), decoded)).To(Succeed())
+		Expect(decoded.Tasks).To(HaveLen(3))
+
+		buf.Reset()
+		Expect(record.WriteDOT(&buf)).To(Succeed())
+		dot := buf.String()
+		Expect(dot).To(HavePrefix(`digraph "foo" {`))
+		Expect(dot).To(ContainSubstring(`"x" -> "y";`))
+		Expect(dot).To(ContainSubstring(`"y" -> "z";`))
+		Expect(dot).To(ContainSubstring(`"z" [label="z", color=gray];`))
+	})
+})
@@ -20,10 +20,13 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/utils"
+
+	"github.com/Masterminds/semver"
 	yaml "gopkg.in/yaml.v2"
 )
 
@@ -47,8 +50,16 @@ func ReadImageVector() (ImageVector, error) {
 		return nil, err
 	}
 
+	names := make(map[string]struct{}, len(vector))
+	for _, image := range vector {
+		names[image.Name] = struct{}{}
+	}
+
 	overwrittenImages := make(map[string]*ImageSource, len(overwrite))
 	for _, image := range overwrite {
+		if _, ok := names[image.Name]; !ok {
+			return nil, fmt.Errorf("image %q of the image vector overwrite %s is not part of the image vector", image.Name, overwritePath)
+		}
 		overwrittenImages[image.Name] = image
 	}
 
@@ -142,13 +153,85 @@ func (i *ImageSource) ToImage(targetK8sVersion string) *Image {
 		Name:       i.Name,
 		Repository: i.Repository,
 		Tag:        tag,
+		Digest:     i.Digest,
 	}
 }
 
-// String will returns the string representation of the image.
+// String will returns the string representation of the image. If the image has a digest then it is appended, i.e.,
+// the image is pinned by the digest.
 func (i *Image) String() string {
-	if len(i.Tag) == 0 {
-		return i.Repository
+	image := i.Repository
+	if len(i.Tag) != 0 {
+		image = fmt.Sprintf("%s:%s", image, i.Tag)
+	}
+	if len(i.Digest) != 0 {
+		image = fmt.Sprintf("%s@%s", image, i.Digest)
+	}
+	return image
+}
+
+// WithRegistry returns a copy of the image vector in which the registries of all repositories are replaced by the
+// given <registry> (see ReplaceRegistry).
+func (v ImageVector) WithRegistry(registry string) ImageVector {
+	out := make(ImageVector, 0, len(v))
+	for _, source := range v {
+		image := *source
+		image.Repository = ReplaceRegistry(source.Repository, registry)
+		out = append(out, &image)
+	}
+	return out
+}
+
+// ReplaceRegistry replaces the registry host of the given <repository> by the given <registry>, which may contain a
+// path prefix. The path of the repository is kept, e.g., "k8s.gcr.io/hyperkube" becomes "<registry>/hyperkube" and
+// "quay.io/coreos/etcd" becomes "<registry>/coreos/etcd". Official images of the Docker Hub get the "library/" prefix.
+func ReplaceRegistry(repository, registry string) string {
+	registry = strings.TrimSuffix(registry, "/")
+
+	parts := strings.SplitN(repository, "/", 2)
+	if len(parts) == 1 {
+		return fmt.Sprintf("%s/library/%s", registry, repository)
 	}
-	return fmt.Sprintf("%s:%s", i.Repository, i.Tag)
+	if isRegistryHost(parts[0]) {
+		return fmt.Sprintf("%s/%s", registry, parts[1])
+	}
+	return fmt.Sprintf("%s/%s", registry, repository)
+}
+
+// isRegistryHost returns true if the given first component of a repository is a registry host, following the rules
+// of Docker for distinguishing registry hosts from Docker Hub user names.
+func isRegistryHost(component string) bool {
+	return strings.ContainsAny(component, ".:") || component == "localhost"
+}
+
+// Validate validates the image vector. Every image must have a name and a repository, the version constraints must
+// be parseable and the digests must be well-formed. If <requireDigests> is true then every image must have a digest.
+func (v ImageVector) Validate(requireDigests bool) error {
+	var errs []string
+	for idx, source := range v {
+		if len(source.Name) == 0 {
+			errs = append(errs, fmt.Sprintf("image %d has no name", idx))
+		}
+		if len(source.Repository) == 0 {
+			errs = append(errs, fmt.Sprintf("image %q has no repository", source.Name))
+		}
+		if len(source.Versions) != 0 {
+			if _, err := semver.NewConstraint(source.Versions); err != nil {
+				errs = append(errs, fmt.Sprintf("image %q has invalid versions %q: %v", source.Name, source.Versions, err))
+			}
+		}
+		switch {
+		case len(source.Digest) != 0 && !digestRegex.MatchString(source.Digest):
+			errs = append(errs, fmt.Sprintf("image %q has invalid digest %q", source.Name, source.Digest))
+		case len(source.Digest) == 0 && requireDigests:
+			errs = append(errs, fmt.Sprintf("image %q is not pinned by a digest", source.Name))
+		}
+	}
+
+	if len(errs) != 0 {
+		return fmt.Errorf("invalid image vector: %s", strings.Join(errs, ", "))
+	}
+	return nil
 }
+
+var digestRegex = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
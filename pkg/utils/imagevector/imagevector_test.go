@@ -16,10 +16,12 @@ package imagevector_test
 
 import (
 	"fmt"
+	"strings"
 
 	. "github.com/gardener/gardener/pkg/utils/imagevector"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
@@ -196,6 +198,88 @@ var _ = Describe("imagevector", func() {
 
 				Expect(image.String()).To(Equal(repo))
 			})
+
+			It("should return the string representation of the image (w/ digest)", func() {
+				image := Image{
+					Name:       "my-image",
+					Repository: "my-repo",
+					Tag:        "1.2.3",
+					Digest:     "sha256:0123",
+				}
+
+				Expect(image.String()).To(Equal("my-repo:1.2.3@sha256:0123"))
+			})
+		})
+	})
+
+	Describe("#ReplaceRegistry", func() {
+		DescribeTable("should replace the registry of the repository",
+			func(repository, registry, expected string) {
+				Expect(ReplaceRegistry(repository, registry)).To(Equal(expected))
+			},
+			Entry("registry host", "k8s.gcr.io/hyperkube", "registry.example.com", "registry.example.com/hyperkube"),
+			Entry("registry host with path", "quay.io/coreos/etcd", "registry.example.com/gardener/", "registry.example.com/gardener/coreos/etcd"),
+			Entry("registry host with port", "localhost:5000/foo/bar", "registry.example.com", "registry.example.com/foo/bar"),
+			Entry("docker hub user image", "grafana/grafana", "registry.example.com", "registry.example.com/grafana/grafana"),
+			Entry("docker hub official image", "busybox", "registry.example.com", "registry.example.com/library/busybox"),
+		)
+	})
+
+	Describe("#WithRegistry", func() {
+		It("should replace the registries of all images without modifying the original vector", func() {
+			vector := ImageVector{
+				{Name: "hyperkube", Repository: "k8s.gcr.io/hyperkube", Versions: "1.13.x"},
+				{Name: "busybox", Repository: "busybox", Tag: "1.29"},
+			}
+
+			out := vector.WithRegistry("registry.example.com")
+
+			Expect(out).To(Equal(ImageVector{
+				{Name: "hyperkube", Repository: "registry.example.com/hyperkube", Versions: "1.13.x"},
+				{Name: "busybox", Repository: "registry.example.com/library/busybox", Tag: "1.29"},
+			}))
+			Expect(vector[0].Repository).To(Equal("k8s.gcr.io/hyperkube"))
+		})
+	})
+
+	Describe("#Validate", func() {
+		digest := "sha256:" + strings.Repeat("a", 64)
+
+		It("should accept a valid image vector", func() {
+			vector := ImageVector{
+				{Name: "hyperkube", Repository: "k8s.gcr.io/hyperkube", Versions: ">= 1.13"},
+				{Name: "busybox", Repository: "busybox", Tag: "1.29", Digest: digest},
+			}
+
+			Expect(vector.Validate(false)).To(Succeed())
+		})
+
+		It("should reject images without name or repository, invalid versions and malformed digests", func() {
+			vector := ImageVector{
+				{Repository: "busybox"},
+				{Name: "foo"},
+				{Name: "bar", Repository: "bar", Versions: "foo"},
+				{Name: "baz", Repository: "baz", Digest: "sha256:xyz"},
+			}
+
+			err := vector.Validate(false)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(And(
+				ContainSubstring("image 0 has no name"),
+				ContainSubstring(`image "foo" has no repository`),
+				ContainSubstring(`image "bar" has invalid versions`),
+				ContainSubstring(`image "baz" has invalid digest`),
+			))
+		})
+
+		It("should reject images without digest if digests are required", func() {
+			vector := ImageVector{
+				{Name: "busybox", Repository: "busybox", Tag: "1.29", Digest: digest},
+				{Name: "alpine", Repository: "alpine", Tag: "3.8"},
+			}
+
+			Expect(vector.Validate(false)).To(Succeed())
+			Expect(vector.Validate(true)).To(MatchError(ContainSubstring(`image "alpine" is not pinned by a digest`)))
 		})
 	})
 
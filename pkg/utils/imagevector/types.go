@@ -22,6 +22,8 @@ type ImageSource struct {
 	Repository string `json:"repository" yaml:"repository"`
 	Tag        string `json:"tag" yaml:"tag"`
 	Versions   string `json:"versions" yaml:"versions"`
+	// Digest is an optional digest (e.g. "sha256:...") which pins the image.
+	Digest string `json:"digest,omitempty" yaml:"digest,omitempty"`
 }
 
 // Image is a concrete, pullable image with a nonempty tag.
@@ -29,6 +31,7 @@ type Image struct {
 	Name       string
 	Repository string
 	Tag        string
+	Digest     string
 }
 
 // ImageVector is a list of image sources.
@@ -0,0 +1,120 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// DeleteObject deletes an object with the given <deleteFunc> using the given propagation <policy> and
+// <gracePeriodSeconds> (which may be nil to use the default of the object). It does not return an error if the
+// object does not exist.
+func DeleteObject(deleteFunc func(*metav1.DeleteOptions) error, policy metav1.DeletionPropagation, gracePeriodSeconds *int64) error {
+	if err := deleteFunc(&metav1.DeleteOptions{
+		PropagationPolicy:  &policy,
+		GracePeriodSeconds: gracePeriodSeconds,
+	}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// ObjectStuckInDeletionError is returned by WaitUntilDeleted if an object still exists after the timeout.
+type ObjectStuckInDeletionError struct {
+	// Kind is the kind of the object.
+	Kind string
+	// Namespace is the namespace of the object (empty for cluster-scoped objects).
+	Namespace string
+	// Name is the name of the object.
+	Name string
+	// DeletionTimestamp is the time at which the deletion of the object has been requested (nil if it has not).
+	DeletionTimestamp *metav1.Time
+	// Finalizers are the finalizers which are still present on the object.
+	Finalizers []string
+	// Timeout is the duration which has been waited for the deletion.
+	Timeout time.Duration
+}
+
+func (e *ObjectStuckInDeletionError) Error() string {
+	object := fmt.Sprintf("%s %q", e.Kind, e.Name)
+	if len(e.Namespace) > 0 {
+		object = fmt.Sprintf("%s %q", e.Kind, e.Namespace+"/"+e.Name)
+	}
+
+	switch {
+	case e.DeletionTimestamp == nil:
+		return fmt.Sprintf("%s has not been deleted within %s: its deletion has not been requested", object, e.Timeout)
+	case len(e.Finalizers) > 0:
+		return fmt.Sprintf("%s has not been deleted within %s: its deletion was requested at %s and is blocked by finalizers [%s]", object, e.Timeout, e.DeletionTimestamp.UTC().Format(time.RFC3339), strings.Join(e.Finalizers, ", "))
+	default:
+		return fmt.Sprintf("%s has not been deleted within %s: its deletion was requested at %s", object, e.Timeout, e.DeletionTimestamp.UTC().Format(time.RFC3339))
+	}
+}
+
+// IsObjectStuckInDeletion determines whether the given error is an ObjectStuckInDeletionError.
+func IsObjectStuckInDeletion(err error) bool {
+	_, ok := err.(*ObjectStuckInDeletionError)
+	return ok
+}
+
+// Finalizers returns the finalizers of the given object. For namespaces, the finalizers of the specification are
+// included as they block the deletion as well.
+func Finalizers(obj metav1.Object) []string {
+	finalizers := append([]string{}, obj.GetFinalizers()...)
+	if namespace, ok := obj.(*corev1.Namespace); ok {
+		for _, finalizer := range namespace.Spec.Finalizers {
+			finalizers = append(finalizers, string(finalizer))
+		}
+	}
+	return finalizers
+}
+
+// WaitUntilDeleted polls the object of the given <kind> with <getFunc> every <interval> until it does not exist anymore.
+// If it still exists after <timeout> then an ObjectStuckInDeletionError describing its deletion state is returned.
+func WaitUntilDeleted(kind string, interval, timeout time.Duration, getFunc func() (metav1.Object, error)) error {
+	var lastObj metav1.Object
+
+	if err := wait.PollImmediate(interval, timeout, func() (bool, error) {
+		obj, err := getFunc()
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		}
+		lastObj = obj
+		return false, nil
+	}); err != nil {
+		if err != wait.ErrWaitTimeout || lastObj == nil {
+			return err
+		}
+		return &ObjectStuckInDeletionError{
+			Kind:              kind,
+			Namespace:         lastObj.GetNamespace(),
+			Name:              lastObj.GetName(),
+			DeletionTimestamp: lastObj.GetDeletionTimestamp(),
+			Finalizers:        Finalizers(lastObj),
+			Timeout:           timeout,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package kubernetes
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var _ = Describe("deletion", func() {
+	var notFound = apierrors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, "foo")
+
+	Describe("#DeleteObject", func() {
+		It("should pass the propagation policy and grace period", func() {
+			var (
+				gracePeriodSeconds = int64(1)
+				options            *metav1.DeleteOptions
+			)
+
+			Expect(DeleteObject(func(opts *metav1.DeleteOptions) error {
+				options = opts
+				return nil
+			}, metav1.DeletePropagationForeground, &gracePeriodSeconds)).To(Succeed())
+
+			Expect(*options.PropagationPolicy).To(Equal(metav1.DeletePropagationForeground))
+			Expect(options.GracePeriodSeconds).To(Equal(&gracePeriodSeconds))
+		})
+
+		It("should ignore not found errors", func() {
+			Expect(DeleteObject(func(*metav1.DeleteOptions) error {
+				return notFound
+			}, metav1.DeletePropagationBackground, nil)).To(Succeed())
+		})
+
+		It("should return other errors", func() {
+			err := errors.New("error")
+			Expect(DeleteObject(func(*metav1.DeleteOptions) error {
+				return err
+			}, metav1.DeletePropagationBackground, nil)).To(BeIdenticalTo(err))
+		})
+	})
+
+	Describe("#Finalizers", func() {
+		It("should include the specification finalizers of namespaces", func() {
+			namespace := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Finalizers: []string{"foo"}},
+				Spec:       corev1.NamespaceSpec{Finalizers: []corev1.FinalizerName{corev1.FinalizerKubernetes}},
+			}
+
+			Expect(Finalizers(namespace)).To(Equal([]string{"foo", "kubernetes"}))
+		})
+	})
+
+	Describe("#WaitUntilDeleted", func() {
+		It("should succeed once the object is gone", func() {
+			calls := 0
+			Expect(WaitUntilDeleted("namespace", time.Millisecond, time.Second, func() (metav1.Object, error) {
+				calls++
+				if calls < 3 {
+					return &corev1.Namespace{}, nil
+				}
+				return nil, notFound
+			})).To(Succeed())
+			Expect(calls).To(Equal(3))
+		})
+
+		It("should return errors of the get function", func() {
+			err := errors.New("error")
+			Expect(WaitUntilDeleted("namespace", time.Millisecond, time.Second, func() (metav1.Object, error) {
+				return nil, err
+			})).To(BeIdenticalTo(err))
+		})
+
+		It("should report the finalizers of an object stuck in deletion", func() {
+			deletionTimestamp := metav1.NewTime(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC))
+
+			err := WaitUntilDeleted("namespace", time.Millisecond, 10*time.Millisecond, func() (metav1.Object, error) {
+				return &corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "foo",
+						DeletionTimestamp: &deletionTimestamp,
+						Finalizers:        []string{"bar"},
+					},
+				}, nil
+			})
+
+			Expect(IsObjectStuckInDeletion(err)).To(BeTrue())
+			Expect(err.(*ObjectStuckInDeletionError).Finalizers).To(Equal([]string{"bar"}))
+			Expect(err.Error()).To(Equal(`namespace "foo" has not been deleted within 10ms: its deletion was requested at 2019-01-01T00:00:00Z and is blocked by finalizers [bar]`))
+		})
+
+		It("should report objects whose deletion has not been requested", func() {
+			err := WaitUntilDeleted("deployment", time.Millisecond, 10*time.Millisecond, func() (metav1.Object, error) {
+				return &metav1.ObjectMeta{Namespace: "foo", Name: "bar"}, nil
+			})
+
+			Expect(err).To(MatchError(`deployment "foo/bar" has not been deleted within 10ms: its deletion has not been requested`))
+		})
+	})
+})
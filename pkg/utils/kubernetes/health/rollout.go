@@ -0,0 +1,85 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// IsDeploymentProgressing checks whether a rollout of the given Deployment is in progress, i.e., whether not all
+// replicas have been updated and become available yet, or whether old replicas of a surge rollout are still
+// present. Only rollouts within their progress deadline are considered, i.e., the Deployment's `Progressing`
+// condition must be present and true (it turns false once the deadline has been exceeded).
+// If the Deployment is progressing, a message describing the progress is returned as well.
+func IsDeploymentProgressing(deployment *appsv1.Deployment) (bool, string) {
+	if condition := getDeploymentCondition(deployment.Status.Conditions, appsv1.DeploymentProgressing); condition == nil || condition.Status != corev1.ConditionTrue {
+		return false, ""
+	}
+
+	if deployment.Status.ObservedGeneration < deployment.Generation {
+		return true, fmt.Sprintf("observed generation outdated (%d/%d)", deployment.Status.ObservedGeneration, deployment.Generation)
+	}
+
+	desiredReplicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desiredReplicas = *deployment.Spec.Replicas
+	}
+
+	if deployment.Status.UpdatedReplicas < desiredReplicas {
+		return true, fmt.Sprintf("%d of %d replicas have been updated", deployment.Status.UpdatedReplicas, desiredReplicas)
+	}
+	if oldReplicas := deployment.Status.Replicas - deployment.Status.UpdatedReplicas; oldReplicas > 0 {
+		return true, fmt.Sprintf("%d old replicas are pending termination", oldReplicas)
+	}
+	if deployment.Status.AvailableReplicas < deployment.Status.UpdatedReplicas {
+		return true, fmt.Sprintf("%d of %d updated replicas are available", deployment.Status.AvailableReplicas, deployment.Status.UpdatedReplicas)
+	}
+
+	return false, ""
+}
+
+// IsStatefulSetProgressing checks whether a rollout of the given StatefulSet is in progress, i.e., whether not all
+// replicas above the partition of a rolling update have been updated and become ready yet. Replicas below the
+// partition are expected to stay at the current revision.
+// If the StatefulSet is progressing, a message describing the progress is returned as well.
+func IsStatefulSetProgressing(statefulSet *appsv1.StatefulSet) (bool, string) {
+	if statefulSet.Status.ObservedGeneration < statefulSet.Generation {
+		return true, fmt.Sprintf("observed generation outdated (%d/%d)", statefulSet.Status.ObservedGeneration, statefulSet.Generation)
+	}
+
+	desiredReplicas := int32(1)
+	if statefulSet.Spec.Replicas != nil {
+		desiredReplicas = *statefulSet.Spec.Replicas
+	}
+
+	if statefulSet.Status.UpdateRevision != "" && statefulSet.Status.UpdateRevision != statefulSet.Status.CurrentRevision {
+		partition := int32(0)
+		if rollingUpdate := statefulSet.Spec.UpdateStrategy.RollingUpdate; rollingUpdate != nil && rollingUpdate.Partition != nil {
+			partition = *rollingUpdate.Partition
+		}
+
+		if expectedUpdatedReplicas := desiredReplicas - partition; statefulSet.Status.UpdatedReplicas < expectedUpdatedReplicas {
+			return true, fmt.Sprintf("%d of %d replicas have been updated", statefulSet.Status.UpdatedReplicas, expectedUpdatedReplicas)
+		}
+		if statefulSet.Status.ReadyReplicas < desiredReplicas {
+			return true, fmt.Sprintf("%d of %d replicas are ready", statefulSet.Status.ReadyReplicas, desiredReplicas)
+		}
+	}
+
+	return false, ""
+}
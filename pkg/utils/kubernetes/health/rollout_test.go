@@ -0,0 +1,176 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health_test
+
+import (
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	progressingCondition = appsv1.DeploymentCondition{
+		Type:   appsv1.DeploymentProgressing,
+		Status: corev1.ConditionTrue,
+	}
+	deadlineExceededCondition = appsv1.DeploymentCondition{
+		Type:   appsv1.DeploymentProgressing,
+		Status: corev1.ConditionFalse,
+		Reason: "ProgressDeadlineExceeded",
+	}
+)
+
+var _ = Describe("rollout", func() {
+	DescribeTable("#IsDeploymentProgressing",
+		func(deployment *appsv1.Deployment, expected bool) {
+			progressing, message := health.IsDeploymentProgressing(deployment)
+			Expect(progressing).To(Equal(expected))
+			if expected {
+				Expect(message).NotTo(BeEmpty())
+			}
+		},
+		Entry("rollout completed", &appsv1.Deployment{
+			Spec: appsv1.DeploymentSpec{Replicas: replicas(2)},
+			Status: appsv1.DeploymentStatus{
+				Replicas:          2,
+				UpdatedReplicas:   2,
+				AvailableReplicas: 2,
+				Conditions:        []appsv1.DeploymentCondition{progressingCondition},
+			},
+		}, false),
+		Entry("not observed at latest version", &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Generation: 2},
+			Status: appsv1.DeploymentStatus{
+				ObservedGeneration: 1,
+				Conditions:         []appsv1.DeploymentCondition{progressingCondition},
+			},
+		}, true),
+		Entry("replicas not updated yet", &appsv1.Deployment{
+			Spec: appsv1.DeploymentSpec{Replicas: replicas(2)},
+			Status: appsv1.DeploymentStatus{
+				Replicas:          2,
+				UpdatedReplicas:   1,
+				AvailableReplicas: 2,
+				Conditions:        []appsv1.DeploymentCondition{progressingCondition},
+			},
+		}, true),
+		Entry("mid-surge with old replicas", &appsv1.Deployment{
+			Spec: appsv1.DeploymentSpec{Replicas: replicas(2)},
+			Status: appsv1.DeploymentStatus{
+				Replicas:          3,
+				UpdatedReplicas:   2,
+				AvailableReplicas: 3,
+				Conditions:        []appsv1.DeploymentCondition{progressingCondition},
+			},
+		}, true),
+		Entry("updated replicas not available yet", &appsv1.Deployment{
+			Spec: appsv1.DeploymentSpec{Replicas: replicas(2)},
+			Status: appsv1.DeploymentStatus{
+				Replicas:          2,
+				UpdatedReplicas:   2,
+				AvailableReplicas: 1,
+				Conditions:        []appsv1.DeploymentCondition{progressingCondition},
+			},
+		}, true),
+		Entry("progress deadline exceeded", &appsv1.Deployment{
+			Spec: appsv1.DeploymentSpec{Replicas: replicas(2)},
+			Status: appsv1.DeploymentStatus{
+				Replicas:          2,
+				UpdatedReplicas:   1,
+				AvailableReplicas: 1,
+				Conditions:        []appsv1.DeploymentCondition{deadlineExceededCondition},
+			},
+		}, false),
+		Entry("progressing condition missing", &appsv1.Deployment{
+			Spec: appsv1.DeploymentSpec{Replicas: replicas(2)},
+		}, false),
+	)
+
+	DescribeTable("#IsStatefulSetProgressing",
+		func(statefulSet *appsv1.StatefulSet, expected bool) {
+			progressing, message := health.IsStatefulSetProgressing(statefulSet)
+			Expect(progressing).To(Equal(expected))
+			if expected {
+				Expect(message).NotTo(BeEmpty())
+			}
+		},
+		Entry("rollout completed", &appsv1.StatefulSet{
+			Spec: appsv1.StatefulSetSpec{Replicas: replicas(3)},
+			Status: appsv1.StatefulSetStatus{
+				ReadyReplicas:   3,
+				CurrentRevision: "b",
+				UpdateRevision:  "b",
+			},
+		}, false),
+		Entry("not observed at latest version", &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Generation: 2},
+			Status:     appsv1.StatefulSetStatus{ObservedGeneration: 1},
+		}, true),
+		Entry("replicas not updated yet", &appsv1.StatefulSet{
+			Spec: appsv1.StatefulSetSpec{Replicas: replicas(3)},
+			Status: appsv1.StatefulSetStatus{
+				ReadyReplicas:   3,
+				UpdatedReplicas: 1,
+				CurrentRevision: "a",
+				UpdateRevision:  "b",
+			},
+		}, true),
+		Entry("updated replica not ready yet", &appsv1.StatefulSet{
+			Spec: appsv1.StatefulSetSpec{Replicas: replicas(3)},
+			Status: appsv1.StatefulSetStatus{
+				ReadyReplicas:   2,
+				UpdatedReplicas: 3,
+				CurrentRevision: "a",
+				UpdateRevision:  "b",
+			},
+		}, true),
+		Entry("partitioned rollout completed", &appsv1.StatefulSet{
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: replicas(3),
+				UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+					Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+					RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: replicas(2)},
+				},
+			},
+			Status: appsv1.StatefulSetStatus{
+				ReadyReplicas:   3,
+				UpdatedReplicas: 1,
+				CurrentRevision: "a",
+				UpdateRevision:  "b",
+			},
+		}, false),
+		Entry("partitioned rollout in progress", &appsv1.StatefulSet{
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: replicas(3),
+				UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+					Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+					RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: replicas(1)},
+				},
+			},
+			Status: appsv1.StatefulSetStatus{
+				ReadyReplicas:   3,
+				UpdatedReplicas: 1,
+				CurrentRevision: "a",
+				UpdateRevision:  "b",
+			},
+		}, true),
+		Entry("no rollout", &appsv1.StatefulSet{}, false),
+	)
+})
@@ -0,0 +1,69 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package kubernetes
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultListPageSize is the default number of objects which are requested per page by ListPaged.
+const DefaultListPageSize int64 = 500
+
+// ListPaged lists the objects into the given <list> in chunks of <pageSize> objects (DefaultListPageSize if it is not
+// positive) and calls <fn> after every page. This avoids loading all objects of a resource into memory at once when
+// they are only read once. The <list> only contains the items of the current page when <fn> is called.
+func ListPaged(ctx context.Context, c client.Client, opts *client.ListOptions, list runtime.Object, pageSize int64, fn func() error) error {
+	if pageSize <= 0 {
+		pageSize = DefaultListPageSize
+	}
+
+	var listOpts client.ListOptions
+	if opts != nil {
+		listOpts = *opts
+	}
+
+	var continueToken string
+	for {
+		rawOpts := metav1.ListOptions{}
+		if listOpts.Raw != nil {
+			rawOpts = *listOpts.Raw
+		}
+		rawOpts.Limit = pageSize
+		rawOpts.Continue = continueToken
+		listOpts.Raw = &rawOpts
+
+		if err := meta.SetList(list, nil); err != nil {
+			return err
+		}
+		if err := c.List(ctx, &listOpts, list); err != nil {
+			return err
+		}
+		if err := fn(); err != nil {
+			return err
+		}
+
+		listAccessor, err := meta.ListAccessor(list)
+		if err != nil {
+			return err
+		}
+		if continueToken = listAccessor.GetContinue(); len(continueToken) == 0 {
+			return nil
+		}
+	}
+}
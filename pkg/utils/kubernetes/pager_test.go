@@ -0,0 +1,119 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package kubernetes
+
+import (
+	"context"
+	"errors"
+
+	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("pager", func() {
+	var (
+		ctrl *gomock.Controller
+		c    *mockclient.MockClient
+		ctx  context.Context
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+		ctx = context.TODO()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#ListPaged", func() {
+		page := func(continueToken string, names ...string) func(context.Context, *client.ListOptions, runtime.Object) error {
+			return func(_ context.Context, _ *client.ListOptions, obj runtime.Object) error {
+				list := obj.(*corev1.SecretList)
+				list.Continue = continueToken
+				for _, name := range names {
+					list.Items = append(list.Items, corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name}})
+				}
+				return nil
+			}
+		}
+
+		It("should list all pages and pass the options", func() {
+			var (
+				selector = labels.SelectorFromSet(labels.Set{"foo": "bar"})
+				list     = &corev1.SecretList{}
+				names    []string
+			)
+
+			gomock.InOrder(
+				c.EXPECT().List(ctx, &client.ListOptions{
+					Namespace:     "default",
+					LabelSelector: selector,
+					Raw:           &metav1.ListOptions{Limit: 2},
+				}, list).DoAndReturn(page("next", "a", "b")),
+				c.EXPECT().List(ctx, &client.ListOptions{
+					Namespace:     "default",
+					LabelSelector: selector,
+					Raw:           &metav1.ListOptions{Limit: 2, Continue: "next"},
+				}, list).DoAndReturn(page("", "c")),
+			)
+
+			Expect(ListPaged(ctx, c, &client.ListOptions{Namespace: "default", LabelSelector: selector}, list, 2, func() error {
+				for _, item := range list.Items {
+					names = append(names, item.Name)
+				}
+				return nil
+			})).To(Succeed())
+			Expect(names).To(Equal([]string{"a", "b", "c"}))
+		})
+
+		It("should use the default page size", func() {
+			list := &corev1.SecretList{}
+
+			c.EXPECT().List(ctx, &client.ListOptions{Raw: &metav1.ListOptions{Limit: DefaultListPageSize}}, list).DoAndReturn(page(""))
+
+			Expect(ListPaged(ctx, c, nil, list, 0, func() error { return nil })).To(Succeed())
+		})
+
+		It("should stop on errors of the callback", func() {
+			var (
+				list = &corev1.SecretList{}
+				err  = errors.New("error")
+			)
+
+			c.EXPECT().List(ctx, gomock.Any(), list).DoAndReturn(page("next", "a"))
+
+			Expect(ListPaged(ctx, c, nil, list, 1, func() error { return err })).To(BeIdenticalTo(err))
+		})
+
+		It("should return errors of the client", func() {
+			var (
+				list = &corev1.SecretList{}
+				err  = errors.New("error")
+			)
+
+			c.EXPECT().List(ctx, gomock.Any(), list).Return(err)
+
+			Expect(ListPaged(ctx, c, nil, list, 1, func() error { return nil })).To(BeIdenticalTo(err))
+		})
+	})
+})
@@ -85,3 +85,13 @@ func TryUpdateSeed(g garden.Interface, backoff wait.Backoff, meta metav1.ObjectM
 		return equality.Semantic.DeepEqual(cur, updated)
 	})
 }
+
+// TryUpdateSeedAnnotations tries to update the annotations of the seed matching the given <meta>.
+// It retries with the given <backoff> characteristics as long as it gets Conflict errors.
+// The transformation function is applied to the current state of the Seed object. If the transformation
+// yields a semantically equal Seed (regarding annotations), no update is done and the operation returns normally.
+func TryUpdateSeedAnnotations(g garden.Interface, backoff wait.Backoff, meta metav1.ObjectMeta, transform func(*gardenv1beta1.Seed) (*gardenv1beta1.Seed, error)) (*gardenv1beta1.Seed, error) {
+	return TryUpdateSeedWithEqualFunc(g, backoff, meta, transform, func(cur, updated *gardenv1beta1.Seed) bool {
+		return equality.Semantic.DeepEqual(cur.Annotations, updated.Annotations)
+	})
+}
@@ -0,0 +1,429 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/cache"
+)
+
+const (
+	// MediaTypeImageManifest is the media type of an OCI image manifest.
+	MediaTypeImageManifest = "application/vnd.oci.image.manifest.v1+json"
+	// MediaTypeHelmChartContent is the media type of the layer holding the Helm chart archive.
+	MediaTypeHelmChartContent = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+	// mediaTypeHelmChartContentLegacy is the media type used for the chart layer by older Helm versions.
+	mediaTypeHelmChartContentLegacy = "application/tar+gzip"
+
+	// maxChartSize is the maximum size of a chart archive that is pulled.
+	maxChartSize = 20 << 20
+	// chartCacheTTL is the time after which cached charts are pulled again (and their signatures are verified again).
+	chartCacheTTL = 24 * time.Hour
+)
+
+// Ref is a reference to an artifact in an OCI registry, i.e., `<registry>/<repository>[:<tag>][@<digest>]`.
+type Ref struct {
+	Registry   string
+	Repository string
+	Tag        string
+	Digest     string
+}
+
+// String returns the string representation of the reference.
+func (r *Ref) String() string {
+	s := r.Registry + "/" + r.Repository
+	if len(r.Tag) > 0 {
+		s += ":" + r.Tag
+	}
+	if len(r.Digest) > 0 {
+		s += "@" + r.Digest
+	}
+	return s
+}
+
+// reference returns the tag or digest which is used to fetch the manifest. The digest takes precedence.
+func (r *Ref) reference() string {
+	if len(r.Digest) > 0 {
+		return r.Digest
+	}
+	return r.Tag
+}
+
+// ParseRef parses the given reference to an artifact in an OCI registry. An optional `oci://` prefix is ignored.
+// The reference must contain a registry host and either a tag or a `sha256` digest.
+func ParseRef(ref string) (*Ref, error) {
+	s := strings.TrimPrefix(ref, "oci://")
+
+	result := &Ref{}
+	if i := strings.Index(s, "@"); i >= 0 {
+		result.Digest, s = s[i+1:], s[:i]
+		if err := validateDigest(result.Digest); err != nil {
+			return nil, fmt.Errorf("invalid reference %q: %v", ref, err)
+		}
+	}
+	if i := strings.LastIndex(s, ":"); i >= 0 && !strings.Contains(s[i+1:], "/") {
+		result.Tag, s = s[i+1:], s[:i]
+	}
+
+	i := strings.Index(s, "/")
+	if i <= 0 || i == len(s)-1 {
+		return nil, fmt.Errorf("invalid reference %q: registry and repository must be given", ref)
+	}
+	result.Registry, result.Repository = s[:i], s[i+1:]
+
+	if len(result.Tag) == 0 && len(result.Digest) == 0 {
+		return nil, fmt.Errorf("invalid reference %q: tag or digest must be given", ref)
+	}
+	return result, nil
+}
+
+func validateDigest(digest string) error {
+	if !strings.HasPrefix(digest, "sha256:") {
+		return fmt.Errorf("unsupported digest algorithm in %q, only sha256 is supported", digest)
+	}
+	if hexPart := strings.TrimPrefix(digest, "sha256:"); len(hexPart) != sha256.Size*2 {
+		return fmt.Errorf("invalid sha256 digest %q", digest)
+	} else if _, err := hex.DecodeString(hexPart); err != nil {
+		return fmt.Errorf("invalid sha256 digest %q: %v", digest, err)
+	}
+	return nil
+}
+
+func verifyDigest(digest string, data []byte) error {
+	if actual := fmt.Sprintf("sha256:%x", sha256.Sum256(data)); actual != digest {
+		return fmt.Errorf("digest mismatch: expected %s, got %s", digest, actual)
+	}
+	return nil
+}
+
+// Credentials are the credentials used to authenticate against an OCI registry.
+type Credentials struct {
+	Username string
+	Password string
+	// TokenRealmHosts are the hosts (with port, if any) besides the registry host which may receive the credentials
+	// when requesting a token, e.g. a separate authentication server of the registry.
+	TokenRealmHosts []string
+}
+
+// DockerConfigJSON returns the content of a `kubernetes.io/dockerconfigjson` secret which allows pulling images from
+// the given registry with the given credentials.
+func DockerConfigJSON(registry string, credentials *Credentials) ([]byte, error) {
+	type authEntry struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+		Auth     string `json:"auth"`
+	}
+
+	return json.Marshal(map[string]map[string]authEntry{
+		"auths": {
+			registry: {
+				Username: credentials.Username,
+				Password: credentials.Password,
+				Auth:     base64.StdEncoding.EncodeToString([]byte(credentials.Username + ":" + credentials.Password)),
+			},
+		},
+	})
+}
+
+// ChartPuller pulls Helm charts from OCI registries. The digests of all downloaded content are verified. Charts
+// referenced by digest are immutable and therefore cached in memory, up to the given number of charts (least recently
+// used charts are evicted first).
+type ChartPuller struct {
+	client *http.Client
+	cache  *cache.LRUExpireCache
+}
+
+// NewChartPuller returns a new ChartPuller using the given HTTP client and caching up to the given number of charts.
+func NewChartPuller(client *http.Client, cacheSize int) *ChartPuller {
+	return &ChartPuller{
+		client: client,
+		cache:  cache.NewLRUExpireCache(cacheSize),
+	}
+}
+
+type descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type manifest struct {
+	SchemaVersion int          `json:"schemaVersion"`
+	Layers        []descriptor `json:"layers"`
+}
+
+// Pull pulls the Helm chart archive with the given reference. If the reference contains a digest, the manifest
+// must match it. The chart archive always has to match the digest given in the manifest. The optional credentials
+// are used if the registry requests authentication. If a public key (PEM) is given, the manifest must be signed
+// with the corresponding private key by cosign.
+func (p *ChartPuller) Pull(ref string, credentials *Credentials, publicKey []byte) ([]byte, error) {
+	r, err := ParseRef(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := fmt.Sprintf("%s|%x", r, sha256.Sum256(publicKey))
+	if len(r.Digest) > 0 {
+		if chart, ok := p.cache.Get(cacheKey); ok {
+			return chart.([]byte), nil
+		}
+	}
+
+	session := &session{client: p.client, registry: r.Registry, repository: r.Repository, credentials: credentials}
+
+	manifestData, err := session.get(fmt.Sprintf("manifests/%s", r.reference()), MediaTypeImageManifest)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch manifest of %s: %v", r, err)
+	}
+	if len(r.Digest) > 0 {
+		if err := verifyDigest(r.Digest, manifestData); err != nil {
+			return nil, fmt.Errorf("manifest of %s cannot be verified: %v", r, err)
+		}
+	}
+	if len(publicKey) > 0 {
+		if err := verifySignature(session, fmt.Sprintf("sha256:%x", sha256.Sum256(manifestData)), publicKey); err != nil {
+			return nil, fmt.Errorf("signature of %s cannot be verified: %v", r, err)
+		}
+	}
+
+	m := &manifest{}
+	if err := json.Unmarshal(manifestData, m); err != nil {
+		return nil, fmt.Errorf("could not decode manifest of %s: %v", r, err)
+	}
+
+	var layer *descriptor
+	for i, l := range m.Layers {
+		if l.MediaType == MediaTypeHelmChartContent || l.MediaType == mediaTypeHelmChartContentLegacy {
+			layer = &m.Layers[i]
+			break
+		}
+	}
+	if layer == nil {
+		return nil, fmt.Errorf("%s does not contain a Helm chart", r)
+	}
+	if err := validateDigest(layer.Digest); err != nil {
+		return nil, fmt.Errorf("chart layer of %s cannot be verified: %v", r, err)
+	}
+	if layer.Size > maxChartSize {
+		return nil, fmt.Errorf("chart of %s exceeds the maximum size of %d bytes", r, maxChartSize)
+	}
+
+	chart, err := session.get(fmt.Sprintf("blobs/%s", layer.Digest), "")
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch chart of %s: %v", r, err)
+	}
+	if err := verifyDigest(layer.Digest, chart); err != nil {
+		return nil, fmt.Errorf("chart of %s cannot be verified: %v", r, err)
+	}
+
+	if len(r.Digest) > 0 {
+		p.cache.Add(cacheKey, chart, chartCacheTTL)
+	}
+	return chart, nil
+}
+
+// session fetches content of a repository in an OCI registry and handles the authentication challenges of the
+// registry (basic and bearer token authentication).
+type session struct {
+	client      *http.Client
+	registry    string
+	repository  string
+	credentials *Credentials
+
+	token string
+}
+
+func (s *session) get(path, accept string) ([]byte, error) {
+	endpoint := fmt.Sprintf("https://%s/v2/%s/%s", s.registry, s.repository, path)
+
+	response, err := s.do(endpoint, accept)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusUnauthorized && len(s.token) == 0 {
+		challenge := response.Header.Get("WWW-Authenticate")
+		response.Body.Close()
+
+		if err := s.authenticate(challenge); err != nil {
+			return nil, err
+		}
+		if response, err = s.do(endpoint, accept); err != nil {
+			return nil, err
+		}
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response status %q for %s", response.Status, endpoint)
+	}
+	return ioutil.ReadAll(io.LimitReader(response.Body, maxChartSize+1))
+}
+
+func (s *session) do(endpoint, accept string) (*http.Response, error) {
+	request, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(accept) > 0 {
+		request.Header.Set("Accept", accept)
+	}
+	switch {
+	case s.token == basicAuthToken && s.credentials != nil:
+		request.SetBasicAuth(s.credentials.Username, s.credentials.Password)
+	case len(s.token) > 0:
+		request.Header.Set("Authorization", "Bearer "+s.token)
+	}
+	return s.client.Do(request)
+}
+
+// basicAuthToken is a marker for sessions which authenticate with basic authentication.
+const basicAuthToken = "<basic>"
+
+// authenticate handles the given authentication challenge. For bearer challenges, a token is requested from the
+// given realm. The credentials, if any, are only sent to trusted realms (see trustsRealm).
+func (s *session) authenticate(challenge string) error {
+	scheme, params := parseChallenge(challenge)
+
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if s.credentials == nil {
+			return fmt.Errorf("registry %s requires credentials", s.registry)
+		}
+		s.token = basicAuthToken
+		return nil
+
+	case "bearer":
+		realm, err := url.Parse(params["realm"])
+		if err != nil || len(params["realm"]) == 0 {
+			return fmt.Errorf("registry %s sent an invalid authentication challenge %q", s.registry, challenge)
+		}
+		query := realm.Query()
+		if service, ok := params["service"]; ok {
+			query.Set("service", service)
+		}
+		scope, ok := params["scope"]
+		if !ok {
+			scope = fmt.Sprintf("repository:%s:pull", s.repository)
+		}
+		query.Set("scope", scope)
+		realm.RawQuery = query.Encode()
+
+		request, err := http.NewRequest(http.MethodGet, realm.String(), nil)
+		if err != nil {
+			return err
+		}
+		if s.credentials != nil {
+			if !s.trustsRealm(realm) {
+				return fmt.Errorf("registry %s requested a token from the untrusted realm %q, refusing to send credentials", s.registry, params["realm"])
+			}
+			request.SetBasicAuth(s.credentials.Username, s.credentials.Password)
+		}
+		response, err := s.client.Do(request)
+		if err != nil {
+			return err
+		}
+		defer response.Body.Close()
+		if response.StatusCode != http.StatusOK {
+			return fmt.Errorf("could not get token for registry %s: unexpected response status %q", s.registry, response.Status)
+		}
+
+		var token struct {
+			Token       string `json:"token"`
+			AccessToken string `json:"access_token"`
+		}
+		if err := json.NewDecoder(response.Body).Decode(&token); err != nil {
+			return fmt.Errorf("could not decode token for registry %s: %v", s.registry, err)
+		}
+		s.token = token.Token
+		if len(s.token) == 0 {
+			s.token = token.AccessToken
+		}
+		if len(s.token) == 0 {
+			return fmt.Errorf("registry %s did not return a token", s.registry)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("registry %s sent an unsupported authentication challenge %q", s.registry, challenge)
+}
+
+// trustsRealm returns whether the credentials may be sent to the given realm. The realm must use https and must be
+// either on the registry host or on one of the TokenRealmHosts of the credentials.
+func (s *session) trustsRealm(realm *url.URL) bool {
+	if realm.Scheme != "https" {
+		return false
+	}
+	if strings.EqualFold(realm.Host, s.registry) {
+		return true
+	}
+	for _, host := range s.credentials.TokenRealmHosts {
+		if strings.EqualFold(realm.Host, host) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseChallenge parses a `WWW-Authenticate` header value like `Bearer realm="...",service="..."`.
+func parseChallenge(challenge string) (string, map[string]string) {
+	var (
+		parts  = strings.SplitN(strings.TrimSpace(challenge), " ", 2)
+		params = map[string]string{}
+	)
+	if len(parts) < 2 {
+		return parts[0], params
+	}
+
+	for _, param := range splitParams(parts[1]) {
+		kv := strings.SplitN(param, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		params[strings.ToLower(strings.TrimSpace(kv[0]))] = strings.Trim(strings.TrimSpace(kv[1]), `"`)
+	}
+	return parts[0], params
+}
+
+// splitParams splits the comma-separated parameters of a challenge, respecting quoted values.
+func splitParams(s string) []string {
+	var (
+		params []string
+		quoted bool
+		start  int
+	)
+	for i, c := range s {
+		switch {
+		case c == '"':
+			quoted = !quoted
+		case c == ',' && !quoted:
+			params = append(params, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(params, s[start:])
+}
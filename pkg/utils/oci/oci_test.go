@@ -0,0 +1,363 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/gardener/gardener/pkg/utils/oci"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func digestOf(data []byte) string {
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(data))
+}
+
+var _ = Describe("OCI", func() {
+	Describe("#ParseRef", func() {
+		It("should parse a reference with tag and digest", func() {
+			digest := digestOf([]byte("foo"))
+			ref, err := ParseRef("oci://registry.example.com:5000/charts/foo:1.0.0@" + digest)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ref).To(Equal(&Ref{Registry: "registry.example.com:5000", Repository: "charts/foo", Tag: "1.0.0", Digest: digest}))
+			Expect(ref.String()).To(Equal("registry.example.com:5000/charts/foo:1.0.0@" + digest))
+		})
+
+		It("should parse a reference with a registry port but without tag", func() {
+			digest := digestOf([]byte("foo"))
+			ref, err := ParseRef("localhost:5000/foo@" + digest)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ref).To(Equal(&Ref{Registry: "localhost:5000", Repository: "foo", Digest: digest}))
+		})
+
+		It("should reject references without tag and digest", func() {
+			_, err := ParseRef("registry.example.com/charts/foo")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject references without repository", func() {
+			_, err := ParseRef("foo:1.0.0")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject unsupported digests", func() {
+			_, err := ParseRef("registry.example.com/foo@md5:abc")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#DockerConfigJSON", func() {
+		It("should return the docker config for the given registry", func() {
+			data, err := DockerConfigJSON("registry.example.com", &Credentials{Username: "user", Password: "pass"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(MatchJSON(`{"auths":{"registry.example.com":{"username":"user","password":"pass","auth":"dXNlcjpwYXNz"}}}`))
+		})
+	})
+
+	Describe("ChartPuller", func() {
+		var (
+			chart         = []byte("chart-archive")
+			manifest      []byte
+			server        *httptest.Server
+			registry      string
+			manifestPulls int
+			blob          []byte
+			signatures    []byte
+			payload       []byte
+			realm         string
+			tokenRequests int
+		)
+
+		BeforeEach(func() {
+			var err error
+			manifest, err = json.Marshal(map[string]interface{}{
+				"schemaVersion": 2,
+				"layers": []map[string]interface{}{
+					{"mediaType": "application/vnd.cncf.helm.chart.provenance.v1.prov", "digest": digestOf([]byte("prov")), "size": 4},
+					{"mediaType": MediaTypeHelmChartContent, "digest": digestOf(chart), "size": len(chart)},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			blob = chart
+			manifestPulls = 0
+			signatures, payload = nil, nil
+			realm, tokenRequests = "", 0
+
+			server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/token" {
+					tokenRequests++
+					if user, password, ok := r.BasicAuth(); !ok || user != "user" || password != "secret" {
+						w.WriteHeader(http.StatusUnauthorized)
+						return
+					}
+					Expect(r.URL.Query().Get("scope")).To(Equal("repository:charts/foo:pull"))
+					fmt.Fprint(w, `{"token":"abc"}`)
+					return
+				}
+
+				if r.Header.Get("Authorization") != "Bearer abc" {
+					if len(realm) == 0 {
+						realm = fmt.Sprintf("https://%s/token", r.Host)
+					}
+					w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s",service="registry"`, realm))
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+
+				switch {
+				case strings.HasSuffix(r.URL.Path, ".sig"):
+					if signatures == nil {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					w.Write(signatures)
+				case payload != nil && r.URL.Path == "/v2/charts/foo/blobs/"+digestOf(payload):
+					w.Write(payload)
+				case strings.HasPrefix(r.URL.Path, "/v2/charts/foo/manifests/"):
+					manifestPulls++
+					Expect(r.Header.Get("Accept")).To(Equal(MediaTypeImageManifest))
+					w.Write(manifest)
+				case r.URL.Path == "/v2/charts/foo/blobs/"+digestOf(chart):
+					w.Write(blob)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			registry = strings.TrimPrefix(server.URL, "https://")
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should pull and verify a chart", func() {
+			puller := NewChartPuller(server.Client(), 10)
+
+			data, err := puller.Pull(registry+"/charts/foo:1.0.0", &Credentials{Username: "user", Password: "secret"}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(Equal(chart))
+		})
+
+		It("should fail without credentials", func() {
+			puller := NewChartPuller(server.Client(), 10)
+
+			_, err := puller.Pull(registry+"/charts/foo:1.0.0", nil, nil)
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("token realm", func() {
+			var credentials *Credentials
+
+			BeforeEach(func() {
+				credentials = &Credentials{Username: "user", Password: "secret"}
+			})
+
+			It("should not send credentials to a realm without https", func() {
+				realm = strings.Replace(server.URL, "https://", "http://", 1) + "/token"
+				puller := NewChartPuller(server.Client(), 10)
+
+				_, err := puller.Pull(registry+"/charts/foo:1.0.0", credentials, nil)
+				Expect(err).To(MatchError(ContainSubstring("untrusted realm")))
+				Expect(tokenRequests).To(BeZero())
+			})
+
+			It("should not send credentials to a realm on another host", func() {
+				realm = "https://auth.example.com/token"
+				puller := NewChartPuller(server.Client(), 10)
+
+				_, err := puller.Pull(registry+"/charts/foo:1.0.0", credentials, nil)
+				Expect(err).To(MatchError(ContainSubstring("untrusted realm")))
+				Expect(tokenRequests).To(BeZero())
+			})
+
+			It("should send credentials to a realm on an allowed host", func() {
+				// The test server's certificate is valid for example.com, hence all connections are redirected to it.
+				var (
+					authHost  = "example.com:" + strings.Split(registry, ":")[1]
+					client    = server.Client()
+					transport = client.Transport.(*http.Transport)
+				)
+				transport.DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+					return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+				}
+				realm = "https://" + authHost + "/token"
+				credentials.TokenRealmHosts = []string{authHost}
+				puller := NewChartPuller(client, 10)
+
+				data, err := puller.Pull(registry+"/charts/foo:1.0.0", credentials, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(data).To(Equal(chart))
+				Expect(tokenRequests).To(Equal(1))
+			})
+		})
+
+		It("should verify the manifest digest and cache charts referenced by digest", func() {
+			var (
+				puller      = NewChartPuller(server.Client(), 10)
+				ref         = registry + "/charts/foo@" + digestOf(manifest)
+				credentials = &Credentials{Username: "user", Password: "secret"}
+			)
+
+			data, err := puller.Pull(ref, credentials, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(Equal(chart))
+
+			data, err = puller.Pull(ref, credentials, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(Equal(chart))
+			Expect(manifestPulls).To(Equal(1))
+		})
+
+		It("should evict the least recently used charts from the cache", func() {
+			var (
+				puller      = NewChartPuller(server.Client(), 1)
+				ref         = registry + "/charts/foo@" + digestOf(manifest)
+				otherRef    = registry + "/charts/foo:1.0.0@" + digestOf(manifest)
+				credentials = &Credentials{Username: "user", Password: "secret"}
+			)
+
+			for _, r := range []string{ref, otherRef, ref} {
+				_, err := puller.Pull(r, credentials, nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(manifestPulls).To(Equal(3))
+		})
+
+		Context("signatures", func() {
+			var (
+				privateKey  *ecdsa.PrivateKey
+				publicKey   []byte
+				credentials = &Credentials{Username: "user", Password: "secret"}
+			)
+
+			sign := func(key *ecdsa.PrivateKey, manifestDigest string) {
+				var err error
+				payload, err = json.Marshal(map[string]interface{}{
+					"critical": map[string]interface{}{
+						"identity": map[string]string{"docker-reference": registry + "/charts/foo"},
+						"image":    map[string]string{"docker-manifest-digest": manifestDigest},
+						"type":     "cosign container image signature",
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				hash := sha256.Sum256(payload)
+				r, sig, err := ecdsa.Sign(rand.Reader, key, hash[:])
+				Expect(err).NotTo(HaveOccurred())
+				signature, err := asn1.Marshal(struct{ R, S *big.Int }{r, sig})
+				Expect(err).NotTo(HaveOccurred())
+
+				signatures, err = json.Marshal(map[string]interface{}{
+					"schemaVersion": 2,
+					"layers": []map[string]interface{}{{
+						"mediaType":   "application/vnd.dev.cosign.simplesigning.v1+json",
+						"digest":      digestOf(payload),
+						"size":        len(payload),
+						"annotations": map[string]string{"dev.cosignproject.cosign/signature": base64.StdEncoding.EncodeToString(signature)},
+					}},
+				})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			BeforeEach(func() {
+				var err error
+				privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+				Expect(err).NotTo(HaveOccurred())
+				der, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+				Expect(err).NotTo(HaveOccurred())
+				publicKey = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+			})
+
+			It("should pull a correctly signed chart", func() {
+				sign(privateKey, digestOf(manifest))
+				puller := NewChartPuller(server.Client(), 10)
+
+				data, err := puller.Pull(registry+"/charts/foo:1.0.0", credentials, publicKey)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(data).To(Equal(chart))
+			})
+
+			It("should reject an unsigned chart", func() {
+				puller := NewChartPuller(server.Client(), 10)
+
+				_, err := puller.Pull(registry+"/charts/foo:1.0.0", credentials, publicKey)
+				Expect(err).To(MatchError(ContainSubstring("could not fetch signatures")))
+			})
+
+			It("should reject a chart signed with another key", func() {
+				otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+				Expect(err).NotTo(HaveOccurred())
+				sign(otherKey, digestOf(manifest))
+				puller := NewChartPuller(server.Client(), 10)
+
+				_, err = puller.Pull(registry+"/charts/foo:1.0.0", credentials, publicKey)
+				Expect(err).To(MatchError(ContainSubstring("no valid signature")))
+			})
+
+			It("should reject a signature of another manifest", func() {
+				sign(privateKey, digestOf([]byte("other")))
+				puller := NewChartPuller(server.Client(), 10)
+
+				_, err := puller.Pull(registry+"/charts/foo:1.0.0", credentials, publicKey)
+				Expect(err).To(MatchError(ContainSubstring("no valid signature")))
+			})
+
+			It("should not serve charts cached without verification", func() {
+				var (
+					puller = NewChartPuller(server.Client(), 10)
+					ref    = registry + "/charts/foo@" + digestOf(manifest)
+				)
+
+				_, err := puller.Pull(ref, credentials, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = puller.Pull(ref, credentials, publicKey)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		It("should reject a manifest not matching the digest", func() {
+			puller := NewChartPuller(server.Client(), 10)
+
+			_, err := puller.Pull(registry+"/charts/foo@"+digestOf([]byte("other")), &Credentials{Username: "user", Password: "secret"}, nil)
+			Expect(err).To(MatchError(ContainSubstring("digest mismatch")))
+		})
+
+		It("should reject a chart not matching the digest of the manifest", func() {
+			blob = []byte("tampered")
+			puller := NewChartPuller(server.Client(), 10)
+
+			_, err := puller.Pull(registry+"/charts/foo:1.0.0", &Credentials{Username: "user", Password: "secret"}, nil)
+			Expect(err).To(MatchError(ContainSubstring("digest mismatch")))
+		})
+	})
+})
@@ -0,0 +1,138 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+const (
+	// mediaTypeCosignSimpleSigning is the media type of the layers of a cosign signature holding the signed payload.
+	mediaTypeCosignSimpleSigning = "application/vnd.dev.cosign.simplesigning.v1+json"
+	// annotationCosignSignature is the annotation of a signature layer containing the base64-encoded signature.
+	annotationCosignSignature = "dev.cosignproject.cosign/signature"
+)
+
+// simpleSigningPayload is the payload signed by cosign.
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// verifySignature verifies that the manifest with the given digest is signed by cosign with the private key
+// corresponding to the given public key (PEM). Cosign stores the signatures of a manifest in the same repository
+// with the tag `sha256-<digest>.sig`. At least one signature has to be valid.
+func verifySignature(session *session, manifestDigest string, publicKey []byte) error {
+	key, err := parsePublicKey(publicKey)
+	if err != nil {
+		return err
+	}
+
+	tag := strings.Replace(manifestDigest, ":", "-", 1) + ".sig"
+	manifestData, err := session.get(fmt.Sprintf("manifests/%s", tag), MediaTypeImageManifest)
+	if err != nil {
+		return fmt.Errorf("could not fetch signatures: %v", err)
+	}
+	m := &manifest{}
+	if err := json.Unmarshal(manifestData, m); err != nil {
+		return fmt.Errorf("could not decode signatures: %v", err)
+	}
+
+	for _, layer := range m.Layers {
+		encodedSignature, ok := layer.Annotations[annotationCosignSignature]
+		if layer.MediaType != mediaTypeCosignSimpleSigning || !ok {
+			continue
+		}
+		signature, err := base64.StdEncoding.DecodeString(encodedSignature)
+		if err != nil {
+			continue
+		}
+		if err := validateDigest(layer.Digest); err != nil {
+			continue
+		}
+		payload, err := session.get(fmt.Sprintf("blobs/%s", layer.Digest), "")
+		if err != nil {
+			return fmt.Errorf("could not fetch signed payload: %v", err)
+		}
+		if err := verifyDigest(layer.Digest, payload); err != nil {
+			continue
+		}
+		if err := verifyPayload(key, payload, signature); err != nil {
+			continue
+		}
+
+		p := &simpleSigningPayload{}
+		if err := json.Unmarshal(payload, p); err != nil {
+			continue
+		}
+		if p.Critical.Image.DockerManifestDigest == manifestDigest {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("no valid signature found for %s", manifestDigest)
+}
+
+func parsePublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("public key is not PEM-encoded")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse public key: %v", err)
+	}
+	switch key.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey:
+		return key, nil
+	}
+	return nil, fmt.Errorf("unsupported public key type %T", key)
+}
+
+func verifyPayload(key crypto.PublicKey, payload, signature []byte) error {
+	hash := sha256.Sum256(payload)
+
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		var sig struct {
+			R, S *big.Int
+		}
+		if rest, err := asn1.Unmarshal(signature, &sig); err != nil || len(rest) > 0 {
+			return errors.New("invalid ECDSA signature")
+		}
+		if !ecdsa.Verify(k, hash[:], sig.R, sig.S) {
+			return errors.New("ECDSA signature does not match")
+		}
+		return nil
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, hash[:], signature)
+	}
+	return fmt.Errorf("unsupported public key type %T", key)
+}
@@ -15,8 +15,9 @@
 package secrets
 
 import (
+	"crypto"
+	"crypto/ecdsa"
 	"crypto/rand"
-	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"fmt"
@@ -50,10 +51,17 @@ const (
 	DataKeyCertificateCA = "ca.crt"
 	// DataKeyPrivateKeyCA is the key in a secret data holding the CA private key.
 	DataKeyPrivateKeyCA = "ca.key"
+
+	// DefaultCertificateValidity is the validity of generated certificates if no validity is configured.
+	DefaultCertificateValidity = 10 * 365 * 24 * time.Hour
 )
 
 // CertificateSecretConfig contains the specification a to-be-generated CA, server, or client certificate.
-// It always contains a 2048-bit RSA private key.
+// The private key is generated according to the KeyAlgorithm (2048-bit RSA if it is empty).
+// The certificate is valid for the given Validity (DefaultCertificateValidity if it is zero). If a RenewalThreshold is given, an existing
+// certificate is renewed by GenerateClusterSecrets once its remaining validity falls below the threshold. A RenewalJitter
+// brings the renewal forward by up to the given duration (derived from the serial number of the existing certificate)
+// in order to spread the renewals of certificates which have been generated at the same time.
 type CertificateSecretConfig struct {
 	Name string
 
@@ -64,6 +72,12 @@ type CertificateSecretConfig struct {
 
 	CertType  certType
 	SigningCA *Certificate
+
+	KeyAlgorithm KeyAlgorithm
+
+	Validity         time.Duration
+	RenewalThreshold time.Duration
+	RenewalJitter    time.Duration
 }
 
 // Certificate contains the private key, and the certificate. It does also contain the CA certificate
@@ -73,7 +87,7 @@ type Certificate struct {
 
 	CA *Certificate
 
-	PrivateKey    *rsa.PrivateKey
+	PrivateKey    crypto.Signer
 	PrivateKeyPEM []byte
 
 	Certificate    *x509.Certificate
@@ -89,10 +103,19 @@ func (s *CertificateSecretConfig) GetName() string {
 func (s *CertificateSecretConfig) Generate() (Interface, error) {
 	var certificate = s.generateCertificateTemplate()
 
-	privateKey, err := generateRSAPrivateKey(2048)
+	privateKey, err := generatePrivateKey(s.KeyAlgorithm)
 	if err != nil {
 		return nil, err
 	}
+	privateKeyPEM, err := encodePrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, isECDSA := privateKey.(*ecdsa.PrivateKey); isECDSA {
+		// Key encipherment is only defined for RSA keys.
+		certificate.KeyUsage &^= x509.KeyUsageKeyEncipherment
+	}
 
 	var (
 		certificateSigner = certificate
@@ -104,7 +127,7 @@ func (s *CertificateSecretConfig) Generate() (Interface, error) {
 		privateKeySigner = s.SigningCA.PrivateKey
 	}
 
-	certificatePEM, err := signCertificate(certificate, privateKey, certificateSigner, privateKeySigner)
+	certificatePEM, err := signCertificate(certificate, privateKey.Public(), certificateSigner, privateKeySigner)
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +138,7 @@ func (s *CertificateSecretConfig) Generate() (Interface, error) {
 		CA: s.SigningCA,
 
 		PrivateKey:    privateKey,
-		PrivateKeyPEM: utils.EncodePrivateKey(privateKey),
+		PrivateKeyPEM: privateKeyPEM,
 
 		Certificate:    certificate,
 		CertificatePEM: certificatePEM,
@@ -146,7 +169,7 @@ func (c *Certificate) SecretData() map[string][]byte {
 // LoadCertificate takes a byte slice representation of a certificate and the corresponding private key, and returns its de-serialized private
 // key, certificate template and PEM certificate which can be used to sign other x509 certificates.
 func LoadCertificate(name string, privateKeyPEM, certificatePEM []byte) (Interface, error) {
-	privateKey, err := utils.DecodePrivateKey(privateKeyPEM)
+	privateKey, err := decodePrivateKey(privateKeyPEM)
 	if err != nil {
 		return nil, err
 	}
@@ -169,8 +192,13 @@ func LoadCertificate(name string, privateKeyPEM, certificatePEM []byte) (Interfa
 // generateCertificateTemplate creates a X509 Certificate object based on the provided information regarding
 // common name, organization, SANs (DNS names and IP addresses). It can create a server or a client certificate
 // or both, depending on the <certType> value. If <isCACert> is true, then a CA certificate is being created.
-// The certificates a valid for 10 years.
+// The certificates are valid for the configured validity, or for 10 years if none is configured.
 func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificate {
+	validity := DefaultCertificateValidity
+	if s.Validity > 0 {
+		validity = s.Validity
+	}
+
 	var (
 		serialNumber, _ = rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
 		now             = time.Now()
@@ -181,7 +209,7 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 			IsCA:                  isCA,
 			SerialNumber:          serialNumber,
 			NotBefore:             now,
-			NotAfter:              now.AddDate(10, 0, 0), // + 10 years
+			NotAfter:              now.Add(validity),
 			KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
 			Subject: pkix.Name{
 				CommonName:   s.CommonName,
@@ -207,10 +235,10 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 }
 
 // SignCertificate takes a <certificateTemplate> and a <certificateTemplateSigner> which is used to sign
-// the first. It also requires the corresponding private keys of both certificates. The created certificate
-// is returned as byte slice.
-func signCertificate(certificateTemplate *x509.Certificate, privateKey *rsa.PrivateKey, certificateTemplateSigner *x509.Certificate, privateKeySigner *rsa.PrivateKey) ([]byte, error) {
-	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, &privateKey.PublicKey, privateKeySigner)
+// the first. It also requires the public key of the first and the signer holding the private key of the
+// latter certificate. The created certificate is returned as byte slice.
+func signCertificate(certificateTemplate *x509.Certificate, publicKey crypto.PublicKey, certificateTemplateSigner *x509.Certificate, privateKeySigner crypto.Signer) ([]byte, error) {
+	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, publicKey, privateKeySigner)
 	if err != nil {
 		return nil, err
 	}
@@ -296,7 +324,8 @@ func GenerateCertificateAuthorities(k8sClusterClient kubernetes.Interface, exist
 	return generatedSecrets, certificateAuthorities, nil
 }
 
-// GenerateClusterSecrets try to deploy in the k8s cluster each secret in the wantedSecretsList. If the secret already exist it jumps to the next one.
+// GenerateClusterSecrets try to deploy in the k8s cluster each secret in the wantedSecretsList. If the secret already exist it jumps to the next one,
+// unless it holds a certificate whose remaining validity has fallen below the configured renewal threshold (then it is regenerated).
 // The function returns a map with all of the successfully deployed wanted secrets plus those already deployed (only from the wantedSecretsList).
 func GenerateClusterSecrets(k8sClusterClient kubernetes.Interface, existingSecretsMap map[string]*corev1.Secret, wantedSecretsList []ConfigInterface, namespace string) (map[string]*corev1.Secret, error) {
 	type secretOutput struct {
@@ -314,13 +343,14 @@ func GenerateClusterSecrets(k8sClusterClient kubernetes.Interface, existingSecre
 	for _, s := range wantedSecretsList {
 		name := s.GetName()
 
-		if existingSecret, ok := existingSecretsMap[name]; ok {
+		existingSecret, exists := existingSecretsMap[name]
+		if exists && !needsRenewal(s, existingSecret) {
 			deployedClusterSecrets[name] = existingSecret
 			continue
 		}
 
 		wg.Add(1)
-		go func(s ConfigInterface) {
+		go func(s ConfigInterface, renew bool) {
 			defer wg.Done()
 
 			obj, err := s.Generate()
@@ -334,9 +364,9 @@ func GenerateClusterSecrets(k8sClusterClient kubernetes.Interface, existingSecre
 				secretType = corev1.SecretTypeTLS
 			}
 
-			secret, err := k8sClusterClient.CreateSecret(namespace, s.GetName(), secretType, obj.SecretData(), false)
+			secret, err := k8sClusterClient.CreateSecret(namespace, s.GetName(), secretType, obj.SecretData(), renew)
 			results <- &secretOutput{secret: secret, err: err}
-		}(s)
+		}(s, exists)
 	}
 
 	go func() {
@@ -360,3 +390,46 @@ func GenerateClusterSecrets(k8sClusterClient kubernetes.Interface, existingSecre
 
 	return deployedClusterSecrets, nil
 }
+
+// needsRenewal checks whether the certificate stored in the <existingSecret> for the given secret <config> must be
+// renewed because its remaining validity has fallen below the configured renewal threshold.
+func needsRenewal(config ConfigInterface, existingSecret *corev1.Secret) bool {
+	var (
+		certificateConfig *CertificateSecretConfig
+		dataKey           string
+	)
+
+	switch c := config.(type) {
+	case *CertificateSecretConfig:
+		certificateConfig, dataKey = c, DataKeyCertificate
+	case *ControlPlaneSecretConfig:
+		certificateConfig, dataKey = c.CertificateSecretConfig, fmt.Sprintf("%s.crt", c.Name)
+	default:
+		return false
+	}
+
+	if certificateConfig.RenewalThreshold <= 0 || certificateConfig.CertType == CACert {
+		return false
+	}
+
+	certificate, err := utils.DecodeCertificate(existingSecret.Data[dataKey])
+	if err != nil {
+		return false
+	}
+	return CertificateNeedsRenewal(certificate, certificateConfig.RenewalThreshold, certificateConfig.RenewalJitter)
+}
+
+// CertificateNeedsRenewal checks whether the remaining validity of the given <certificate> has fallen below the
+// <renewalThreshold>, which is brought forward by a jitter of at most <maxJitter> derived from the certificate.
+func CertificateNeedsRenewal(certificate *x509.Certificate, renewalThreshold, maxJitter time.Duration) bool {
+	return time.Until(certificate.NotAfter) < renewalThreshold+renewalJitter(certificate, maxJitter)
+}
+
+// renewalJitter returns a duration in [0, maxJitter) which is derived from the serial number of the given certificate,
+// i.e., it is stable across reconciliations but differs between certificates.
+func renewalJitter(certificate *x509.Certificate, maxJitter time.Duration) time.Duration {
+	if maxJitter <= 0 || certificate.SerialNumber == nil {
+		return 0
+	}
+	return time.Duration(new(big.Int).Mod(new(big.Int).Abs(certificate.SerialNumber), big.NewInt(int64(maxJitter))).Int64())
+}
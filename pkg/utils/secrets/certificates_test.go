@@ -0,0 +1,156 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets_test
+
+import (
+	"crypto/x509"
+	"math/big"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils"
+	. "github.com/gardener/gardener/pkg/utils/secrets"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("Certificates", func() {
+	var ca *Certificate
+
+	BeforeEach(func() {
+		caConfig := &CertificateSecretConfig{
+			Name:       "ca",
+			CommonName: "ca",
+			CertType:   CACert,
+		}
+		obj, err := caConfig.Generate()
+		Expect(err).NotTo(HaveOccurred())
+		ca = obj.(*Certificate)
+	})
+
+	Describe("#Generate", func() {
+		It("should use the default validity", func() {
+			Expect(ca.Certificate.NotAfter.Sub(ca.Certificate.NotBefore)).To(Equal(DefaultCertificateValidity))
+		})
+
+		It("should use the configured validity", func() {
+			config := &CertificateSecretConfig{
+				Name:       "server",
+				CommonName: "server",
+				CertType:   ServerCert,
+				SigningCA:  ca,
+				Validity:   24 * time.Hour,
+			}
+			obj, err := config.Generate()
+			Expect(err).NotTo(HaveOccurred())
+
+			certificate, err := utils.DecodeCertificate(obj.(*Certificate).CertificatePEM)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(certificate.NotAfter.Sub(certificate.NotBefore)).To(Equal(24 * time.Hour))
+		})
+	})
+
+	Describe("#needsRenewal", func() {
+		var (
+			config *CertificateSecretConfig
+			secret *corev1.Secret
+		)
+
+		BeforeEach(func() {
+			config = &CertificateSecretConfig{
+				Name:       "server",
+				CommonName: "server",
+				CertType:   ServerCert,
+				SigningCA:  ca,
+				Validity:   48 * time.Hour,
+			}
+			obj, err := config.Generate()
+			Expect(err).NotTo(HaveOccurred())
+			secret = &corev1.Secret{Data: obj.SecretData()}
+		})
+
+		It("should not renew certificates without renewal threshold", func() {
+			Expect(ExportNeedsRenewal(config, secret)).To(BeFalse())
+		})
+
+		It("should not renew certificates whose remaining validity is above the threshold", func() {
+			config.RenewalThreshold = 24 * time.Hour
+			Expect(ExportNeedsRenewal(config, secret)).To(BeFalse())
+		})
+
+		It("should renew certificates whose remaining validity is below the threshold", func() {
+			config.RenewalThreshold = 72 * time.Hour
+			Expect(ExportNeedsRenewal(config, secret)).To(BeTrue())
+		})
+
+		It("should renew certificates of control plane secrets", func() {
+			config.RenewalThreshold = 72 * time.Hour
+			controlPlaneConfig := &ControlPlaneSecretConfig{CertificateSecretConfig: config}
+			obj, err := controlPlaneConfig.Generate()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ExportNeedsRenewal(controlPlaneConfig, &corev1.Secret{Data: obj.SecretData()})).To(BeTrue())
+		})
+
+		It("should never renew certificate authorities", func() {
+			caConfig := &CertificateSecretConfig{
+				Name:             "ca",
+				CertType:         CACert,
+				RenewalThreshold: 2 * DefaultCertificateValidity,
+			}
+			Expect(ExportNeedsRenewal(caConfig, &corev1.Secret{Data: ca.SecretData()})).To(BeFalse())
+		})
+	})
+
+	Describe("#CertificateNeedsRenewal", func() {
+		It("should consider the renewal threshold and the jitter", func() {
+			certificate := &x509.Certificate{
+				SerialNumber: big.NewInt(int64(2 * time.Hour)),
+				NotAfter:     time.Now().Add(10 * time.Hour),
+			}
+
+			Expect(CertificateNeedsRenewal(certificate, 9*time.Hour, 0)).To(BeFalse())
+			Expect(CertificateNeedsRenewal(certificate, 11*time.Hour, 0)).To(BeTrue())
+			Expect(CertificateNeedsRenewal(certificate, 9*time.Hour, 3*time.Hour)).To(BeTrue())
+		})
+	})
+
+	Describe("#renewalJitter", func() {
+		It("should return no jitter without maximum", func() {
+			Expect(ExportRenewalJitter(&x509.Certificate{SerialNumber: big.NewInt(42)}, 0)).To(BeZero())
+		})
+
+		It("should derive the jitter from the serial number", func() {
+			serialNumber := big.NewInt(int64(5*time.Hour + time.Minute))
+
+			Expect(ExportRenewalJitter(&x509.Certificate{SerialNumber: serialNumber}, 2*time.Hour)).To(Equal(time.Hour + time.Minute))
+		})
+
+		It("should spread the jitter of generated certificates", func() {
+			jitters := map[time.Duration]struct{}{}
+			for i := 0; i < 10; i++ {
+				jitter := ExportRenewalJitter(ca.Certificate, 24*time.Hour)
+				Expect(jitter).To(BeNumerically("<", 24*time.Hour))
+				jitters[jitter] = struct{}{}
+
+				obj, err := (&CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: CACert}).Generate()
+				Expect(err).NotTo(HaveOccurred())
+				ca = obj.(*Certificate)
+			}
+			Expect(len(jitters)).To(BeNumerically(">", 1))
+		})
+	})
+})
@@ -18,4 +18,6 @@ package secrets
 
 var (
 	ExportGenerateKubeconfig = generateKubeconfig
+	ExportNeedsRenewal       = needsRenewal
+	ExportRenewalJitter      = renewalJitter
 )
@@ -0,0 +1,116 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// KeyAlgorithm is the algorithm (and size) of a private key generated for a certificate.
+type KeyAlgorithm string
+
+const (
+	// KeyAlgorithmRSA2048 is a 2048-bit RSA private key. It is used if no key algorithm is specified.
+	KeyAlgorithmRSA2048 KeyAlgorithm = "RSA-2048"
+	// KeyAlgorithmRSA3072 is a 3072-bit RSA private key.
+	KeyAlgorithmRSA3072 KeyAlgorithm = "RSA-3072"
+	// KeyAlgorithmRSA4096 is a 4096-bit RSA private key.
+	KeyAlgorithmRSA4096 KeyAlgorithm = "RSA-4096"
+	// KeyAlgorithmECDSAP256 is an ECDSA private key on the NIST P-256 curve.
+	KeyAlgorithmECDSAP256 KeyAlgorithm = "ECDSA-P256"
+	// KeyAlgorithmECDSAP384 is an ECDSA private key on the NIST P-384 curve.
+	KeyAlgorithmECDSAP384 KeyAlgorithm = "ECDSA-P384"
+
+	pemTypeECPrivateKey = "EC PRIVATE KEY"
+)
+
+// SupportedKeyAlgorithms is the list of key algorithms which can be used for generated certificates.
+var SupportedKeyAlgorithms = []KeyAlgorithm{
+	KeyAlgorithmRSA2048,
+	KeyAlgorithmRSA3072,
+	KeyAlgorithmRSA4096,
+	KeyAlgorithmECDSAP256,
+	KeyAlgorithmECDSAP384,
+}
+
+// ParseKeyAlgorithm returns the KeyAlgorithm for the given string. An empty string results in the default
+// algorithm (2048-bit RSA). An error is returned if the algorithm is not supported.
+func ParseKeyAlgorithm(algorithm string) (KeyAlgorithm, error) {
+	if len(algorithm) == 0 {
+		return KeyAlgorithmRSA2048, nil
+	}
+	for _, supported := range SupportedKeyAlgorithms {
+		if KeyAlgorithm(algorithm) == supported {
+			return supported, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported key algorithm %q, supported are %v", algorithm, SupportedKeyAlgorithms)
+}
+
+// generatePrivateKey generates a private key for the given <algorithm>.
+func generatePrivateKey(algorithm KeyAlgorithm) (crypto.Signer, error) {
+	switch algorithm {
+	case "", KeyAlgorithmRSA2048:
+		return generateRSAPrivateKey(2048)
+	case KeyAlgorithmRSA3072:
+		return generateRSAPrivateKey(3072)
+	case KeyAlgorithmRSA4096:
+		return generateRSAPrivateKey(4096)
+	case KeyAlgorithmECDSAP256:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case KeyAlgorithmECDSAP384:
+		return ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	}
+	return nil, fmt.Errorf("unsupported key algorithm %q", algorithm)
+}
+
+// encodePrivateKey takes a RSA or ECDSA private key object, encodes it to the PEM format, and returns it as
+// a byte slice.
+func encodePrivateKey(key crypto.Signer) ([]byte, error) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return utils.EncodePrivateKey(k), nil
+	case *ecdsa.PrivateKey:
+		data, err := x509.MarshalECPrivateKey(k)
+		if err != nil {
+			return nil, err
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: pemTypeECPrivateKey, Bytes: data}), nil
+	}
+	return nil, fmt.Errorf("unsupported private key type %T", key)
+}
+
+// decodePrivateKey takes a byte slice, decodes it from the PEM format, converts it to a RSA or ECDSA private
+// key object, and returns it. In case an error occurs, it returns the error.
+func decodePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("could not decode the PEM-encoded private key")
+	}
+	if block.Type == pemTypeECPrivateKey {
+		return x509.ParseECPrivateKey(block.Bytes)
+	}
+	return utils.DecodePrivateKey(data)
+}
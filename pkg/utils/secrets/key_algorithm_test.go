@@ -0,0 +1,98 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+
+	"github.com/gardener/gardener/pkg/utils"
+	. "github.com/gardener/gardener/pkg/utils/secrets"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("KeyAlgorithm", func() {
+	Describe("#ParseKeyAlgorithm", func() {
+		It("should default to 2048-bit RSA", func() {
+			Expect(ParseKeyAlgorithm("")).To(Equal(KeyAlgorithmRSA2048))
+		})
+
+		It("should accept supported algorithms", func() {
+			Expect(ParseKeyAlgorithm("ECDSA-P384")).To(Equal(KeyAlgorithmECDSAP384))
+		})
+
+		It("should reject unsupported algorithms", func() {
+			_, err := ParseKeyAlgorithm("DSA-1024")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	DescribeTable("#Generate",
+		func(keyAlgorithm KeyAlgorithm, checkKey func(*x509.Certificate)) {
+			caConfig := &CertificateSecretConfig{
+				Name:         "ca",
+				CommonName:   "ca",
+				CertType:     CACert,
+				KeyAlgorithm: keyAlgorithm,
+			}
+			ca, err := caConfig.Generate()
+			Expect(err).NotTo(HaveOccurred())
+
+			serverConfig := &CertificateSecretConfig{
+				Name:         "server",
+				CommonName:   "server",
+				CertType:     ServerCert,
+				SigningCA:    ca.(*Certificate),
+				KeyAlgorithm: keyAlgorithm,
+			}
+			server, err := serverConfig.Generate()
+			Expect(err).NotTo(HaveOccurred())
+
+			data := server.SecretData()
+			certificate, err := utils.DecodeCertificate(data[DataKeyCertificate])
+			Expect(err).NotTo(HaveOccurred())
+			checkKey(certificate)
+
+			roots := x509.NewCertPool()
+			Expect(roots.AppendCertsFromPEM(data[DataKeyCertificateCA])).To(BeTrue())
+			_, err = certificate.Verify(x509.VerifyOptions{Roots: roots})
+			Expect(err).NotTo(HaveOccurred())
+
+			loaded, err := LoadCertificate("server", data[DataKeyPrivateKey], data[DataKeyCertificate])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loaded.(*Certificate).PrivateKey.Public()).To(Equal(certificate.PublicKey))
+		},
+
+		Entry("default", KeyAlgorithm(""), func(certificate *x509.Certificate) {
+			Expect(certificate.PublicKey.(*rsa.PublicKey).N.BitLen()).To(Equal(2048))
+			Expect(certificate.KeyUsage & x509.KeyUsageKeyEncipherment).NotTo(BeZero())
+		}),
+		Entry("RSA-3072", KeyAlgorithmRSA3072, func(certificate *x509.Certificate) {
+			Expect(certificate.PublicKey.(*rsa.PublicKey).N.BitLen()).To(Equal(3072))
+		}),
+		Entry("ECDSA-P256", KeyAlgorithmECDSAP256, func(certificate *x509.Certificate) {
+			Expect(certificate.PublicKey.(*ecdsa.PublicKey).Curve).To(Equal(elliptic.P256()))
+			Expect(certificate.KeyUsage & x509.KeyUsageKeyEncipherment).To(BeZero())
+		}),
+		Entry("ECDSA-P384", KeyAlgorithmECDSAP384, func(certificate *x509.Certificate) {
+			Expect(certificate.PublicKey.(*ecdsa.PublicKey).Curve).To(Equal(elliptic.P384()))
+		}),
+	)
+})
@@ -22,6 +22,16 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
+// IPFamily is the family of the addresses of a CIDR.
+type IPFamily string
+
+const (
+	// IPFamilyIPv4 is the family of IPv4 addresses.
+	IPFamilyIPv4 IPFamily = "IPv4"
+	// IPFamilyIPv6 is the family of IPv6 addresses (including IPv4-mapped IPv6 addresses).
+	IPFamilyIPv6 IPFamily = "IPv6"
+)
+
 // CIDR contains CIDR and Path information
 type CIDR interface {
 	// GetCIDR returns the provided CIDR
@@ -30,6 +40,8 @@ type CIDR interface {
 	GetFieldPath() *field.Path
 	// GetIPNet optionaly returns the IPNet of the CIDR
 	GetIPNet() *net.IPNet
+	// GetIPFamily returns the IP family of the CIDR, or an empty string if it can't be parsed.
+	GetIPFamily() IPFamily
 	// Parse checks if CIDR parses
 	Parse() bool
 	// ValidateNotSubset returns errors if subsets is a subset.
@@ -38,6 +50,8 @@ type CIDR interface {
 	ValidateParse() field.ErrorList
 	// ValidateSubset returns errors if subsets is not a subset.
 	ValidateSubset(subsets ...CIDR) field.ErrorList
+	// ValidateIPFamily returns errors if the CIDR is not of the given IP family.
+	ValidateIPFamily(family IPFamily) field.ErrorList
 }
 
 type cidrPath struct {
@@ -62,7 +76,7 @@ func (c *cidrPath) ValidateSubset(subsets ...CIDR) field.ErrorList {
 		if subset == nil || c == subset || !subset.Parse() {
 			continue
 		}
-		if !c.net.Contains(subset.GetIPNet().IP) {
+		if !c.contains(subset) {
 			allErrs = append(allErrs, field.Invalid(subset.GetFieldPath(), subset.GetCIDR(), fmt.Sprintf("must be a subset of %q (%q)", c.fieldPath.String(), c.cidr)))
 		}
 	}
@@ -78,13 +92,24 @@ func (c *cidrPath) ValidateNotSubset(subsets ...CIDR) field.ErrorList {
 		if subset == nil || c == subset || !subset.Parse() {
 			continue
 		}
-		if c.net.Contains(subset.GetIPNet().IP) {
+		if c.sameIPFamily(subset) && c.net.Contains(subset.GetIPNet().IP) {
 			allErrs = append(allErrs, field.Invalid(subset.GetFieldPath(), subset.GetCIDR(), fmt.Sprintf("must not be a subset of %q (%q)", c.fieldPath.String(), c.cidr)))
 		}
 	}
 	return allErrs
 }
 
+func (c *cidrPath) ValidateIPFamily(family IPFamily) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if c.ParseError != nil {
+		return allErrs
+	}
+	if actual := c.GetIPFamily(); actual != family {
+		allErrs = append(allErrs, field.Invalid(c.fieldPath, c.cidr, fmt.Sprintf("must be an %s CIDR, but is an %s CIDR", family, actual)))
+	}
+	return allErrs
+}
+
 func (c *cidrPath) ValidateParse() field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -103,6 +128,32 @@ func (c *cidrPath) GetIPNet() *net.IPNet {
 	return c.net
 }
 
+func (c *cidrPath) GetIPFamily() IPFamily {
+	if c.net == nil {
+		return ""
+	}
+	return ipFamily(c.net)
+}
+
+// contains returns true if the whole range of <other> is contained in the range of the CIDR. Ranges of different IP
+// families are never contained in each other, even if one of them consists of IPv4-mapped IPv6 addresses.
+func (c *cidrPath) contains(other CIDR) bool {
+	if !c.sameIPFamily(other) {
+		return false
+	}
+
+	var (
+		otherNet     = other.GetIPNet()
+		ones, _      = c.net.Mask.Size()
+		otherOnes, _ = otherNet.Mask.Size()
+	)
+	return ones <= otherOnes && c.net.Contains(otherNet.IP)
+}
+
+func (c *cidrPath) sameIPFamily(other CIDR) bool {
+	return c.GetIPFamily() == other.GetIPFamily()
+}
+
 func (c *cidrPath) GetFieldPath() *field.Path {
 	return c.fieldPath
 }
@@ -110,3 +161,40 @@ func (c *cidrPath) GetFieldPath() *field.Path {
 func (c *cidrPath) GetCIDR() garden.CIDR {
 	return c.cidr
 }
+
+// ValidateSameIPFamily returns errors for all of the given <cidrs> whose IP family differs from the one of the first
+// parsable CIDR. CIDRs which can't be parsed are ignored.
+func ValidateSameIPFamily(cidrs ...CIDR) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var family IPFamily
+	for _, c := range cidrs {
+		if c == nil || !c.Parse() {
+			continue
+		}
+		if len(family) == 0 {
+			family = c.GetIPFamily()
+			continue
+		}
+		allErrs = append(allErrs, c.ValidateIPFamily(family)...)
+	}
+
+	return allErrs
+}
+
+// Overlap returns true if the ranges of both given CIDRs have at least one address in common. CIDRs of different IP
+// families never overlap. If one of the CIDRs can't be parsed then false is returned.
+func Overlap(c1, c2 CIDR) bool {
+	if c1 == nil || c2 == nil || !c1.Parse() || !c2.Parse() || c1.GetIPFamily() != c2.GetIPFamily() {
+		return false
+	}
+	net1, net2 := c1.GetIPNet(), c2.GetIPNet()
+	return net1.Contains(net2.IP) || net2.Contains(net1.IP)
+}
+
+func ipFamily(ipNet *net.IPNet) IPFamily {
+	if len(ipNet.Mask) == net.IPv4len {
+		return IPFamilyIPv4
+	}
+	return IPFamilyIPv6
+}
@@ -125,6 +125,13 @@ var _ = Describe("cidr", func() {
 			Expect(cdr.ValidateNotSubset(other)).To(BeEmpty())
 		})
 
+		It("should not be a subset of a different IP family", func() {
+			cdr := NewCIDR(validGardenCIDR, path)
+			other := NewCIDR(garden.CIDR("::ffff:10.0.0.1/128"), path)
+
+			Expect(cdr.ValidateNotSubset(other)).To(BeEmpty())
+		})
+
 		It("should return a nil FieldPath", func() {
 			cdr := NewCIDR(validGardenCIDR, path)
 			badCIDR := garden.CIDR("10.0.0.1/32")
@@ -140,6 +147,86 @@ var _ = Describe("cidr", func() {
 		})
 	})
 
+	Context("GetIPFamily", func() {
+		It("should return IPv4 for an IPv4 CIDR", func() {
+			Expect(NewCIDR(validGardenCIDR, path).GetIPFamily()).To(Equal(IPFamilyIPv4))
+		})
+
+		It("should return IPv6 for an IPv6 CIDR", func() {
+			Expect(NewCIDR(garden.CIDR("2001:db8::/32"), path).GetIPFamily()).To(Equal(IPFamilyIPv6))
+		})
+
+		It("should return IPv6 for an IPv4-mapped IPv6 CIDR", func() {
+			Expect(NewCIDR(garden.CIDR("::ffff:10.0.0.0/104"), path).GetIPFamily()).To(Equal(IPFamilyIPv6))
+		})
+
+		It("should return an empty family when parse error", func() {
+			Expect(NewCIDR(invalidGardenCIDR, path).GetIPFamily()).To(BeEmpty())
+		})
+	})
+
+	Context("ValidateIPFamily", func() {
+		It("should accept a CIDR of the given family", func() {
+			Expect(NewCIDR(validGardenCIDR, path).ValidateIPFamily(IPFamilyIPv4)).To(BeEmpty())
+		})
+
+		It("should ignore parse errors", func() {
+			Expect(NewCIDR(invalidGardenCIDR, path).ValidateIPFamily(IPFamilyIPv4)).To(BeEmpty())
+		})
+
+		It("should reject a CIDR of a different family", func() {
+			ipv6CIDR := garden.CIDR("2001:db8::/32")
+
+			Expect(NewCIDR(ipv6CIDR, path).ValidateIPFamily(IPFamilyIPv4)).To(ConsistOfFields(Fields{
+				"Type":     Equal(field.ErrorTypeInvalid),
+				"Field":    Equal(path.String()),
+				"BadValue": Equal(ipv6CIDR),
+				"Detail":   Equal(`must be an IPv4 CIDR, but is an IPv6 CIDR`),
+			}))
+		})
+	})
+
+	Context("ValidateSameIPFamily", func() {
+		It("should accept CIDRs of the same family", func() {
+			Expect(ValidateSameIPFamily(NewCIDR("2001:db8::/32", path), NewCIDR("fd00::/8", path))).To(BeEmpty())
+		})
+
+		It("should ignore nil values and parse errors", func() {
+			Expect(ValidateSameIPFamily(nil, NewCIDR(invalidGardenCIDR, path), NewCIDR(validGardenCIDR, path))).To(BeEmpty())
+		})
+
+		It("should reject mixed-family CIDRs", func() {
+			otherPath := field.NewPath("other")
+
+			Expect(ValidateSameIPFamily(NewCIDR(validGardenCIDR, path), NewCIDR("fd00::/8", otherPath))).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal(otherPath.String()),
+			}))
+		})
+	})
+
+	Context("Overlap", func() {
+		It("should detect overlapping IPv4 CIDRs", func() {
+			Expect(Overlap(NewCIDR("10.0.0.0/8", path), NewCIDR("10.1.0.0/16", path))).To(BeTrue())
+		})
+
+		It("should detect overlapping IPv6 CIDRs", func() {
+			Expect(Overlap(NewCIDR("2001:db8:1::/48", path), NewCIDR("2001:db8::/32", path))).To(BeTrue())
+		})
+
+		It("should not detect disjoint IPv6 CIDRs as overlapping", func() {
+			Expect(Overlap(NewCIDR("2001:db8::/32", path), NewCIDR("fd00::/8", path))).To(BeFalse())
+		})
+
+		It("should not detect CIDRs of different families as overlapping", func() {
+			Expect(Overlap(NewCIDR("10.0.0.0/8", path), NewCIDR("::ffff:10.0.0.0/104", path))).To(BeFalse())
+		})
+
+		It("should return false when parse error", func() {
+			Expect(Overlap(NewCIDR(invalidGardenCIDR, path), NewCIDR(validGardenCIDR, path))).To(BeFalse())
+		})
+	})
+
 	Context("ValidateParse", func() {
 		It("should parse without errors", func() {
 			cdr := NewCIDR(validGardenCIDR, path)
@@ -180,6 +267,37 @@ var _ = Describe("cidr", func() {
 			Expect(cdr.ValidateSubset(other)).To(BeEmpty())
 		})
 
+		It("should be an IPv6 subset", func() {
+			cdr := NewCIDR(garden.CIDR("2001:db8::/32"), path)
+			other := NewCIDR(garden.CIDR("2001:db8:1::/48"), field.NewPath("other"))
+
+			Expect(cdr.ValidateSubset(other)).To(BeEmpty())
+		})
+
+		It("should not be a subset if the range is larger", func() {
+			cdr := NewCIDR(validGardenCIDR, path)
+			badCIDR := garden.CIDR("10.0.0.0/7")
+			other := NewCIDR(badCIDR, field.NewPath("bad"))
+
+			Expect(cdr.ValidateSubset(other)).To(ConsistOfFields(Fields{
+				"Type":     Equal(field.ErrorTypeInvalid),
+				"Field":    Equal("bad"),
+				"BadValue": Equal(badCIDR),
+			}))
+		})
+
+		It("should not be a subset of a different IP family", func() {
+			cdr := NewCIDR(validGardenCIDR, path)
+			badCIDR := garden.CIDR("::ffff:10.0.0.1/128")
+			other := NewCIDR(badCIDR, field.NewPath("bad"))
+
+			Expect(cdr.ValidateSubset(other)).To(ConsistOfFields(Fields{
+				"Type":     Equal(field.ErrorTypeInvalid),
+				"Field":    Equal("bad"),
+				"BadValue": Equal(badCIDR),
+			}))
+		})
+
 		It("should not be a subset", func() {
 			cdr := NewCIDR(validGardenCIDR, path)
 			other := NewCIDR(garden.CIDR("10.0.0.1/32"), field.NewPath("bad"))
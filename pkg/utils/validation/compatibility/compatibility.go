@@ -0,0 +1,145 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compatibility
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/utils"
+
+	"github.com/Masterminds/semver"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// versionRange is the range of Kubernetes minor versions in which a feature gate, an admission plugin, or an API group
+// version is available. An empty AddedInVersion means that it is available since before the oldest version covered by
+// the compatibility matrix, an empty RemovedInVersion that it has not been removed yet.
+type versionRange struct {
+	AddedInVersion   string
+	RemovedInVersion string
+}
+
+func (r *versionRange) contains(version string) (bool, error) {
+	if len(r.AddedInVersion) > 0 {
+		added, err := utils.CompareVersions(version, ">=", r.AddedInVersion)
+		if err != nil || !added {
+			return false, err
+		}
+	}
+	if len(r.RemovedInVersion) > 0 {
+		return utils.CompareVersions(version, "<", r.RemovedInVersion)
+	}
+	return true, nil
+}
+
+// isSupported returns true if <name> is available in the given Kubernetes <version> according to <ranges>. Names which
+// are not contained in <ranges> are only supported by versions newer than the ones covered by the compatibility matrix.
+func isSupported(ranges map[string]*versionRange, name, version string) (bool, error) {
+	if _, err := semver.NewVersion(version); err != nil {
+		return false, fmt.Errorf("invalid Kubernetes version %q: %v", version, err)
+	}
+
+	r, ok := ranges[name]
+	if !ok {
+		return utils.CompareVersions(version, ">=", firstUncoveredVersion)
+	}
+	return r.contains(version)
+}
+
+// IsFeatureGateSupported returns true if the given feature gate is supported by the given Kubernetes version.
+func IsFeatureGateSupported(featureGate, version string) (bool, error) {
+	return isSupported(featureGateVersionRanges, featureGate, version)
+}
+
+// IsAdmissionPluginSupported returns true if the given admission plugin is supported by the given Kubernetes version.
+func IsAdmissionPluginSupported(plugin, version string) (bool, error) {
+	return isSupported(admissionPluginVersionRanges, plugin, version)
+}
+
+// IsRuntimeConfigSupported returns true if the given key of the `--runtime-config` flag of the kube-apiserver is supported
+// by the given Kubernetes version. Keys are either API group versions (e.g. `batch/v2alpha1`), resources of API group
+// versions (e.g. `extensions/v1beta1/podsecuritypolicy`), or `api/all`.
+func IsRuntimeConfigSupported(key, version string) (bool, error) {
+	if parts := strings.Split(key, "/"); len(parts) == 3 {
+		key = parts[0] + "/" + parts[1]
+	}
+	return isSupported(apiGroupVersionRanges, key, version)
+}
+
+// ValidateFeatureGates validates that the given feature gates are supported by the given Kubernetes version.
+func ValidateFeatureGates(featureGates map[string]bool, version string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, featureGate := range sortedKeys(featureGates) {
+		supported, err := IsFeatureGateSupported(featureGate, version)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(featureGate), featureGate, err.Error()))
+		} else if !supported {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Key(featureGate), fmt.Sprintf("feature gate %q is not supported in Kubernetes version %s", featureGate, version)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateAdmissionPlugins validates that the admission plugins with the given names are supported by the given
+// Kubernetes version.
+func ValidateAdmissionPlugins(plugins []string, version string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, plugin := range plugins {
+		// Missing names are not a matter of compatibility.
+		if len(plugin) == 0 {
+			continue
+		}
+
+		supported, err := IsAdmissionPluginSupported(plugin, version)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("name"), plugin, err.Error()))
+		} else if !supported {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(i).Child("name"), fmt.Sprintf("admission plugin %q is not supported in Kubernetes version %s", plugin, version)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateRuntimeConfig validates that the given runtime configuration of the kube-apiserver is supported by the given
+// Kubernetes version.
+func ValidateRuntimeConfig(runtimeConfig map[string]bool, version string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, key := range sortedKeys(runtimeConfig) {
+		supported, err := IsRuntimeConfigSupported(key, version)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(key), key, err.Error()))
+		} else if !supported {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Key(key), fmt.Sprintf("API %q is not supported in Kubernetes version %s", key, version)))
+		}
+	}
+
+	return allErrs
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
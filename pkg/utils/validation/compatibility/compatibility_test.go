@@ -0,0 +1,109 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compatibility_test
+
+import (
+	. "github.com/gardener/gardener/pkg/utils/validation/compatibility"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("Compatibility", func() {
+	DescribeTable("#IsFeatureGateSupported",
+		func(featureGate, version string, expected bool) {
+			supported, err := IsFeatureGateSupported(featureGate, version)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(supported).To(Equal(expected))
+		},
+		Entry("available in all versions", "PodPriority", "1.10.3", true),
+		Entry("added before the version", "NodeLease", "1.13.0", true),
+		Entry("added in the version", "NodeLease", "1.12.0", true),
+		Entry("added after the version", "NodeLease", "1.11.9", false),
+		Entry("removed before the version", "Initializers", "1.14.1", false),
+		Entry("removed after the version", "Initializers", "1.13.4", true),
+		Entry("pre-release version", "ServerSideApply", "1.14.0-rc.1", true),
+		Entry("unknown in a covered version", "Foo", "1.14.5", false),
+		Entry("unknown in a version newer than the covered ones", "Foo", "1.15.0", true),
+	)
+
+	It("should fail for invalid versions", func() {
+		_, err := IsFeatureGateSupported("PodPriority", "foo")
+		Expect(err).To(HaveOccurred())
+	})
+
+	DescribeTable("#IsRuntimeConfigSupported",
+		func(key, version string, expected bool) {
+			supported, err := IsRuntimeConfigSupported(key, version)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(supported).To(Equal(expected))
+		},
+		Entry("all APIs", "api/all", "1.10.0", true),
+		Entry("API group version", "batch/v2alpha1", "1.13.0", true),
+		Entry("API group version added later", "scheduling.k8s.io/v1", "1.13.0", false),
+		Entry("resource of an API group version", "extensions/v1beta1/podsecuritypolicy", "1.13.0", true),
+		Entry("resource of an unknown API group version", "foo/v1/bars", "1.13.0", false),
+	)
+
+	Describe("#ValidateFeatureGates", func() {
+		It("should forbid unsupported feature gates", func() {
+			errorList := ValidateFeatureGates(map[string]bool{
+				"PodPriority":  true,
+				"Initializers": true,
+				"Foo":          false,
+			}, "1.14.0", field.NewPath("featureGates"))
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("featureGates[Foo]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("featureGates[Initializers]"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateAdmissionPlugins", func() {
+		It("should forbid unsupported admission plugins and ignore missing names", func() {
+			errorList := ValidateAdmissionPlugins([]string{"PodNodeSelector", "", "TaintNodesByCondition"}, "1.11.2", field.NewPath("admissionPlugins"))
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("admissionPlugins[2].name"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateRuntimeConfig", func() {
+		It("should report invalid versions", func() {
+			errorList := ValidateRuntimeConfig(map[string]bool{"api/all": true}, "foo", field.NewPath("runtimeConfig"))
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("runtimeConfig[api/all]"),
+				})),
+			))
+		})
+	})
+})
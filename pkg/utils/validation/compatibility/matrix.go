@@ -0,0 +1,189 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compatibility
+
+// The compatibility matrix covers the Kubernetes versions 1.10 to 1.14. Feature gates and admission plugins are listed as
+// printed by `hack/generate-kubernetes-compatibility` for a checkout of https://github.com/kubernetes/kubernetes, API
+// group versions are maintained manually.
+
+// firstUncoveredVersion is the first Kubernetes version which is not covered by the compatibility matrix anymore.
+const firstUncoveredVersion = "1.15"
+
+// featureGateVersionRanges contains the feature gates of the kube-apiserver, the kube-controller-manager, the
+// kube-scheduler, the kube-proxy, and the kubelet.
+var featureGateVersionRanges = map[string]*versionRange{
+	"APIListChunking":                             {},
+	"APIResponseCompression":                      {},
+	"Accelerators":                                {RemovedInVersion: "1.11"},
+	"AdvancedAuditing":                            {},
+	"AppArmor":                                    {},
+	"AttachVolumeLimit":                           {AddedInVersion: "1.11"},
+	"BalanceAttachedNodeVolumes":                  {AddedInVersion: "1.11"},
+	"BlockVolume":                                 {},
+	"BoundServiceAccountTokenVolume":              {AddedInVersion: "1.13"},
+	"CPUCFSQuotaPeriod":                           {AddedInVersion: "1.12"},
+	"CPUManager":                                  {},
+	"CRIContainerLogRotation":                     {AddedInVersion: "1.11"},
+	"CSIBlockVolume":                              {AddedInVersion: "1.11"},
+	"CSIDriverRegistry":                           {AddedInVersion: "1.12"},
+	"CSIMigration":                                {AddedInVersion: "1.14"},
+	"CSIMigrationAWS":                             {AddedInVersion: "1.14"},
+	"CSIMigrationGCE":                             {AddedInVersion: "1.14"},
+	"CSINodeInfo":                                 {AddedInVersion: "1.12"},
+	"CSIPersistentVolume":                         {},
+	"CustomPodDNS":                                {},
+	"CustomResourcePublishOpenAPI":                {AddedInVersion: "1.14"},
+	"CustomResourceSubresources":                  {},
+	"CustomResourceValidation":                    {},
+	"CustomResourceWebhookConversion":             {AddedInVersion: "1.13"},
+	"DebugContainers":                             {},
+	"DevicePlugins":                               {},
+	"DryRun":                                      {AddedInVersion: "1.12"},
+	"DynamicAuditing":                             {AddedInVersion: "1.13"},
+	"DynamicKubeletConfig":                        {},
+	"DynamicProvisioningScheduling":               {AddedInVersion: "1.11", RemovedInVersion: "1.12"},
+	"EnableEquivalenceClassCache":                 {},
+	"ExpandCSIVolumes":                            {AddedInVersion: "1.14"},
+	"ExpandInUsePersistentVolumes":                {AddedInVersion: "1.11"},
+	"ExpandPersistentVolumes":                     {},
+	"ExperimentalCriticalPodAnnotation":           {},
+	"ExperimentalHostUserNamespaceDefaultingGate": {},
+	"GCERegionalPersistentDisk":                   {},
+	"HugePages":                                   {},
+	"HyperVContainer":                             {},
+	"Initializers":                                {RemovedInVersion: "1.14"},
+	"KubeletPluginsWatcher":                       {AddedInVersion: "1.11"},
+	"KubeletPodResources":                         {AddedInVersion: "1.13"},
+	"LocalStorageCapacityIsolation":               {},
+	"MountContainers":                             {},
+	"MountPropagation":                            {},
+	"NodeLease":                                   {AddedInVersion: "1.12"},
+	"PersistentLocalVolumes":                      {},
+	"PodPriority":                                 {},
+	"PodReadinessGates":                           {AddedInVersion: "1.11"},
+	"PodShareProcessNamespace":                    {},
+	"ProcMountType":                               {AddedInVersion: "1.12"},
+	"QOSReserved":                                 {AddedInVersion: "1.11"},
+	"ReadOnlyAPIDataVolumes":                      {RemovedInVersion: "1.11"},
+	"ResourceLimitsPriorityFunction":              {},
+	"ResourceQuotaScopeSelectors":                 {AddedInVersion: "1.11"},
+	"RotateKubeletClientCertificate":              {},
+	"RotateKubeletServerCertificate":              {},
+	"RunAsGroup":                                  {},
+	"RuntimeClass":                                {AddedInVersion: "1.12"},
+	"SCTPSupport":                                 {AddedInVersion: "1.12"},
+	"ScheduleDaemonSetPods":                       {AddedInVersion: "1.11"},
+	"ServerSideApply":                             {AddedInVersion: "1.14"},
+	"ServiceNodeExclusion":                        {},
+	"StorageObjectInUseProtection":                {},
+	"StreamingProxyRedirects":                     {},
+	"SupportIPVSProxyMode":                        {},
+	"SupportPodPidsLimit":                         {},
+	"Sysctls":                                     {AddedInVersion: "1.11"},
+	"TTLAfterFinished":                            {AddedInVersion: "1.12"},
+	"TaintBasedEvictions":                         {},
+	"TaintNodesByCondition":                       {},
+	"TokenRequest":                                {},
+	"TokenRequestProjection":                      {AddedInVersion: "1.11"},
+	"ValidateProxyRedirects":                      {AddedInVersion: "1.12"},
+	"VolumeScheduling":                            {},
+	"VolumeSnapshotDataSource":                    {AddedInVersion: "1.12"},
+	"VolumeSubpath":                               {},
+	"VolumeSubpathEnvExpansion":                   {AddedInVersion: "1.11"},
+	"WinDVR":                                      {AddedInVersion: "1.14"},
+	"WinOverlay":                                  {AddedInVersion: "1.14"},
+}
+
+// admissionPluginVersionRanges contains the admission plugins of the kube-apiserver.
+var admissionPluginVersionRanges = map[string]*versionRange{
+	"AlwaysAdmit":                          {},
+	"AlwaysDeny":                           {},
+	"AlwaysPullImages":                     {},
+	"DefaultStorageClass":                  {},
+	"DefaultTolerationSeconds":             {},
+	"DenyEscalatingExec":                   {},
+	"DenyExecOnPrivileged":                 {},
+	"EventRateLimit":                       {},
+	"ExtendedResourceToleration":           {},
+	"ImagePolicyWebhook":                   {},
+	"Initializers":                         {RemovedInVersion: "1.14"},
+	"LimitPodHardAntiAffinityTopology":     {},
+	"LimitRanger":                          {},
+	"MutatingAdmissionWebhook":             {},
+	"NamespaceAutoProvision":               {},
+	"NamespaceExists":                      {},
+	"NamespaceLifecycle":                   {},
+	"NodeRestriction":                      {},
+	"OwnerReferencesPermissionEnforcement": {},
+	"PersistentVolumeClaimResize":          {},
+	"PersistentVolumeLabel":                {},
+	"PodNodeSelector":                      {},
+	"PodPreset":                            {},
+	"PodSecurityPolicy":                    {},
+	"PodTolerationRestriction":             {},
+	"Priority":                             {},
+	"ResourceQuota":                        {},
+	"SecurityContextDeny":                  {},
+	"ServiceAccount":                       {},
+	"StorageObjectInUseProtection":         {},
+	"TaintNodesByCondition":                {AddedInVersion: "1.12"},
+	"ValidatingAdmissionWebhook":           {},
+}
+
+// apiGroupVersionRanges contains the API group versions served by the kube-apiserver which can be enabled or disabled
+// with its `--runtime-config` flag.
+var apiGroupVersionRanges = map[string]*versionRange{
+	"api/all":                               {},
+	"api/v1":                                {},
+	"admissionregistration.k8s.io/v1alpha1": {RemovedInVersion: "1.14"},
+	"admissionregistration.k8s.io/v1beta1":  {},
+	"apiextensions.k8s.io/v1beta1":          {},
+	"apiregistration.k8s.io/v1":             {},
+	"apiregistration.k8s.io/v1beta1":        {},
+	"apps/v1":                               {},
+	"apps/v1beta1":                          {},
+	"apps/v1beta2":                          {},
+	"auditregistration.k8s.io/v1alpha1":     {AddedInVersion: "1.13"},
+	"authentication.k8s.io/v1":              {},
+	"authentication.k8s.io/v1beta1":         {},
+	"authorization.k8s.io/v1":               {},
+	"authorization.k8s.io/v1beta1":          {},
+	"autoscaling/v1":                        {},
+	"autoscaling/v2beta1":                   {},
+	"autoscaling/v2beta2":                   {AddedInVersion: "1.12"},
+	"batch/v1":                              {},
+	"batch/v1beta1":                         {},
+	"batch/v2alpha1":                        {},
+	"certificates.k8s.io/v1beta1":           {},
+	"coordination.k8s.io/v1":                {AddedInVersion: "1.14"},
+	"coordination.k8s.io/v1beta1":           {AddedInVersion: "1.12"},
+	"events.k8s.io/v1beta1":                 {},
+	"extensions/v1beta1":                    {},
+	"networking.k8s.io/v1":                  {},
+	"networking.k8s.io/v1beta1":             {AddedInVersion: "1.14"},
+	"node.k8s.io/v1alpha1":                  {AddedInVersion: "1.14"},
+	"node.k8s.io/v1beta1":                   {AddedInVersion: "1.14"},
+	"policy/v1beta1":                        {},
+	"rbac.authorization.k8s.io/v1":          {},
+	"rbac.authorization.k8s.io/v1alpha1":    {},
+	"rbac.authorization.k8s.io/v1beta1":     {},
+	"scheduling.k8s.io/v1":                  {AddedInVersion: "1.14"},
+	"scheduling.k8s.io/v1alpha1":            {},
+	"scheduling.k8s.io/v1beta1":             {AddedInVersion: "1.11"},
+	"settings.k8s.io/v1alpha1":              {},
+	"storage.k8s.io/v1":                     {},
+	"storage.k8s.io/v1alpha1":               {},
+	"storage.k8s.io/v1beta1":                {},
+}
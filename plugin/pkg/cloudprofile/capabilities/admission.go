@@ -0,0 +1,335 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capabilities
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/apis/garden/helper"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	"github.com/ghodss/yaml"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/admission"
+	kubeinformers "k8s.io/client-go/informers"
+	kubecorev1listers "k8s.io/client-go/listers/core/v1"
+)
+
+const (
+	// PluginName is the name of this admission plugin.
+	PluginName = "CloudProfileCapabilities"
+)
+
+// CapabilityMatrix describes what a cloud provider offers. It is published by the provider in the data key named
+// after the cloud provider (e.g. `aws`) of ConfigMaps labeled with `garden.sapcloud.io/role=cloudprofile-capabilities`.
+// Categories which are not set are not validated.
+type CapabilityMatrix struct {
+	// MachineTypes is the list of names of the machine types offered by the provider.
+	// +optional
+	MachineTypes []string `json:"machineTypes,omitempty"`
+	// VolumeTypes is the list of names of the volume types offered by the provider.
+	// +optional
+	VolumeTypes []string `json:"volumeTypes,omitempty"`
+	// Zones maps the regions offered by the provider to the names of their availability zones.
+	// +optional
+	Zones map[string][]string `json:"zones,omitempty"`
+}
+
+// offeredCapabilities is the union of all capability matrices published for a cloud provider. Nil sets are not
+// validated.
+type offeredCapabilities struct {
+	machineTypes sets.String
+	volumeTypes  sets.String
+	zones        map[string]sets.String
+}
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// CapabilityValidator contains listers and and admission handler.
+type CapabilityValidator struct {
+	*admission.Handler
+	configMapLister kubecorev1listers.ConfigMapLister
+	readyFunc       admission.ReadyFunc
+}
+
+var (
+	_ = admissioninitializer.WantsKubeInformerFactory(&CapabilityValidator{})
+
+	readyFuncs = []admission.ReadyFunc{}
+)
+
+// New creates a new CapabilityValidator admission plugin.
+func New() (*CapabilityValidator, error) {
+	return &CapabilityValidator{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (c *CapabilityValidator) AssignReadyFunc(f admission.ReadyFunc) {
+	c.readyFunc = f
+	c.SetReadyFunc(f)
+}
+
+// SetKubeInformerFactory gets Lister from SharedInformerFactory.
+func (c *CapabilityValidator) SetKubeInformerFactory(f kubeinformers.SharedInformerFactory) {
+	configMapInformer := f.Core().V1().ConfigMaps()
+	c.configMapLister = configMapInformer.Lister()
+
+	readyFuncs = append(readyFuncs, configMapInformer.Informer().HasSynced)
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (c *CapabilityValidator) ValidateInitialization() error {
+	if c.configMapLister == nil {
+		return errors.New("missing configMap lister")
+	}
+	return nil
+}
+
+// Validate rejects CloudProfiles referencing machine types, volume types, regions or zones which are not part of the
+// capability matrix published for their cloud provider. On updates, only newly added entries are validated so that
+// capabilities withdrawn by the provider do not block unrelated changes.
+func (c *CapabilityValidator) Validate(a admission.Attributes) error {
+	// Wait until the caches have been synced
+	if c.readyFunc == nil {
+		c.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	if !c.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+
+	// Ignore all kinds other than CloudProfile
+	if a.GetKind().GroupKind() != garden.Kind("CloudProfile") {
+		return nil
+	}
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	cloudProfile, ok := a.GetObject().(*garden.CloudProfile)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into CloudProfile object")
+	}
+
+	var oldCloudProfile *garden.CloudProfile
+	if a.GetOperation() == admission.Update {
+		oldCloudProfile, ok = a.GetOldObject().(*garden.CloudProfile)
+		if !ok {
+			return apierrors.NewBadRequest("could not convert old resource into CloudProfile object")
+		}
+	}
+
+	cloudProvider, err := helper.DetermineCloudProviderInProfile(cloudProfile.Spec)
+	if err != nil {
+		// The validation of the CloudProfile reports the missing or ambiguous cloud provider.
+		return nil
+	}
+
+	offered, err := c.capabilitiesOf(cloudProvider)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	if offered == nil {
+		return nil
+	}
+
+	if allErrs := validateCapabilities(cloudProvider, cloudProfile, oldCloudProfile, offered); len(allErrs) > 0 {
+		return admission.NewForbidden(a, allErrs.ToAggregate())
+	}
+	return nil
+}
+
+// capabilitiesOf returns the union of all capability matrices published for the given cloud provider (nil if there
+// are none). Matrices which cannot be parsed are ignored.
+func (c *CapabilityValidator) capabilitiesOf(cloudProvider garden.CloudProvider) (*offeredCapabilities, error) {
+	configMaps, err := c.configMapLister.List(labels.SelectorFromSet(labels.Set{common.GardenRole: common.GardenRoleCloudProfileCapabilities}))
+	if err != nil {
+		return nil, err
+	}
+
+	var result *offeredCapabilities
+	for _, configMap := range configMaps {
+		data, ok := configMap.Data[string(cloudProvider)]
+		if !ok {
+			continue
+		}
+
+		matrix := &CapabilityMatrix{}
+		if err := yaml.Unmarshal([]byte(data), matrix); err != nil {
+			utilruntime.HandleError(fmt.Errorf("could not parse capabilities of cloud provider %s in config map %s/%s: %v", cloudProvider, configMap.Namespace, configMap.Name, err))
+			continue
+		}
+
+		if result == nil {
+			result = &offeredCapabilities{}
+		}
+		if matrix.MachineTypes != nil {
+			if result.machineTypes == nil {
+				result.machineTypes = sets.NewString()
+			}
+			result.machineTypes.Insert(matrix.MachineTypes...)
+		}
+		if matrix.VolumeTypes != nil {
+			if result.volumeTypes == nil {
+				result.volumeTypes = sets.NewString()
+			}
+			result.volumeTypes.Insert(matrix.VolumeTypes...)
+		}
+		if matrix.Zones != nil {
+			if result.zones == nil {
+				result.zones = map[string]sets.String{}
+			}
+			for region, zones := range matrix.Zones {
+				if result.zones[region] == nil {
+					result.zones[region] = sets.NewString()
+				}
+				result.zones[region].Insert(zones...)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func validateCapabilities(cloudProvider garden.CloudProvider, cloudProfile, oldCloudProfile *garden.CloudProfile, offered *offeredCapabilities) field.ErrorList {
+	var (
+		allErrs                          = field.ErrorList{}
+		fldPath                          = field.NewPath("spec", string(cloudProvider), "constraints")
+		machineTypes, volumeTypes, zones = constraintsOf(cloudProvider, cloudProfile)
+		oldMachineTypes, oldVolumeTypes  = sets.NewString(), sets.NewString()
+		oldZones                         = map[string]sets.String{}
+	)
+
+	if oldCloudProfile != nil {
+		if oldCloudProvider, err := helper.DetermineCloudProviderInProfile(oldCloudProfile.Spec); err == nil && oldCloudProvider == cloudProvider {
+			machineTypes, volumeTypes, zones := constraintsOf(cloudProvider, oldCloudProfile)
+			oldMachineTypes.Insert(machineTypes...)
+			oldVolumeTypes.Insert(volumeTypes...)
+			for _, zone := range zones {
+				oldZones[zone.Region] = sets.NewString(zone.Names...)
+			}
+		}
+	}
+
+	if offered.machineTypes != nil {
+		for i, machineType := range machineTypes {
+			if !offered.machineTypes.Has(machineType) && !oldMachineTypes.Has(machineType) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("machineTypes").Index(i).Child("name"), machineType, "machine type is not offered by the cloud provider"))
+			}
+		}
+	}
+
+	if offered.volumeTypes != nil {
+		for i, volumeType := range volumeTypes {
+			if !offered.volumeTypes.Has(volumeType) && !oldVolumeTypes.Has(volumeType) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("volumeTypes").Index(i).Child("name"), volumeType, "volume type is not offered by the cloud provider"))
+			}
+		}
+	}
+
+	if offered.zones != nil {
+		for i, zone := range zones {
+			idxPath := fldPath.Child("zones").Index(i)
+
+			offeredZones, ok := offered.zones[zone.Region]
+			if !ok {
+				if _, ok := oldZones[zone.Region]; !ok {
+					allErrs = append(allErrs, field.Invalid(idxPath.Child("region"), zone.Region, "region is not offered by the cloud provider"))
+				}
+				continue
+			}
+
+			for j, name := range zone.Names {
+				if !offeredZones.Has(name) && !oldZones[zone.Region].Has(name) {
+					allErrs = append(allErrs, field.Invalid(idxPath.Child("names").Index(j), name, "zone is not offered by the cloud provider in this region"))
+				}
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// constraintsOf returns the names of the machine types and volume types as well as the zones of the given CloudProfile,
+// in the order of their definition.
+func constraintsOf(cloudProvider garden.CloudProvider, cloudProfile *garden.CloudProfile) ([]string, []string, []garden.Zone) {
+	var (
+		machineTypes []string
+		volumeTypes  []string
+		zones        []garden.Zone
+	)
+
+	switch cloudProvider {
+	case garden.CloudProviderAWS:
+		for _, machineType := range cloudProfile.Spec.AWS.Constraints.MachineTypes {
+			machineTypes = append(machineTypes, machineType.Name)
+		}
+		for _, volumeType := range cloudProfile.Spec.AWS.Constraints.VolumeTypes {
+			volumeTypes = append(volumeTypes, volumeType.Name)
+		}
+		zones = cloudProfile.Spec.AWS.Constraints.Zones
+	case garden.CloudProviderAzure:
+		for _, machineType := range cloudProfile.Spec.Azure.Constraints.MachineTypes {
+			machineTypes = append(machineTypes, machineType.Name)
+		}
+		for _, volumeType := range cloudProfile.Spec.Azure.Constraints.VolumeTypes {
+			volumeTypes = append(volumeTypes, volumeType.Name)
+		}
+	case garden.CloudProviderGCP:
+		for _, machineType := range cloudProfile.Spec.GCP.Constraints.MachineTypes {
+			machineTypes = append(machineTypes, machineType.Name)
+		}
+		for _, volumeType := range cloudProfile.Spec.GCP.Constraints.VolumeTypes {
+			volumeTypes = append(volumeTypes, volumeType.Name)
+		}
+		zones = cloudProfile.Spec.GCP.Constraints.Zones
+	case garden.CloudProviderOpenStack:
+		for _, machineType := range cloudProfile.Spec.OpenStack.Constraints.MachineTypes {
+			machineTypes = append(machineTypes, machineType.Name)
+		}
+		zones = cloudProfile.Spec.OpenStack.Constraints.Zones
+	case garden.CloudProviderAlicloud:
+		for _, machineType := range cloudProfile.Spec.Alicloud.Constraints.MachineTypes {
+			machineTypes = append(machineTypes, machineType.Name)
+		}
+		for _, volumeType := range cloudProfile.Spec.Alicloud.Constraints.VolumeTypes {
+			volumeTypes = append(volumeTypes, volumeType.Name)
+		}
+		zones = cloudProfile.Spec.Alicloud.Constraints.Zones
+	}
+
+	return machineTypes, volumeTypes, zones
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capabilities_test
+
+import (
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/plugin/pkg/cloudprofile/capabilities"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	kubeinformers "k8s.io/client-go/informers"
+)
+
+var _ = Describe("capabilities", func() {
+	Describe("#Validate", func() {
+		var (
+			admissionHandler    *CapabilityValidator
+			kubeInformerFactory kubeinformers.SharedInformerFactory
+			cloudProfile        *garden.CloudProfile
+
+			capabilities = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "aws-capabilities",
+					Namespace: "garden",
+					Labels: map[string]string{
+						common.GardenRole: common.GardenRoleCloudProfileCapabilities,
+					},
+				},
+				Data: map[string]string{
+					"aws": `machineTypes: [m5.large, m5.xlarge]
+volumeTypes: [gp2]
+zones:
+  eu-west-1: [eu-west-1a, eu-west-1b]
+`,
+				},
+			}
+		)
+
+		BeforeEach(func() {
+			admissionHandler, _ = New()
+			admissionHandler.AssignReadyFunc(func() bool { return true })
+			kubeInformerFactory = kubeinformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetKubeInformerFactory(kubeInformerFactory)
+
+			cloudProfile = &garden.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "aws",
+				},
+				Spec: garden.CloudProfileSpec{
+					AWS: &garden.AWSProfile{
+						Constraints: garden.AWSConstraints{
+							MachineTypes: []garden.MachineType{{Name: "m5.large"}},
+							VolumeTypes:  []garden.VolumeType{{Name: "gp2"}},
+							Zones: []garden.Zone{
+								{Region: "eu-west-1", Names: []string{"eu-west-1a"}},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		validate := func(cloudProfile, oldCloudProfile *garden.CloudProfile) error {
+			operation := admission.Create
+			if oldCloudProfile != nil {
+				operation = admission.Update
+			}
+			attrs := admission.NewAttributesRecord(cloudProfile, oldCloudProfile, garden.Kind("CloudProfile").WithVersion("version"), "", cloudProfile.Name, garden.Resource("cloudprofiles").WithVersion("version"), "", operation, false, nil)
+			return admissionHandler.Validate(attrs)
+		}
+
+		It("should allow any CloudProfile if no capabilities are published", func() {
+			cloudProfile.Spec.AWS.Constraints.MachineTypes = append(cloudProfile.Spec.AWS.Constraints.MachineTypes, garden.MachineType{Name: "does-not-exist"})
+
+			Expect(validate(cloudProfile, nil)).To(Succeed())
+		})
+
+		It("should allow CloudProfiles which only reference offered capabilities", func() {
+			kubeInformerFactory.Core().V1().ConfigMaps().Informer().GetStore().Add(capabilities)
+
+			Expect(validate(cloudProfile, nil)).To(Succeed())
+		})
+
+		It("should reject CloudProfiles referencing capabilities which are not offered", func() {
+			kubeInformerFactory.Core().V1().ConfigMaps().Informer().GetStore().Add(capabilities)
+			cloudProfile.Spec.AWS.Constraints.MachineTypes = append(cloudProfile.Spec.AWS.Constraints.MachineTypes, garden.MachineType{Name: "m5.huge"})
+			cloudProfile.Spec.AWS.Constraints.VolumeTypes = append(cloudProfile.Spec.AWS.Constraints.VolumeTypes, garden.VolumeType{Name: "io2"})
+			cloudProfile.Spec.AWS.Constraints.Zones = append(cloudProfile.Spec.AWS.Constraints.Zones, garden.Zone{Region: "eu-east-1", Names: []string{"eu-east-1a"}})
+			cloudProfile.Spec.AWS.Constraints.Zones[0].Names = append(cloudProfile.Spec.AWS.Constraints.Zones[0].Names, "eu-west-1z")
+
+			err := validate(cloudProfile, nil)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.aws.constraints.machineTypes[1].name"))
+			Expect(err.Error()).To(ContainSubstring("spec.aws.constraints.volumeTypes[1].name"))
+			Expect(err.Error()).To(ContainSubstring("spec.aws.constraints.zones[0].names[1]"))
+			Expect(err.Error()).To(ContainSubstring("spec.aws.constraints.zones[1].region"))
+		})
+
+		It("should ignore capabilities published for other cloud providers", func() {
+			otherCapabilities := capabilities.DeepCopy()
+			otherCapabilities.Data = map[string]string{"gcp": `machineTypes: [n1-standard-2]`}
+			kubeInformerFactory.Core().V1().ConfigMaps().Informer().GetStore().Add(otherCapabilities)
+
+			Expect(validate(cloudProfile, nil)).To(Succeed())
+		})
+
+		It("should combine the capabilities of multiple config maps", func() {
+			moreCapabilities := capabilities.DeepCopy()
+			moreCapabilities.Name = "aws-capabilities-2"
+			moreCapabilities.Data = map[string]string{"aws": `machineTypes: [m5.huge]`}
+			kubeInformerFactory.Core().V1().ConfigMaps().Informer().GetStore().Add(capabilities)
+			kubeInformerFactory.Core().V1().ConfigMaps().Informer().GetStore().Add(moreCapabilities)
+			cloudProfile.Spec.AWS.Constraints.MachineTypes = append(cloudProfile.Spec.AWS.Constraints.MachineTypes, garden.MachineType{Name: "m5.huge"})
+
+			Expect(validate(cloudProfile, nil)).To(Succeed())
+		})
+
+		It("should only validate newly added entries on updates", func() {
+			kubeInformerFactory.Core().V1().ConfigMaps().Informer().GetStore().Add(capabilities)
+			oldCloudProfile := cloudProfile.DeepCopy()
+			oldCloudProfile.Spec.AWS.Constraints.MachineTypes = append(oldCloudProfile.Spec.AWS.Constraints.MachineTypes, garden.MachineType{Name: "m4.withdrawn"})
+			cloudProfile = oldCloudProfile.DeepCopy()
+			cloudProfile.Spec.AWS.Constraints.MachineTypes = append(cloudProfile.Spec.AWS.Constraints.MachineTypes, garden.MachineType{Name: "m5.xlarge"})
+
+			Expect(validate(cloudProfile, oldCloudProfile)).To(Succeed())
+
+			cloudProfile.Spec.AWS.Constraints.MachineTypes = append(cloudProfile.Spec.AWS.Constraints.MachineTypes, garden.MachineType{Name: "m5.huge"})
+
+			Expect(validate(cloudProfile, oldCloudProfile)).NotTo(Succeed())
+		})
+
+		It("should ignore capabilities which cannot be parsed", func() {
+			brokenCapabilities := capabilities.DeepCopy()
+			brokenCapabilities.Data = map[string]string{"aws": `machineTypes: {`}
+			kubeInformerFactory.Core().V1().ConfigMaps().Informer().GetStore().Add(brokenCapabilities)
+			cloudProfile.Spec.AWS.Constraints.MachineTypes = append(cloudProfile.Spec.AWS.Constraints.MachineTypes, garden.MachineType{Name: "m5.huge"})
+
+			Expect(validate(cloudProfile, nil)).To(Succeed())
+		})
+	})
+})
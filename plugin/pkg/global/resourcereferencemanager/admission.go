@@ -27,6 +27,7 @@ import (
 	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
 	"github.com/gardener/gardener/pkg/operation/common"
 
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -256,15 +257,26 @@ func (r *ReferenceManager) Admit(a admission.Attributes) error {
 			}
 		}
 
+		var oldProject *garden.Project
+		if a.GetOperation() == admission.Update {
+			oldProject, ok = a.GetOldObject().(*garden.Project)
+			if !ok {
+				return apierrors.NewBadRequest("could not convert old resource into Project object")
+			}
+		}
+		if err := r.ensureProjectQuotaReferences(a, project, oldProject); err != nil {
+			return admission.NewForbidden(a, err)
+		}
+
 		if project.Spec.Owner != nil {
 			ownerPartOfMember := false
 			for _, member := range project.Spec.Members {
-				if member == *project.Spec.Owner {
+				if member.Subject == *project.Spec.Owner {
 					ownerPartOfMember = true
 				}
 			}
 			if !ownerPartOfMember {
-				project.Spec.Members = append(project.Spec.Members, *project.Spec.Owner)
+				project.Spec.Members = append(project.Spec.Members, garden.ProjectMember{Subject: *project.Spec.Owner})
 			}
 		}
 	}
@@ -335,6 +347,64 @@ func (r *ReferenceManager) ensureSecretBindingReferences(attributes admission.At
 	return nil
 }
 
+// ensureProjectQuotaReferences ensures that the quotas referenced by the project exist and are of scope project.
+// Only users which are allowed to update a quota may add or remove the reference to it, otherwise the members of
+// a project could lift the limits of their own project.
+func (r *ReferenceManager) ensureProjectQuotaReferences(attributes admission.Attributes, project, oldProject *garden.Project) error {
+	var (
+		oldQuotas     = make(map[corev1.ObjectReference]bool)
+		newQuotas     = make(map[corev1.ObjectReference]bool, len(project.Spec.Quotas))
+		changedQuotas []corev1.ObjectReference
+	)
+
+	if oldProject != nil {
+		for _, quotaRef := range oldProject.Spec.Quotas {
+			oldQuotas[quotaRef] = true
+		}
+	}
+	for _, quotaRef := range project.Spec.Quotas {
+		newQuotas[quotaRef] = true
+		if !oldQuotas[quotaRef] {
+			changedQuotas = append(changedQuotas, quotaRef)
+		}
+	}
+	for quotaRef := range oldQuotas {
+		if !newQuotas[quotaRef] {
+			changedQuotas = append(changedQuotas, quotaRef)
+		}
+	}
+
+	for _, quotaRef := range changedQuotas {
+		updateAttributes := authorizer.AttributesRecord{
+			User:            attributes.GetUserInfo(),
+			Verb:            "update",
+			APIGroup:        gardenv1beta1.SchemeGroupVersion.Group,
+			APIVersion:      gardenv1beta1.SchemeGroupVersion.Version,
+			Resource:        "quotas",
+			Namespace:       quotaRef.Namespace,
+			Name:            quotaRef.Name,
+			ResourceRequest: true,
+		}
+		if decision, _, _ := r.authorizer.Authorize(updateAttributes); decision != authorizer.DecisionAllow {
+			return fmt.Errorf("Project cannot add or remove a reference to quota %s/%s you are not allowed to update", quotaRef.Namespace, quotaRef.Name)
+		}
+
+		if !newQuotas[quotaRef] {
+			continue
+		}
+
+		quota, err := r.quotaLister.Quotas(quotaRef.Namespace).Get(quotaRef.Name)
+		if err != nil {
+			return err
+		}
+		if quota.Spec.Scope != garden.QuotaScopeProject {
+			return fmt.Errorf("Project can only reference quotas of scope %s", garden.QuotaScopeProject)
+		}
+	}
+
+	return nil
+}
+
 func (r *ReferenceManager) ensureSeedReferences(seed *garden.Seed) error {
 	if _, err := r.cloudProfileLister.Get(seed.Spec.Cloud.Profile); err != nil {
 		return err
@@ -510,12 +510,83 @@ var _ = Describe("resourcereferencemanager", func() {
 				err := admissionHandler.Admit(attrs)
 
 				Expect(err).NotTo(HaveOccurred())
-				Expect(project.Spec.Members).To(ContainElement(Equal(rbacv1.Subject{
-					APIGroup: "rbac.authorization.k8s.io",
-					Kind:     rbacv1.UserKind,
-					Name:     defaultUserName,
+				Expect(project.Spec.Members).To(ContainElement(Equal(garden.ProjectMember{
+					Subject: rbacv1.Subject{
+						APIGroup: "rbac.authorization.k8s.io",
+						Kind:     rbacv1.UserKind,
+						Name:     defaultUserName,
+					},
 				})))
 			})
+
+			It("should accept references to quotas of scope project added by users allowed to update them", func() {
+				gardenInformerFactory.Garden().InternalVersion().Quotas().Informer().GetStore().Add(&quota)
+
+				newProject := project.DeepCopy()
+				newProject.Spec.Quotas = []corev1.ObjectReference{{Name: quotaName, Namespace: namespace}}
+
+				user := &user.DefaultInfo{Name: allowedUser}
+				attrs := admission.NewAttributesRecord(newProject, &project, garden.Kind("Project").WithVersion("version"), project.Namespace, project.Name, garden.Resource("projects").WithVersion("version"), "", admission.Update, false, user)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject references to quotas added by users not allowed to update them", func() {
+				gardenInformerFactory.Garden().InternalVersion().Quotas().Informer().GetStore().Add(&quota)
+
+				newProject := project.DeepCopy()
+				newProject.Spec.Quotas = []corev1.ObjectReference{{Name: quotaName, Namespace: namespace}}
+
+				attrs := admission.NewAttributesRecord(newProject, &project, garden.Kind("Project").WithVersion("version"), project.Namespace, project.Name, garden.Resource("projects").WithVersion("version"), "", admission.Update, false, defaultUserInfo)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should reject removing references to quotas by users not allowed to update them", func() {
+				oldProject := project.DeepCopy()
+				oldProject.Spec.Quotas = []corev1.ObjectReference{{Name: quotaName, Namespace: namespace}}
+
+				attrs := admission.NewAttributesRecord(&project, oldProject, garden.Kind("Project").WithVersion("version"), project.Namespace, project.Name, garden.Resource("projects").WithVersion("version"), "", admission.Update, false, defaultUserInfo)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should accept unchanged references to quotas", func() {
+				gardenInformerFactory.Garden().InternalVersion().Quotas().Informer().GetStore().Add(&quota)
+
+				oldProject := project.DeepCopy()
+				oldProject.Spec.Quotas = []corev1.ObjectReference{{Name: quotaName, Namespace: namespace}}
+				newProject := oldProject.DeepCopy()
+				newProject.Spec.Description = &projectName
+
+				attrs := admission.NewAttributesRecord(newProject, oldProject, garden.Kind("Project").WithVersion("version"), project.Namespace, project.Name, garden.Resource("projects").WithVersion("version"), "", admission.Update, false, defaultUserInfo)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject references to quotas of scope secret", func() {
+				secretQuota := quota.DeepCopy()
+				secretQuota.Spec.Scope = garden.QuotaScopeSecret
+				gardenInformerFactory.Garden().InternalVersion().Quotas().Informer().GetStore().Add(secretQuota)
+
+				newProject := project.DeepCopy()
+				newProject.Spec.Quotas = []corev1.ObjectReference{{Name: quotaName, Namespace: namespace}}
+
+				user := &user.DefaultInfo{Name: allowedUser}
+				attrs := admission.NewAttributesRecord(newProject, &project, garden.Kind("Project").WithVersion("version"), project.Namespace, project.Name, garden.Resource("projects").WithVersion("version"), "", admission.Update, false, user)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).To(HaveOccurred())
+			})
 		})
 	})
 })
@@ -0,0 +1,275 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package costestimator
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/apis/garden/helper"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	informers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	listers "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	"github.com/ghodss/yaml"
+	"gopkg.in/inf.v0"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+const (
+	// PluginName is the name of this admission plugin.
+	PluginName = "ShootCostEstimator"
+
+	defaultEndpointTimeout = 5 * time.Second
+)
+
+// Configuration is the configuration of the ShootCostEstimator admission plugin.
+type Configuration struct {
+	// Endpoint is the URL of an external cost model. If it is empty then the prices of the machine and volume types
+	// maintained in the CloudProfiles are used.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+	// Timeout is the timeout for requests to the external cost model. Defaults to 5s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		costModel, err := newCostModel(config)
+		if err != nil {
+			return nil, err
+		}
+		return New(costModel)
+	})
+}
+
+func newCostModel(config io.Reader) (CostModel, error) {
+	if config == nil {
+		return NewCloudProfileCostModel(), nil
+	}
+
+	data, err := ioutil.ReadAll(config)
+	if err != nil {
+		return nil, err
+	}
+	configuration := &Configuration{}
+	if err := yaml.Unmarshal(data, configuration); err != nil {
+		return nil, fmt.Errorf("could not parse configuration of admission plugin %s: %v", PluginName, err)
+	}
+
+	if len(configuration.Endpoint) == 0 {
+		return NewCloudProfileCostModel(), nil
+	}
+
+	timeout := defaultEndpointTimeout
+	if configuration.Timeout != nil {
+		timeout = configuration.Timeout.Duration
+	}
+	return NewExternalCostModel(configuration.Endpoint, timeout), nil
+}
+
+// CostEstimator contains listers and and admission handler.
+type CostEstimator struct {
+	*admission.Handler
+	cloudProfileLister listers.CloudProfileLister
+	costModel          CostModel
+	readyFunc          admission.ReadyFunc
+}
+
+var (
+	_ = admissioninitializer.WantsInternalGardenInformerFactory(&CostEstimator{})
+
+	readyFuncs = []admission.ReadyFunc{}
+)
+
+// New creates a new CostEstimator admission plugin.
+func New(costModel CostModel) (*CostEstimator, error) {
+	return &CostEstimator{
+		Handler:   admission.NewHandler(admission.Create, admission.Update),
+		costModel: costModel,
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (c *CostEstimator) AssignReadyFunc(f admission.ReadyFunc) {
+	c.readyFunc = f
+	c.SetReadyFunc(f)
+}
+
+// SetInternalGardenInformerFactory gets Lister from SharedInformerFactory.
+func (c *CostEstimator) SetInternalGardenInformerFactory(f informers.SharedInformerFactory) {
+	cloudProfileInformer := f.Garden().InternalVersion().CloudProfiles()
+	c.cloudProfileLister = cloudProfileInformer.Lister()
+
+	readyFuncs = append(readyFuncs, cloudProfileInformer.Informer().HasSynced)
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (c *CostEstimator) ValidateInitialization() error {
+	if c.cloudProfileLister == nil {
+		return errors.New("missing cloudProfile lister")
+	}
+	if c.costModel == nil {
+		return errors.New("missing cost model")
+	}
+	return nil
+}
+
+// Admit estimates the monthly cost of the Shoot's worker machines and records it as annotation on the Shoot.
+// Failures of the estimation do not reject the request, the annotation is removed instead.
+func (c *CostEstimator) Admit(a admission.Attributes) error {
+	// Wait until the caches have been synced
+	if c.readyFunc == nil {
+		c.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	if !c.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+
+	// Ignore all kinds other than Shoot
+	if a.GetKind().GroupKind() != garden.Kind("Shoot") {
+		return nil
+	}
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*garden.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	// Pass if the shoot is intended to get deleted
+	if shoot.DeletionTimestamp != nil {
+		return nil
+	}
+
+	cost, err := c.estimateMonthlyCost(shoot)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("could not estimate the monthly cost of shoot %s/%s: %v", shoot.Namespace, shoot.Name, err))
+	}
+
+	if cost == nil {
+		delete(shoot.Annotations, common.ShootEstimatedMonthlyCost)
+		return nil
+	}
+
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, common.ShootEstimatedMonthlyCost, cost.String())
+	return nil
+}
+
+func (c *CostEstimator) estimateMonthlyCost(shoot *garden.Shoot) (*inf.Dec, error) {
+	cloudProfile, err := c.cloudProfileLister.Get(shoot.Spec.Cloud.Profile)
+	if err != nil {
+		return nil, fmt.Errorf("could not find referenced cloud profile: %v", err)
+	}
+
+	cloudProvider, err := helper.DetermineCloudProviderInShoot(shoot.Spec.Cloud)
+	if err != nil {
+		return nil, fmt.Errorf("could not identify the cloud provider kind in the Shoot resource: %v", err)
+	}
+
+	machines, err := getShootMachines(shoot, cloudProvider, cloudProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.costModel.EstimateMonthlyCost(cloudProfile, shoot.Spec.Cloud.Region, machines)
+}
+
+// getShootMachines returns the machines of all worker pools of the given Shoot. The maximum number of machines of a
+// worker pool is used, i.e., the estimation is the upper bound of the Shoot's cost.
+func getShootMachines(shoot *garden.Shoot, cloudProvider garden.CloudProvider, cloudProfile *garden.CloudProfile) ([]Machine, error) {
+	var machines []Machine
+
+	addMachine := func(worker garden.Worker, volumeType, volumeSize string) error {
+		size, err := resource.ParseQuantity(volumeSize)
+		if err != nil {
+			return fmt.Errorf("invalid volume size %q of worker %s: %v", volumeSize, worker.Name, err)
+		}
+		machines = append(machines, Machine{
+			MachineType: worker.MachineType,
+			Count:       worker.AutoScalerMax,
+			VolumeType:  volumeType,
+			VolumeSize:  size,
+		})
+		return nil
+	}
+
+	switch cloudProvider {
+	case garden.CloudProviderAWS:
+		for _, worker := range shoot.Spec.Cloud.AWS.Workers {
+			if err := addMachine(worker.Worker, worker.VolumeType, worker.VolumeSize); err != nil {
+				return nil, err
+			}
+		}
+	case garden.CloudProviderAzure:
+		for _, worker := range shoot.Spec.Cloud.Azure.Workers {
+			if err := addMachine(worker.Worker, worker.VolumeType, worker.VolumeSize); err != nil {
+				return nil, err
+			}
+		}
+	case garden.CloudProviderGCP:
+		for _, worker := range shoot.Spec.Cloud.GCP.Workers {
+			if err := addMachine(worker.Worker, worker.VolumeType, worker.VolumeSize); err != nil {
+				return nil, err
+			}
+		}
+	case garden.CloudProviderAlicloud:
+		for _, worker := range shoot.Spec.Cloud.Alicloud.Workers {
+			if err := addMachine(worker.Worker, worker.VolumeType, worker.VolumeSize); err != nil {
+				return nil, err
+			}
+		}
+	case garden.CloudProviderOpenStack:
+		if cloudProfile.Spec.OpenStack == nil {
+			return nil, fmt.Errorf("cloud profile %s does not contain an OpenStack specification", cloudProfile.Name)
+		}
+		// The root volumes of OpenStack machines are part of the machine type, hence, they have no separate price.
+		for _, worker := range shoot.Spec.Cloud.OpenStack.Workers {
+			machine := Machine{
+				MachineType: worker.MachineType,
+				Count:       worker.AutoScalerMax,
+			}
+			for _, machineType := range cloudProfile.Spec.OpenStack.Constraints.MachineTypes {
+				if machineType.Name == worker.MachineType {
+					machine.VolumeSize = machineType.VolumeSize
+					break
+				}
+			}
+			machines = append(machines, machine)
+		}
+	}
+
+	return machines, nil
+}
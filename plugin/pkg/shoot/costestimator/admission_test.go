@@ -0,0 +1,210 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package costestimator_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/costestimator"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+var _ = Describe("costestimator", func() {
+	Describe("#Admit", func() {
+		var (
+			admissionHandler      *CostEstimator
+			gardenInformerFactory gardeninformers.SharedInformerFactory
+			shoot                 garden.Shoot
+			cloudProfile          garden.CloudProfile
+			machineTypeName       = "n1-standard-2"
+			volumeTypeName        = "pd-standard"
+			machinePrice          = resource.MustParse("0.1")
+			volumePrice           = resource.MustParse("0.05")
+
+			cloudProfileBase = garden.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "profile",
+				},
+				Spec: garden.CloudProfileSpec{
+					GCP: &garden.GCPProfile{
+						Constraints: garden.GCPConstraints{
+							MachineTypes: []garden.MachineType{
+								{
+									Name:   machineTypeName,
+									CPU:    resource.MustParse("2"),
+									GPU:    resource.MustParse("0"),
+									Memory: resource.MustParse("5Gi"),
+									Price:  &machinePrice,
+								},
+							},
+							VolumeTypes: []garden.VolumeType{
+								{
+									Name:  volumeTypeName,
+									Class: "standard",
+									Price: &volumePrice,
+								},
+							},
+						},
+					},
+				},
+			}
+
+			shootBase = garden.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "garden-test",
+					Name:      "test-shoot",
+				},
+				Spec: garden.ShootSpec{
+					Cloud: garden.Cloud{
+						Profile: "profile",
+						Region:  "europe-west1",
+						GCP: &garden.GCPCloud{
+							Workers: []garden.GCPWorker{
+								{
+									Worker: garden.Worker{
+										Name:          "test-worker-1",
+										MachineType:   machineTypeName,
+										AutoScalerMin: 1,
+										AutoScalerMax: 2,
+									},
+									VolumeType: volumeTypeName,
+									VolumeSize: "20Gi",
+								},
+							},
+						},
+					},
+				},
+			}
+
+			admit = func() error {
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+				return admissionHandler.Admit(attrs)
+			}
+		)
+
+		BeforeEach(func() {
+			shoot = *shootBase.DeepCopy()
+			cloudProfile = *cloudProfileBase.DeepCopy()
+
+			admissionHandler, _ = New(NewCloudProfileCostModel())
+			admissionHandler.AssignReadyFunc(func() bool { return true })
+			gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+			gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+		})
+
+		Context("cost model based on the CloudProfile", func() {
+			It("should annotate the Shoot with the estimated monthly cost", func() {
+				Expect(admit()).To(Succeed())
+
+				// 2 * 0.1 * 730 (machines) + 2 * 20 * 0.05 (volumes)
+				Expect(shoot.Annotations).To(HaveKeyWithValue(common.ShootEstimatedMonthlyCost, "148.00"))
+			})
+
+			It("should remove the annotation if the price of a machine type is unknown", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, common.ShootEstimatedMonthlyCost, "1.00")
+				cloudProfile.Spec.GCP.Constraints.MachineTypes[0].Price = nil
+
+				Expect(admit()).To(Succeed())
+				Expect(shoot.Annotations).NotTo(HaveKey(common.ShootEstimatedMonthlyCost))
+			})
+
+			It("should remove the annotation if the price of a volume type is unknown", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, common.ShootEstimatedMonthlyCost, "1.00")
+				cloudProfile.Spec.GCP.Constraints.VolumeTypes[0].Price = nil
+
+				Expect(admit()).To(Succeed())
+				Expect(shoot.Annotations).NotTo(HaveKey(common.ShootEstimatedMonthlyCost))
+			})
+
+			It("should not reject Shoots referencing an unknown cloud profile but remove the annotation", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, common.ShootEstimatedMonthlyCost, "1.00")
+				shoot.Spec.Cloud.Profile = "unknown"
+
+				Expect(admit()).To(Succeed())
+				Expect(shoot.Annotations).NotTo(HaveKey(common.ShootEstimatedMonthlyCost))
+			})
+
+			It("should not reject Shoots with an invalid volume size but remove the annotation", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, common.ShootEstimatedMonthlyCost, "1.00")
+				shoot.Spec.Cloud.GCP.Workers[0].VolumeSize = "invalid"
+
+				Expect(admit()).To(Succeed())
+				Expect(shoot.Annotations).NotTo(HaveKey(common.ShootEstimatedMonthlyCost))
+			})
+		})
+
+		Context("external cost model", func() {
+			var (
+				server   *httptest.Server
+				handler  http.HandlerFunc
+				received *EstimationRequest
+			)
+
+			BeforeEach(func() {
+				received = nil
+				server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					handler(w, r)
+				}))
+				admissionHandler, _ = New(NewExternalCostModel(server.URL, time.Second))
+				admissionHandler.AssignReadyFunc(func() bool { return true })
+				admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+			})
+
+			AfterEach(func() {
+				server.Close()
+			})
+
+			It("should annotate the Shoot with the cost returned by the endpoint", func() {
+				handler = func(w http.ResponseWriter, r *http.Request) {
+					received = &EstimationRequest{}
+					Expect(json.NewDecoder(r.Body).Decode(received)).To(Succeed())
+					Expect(json.NewEncoder(w).Encode(&EstimationResponse{MonthlyCost: "12.345"})).To(Succeed())
+				}
+
+				Expect(admit()).To(Succeed())
+				Expect(shoot.Annotations).To(HaveKeyWithValue(common.ShootEstimatedMonthlyCost, "12.35"))
+				Expect(received.CloudProfile).To(Equal("profile"))
+				Expect(received.Region).To(Equal("europe-west1"))
+				Expect(received.Machines).To(HaveLen(1))
+				Expect(received.Machines[0].MachineType).To(Equal(machineTypeName))
+				Expect(received.Machines[0].Count).To(Equal(2))
+				Expect(received.Machines[0].VolumeType).To(Equal(volumeTypeName))
+				Expect(received.Machines[0].VolumeSize.Cmp(resource.MustParse("20Gi"))).To(Equal(0))
+			})
+
+			It("should not reject the Shoot but remove the annotation if the endpoint fails", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, common.ShootEstimatedMonthlyCost, "1.00")
+				handler = func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+
+				Expect(admit()).To(Succeed())
+				Expect(shoot.Annotations).NotTo(HaveKey(common.ShootEstimatedMonthlyCost))
+			})
+		})
+	})
+})
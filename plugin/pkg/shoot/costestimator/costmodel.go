@@ -0,0 +1,237 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package costestimator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+
+	"gopkg.in/inf.v0"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// HoursPerMonth is the number of hours which are used to compute the monthly cost of a machine.
+const HoursPerMonth = 730
+
+var bytesPerGiB = inf.NewDec(1<<30, 0)
+
+// Machine describes a group of worker machines of a Shoot whose cost shall be estimated.
+type Machine struct {
+	// MachineType is the name of the machine type.
+	MachineType string `json:"machineType"`
+	// Count is the number of machines.
+	Count int `json:"count"`
+	// VolumeType is the name of the volume type of the root volumes. It is empty if the volume is part of the machine type.
+	VolumeType string `json:"volumeType,omitempty"`
+	// VolumeSize is the size of one root volume.
+	VolumeSize resource.Quantity `json:"volumeSize"`
+}
+
+// CostModel computes the estimated monthly cost of a set of machines.
+type CostModel interface {
+	// EstimateMonthlyCost returns the estimated monthly cost of the given machines in the given region. It returns nil
+	// if no estimation is possible, e.g. because no prices are known for the machine or volume types.
+	EstimateMonthlyCost(cloudProfile *garden.CloudProfile, region string, machines []Machine) (*inf.Dec, error)
+}
+
+// NewCloudProfileCostModel returns a CostModel which computes the cost based on the prices of the machine and volume
+// types maintained in the CloudProfile.
+func NewCloudProfileCostModel() CostModel {
+	return &cloudProfileCostModel{}
+}
+
+type cloudProfileCostModel struct{}
+
+func (c *cloudProfileCostModel) EstimateMonthlyCost(cloudProfile *garden.CloudProfile, region string, machines []Machine) (*inf.Dec, error) {
+	cloudProvider, err := cloudProviderOfCloudProfile(cloudProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		total        = new(inf.Dec)
+		machineTypes = getMachineTypes(cloudProvider, cloudProfile)
+		volumeTypes  = getVolumeTypes(cloudProvider, cloudProfile)
+	)
+
+	for _, machine := range machines {
+		machineType, ok := machineTypes[machine.MachineType]
+		if !ok || machineType.Price == nil {
+			return nil, nil
+		}
+
+		// price per hour * hours per month * count
+		cost := new(inf.Dec).Mul(asDec(*machineType.Price), inf.NewDec(int64(HoursPerMonth*machine.Count), 0))
+
+		if len(machine.VolumeType) > 0 {
+			volumeType, ok := volumeTypes[machine.VolumeType]
+			if !ok || volumeType.Price == nil {
+				return nil, nil
+			}
+
+			// price per GiB and month * size in GiB * count
+			sizeInGiB := new(inf.Dec).QuoRound(asDec(machine.VolumeSize), bytesPerGiB, 6, inf.RoundHalfUp)
+			volumeCost := new(inf.Dec).Mul(asDec(*volumeType.Price), sizeInGiB)
+			cost.Add(cost, volumeCost.Mul(volumeCost, inf.NewDec(int64(machine.Count), 0)))
+		}
+
+		total.Add(total, cost)
+	}
+
+	return total.Round(total, 2, inf.RoundHalfUp), nil
+}
+
+// NewExternalCostModel returns a CostModel which requests the cost estimation from the given HTTP endpoint. The
+// endpoint receives a POST request with a JSON encoded EstimationRequest and must respond with a JSON encoded
+// EstimationResponse.
+func NewExternalCostModel(endpoint string, timeout time.Duration) CostModel {
+	return &externalCostModel{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: timeout},
+	}
+}
+
+// EstimationRequest is the request which is sent to an external cost model.
+type EstimationRequest struct {
+	// CloudProfile is the name of the CloudProfile of the Shoot.
+	CloudProfile string `json:"cloudProfile"`
+	// Region is the region of the Shoot.
+	Region string `json:"region"`
+	// Machines are the worker machines of the Shoot.
+	Machines []Machine `json:"machines"`
+}
+
+// EstimationResponse is the response of an external cost model.
+type EstimationResponse struct {
+	// MonthlyCost is the estimated monthly cost. It is empty if no estimation is possible.
+	MonthlyCost string `json:"monthlyCost,omitempty"`
+}
+
+type externalCostModel struct {
+	endpoint string
+	client   *http.Client
+}
+
+func (e *externalCostModel) EstimateMonthlyCost(cloudProfile *garden.CloudProfile, region string, machines []Machine) (*inf.Dec, error) {
+	body, err := json.Marshal(&EstimationRequest{
+		CloudProfile: cloudProfile.Name,
+		Region:       region,
+		Machines:     machines,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := e.client.Post(e.endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cost model endpoint %s responded with status code %d", e.endpoint, resp.StatusCode)
+	}
+
+	estimation := &EstimationResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(estimation); err != nil {
+		return nil, err
+	}
+	if len(estimation.MonthlyCost) == 0 {
+		return nil, nil
+	}
+
+	cost, ok := new(inf.Dec).SetString(estimation.MonthlyCost)
+	if !ok {
+		return nil, fmt.Errorf("cost model endpoint %s responded with invalid monthly cost %q", e.endpoint, estimation.MonthlyCost)
+	}
+	return cost.Round(cost, 2, inf.RoundHalfUp), nil
+}
+
+// asDec returns the value of the given quantity as decimal. The quantity is passed by value in order to not mutate
+// the internal representation of quantities which belong to objects of the informer caches.
+func asDec(quantity resource.Quantity) *inf.Dec {
+	return quantity.AsDec()
+}
+
+func cloudProviderOfCloudProfile(cloudProfile *garden.CloudProfile) (garden.CloudProvider, error) {
+	switch {
+	case cloudProfile.Spec.AWS != nil:
+		return garden.CloudProviderAWS, nil
+	case cloudProfile.Spec.Azure != nil:
+		return garden.CloudProviderAzure, nil
+	case cloudProfile.Spec.GCP != nil:
+		return garden.CloudProviderGCP, nil
+	case cloudProfile.Spec.OpenStack != nil:
+		return garden.CloudProviderOpenStack, nil
+	case cloudProfile.Spec.Alicloud != nil:
+		return garden.CloudProviderAlicloud, nil
+	}
+	return "", fmt.Errorf("could not identify the cloud provider kind in the CloudProfile %s", cloudProfile.Name)
+}
+
+func getMachineTypes(provider garden.CloudProvider, cloudProfile *garden.CloudProfile) map[string]garden.MachineType {
+	machineTypes := make(map[string]garden.MachineType)
+	switch provider {
+	case garden.CloudProviderAWS:
+		for _, machineType := range cloudProfile.Spec.AWS.Constraints.MachineTypes {
+			machineTypes[machineType.Name] = machineType
+		}
+	case garden.CloudProviderAzure:
+		for _, machineType := range cloudProfile.Spec.Azure.Constraints.MachineTypes {
+			machineTypes[machineType.Name] = machineType
+		}
+	case garden.CloudProviderGCP:
+		for _, machineType := range cloudProfile.Spec.GCP.Constraints.MachineTypes {
+			machineTypes[machineType.Name] = machineType
+		}
+	case garden.CloudProviderOpenStack:
+		for _, machineType := range cloudProfile.Spec.OpenStack.Constraints.MachineTypes {
+			machineTypes[machineType.Name] = machineType.MachineType
+		}
+	case garden.CloudProviderAlicloud:
+		for _, machineType := range cloudProfile.Spec.Alicloud.Constraints.MachineTypes {
+			machineTypes[machineType.Name] = machineType.MachineType
+		}
+	}
+	return machineTypes
+}
+
+func getVolumeTypes(provider garden.CloudProvider, cloudProfile *garden.CloudProfile) map[string]garden.VolumeType {
+	volumeTypes := make(map[string]garden.VolumeType)
+	switch provider {
+	case garden.CloudProviderAWS:
+		for _, volumeType := range cloudProfile.Spec.AWS.Constraints.VolumeTypes {
+			volumeTypes[volumeType.Name] = volumeType
+		}
+	case garden.CloudProviderAzure:
+		for _, volumeType := range cloudProfile.Spec.Azure.Constraints.VolumeTypes {
+			volumeTypes[volumeType.Name] = volumeType
+		}
+	case garden.CloudProviderGCP:
+		for _, volumeType := range cloudProfile.Spec.GCP.Constraints.VolumeTypes {
+			volumeTypes[volumeType.Name] = volumeType
+		}
+	case garden.CloudProviderAlicloud:
+		for _, volumeType := range cloudProfile.Spec.Alicloud.Constraints.VolumeTypes {
+			volumeTypes[volumeType.Name] = volumeType.VolumeType
+		}
+	}
+	return volumeTypes
+}
@@ -31,23 +31,35 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/client-go/tools/cache"
 )
 
 const (
 	// PluginName is the name of this admission plugin.
 	PluginName = "ShootQuotaValidator"
+
+	projectNamespaceIndex = "spec.namespace"
 )
 
 var (
-	quotaMetricNames = [6]v1.ResourceName{
+	quotaMetricNames = [8]v1.ResourceName{
 		garden.QuotaMetricCPU,
 		garden.QuotaMetricGPU,
 		garden.QuotaMetricMemory,
 		garden.QuotaMetricStorageStandard,
 		garden.QuotaMetricStoragePremium,
-		garden.QuotaMetricLoadbalancer}
+		garden.QuotaMetricLoadbalancer,
+		garden.QuotaMetricShoots,
+		garden.QuotaMetricWorkers}
 )
 
+// quotaReference is a reference to a Quota object, either by the SecretBinding or by the Project of a Shoot.
+type quotaReference struct {
+	v1.ObjectReference
+	// Project is true if the Quota is referenced by the Project, i.e. it limits all Shoots of the project.
+	Project bool
+}
+
 type quotaWorker struct {
 	garden.Worker
 	// VolumeType is the type of the root volumes.
@@ -70,6 +82,8 @@ type QuotaValidator struct {
 	cloudProfileLister  listers.CloudProfileLister
 	secretBindingLister listers.SecretBindingLister
 	quotaLister         listers.QuotaLister
+	projectIndexer      cache.Indexer
+	indexerErr          error
 	readyFunc           admission.ReadyFunc
 }
 
@@ -106,7 +120,12 @@ func (q *QuotaValidator) SetInternalGardenInformerFactory(f informers.SharedInfo
 	quotaInformer := f.Garden().InternalVersion().Quotas()
 	q.quotaLister = quotaInformer.Lister()
 
-	readyFuncs = append(readyFuncs, shootInformer.Informer().HasSynced, cloudProfileInformer.Informer().HasSynced, secretBindingInformer.Informer().HasSynced, quotaInformer.Informer().HasSynced)
+	// The Project of a Shoot is determined via an index instead of listing all Projects for every request.
+	projectInformer := f.Garden().InternalVersion().Projects()
+	q.indexerErr = projectInformer.Informer().AddIndexers(cache.Indexers{projectNamespaceIndex: indexProjectByNamespace})
+	q.projectIndexer = projectInformer.Informer().GetIndexer()
+
+	readyFuncs = append(readyFuncs, shootInformer.Informer().HasSynced, cloudProfileInformer.Informer().HasSynced, secretBindingInformer.Informer().HasSynced, quotaInformer.Informer().HasSynced, projectInformer.Informer().HasSynced)
 }
 
 // ValidateInitialization checks whether the plugin was correctly initialized.
@@ -123,6 +142,12 @@ func (q *QuotaValidator) ValidateInitialization() error {
 	if q.quotaLister == nil {
 		return errors.New("missing quota lister")
 	}
+	if q.projectIndexer == nil {
+		return errors.New("missing project indexer")
+	}
+	if q.indexerErr != nil {
+		return fmt.Errorf("could not add project indexers: %v", q.indexerErr)
+	}
 	return nil
 }
 
@@ -164,6 +189,7 @@ func (q *QuotaValidator) Admit(a admission.Attributes) error {
 	var (
 		oldShoot         *garden.Shoot
 		maxShootLifetime *int
+		maxExtensionDays *int
 		checkLifetime    = false
 		checkQuota       = false
 	)
@@ -191,10 +217,27 @@ func (q *QuotaValidator) Admit(a admission.Attributes) error {
 		return apierrors.NewInternalError(err)
 	}
 
-	// Quotas are cumulative, means each quota must be not exceeded that the admission pass.
+	quotaRefs := make([]quotaReference, 0, len(secretBinding.Quotas))
 	for _, quotaRef := range secretBinding.Quotas {
+		quotaRefs = append(quotaRefs, quotaReference{ObjectReference: quotaRef})
+	}
+	projectQuotaRefs, err := q.findProjectQuotas(shoot.Namespace)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	for _, quotaRef := range projectQuotaRefs {
+		quotaRefs = append(quotaRefs, quotaReference{ObjectReference: quotaRef, Project: true})
+	}
+
+	// Quotas are cumulative, means each quota must be not exceeded that the admission pass.
+	for _, quotaRef := range quotaRefs {
 		quota, err := q.quotaLister.Quotas(quotaRef.Namespace).Get(quotaRef.Name)
 		if err != nil {
+			// Quotas of the Project which do not exist (anymore) do not limit its Shoots, like for the quota status of
+			// the Project.
+			if quotaRef.Project && apierrors.IsNotFound(err) {
+				continue
+			}
 			return apierrors.NewInternalError(err)
 		}
 
@@ -206,10 +249,13 @@ func (q *QuotaValidator) Admit(a admission.Attributes) error {
 			if *maxShootLifetime > *quota.Spec.ClusterLifetimeDays {
 				maxShootLifetime = quota.Spec.ClusterLifetimeDays
 			}
+			if days := quota.Spec.MaxClusterLifetimeExtensionDays; days != nil && (maxExtensionDays == nil || *days < *maxExtensionDays) {
+				maxExtensionDays = days
+			}
 		}
 
 		if checkQuota {
-			exceededMetrics, err := q.isQuotaExceeded(*shoot, *quota)
+			exceededMetrics, err := q.isQuotaExceeded(*shoot, *quota, quotaRef.Project)
 			if err != nil {
 				return apierrors.NewInternalError(err)
 			}
@@ -218,6 +264,9 @@ func (q *QuotaValidator) Admit(a admission.Attributes) error {
 				for _, metric := range *exceededMetrics {
 					message = message + metric.String() + " "
 				}
+				if quotaRef.Project {
+					return admission.NewForbidden(a, fmt.Errorf("Project quota limits exceeded. Unable to allocate further %s", message))
+				}
 				return admission.NewForbidden(a, fmt.Errorf("Quota limits exceeded. Unable to allocate further %s", message))
 			}
 		}
@@ -251,13 +300,47 @@ func (q *QuotaValidator) Admit(a admission.Attributes) error {
 		if plannedExpirationTime.After(maxPossibleExpirationTime) {
 			return admission.NewForbidden(a, fmt.Errorf("Requested shoot expiration time to long. Can only be extended by %d day(s)", *maxShootLifetime))
 		}
+
+		// The total extension of the lifetime is limited like for lifetime extension requests, otherwise it could be
+		// bypassed by repeated changes of the expiration timestamp.
+		if maxExtensionDays != nil {
+			maxExpirationTime := shoot.CreationTimestamp.Time.Add(time.Duration((*maxShootLifetime+*maxExtensionDays)*24) * time.Hour)
+			if plannedExpirationTime.After(maxExpirationTime) && plannedExpirationTime.After(oldExpirationTime) {
+				return admission.NewForbidden(a, fmt.Errorf("Requested shoot expiration time to long. The lifetime can be extended by at most %d day(s) in total, the Shoot must expire until %s", *maxExtensionDays, maxExpirationTime.UTC().Format(time.RFC3339)))
+			}
+		}
 	}
 
 	return nil
 }
 
-func (q *QuotaValidator) isQuotaExceeded(shoot garden.Shoot, quota garden.Quota) (*[]v1.ResourceName, error) {
-	allocatedResources, err := q.determineAllocatedResources(quota, shoot)
+// findProjectQuotas returns the references to the quotas of the project the given namespace belongs to.
+func (q *QuotaValidator) findProjectQuotas(namespace string) ([]v1.ObjectReference, error) {
+	projects, err := q.projectIndexer.ByIndex(projectNamespaceIndex, namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range projects {
+		if project, ok := obj.(*garden.Project); ok {
+			return project.Spec.Quotas, nil
+		}
+	}
+	return nil, nil
+}
+
+func indexProjectByNamespace(obj interface{}) ([]string, error) {
+	project, ok := obj.(*garden.Project)
+	if !ok {
+		return nil, fmt.Errorf("expected *garden.Project but got %T", obj)
+	}
+	if project.Spec.Namespace == nil {
+		return nil, nil
+	}
+	return []string{*project.Spec.Namespace}, nil
+}
+
+func (q *QuotaValidator) isQuotaExceeded(shoot garden.Shoot, quota garden.Quota, projectQuota bool) (*[]v1.ResourceName, error) {
+	allocatedResources, err := q.determineAllocatedResources(quota, shoot, projectQuota)
 	if err != nil {
 		return nil, err
 	}
@@ -281,8 +364,17 @@ func (q *QuotaValidator) isQuotaExceeded(shoot garden.Shoot, quota garden.Quota)
 	return nil, nil
 }
 
-func (q *QuotaValidator) determineAllocatedResources(quota garden.Quota, shoot garden.Shoot) (v1.ResourceList, error) {
-	shoots, err := q.findShootsReferQuota(quota, shoot)
+func (q *QuotaValidator) determineAllocatedResources(quota garden.Quota, shoot garden.Shoot, projectQuota bool) (v1.ResourceList, error) {
+	var (
+		shoots []garden.Shoot
+		err    error
+	)
+
+	if projectQuota {
+		shoots, err = q.findShootsOfProject(shoot)
+	} else {
+		shoots, err = q.findShootsReferQuota(quota, shoot)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -344,6 +436,22 @@ func (q *QuotaValidator) findShootsReferQuota(quota garden.Quota, shoot garden.S
 	return shootsReferQuota, nil
 }
 
+func (q *QuotaValidator) findShootsOfProject(shoot garden.Shoot) ([]garden.Shoot, error) {
+	shoots, err := q.shootLister.Shoots(shoot.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var shootsOfProject []garden.Shoot
+	for _, s := range shoots {
+		if shoot.Name == s.Name {
+			continue
+		}
+		shootsOfProject = append(shootsOfProject, *s)
+	}
+	return shootsOfProject, nil
+}
+
 func (q *QuotaValidator) determineRequiredResources(allocatedResources v1.ResourceList, shoot garden.Shoot) (v1.ResourceList, error) {
 	shootResources, err := q.getShootResources(shoot)
 	if err != nil {
@@ -370,10 +478,11 @@ func (q *QuotaValidator) getShootResources(shoot garden.Shoot) (v1.ResourceList,
 
 	var (
 		countLB      int64 = 1
-		resources          = make(v1.ResourceList)
-		workers            = getShootWorkerResources(shoot, cloudProvider, *cloudProfile)
-		machineTypes       = getMachineTypes(cloudProvider, *cloudProfile)
-		volumeTypes        = getVolumeTypes(cloudProvider, *cloudProfile)
+		countWorkers int64
+		resources    = make(v1.ResourceList)
+		workers      = getShootWorkerResources(shoot, cloudProvider, *cloudProfile)
+		machineTypes = getMachineTypes(cloudProvider, *cloudProfile)
+		volumeTypes  = getVolumeTypes(cloudProvider, *cloudProfile)
 	)
 
 	for _, worker := range workers {
@@ -405,6 +514,7 @@ func (q *QuotaValidator) getShootResources(shoot garden.Shoot) (v1.ResourceList,
 		}
 
 		// For now we always use the max. amount of resources for quota calculation
+		countWorkers += int64(worker.AutoScalerMax)
 		resources[garden.QuotaMetricCPU] = sumQuantity(resources[garden.QuotaMetricCPU], multiplyQuantity(machineType.CPU, worker.AutoScalerMax))
 		resources[garden.QuotaMetricGPU] = sumQuantity(resources[garden.QuotaMetricGPU], multiplyQuantity(machineType.GPU, worker.AutoScalerMax))
 		resources[garden.QuotaMetricMemory] = sumQuantity(resources[garden.QuotaMetricMemory], multiplyQuantity(machineType.Memory, worker.AutoScalerMax))
@@ -423,6 +533,8 @@ func (q *QuotaValidator) getShootResources(shoot garden.Shoot) (v1.ResourceList,
 		countLB++
 	}
 	resources[garden.QuotaMetricLoadbalancer] = *resource.NewQuantity(countLB, resource.DecimalSI)
+	resources[garden.QuotaMetricShoots] = *resource.NewQuantity(1, resource.DecimalSI)
+	resources[garden.QuotaMetricWorkers] = *resource.NewQuantity(countWorkers, resource.DecimalSI)
 
 	return resources, nil
 }
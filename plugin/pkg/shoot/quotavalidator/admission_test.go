@@ -273,6 +273,86 @@ var _ = Describe("quotavalidator", func() {
 			})
 		})
 
+		Context("tests for Shoots of projects which reference Quotas", func() {
+			var projectQuota garden.Quota
+
+			BeforeEach(func() {
+				secretBinding.Quotas = nil
+				gardenInformerFactory.Garden().InternalVersion().SecretBindings().Informer().GetStore().Add(&secretBinding)
+
+				projectQuota = garden.Quota{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: trialNamespace,
+						Name:      "project-limits",
+					},
+					Spec: garden.QuotaSpec{
+						Scope: garden.QuotaScopeProject,
+						Metrics: corev1.ResourceList{
+							garden.QuotaMetricShoots:  resource.MustParse("2"),
+							garden.QuotaMetricWorkers: resource.MustParse("2"),
+						},
+					},
+				}
+				gardenInformerFactory.Garden().InternalVersion().Quotas().Informer().GetStore().Add(&projectQuota)
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&garden.Project{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test",
+					},
+					Spec: garden.ProjectSpec{
+						Namespace: &namespace,
+						Quotas: []corev1.ObjectReference{
+							{
+								Namespace: trialNamespace,
+								Name:      "project-limits",
+							},
+						},
+					},
+				})
+			})
+
+			It("should pass because the project quota is sufficient", func() {
+				shoot2 := *shoot.DeepCopy()
+				shoot2.Name = "test-shoot-2"
+				gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(&shoot2)
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should fail because other shoots of the project exhaust the number of shoots", func() {
+				for _, name := range []string{"test-shoot-2", "test-shoot-3"} {
+					otherShoot := *shoot.DeepCopy()
+					otherShoot.Name = name
+					otherShoot.Spec.Cloud.SecretBindingRef.Name = "other-binding"
+					gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(&otherShoot)
+				}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs)
+				Expect(err).To(MatchError(ContainSubstring("Project quota limits exceeded")))
+			})
+
+			It("should fail because the workers of the shoot exceed the project quota", func() {
+				shoot.Spec.Cloud.GCP.Workers[0].AutoScalerMax = 3
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs)
+				Expect(err).To(MatchError(ContainSubstring("workers")))
+			})
+
+			It("should pass because a quota referenced by the project does not exist", func() {
+				gardenInformerFactory.Garden().InternalVersion().Quotas().Informer().GetStore().Delete(&projectQuota)
+				shoot.Spec.Cloud.GCP.Workers[0].AutoScalerMax = 3
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
 		Context("tests for Quota validation corner cases", func() {
 			It("should pass because shoot is intended to get deleted", func() {
 				var now metav1.Time
@@ -407,6 +487,32 @@ var _ = Describe("quotavalidator", func() {
 				err := admissionHandler.Admit(attrs)
 				Expect(err).To(HaveOccurred())
 			})
+
+			Context("with a maximum total extension", func() {
+				BeforeEach(func() {
+					maxExtensionDays := 2
+					quotaProject.Spec.MaxClusterLifetimeExtensionDays = &maxExtensionDays
+					shoot.CreationTimestamp = metav1.NewTime(time.Date(2017, 12, 31, 0, 0, 0, 0, time.UTC))
+					oldShoot = *shoot.DeepCopy()
+				})
+
+				It("should pass as the total extension is within the limit", func() {
+					shoot.Annotations[common.ShootExpirationTimestamp] = "2018-01-02T00:00:00+00:00" // 1 day lifetime + 1 day extension
+					attrs := admission.NewAttributesRecord(&shoot, &oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+
+					err := admissionHandler.Admit(attrs)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("should fail as repeated extensions exceed the total limit", func() {
+					oldShoot.Annotations[common.ShootExpirationTimestamp] = "2018-01-03T00:00:00+00:00"
+					shoot.Annotations[common.ShootExpirationTimestamp] = "2018-01-04T00:00:00+00:00" // plus 1 day, 3 days extension in total
+					attrs := admission.NewAttributesRecord(&shoot, &oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+
+					err := admissionHandler.Admit(attrs)
+					Expect(err).To(HaveOccurred())
+				})
+			})
 		})
 
 		Context("tests for Alicloud Shoots, which have special logic for collecting volume and machine types", func() {
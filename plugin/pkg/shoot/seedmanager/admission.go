@@ -15,8 +15,14 @@
 package seedmanager
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gardener/gardener/pkg/apis/garden"
 	"github.com/gardener/gardener/pkg/apis/garden/helper"
@@ -26,30 +32,114 @@ import (
 	"github.com/gardener/gardener/pkg/operation/common"
 	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
 
+	"github.com/ghodss/yaml"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/client-go/tools/cache"
 )
 
 const (
 	// PluginName is the name of this admission plugin.
 	PluginName = "ShootSeedManager"
+
+	// StrategyMinimalUsage is the name of the score plugin which prefers the Seeds with the least number of Shoots.
+	StrategyMinimalUsage = "MinimalUsage"
+
+	// shootSeedNameIndex is the name of the index of Shoots by the name of the Seed they are scheduled to.
+	shootSeedNameIndex = "spec.cloud.seed"
+
+	auditAnnotationPrefix = "shootseedmanager.admission.garden.sapcloud.io/"
+	// AuditAnnotationSeed is the key of the audit annotation containing the name of the chosen Seed.
+	AuditAnnotationSeed = auditAnnotationPrefix + "seed"
+	// AuditAnnotationStrategy is the key of the audit annotation containing the score plugins used to choose the Seed.
+	AuditAnnotationStrategy = auditAnnotationPrefix + "strategy"
+	// AuditAnnotationCandidates is the key of the audit annotation containing the number of candidate Seeds.
+	AuditAnnotationCandidates = auditAnnotationPrefix + "candidates"
+	// AuditAnnotationDuration is the key of the audit annotation containing the time it took to choose the Seed.
+	AuditAnnotationDuration = auditAnnotationPrefix + "duration"
+	// AuditAnnotationError is the key of the audit annotation containing the reason why no Seed could be chosen.
+	AuditAnnotationError = auditAnnotationPrefix + "error"
+	// AuditAnnotationRejections is the key of the audit annotation containing the Seeds rejected by the filter plugins
+	// and the reasons.
+	AuditAnnotationRejections = auditAnnotationPrefix + "rejections"
+
+	// CauseTypeSeedRejected is the type of the causes of the error returned if no Seed could be chosen. There is one
+	// cause per rejected Seed.
+	CauseTypeSeedRejected metav1.CauseType = "SeedRejected"
 )
 
+// Configuration is the configuration of the ShootSeedManager admission plugin.
+type Configuration struct {
+	// Filters are the filter plugins deciding whether a Seed is a candidate for a Shoot, in the order they are
+	// executed. Defaults to the CloudProfileAndRegion and DisjointNetworks plugins.
+	// +optional
+	Filters []PluginConfiguration `json:"filters,omitempty"`
+	// Scores are the score plugins rating the candidate Seeds. The candidate with the highest sum of weighted scores is
+	// chosen. Defaults to the MinimalUsage plugin.
+	// +optional
+	Scores []PluginConfiguration `json:"scores,omitempty"`
+}
+
+// PluginConfiguration is the configuration of a filter or score plugin.
+type PluginConfiguration struct {
+	// Name is the name the plugin is registered with.
+	Name string `json:"name"`
+	// Weight is the factor the scores of a score plugin are multiplied with after they have been normalized. Defaults to 1.
+	// +optional
+	Weight *int64 `json:"weight,omitempty"`
+	// Args are the arguments passed to the plugin.
+	// +optional
+	Args json.RawMessage `json:"args,omitempty"`
+}
+
+// errNoSeed is returned if there is no Seed at all.
+var errNoSeed = errors.New("no seed cluster found")
+
 // Register registers a plugin.
 func Register(plugins *admission.Plugins) {
+	registerMetrics()
 	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
-		return New()
+		profile, err := newProfile(config)
+		if err != nil {
+			return nil, err
+		}
+		return New(profile)
 	})
 }
 
+func newProfile(config io.Reader) (*Profile, error) {
+	if config == nil {
+		return DefaultProfile(), nil
+	}
+
+	data, err := ioutil.ReadAll(config)
+	if err != nil {
+		return nil, err
+	}
+	configuration := &Configuration{}
+	if err := yaml.Unmarshal(data, configuration); err != nil {
+		return nil, fmt.Errorf("could not parse configuration of admission plugin %s: %v", PluginName, err)
+	}
+
+	profile, err := NewProfile(configuration)
+	if err != nil {
+		return nil, fmt.Errorf("invalid configuration of admission plugin %s: %v", PluginName, err)
+	}
+	return profile, nil
+}
+
 // SeedManager contains listers and and admission handler.
 type SeedManager struct {
 	*admission.Handler
-	seedLister  gardenlisters.SeedLister
-	shootLister gardenlisters.ShootLister
-	readyFunc   admission.ReadyFunc
+	profile            *Profile
+	seedLister         gardenlisters.SeedLister
+	cloudProfileLister gardenlisters.CloudProfileLister
+	shootIndexer       cache.Indexer
+	indexerErr         error
+	readyFunc          admission.ReadyFunc
 }
 
 var (
@@ -59,9 +149,10 @@ var (
 )
 
 // New creates a new SeedManager admission plugin.
-func New() (*SeedManager, error) {
+func New(profile *Profile) (*SeedManager, error) {
 	return &SeedManager{
 		Handler: admission.NewHandler(admission.Create, admission.Update),
+		profile: profile,
 	}, nil
 }
 
@@ -76,10 +167,15 @@ func (s *SeedManager) SetInternalGardenInformerFactory(f gardeninformers.SharedI
 	seedInformer := f.Garden().InternalVersion().Seeds()
 	s.seedLister = seedInformer.Lister()
 
+	cloudProfileInformer := f.Garden().InternalVersion().CloudProfiles()
+	s.cloudProfileLister = cloudProfileInformer.Lister()
+
+	// The usage of the Seeds is determined via an index instead of listing all Shoots for every request.
 	shootInformer := f.Garden().InternalVersion().Shoots()
-	s.shootLister = shootInformer.Lister()
+	s.indexerErr = shootInformer.Informer().AddIndexers(cache.Indexers{shootSeedNameIndex: indexShootBySeedName})
+	s.shootIndexer = shootInformer.Informer().GetIndexer()
 
-	readyFuncs = append(readyFuncs, seedInformer.Informer().HasSynced, shootInformer.Informer().HasSynced)
+	readyFuncs = append(readyFuncs, seedInformer.Informer().HasSynced, cloudProfileInformer.Informer().HasSynced, shootInformer.Informer().HasSynced)
 }
 
 // ValidateInitialization checks whether the plugin was correctly initialized.
@@ -87,8 +183,17 @@ func (s *SeedManager) ValidateInitialization() error {
 	if s.seedLister == nil {
 		return errors.New("missing seed lister")
 	}
-	if s.shootLister == nil {
-		return errors.New("missing shoot lister")
+	if s.cloudProfileLister == nil {
+		return errors.New("missing cloud profile lister")
+	}
+	if s.shootIndexer == nil {
+		return errors.New("missing shoot indexer")
+	}
+	if s.indexerErr != nil {
+		return fmt.Errorf("could not add shoot indexers: %v", s.indexerErr)
+	}
+	if s.profile == nil {
+		return errors.New("missing profile")
 	}
 	return nil
 }
@@ -142,86 +247,217 @@ func (s *SeedManager) Admit(a admission.Attributes) error {
 			return admission.NewForbidden(a, errors.New("forbidden to deploy a shoot overlapping the network of the seed"))
 		}
 
+		if a.GetOperation() == admission.Create {
+			cloudProfile, err := s.cloudProfileLister.Get(shoot.Spec.Cloud.Profile)
+			if err != nil && !apierrors.IsNotFound(err) {
+				return apierrors.NewInternalError(err)
+			}
+			if cloudProfile != nil {
+				if err := checkRegionAffinity(cloudProfile, shoot, seed); err != nil {
+					return admission.NewForbidden(a, err)
+				}
+			}
+		}
+
 		return nil
 	}
 
-	// If no Seed is referenced, we try to determine an adequate one.
-	seed, err := determineSeed(shoot, s.seedLister, s.shootLister)
+	// If no Seed is referenced, we try to determine an adequate one. The decision is recorded as audit annotations so
+	// that it can be reconstructed from the audit log later on.
+	var (
+		start                             = time.Now()
+		seed, candidates, rejections, err = determineSeed(shoot, s.profile, s.seedLister, &handle{s.shootIndexer, s.cloudProfileLister})
+		duration                          = time.Since(start)
+	)
+	recordPlacement(shoot, duration, candidates, rejections, err)
+
+	annotations := map[string]string{
+		AuditAnnotationStrategy:   s.profile.Strategy(),
+		AuditAnnotationCandidates: strconv.Itoa(candidates),
+		AuditAnnotationDuration:   duration.String(),
+	}
 	if err != nil {
-		return admission.NewForbidden(a, err)
+		annotations[AuditAnnotationError] = err.Error()
+	} else {
+		annotations[AuditAnnotationSeed] = seed.Name
+	}
+	if len(rejections) > 0 {
+		annotations[AuditAnnotationRejections] = rejectionsToString(rejections)
+	}
+	for key, value := range annotations {
+		if err := a.AddAnnotation(key, value); err != nil {
+			return apierrors.NewInternalError(err)
+		}
+	}
+
+	if err != nil {
+		return newSchedulingError(a, err)
 	}
 
 	shoot.Spec.Cloud.Seed = &seed.Name
 	return nil
 }
 
-// determineSeed returns an appropriate Seed cluster (or nil).
-func determineSeed(shoot *garden.Shoot, seedLister gardenlisters.SeedLister, shootLister gardenlisters.ShootLister) (*garden.Seed, error) {
-	seedList, err := seedLister.List(labels.Everything())
-	if err != nil {
-		return nil, err
+// SeedRejection describes why a filter plugin rejected a Seed for a Shoot.
+type SeedRejection struct {
+	// Seed is the name of the rejected Seed.
+	Seed string
+	// Filter is the name of the filter plugin which rejected the Seed.
+	Filter string
+	// Reason is the reason why the Seed was rejected.
+	Reason string
+}
+
+func (r SeedRejection) String() string {
+	return fmt.Sprintf("seed %s rejected by %s: %s", r.Seed, r.Filter, r.Reason)
+}
+
+// NoAdequateSeedError is the error returned if the filter plugins rejected all Seeds for a Shoot.
+type NoAdequateSeedError struct {
+	// Rejections are the rejections of all Seeds, in the order of the filter plugins.
+	Rejections []SeedRejection
+}
+
+func (e *NoAdequateSeedError) Error() string {
+	return fmt.Sprintf("no adequate seed cluster found (%s)", rejectionsToString(e.Rejections))
+}
+
+func rejectionsToString(rejections []SeedRejection) string {
+	messages := make([]string, 0, len(rejections))
+	for _, rejection := range rejections {
+		messages = append(messages, rejection.String())
 	}
-	shootList, err := shootLister.List(labels.Everything())
+	return strings.Join(messages, "; ")
+}
+
+// determineSeed returns an appropriate Seed cluster (or nil), the number of candidate Seeds it was chosen from and the
+// rejections of the other Seeds. The candidates are the Seeds accepted by all filter plugins of the profile, the chosen
+// one is the candidate with the highest sum of weighted scores of the score plugins (the first one in case of a tie).
+// The scores of every plugin are normalized across the candidates before they are weighted.
+func determineSeed(shoot *garden.Shoot, profile *Profile, seedLister gardenlisters.SeedLister, handle Handle) (*garden.Seed, int, []SeedRejection, error) {
+	candidates, err := seedLister.List(labels.Everything())
 	if err != nil {
-		return nil, err
+		return nil, 0, nil, err
+	}
+	if len(candidates) == 0 {
+		return nil, 0, nil, errNoSeed
 	}
 
-	// Map seeds to number of managed shoots.
-	var (
-		seedUsage  = generateSeedUsageMap(shootList)
-		candidates []*garden.Seed
-	)
+	var rejections []SeedRejection
+	for _, filter := range profile.Filters {
+		old := candidates
+		candidates = nil
 
-	// Determine all candidate seed cluster matching the shoot's cloud and region.
-	for _, seed := range seedList {
-		if seed.DeletionTimestamp == nil && seed.Spec.Cloud.Profile == shoot.Spec.Cloud.Profile && seed.Spec.Cloud.Region == shoot.Spec.Cloud.Region && seed.Spec.Visible != nil && *seed.Spec.Visible && verifySeedAvailability(seed) {
+		for _, seed := range old {
+			if err := filter.Filter(handle, shoot, seed); err != nil {
+				rejections = append(rejections, SeedRejection{Seed: seed.Name, Filter: filter.Name(), Reason: err.Error()})
+				continue
+			}
 			candidates = append(candidates, seed)
 		}
-	}
 
-	if candidates == nil {
-		return nil, errors.New("no adequate seed cluster found for this cloud profile and region")
+		if candidates == nil {
+			return nil, 0, rejections, &NoAdequateSeedError{Rejections: rejections}
+		}
 	}
 
-	old := candidates
-	candidates = nil
+	totals := make([]int64, len(candidates))
+	for _, score := range profile.Scores {
+		values := make([]int64, len(candidates))
+		for i, seed := range candidates {
+			value, err := score.Score(handle, shoot, seed)
+			if err != nil {
+				return nil, 0, rejections, fmt.Errorf("score plugin %s failed for seed %s: %v", score.Name(), seed.Name, err)
+			}
+			values[i] = value
+		}
+		for i, value := range NormalizeScores(values) {
+			totals[i] += score.Weight * value
+		}
+	}
 
-	for _, seed := range old {
-		if hasDisjointedNetworks(seed, shoot) {
-			candidates = append(candidates, seed)
+	// Find the best candidate (i.e. the one with the highest sum of weighted scores).
+	best := 0
+	for i := range candidates {
+		if totals[i] > totals[best] {
+			best = i
 		}
 	}
 
-	if candidates == nil {
-		return nil, errors.New("no adequate seed cluster found with disjoint network")
+	return candidates[best], len(candidates), rejections, nil
+}
+
+// handle implements the Handle interface based on the index of Shoots by the name of their Seed.
+type handle struct {
+	shootIndexer       cache.Indexer
+	cloudProfileLister gardenlisters.CloudProfileLister
+}
+
+func (h *handle) NumberOfShoots(seedName string) (int, error) {
+	shoots, err := h.shootIndexer.ByIndex(shootSeedNameIndex, seedName)
+	if err != nil {
+		return 0, err
 	}
+	return len(shoots), nil
+}
 
-	var (
-		bestCandidate *garden.Seed
-		min           *int
-	)
+func (h *handle) Shoots(seedName string) ([]*garden.Shoot, error) {
+	objs, err := h.shootIndexer.ByIndex(shootSeedNameIndex, seedName)
+	if err != nil {
+		return nil, err
+	}
 
-	// Find the best candidate (i.e. the one managing the smallest number of shoots right now).
-	for _, seed := range candidates {
-		if numberOfManagedShoots := seedUsage[seed.Name]; min == nil || numberOfManagedShoots < *min {
-			bestCandidate = seed
-			min = &numberOfManagedShoots
+	shoots := make([]*garden.Shoot, 0, len(objs))
+	for _, obj := range objs {
+		shoot, ok := obj.(*garden.Shoot)
+		if !ok {
+			return nil, fmt.Errorf("expected *garden.Shoot but got %T", obj)
 		}
+		shoots = append(shoots, shoot)
 	}
+	return shoots, nil
+}
 
-	return bestCandidate, nil
+func (h *handle) CloudProfile(name string) (*garden.CloudProfile, error) {
+	return h.cloudProfileLister.Get(name)
 }
 
-func generateSeedUsageMap(shootList []*garden.Shoot) map[string]int {
-	m := map[string]int{}
+// newSchedulingError returns a forbidden error for the given error of determineSeed. The rejections of the Seeds are
+// added as causes so that clients can evaluate them.
+func newSchedulingError(a admission.Attributes, err error) error {
+	forbidden := admission.NewForbidden(a, err)
 
-	for _, shoot := range shootList {
-		if seed := shoot.Spec.Cloud.Seed; seed != nil {
-			m[*seed]++
-		}
+	noAdequateSeedErr, ok := err.(*NoAdequateSeedError)
+	if !ok {
+		return forbidden
 	}
+	statusErr, ok := forbidden.(*apierrors.StatusError)
+	if !ok {
+		return forbidden
+	}
+
+	if statusErr.ErrStatus.Details == nil {
+		statusErr.ErrStatus.Details = &metav1.StatusDetails{}
+	}
+	for _, rejection := range noAdequateSeedErr.Rejections {
+		statusErr.ErrStatus.Details.Causes = append(statusErr.ErrStatus.Details.Causes, metav1.StatusCause{
+			Type:    CauseTypeSeedRejected,
+			Message: rejection.String(),
+			Field:   "spec.cloud.seed",
+		})
+	}
+	return statusErr
+}
 
-	return m
+func indexShootBySeedName(obj interface{}) ([]string, error) {
+	shoot, ok := obj.(*garden.Shoot)
+	if !ok {
+		return nil, fmt.Errorf("expected *garden.Shoot but got %T", obj)
+	}
+	if shoot.Spec.Cloud.Seed == nil {
+		return nil, nil
+	}
+	return []string{*shoot.Spec.Cloud.Seed}, nil
 }
 
 func verifySeedAvailability(seed *garden.Seed) bool {
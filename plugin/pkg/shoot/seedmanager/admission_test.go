@@ -15,8 +15,11 @@
 package seedmanager_test
 
 import (
+	"encoding/json"
+
 	"github.com/gardener/gardener/pkg/apis/garden"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	"github.com/gardener/gardener/pkg/operation/common"
 	. "github.com/gardener/gardener/plugin/pkg/shoot/seedmanager"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -24,6 +27,8 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 )
 
 var _ = Describe("seedmanager", func() {
@@ -91,7 +96,7 @@ var _ = Describe("seedmanager", func() {
 		)
 
 		BeforeEach(func() {
-			admissionHandler, _ = New()
+			admissionHandler, _ = New(DefaultProfile())
 			admissionHandler.AssignReadyFunc(func() bool { return true })
 			gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
 			admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
@@ -283,10 +288,191 @@ var _ = Describe("seedmanager", func() {
 				Expect(apierrors.IsForbidden(err)).To(BeTrue())
 				Expect(shoot.Spec.Cloud.Seed).To(BeNil())
 			})
+
+			It("should fail because it cannot find a seed cluster due to cordoning", func() {
+				seed.Annotations = map[string]string{common.SeedCordoned: common.SeedCordonedAutomatically}
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(shoot.Spec.Cloud.Seed).To(BeNil())
+			})
+
+			It("should report the rejections of all seeds per filter", func() {
+				otherSeed := seedBase
+				otherSeed.Name = "seed-2"
+				otherSeed.Spec.Cloud.Region = "another-region"
+				shoot.Spec.Cloud.AWS.Networks.K8SNetworks = garden.K8SNetworks{
+					Pods:     &seed.Spec.Networks.Pods,
+					Services: &seed.Spec.Networks.Services,
+					Nodes:    &seed.Spec.Networks.Nodes,
+				}
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&otherSeed)
+				attrs := &annotationRecorder{Attributes: admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)}
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				statusErr, ok := err.(*apierrors.StatusError)
+				Expect(ok).To(BeTrue())
+				Expect(statusErr.ErrStatus.Details.Causes).To(ConsistOf(
+					metav1.StatusCause{Type: CauseTypeSeedRejected, Field: "spec.cloud.seed", Message: `seed seed-2 rejected by CloudProfileAndRegion: seed is not in region "europe"`},
+					metav1.StatusCause{Type: CauseTypeSeedRejected, Field: "spec.cloud.seed", Message: "seed seed-1 rejected by DisjointNetworks: networks of seed overlap with the networks of the shoot"},
+				))
+				Expect(attrs.annotations).To(HaveKeyWithValue(AuditAnnotationRejections, ContainSubstring("seed seed-1 rejected by DisjointNetworks")))
+			})
+
+			It("should record the failed scheduling decision as metrics", func() {
+				Register(admission.NewPlugins())
+				shoot.Spec.Cloud.Region = "metrics-region"
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				Expect(admissionHandler.Admit(attrs)).NotTo(Succeed())
+
+				families, err := prometheus.DefaultGatherer.Gather()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(metricValue(families, "garden_apiserver_seed_placement_rejections_total", map[string]string{"filter": FilterPluginCloudProfileAndRegion})).To(BeNumerically(">=", 1))
+				Expect(metricValue(families, "garden_apiserver_seed_placement_failures_total", map[string]string{"reason": "NoAdequateSeed"})).To(BeNumerically(">=", 1))
+				Expect(metricValue(families, "garden_apiserver_seed_placement_unschedulable", map[string]string{"cloudprofile": cloudProfileName, "region": "metrics-region"})).To(Equal(1.0))
+			})
+
+			It("should record the scheduling decision as audit annotations", func() {
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := &annotationRecorder{Attributes: admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)}
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(attrs.annotations).To(HaveKeyWithValue(AuditAnnotationSeed, seedName))
+				Expect(attrs.annotations).To(HaveKeyWithValue(AuditAnnotationStrategy, StrategyMinimalUsage))
+				Expect(attrs.annotations).To(HaveKeyWithValue(AuditAnnotationCandidates, "1"))
+				Expect(attrs.annotations).To(HaveKey(AuditAnnotationDuration))
+				Expect(attrs.annotations).NotTo(HaveKey(AuditAnnotationError))
+			})
+
+			It("should record the failed scheduling decision as audit annotations", func() {
+				attrs := &annotationRecorder{Attributes: admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)}
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).To(HaveOccurred())
+				Expect(attrs.annotations).To(HaveKey(AuditAnnotationError))
+				Expect(attrs.annotations).To(HaveKeyWithValue(AuditAnnotationCandidates, "0"))
+				Expect(attrs.annotations).NotTo(HaveKey(AuditAnnotationSeed))
+			})
+		})
+
+		Context("region affinity", func() {
+			var cloudProfile *garden.CloudProfile
+
+			BeforeEach(func() {
+				profile, err := NewProfile(&Configuration{
+					Filters: []PluginConfiguration{{Name: FilterPluginCloudProfile}, {Name: FilterPluginDisjointNetworks}},
+					Scores:  []PluginConfiguration{{Name: ScorePluginMinimalDistance, Args: json.RawMessage(`{"kilometersPerEdit": 1}`)}},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				admissionHandler, _ = New(profile)
+				admissionHandler.AssignReadyFunc(func() bool { return true })
+				admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+
+				cloudProfile = &garden.CloudProfile{
+					ObjectMeta: metav1.ObjectMeta{Name: cloudProfileName},
+					Spec: garden.CloudProfileSpec{
+						RegionAffinity: []garden.RegionAffinityGroup{
+							{Name: "EU", Regions: []string{region, "europe-far-away"}},
+							{Name: "US", Regions: []string{"europa"}},
+						},
+					},
+				}
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(cloudProfile)
+
+				seed.Spec.Cloud.Region = "europa"
+			})
+
+			It("should never choose a seed outside of the region affinity group of the shoot", func() {
+				otherSeed := seedBase
+				otherSeed.Name = "seed-2"
+				otherSeed.Spec.Cloud.Region = "europe-far-away"
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&otherSeed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*shoot.Spec.Cloud.Seed).To(Equal("seed-2"))
+			})
+
+			It("should choose the closest seed if the region does not belong to a group", func() {
+				cloudProfile.Spec.RegionAffinity = nil
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*shoot.Spec.Cloud.Seed).To(Equal(seedName))
+			})
+
+			It("should forbid referencing a seed outside of the region affinity group of the shoot", func() {
+				shoot.Spec.Cloud.Seed = &seedName
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
 		})
 	})
 })
 
+type annotationRecorder struct {
+	admission.Attributes
+	annotations map[string]string
+}
+
+func (r *annotationRecorder) AddAnnotation(key, value string) error {
+	if r.annotations == nil {
+		r.annotations = make(map[string]string)
+	}
+	r.annotations[key] = value
+	return r.Attributes.AddAnnotation(key, value)
+}
+
+// metricValue returns the value of the counter or gauge with the given name and labels, or -1 if it does not exist.
+func metricValue(families []*dto.MetricFamily, name string, labels map[string]string) float64 {
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+	metrics:
+		for _, metric := range family.Metric {
+			for _, label := range metric.Label {
+				if value, ok := labels[label.GetName()]; ok && value != label.GetValue() {
+					continue metrics
+				}
+			}
+			if metric.Counter != nil {
+				return metric.Counter.GetValue()
+			}
+			return metric.Gauge.GetValue()
+		}
+	}
+	return -1
+}
+
 func makeCIDRPtr(cidr string) *garden.CIDR {
 	c := garden.CIDR(cidr)
 	return &c
@@ -0,0 +1,115 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seedmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// defaultControlPlaneRequests is a rough estimate of the resources requested by the control plane of a Shoot in its
+// Seed, including monitoring and logging.
+var defaultControlPlaneRequests = corev1.ResourceList{
+	corev1.ResourceCPU:    resource.MustParse("500m"),
+	corev1.ResourceMemory: resource.MustParse("2Gi"),
+	corev1.ResourcePods:   resource.MustParse("20"),
+}
+
+// FreeCapacityArgs are the arguments of the FreeCapacity score plugin.
+type FreeCapacityArgs struct {
+	// ControlPlaneRequests are the resources expected to be requested by the control plane of a Shoot in its Seed.
+	// Only the listed resources are considered. Defaults to 500m CPU, 2Gi memory and 20 pods.
+	// +optional
+	ControlPlaneRequests corev1.ResourceList `json:"controlPlaneRequests,omitempty"`
+	// MaxShoots is the maximum number of Shoots per Seed. If it is set, the number of Shoots is considered like a
+	// further resource.
+	// +optional
+	MaxShoots *int `json:"maxShoots,omitempty"`
+}
+
+func newFreeCapacity(args json.RawMessage) (ScorePlugin, error) {
+	plugin := freeCapacity{}
+	if len(args) > 0 {
+		if err := json.Unmarshal(args, &plugin.args); err != nil {
+			return nil, err
+		}
+	}
+	if len(plugin.args.ControlPlaneRequests) == 0 {
+		plugin.args.ControlPlaneRequests = defaultControlPlaneRequests
+	}
+	if plugin.args.MaxShoots != nil && *plugin.args.MaxShoots <= 0 {
+		return nil, fmt.Errorf("maxShoots must be positive")
+	}
+	return plugin, nil
+}
+
+// freeCapacity scores Seeds by the headroom which is left after the control plane of the Shoot has been added, i.e.
+// by the free share of their scarcest resource (from -100 to 100). Seeds which have not reported their utilization
+// yet or lack one of the resources score 0.
+type freeCapacity struct {
+	args FreeCapacityArgs
+}
+
+func (freeCapacity) Name() string {
+	return ScorePluginFreeCapacity
+}
+
+func (f freeCapacity) Score(handle Handle, _ *garden.Shoot, seed *garden.Seed) (int64, error) {
+	utilization := seed.Status.Utilization
+	if utilization == nil {
+		return 0, nil
+	}
+
+	numberOfShoots, err := handle.NumberOfShoots(seed.Name)
+	if err != nil {
+		return 0, err
+	}
+
+	headroom := 1.0
+	if f.args.MaxShoots != nil {
+		headroom = freeShare(float64(*f.args.MaxShoots), float64(numberOfShoots+1))
+	}
+
+	// Shoots which have been scheduled to the Seed since it reported its utilization are not part of the requested
+	// resources yet.
+	pendingShoots := int64(numberOfShoots - utilization.Shoots)
+	if pendingShoots < 0 {
+		pendingShoots = 0
+	}
+
+	for name, controlPlaneRequest := range f.args.ControlPlaneRequests {
+		allocatable, ok := utilization.Allocatable[name]
+		if !ok || allocatable.IsZero() {
+			return 0, nil
+		}
+		requested := utilization.Requested[name]
+
+		expected := float64(requested.MilliValue()) + float64(controlPlaneRequest.MilliValue())*float64(pendingShoots+1)
+		headroom = math.Min(headroom, freeShare(float64(allocatable.MilliValue()), expected))
+	}
+
+	return int64(math.Round(math.Max(headroom, -1) * 100)), nil
+}
+
+// freeShare returns the share of the given capacity which is not used.
+func freeShare(capacity, used float64) float64 {
+	return (capacity - used) / capacity
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seedmanager_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/seedmanager"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+type fakeHandle map[string][]*garden.Shoot
+
+func (h fakeHandle) NumberOfShoots(seedName string) (int, error) {
+	return len(h[seedName]), nil
+}
+
+func (h fakeHandle) Shoots(seedName string) ([]*garden.Shoot, error) {
+	return h[seedName], nil
+}
+
+func (h fakeHandle) CloudProfile(name string) (*garden.CloudProfile, error) {
+	return nil, apierrors.NewNotFound(garden.Resource("cloudprofiles"), name)
+}
+
+// shoots returns the given number of Shoots in the given namespace.
+func shoots(number int, namespace string) []*garden.Shoot {
+	var shoots []*garden.Shoot
+	for i := 0; i < number; i++ {
+		shoots = append(shoots, &garden.Shoot{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("shoot-%d", i), Namespace: namespace}})
+	}
+	return shoots
+}
+
+var _ = Describe("FreeCapacity", func() {
+	newSeed := func(shoots int, allocatable, requested corev1.ResourceList) *garden.Seed {
+		return &garden.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: "seed"},
+			Status: garden.SeedStatus{
+				Utilization: &garden.SeedUtilization{
+					Shoots:      shoots,
+					Allocatable: allocatable,
+					Requested:   requested,
+				},
+			},
+		}
+	}
+	resources := func(cpu, memory string) corev1.ResourceList {
+		return corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(cpu),
+			corev1.ResourceMemory: resource.MustParse(memory),
+		}
+	}
+
+	DescribeTable("#Score",
+		func(args string, numberOfShoots int, seed *garden.Seed, expected int64) {
+			profile, err := NewProfile(&Configuration{Scores: []PluginConfiguration{{Name: ScorePluginFreeCapacity, Args: json.RawMessage(args)}}})
+			Expect(err).NotTo(HaveOccurred())
+
+			score, err := profile.Scores[0].Score(fakeHandle{"seed": shoots(numberOfShoots, "garden-dev")}, &garden.Shoot{}, seed)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(score).To(Equal(expected))
+		},
+		Entry("seed without utilization", `{}`, 0, &garden.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed"}}, int64(0)),
+		Entry("seed lacking a resource", `{}`, 0, newSeed(0, resources("10", "40Gi"), nil), int64(0)),
+		Entry("scarcest resource determines the score",
+			`{"controlPlaneRequests":{"cpu":"1","memory":"4Gi"}}`, 2,
+			newSeed(2, resources("10", "40Gi"), resources("3", "20Gi")), int64(40)),
+		Entry("shoots scheduled since the last report are considered",
+			`{"controlPlaneRequests":{"cpu":"1","memory":"4Gi"}}`, 4,
+			newSeed(2, resources("10", "40Gi"), resources("3", "20Gi")), int64(20)),
+		Entry("maximum number of shoots",
+			`{"controlPlaneRequests":{"cpu":"1"},"maxShoots":10}`, 8,
+			newSeed(8, resources("10", "40Gi"), resources("3", "20Gi")), int64(10)),
+		Entry("overcommitted seed",
+			`{"controlPlaneRequests":{"cpu":"1"}}`, 0,
+			newSeed(0, resources("10", "40Gi"), resources("14", "20Gi")), int64(-50)),
+	)
+
+	It("should reject invalid arguments", func() {
+		_, err := NewProfile(&Configuration{Scores: []PluginConfiguration{{Name: ScorePluginFreeCapacity, Args: json.RawMessage(`{"maxShoots":0}`)}}})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,172 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seedmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+)
+
+const (
+	// earthRadiusKilometers is the mean radius of the earth.
+	earthRadiusKilometers = 6371.0
+	// defaultKilometersPerEdit is the distance assumed per edit between the names of two regions whose distance is
+	// neither configured nor computable from their coordinates.
+	defaultKilometersPerEdit = 1000.0
+)
+
+// MinimalDistanceArgs are the arguments of the MinimalDistance score plugin.
+type MinimalDistanceArgs struct {
+	// Regions maps the names of regions to their coordinates.
+	// +optional
+	Regions map[string]Coordinates `json:"regions,omitempty"`
+	// Distances are explicit distances between regions. They take precedence over the distances computed from the
+	// coordinates of the regions.
+	// +optional
+	Distances []RegionDistance `json:"distances,omitempty"`
+	// KilometersPerEdit is the distance assumed per edit between the names of two regions whose distance is neither
+	// configured nor computable from their coordinates. Defaults to 1000.
+	// +optional
+	KilometersPerEdit *float64 `json:"kilometersPerEdit,omitempty"`
+}
+
+// Coordinates are the coordinates of a region.
+type Coordinates struct {
+	// Latitude is the latitude in degrees.
+	Latitude float64 `json:"latitude"`
+	// Longitude is the longitude in degrees.
+	Longitude float64 `json:"longitude"`
+}
+
+// RegionDistance is the distance between two regions. Distances are symmetric.
+type RegionDistance struct {
+	// From is the name of the first region.
+	From string `json:"from"`
+	// To is the name of the second region.
+	To string `json:"to"`
+	// Kilometers is the distance between the regions.
+	Kilometers float64 `json:"kilometers"`
+}
+
+func newMinimalDistance(args json.RawMessage) (ScorePlugin, error) {
+	plugin := minimalDistance{distances: make(map[[2]string]float64)}
+	if len(args) > 0 {
+		if err := json.Unmarshal(args, &plugin.args); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, distance := range plugin.args.Distances {
+		if distance.Kilometers < 0 {
+			return nil, fmt.Errorf("distance between %s and %s must not be negative", distance.From, distance.To)
+		}
+		plugin.distances[[2]string{distance.From, distance.To}] = distance.Kilometers
+		plugin.distances[[2]string{distance.To, distance.From}] = distance.Kilometers
+	}
+	for region, coordinates := range plugin.args.Regions {
+		if math.Abs(coordinates.Latitude) > 90 || math.Abs(coordinates.Longitude) > 180 {
+			return nil, fmt.Errorf("coordinates of region %s are invalid", region)
+		}
+	}
+
+	plugin.kilometersPerEdit = defaultKilometersPerEdit
+	if plugin.args.KilometersPerEdit != nil {
+		if *plugin.args.KilometersPerEdit < 0 {
+			return nil, fmt.Errorf("kilometersPerEdit must not be negative")
+		}
+		plugin.kilometersPerEdit = *plugin.args.KilometersPerEdit
+	}
+	return plugin, nil
+}
+
+// minimalDistance scores Seeds by the negative distance in kilometers between their region and the region of the
+// Shoot.
+type minimalDistance struct {
+	args              MinimalDistanceArgs
+	distances         map[[2]string]float64
+	kilometersPerEdit float64
+}
+
+func (minimalDistance) Name() string {
+	return ScorePluginMinimalDistance
+}
+
+func (m minimalDistance) Score(_ Handle, shoot *garden.Shoot, seed *garden.Seed) (int64, error) {
+	return -int64(math.Round(m.distance(shoot.Spec.Cloud.Region, seed.Spec.Cloud.Region))), nil
+}
+
+// distance returns the distance between the given regions in kilometers. Explicitly configured distances take
+// precedence over the distances computed from the coordinates of the regions. If neither is known, the edit distance
+// between the names of the regions is used as heuristic.
+func (m minimalDistance) distance(from, to string) float64 {
+	if from == to {
+		return 0
+	}
+	if distance, ok := m.distances[[2]string{from, to}]; ok {
+		return distance
+	}
+
+	fromCoordinates, fromOK := m.args.Regions[from]
+	toCoordinates, toOK := m.args.Regions[to]
+	if fromOK && toOK {
+		return greatCircleDistance(fromCoordinates, toCoordinates)
+	}
+
+	return float64(editDistance(from, to)) * m.kilometersPerEdit
+}
+
+// greatCircleDistance returns the distance between the given coordinates on the surface of the earth in kilometers,
+// computed with the haversine formula.
+func greatCircleDistance(from, to Coordinates) float64 {
+	var (
+		radians      = func(degrees float64) float64 { return degrees * math.Pi / 180 }
+		deltaLat     = radians(to.Latitude - from.Latitude)
+		deltaLong    = radians(to.Longitude - from.Longitude)
+		haversine    = math.Pow(math.Sin(deltaLat/2), 2) + math.Cos(radians(from.Latitude))*math.Cos(radians(to.Latitude))*math.Pow(math.Sin(deltaLong/2), 2)
+		centralAngle = 2 * math.Atan2(math.Sqrt(haversine), math.Sqrt(1-haversine))
+	)
+	return earthRadiusKilometers * centralAngle
+}
+
+// editDistance returns the Levenshtein distance between the given strings.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = previous[j-1] + cost
+			if previous[j]+1 < current[j] {
+				current[j] = previous[j] + 1
+			}
+			if current[j-1]+1 < current[j] {
+				current[j] = current[j-1] + 1
+			}
+		}
+		previous = current
+	}
+	return previous[len(b)]
+}
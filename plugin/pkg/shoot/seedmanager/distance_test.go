@@ -0,0 +1,72 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seedmanager_test
+
+import (
+	"encoding/json"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/seedmanager"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+)
+
+var _ = Describe("MinimalDistance", func() {
+	const args = `{
+  "regions": {
+    "eu-central-1": {"latitude": 50.11, "longitude": 8.68},
+    "eu-west-1": {"latitude": 53.35, "longitude": -6.26},
+    "us-east-1": {"latitude": 38.90, "longitude": -77.04}
+  },
+  "distances": [
+    {"from": "eu-central-1", "to": "us-east-1", "kilometers": 7000}
+  ]
+}`
+
+	DescribeTable("#Score",
+		func(args string, shootRegion, seedRegion string, matcher types.GomegaMatcher) {
+			profile, err := NewProfile(&Configuration{Scores: []PluginConfiguration{{Name: ScorePluginMinimalDistance, Args: json.RawMessage(args)}}})
+			Expect(err).NotTo(HaveOccurred())
+
+			var (
+				shoot = &garden.Shoot{Spec: garden.ShootSpec{Cloud: garden.Cloud{Region: shootRegion}}}
+				seed  = &garden.Seed{Spec: garden.SeedSpec{Cloud: garden.SeedCloud{Region: seedRegion}}}
+			)
+			score, err := profile.Scores[0].Score(fakeHandle{}, shoot, seed)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(score).To(matcher)
+		},
+		Entry("same region", args, "eu-west-1", "eu-west-1", BeZero()),
+		Entry("distance from coordinates", args, "eu-west-1", "eu-central-1", BeNumerically("~", -1090, 10)),
+		Entry("configured distance", args, "eu-central-1", "us-east-1", Equal(int64(-7000))),
+		Entry("configured distance is symmetric", args, "us-east-1", "eu-central-1", Equal(int64(-7000))),
+		Entry("edit distance as fallback", args, "eu-west-1", "eu-west-2", Equal(int64(-1000))),
+		Entry("edit distance with configured factor", `{"kilometersPerEdit": 10}`, "region-a", "region-bc", Equal(int64(-20))),
+	)
+
+	DescribeTable("invalid arguments",
+		func(args string) {
+			_, err := NewProfile(&Configuration{Scores: []PluginConfiguration{{Name: ScorePluginMinimalDistance, Args: json.RawMessage(args)}}})
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("negative distance", `{"distances": [{"from": "a", "to": "b", "kilometers": -1}]}`),
+		Entry("invalid coordinates", `{"regions": {"a": {"latitude": 91, "longitude": 0}}}`),
+		Entry("negative kilometers per edit", `{"kilometersPerEdit": -1}`),
+	)
+})
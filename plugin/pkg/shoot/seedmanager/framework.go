@@ -0,0 +1,333 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seedmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// FilterPluginCloudProfileAndRegion is the name of the filter plugin which only accepts visible and available Seeds
+	// in the cloud profile and region of the Shoot that are not marked to be deleted.
+	FilterPluginCloudProfileAndRegion = "CloudProfileAndRegion"
+	// FilterPluginCloudProfile is the name of the filter plugin which only accepts visible and available Seeds in the
+	// cloud profile of the Shoot that are not marked to be deleted, independent of their region.
+	FilterPluginCloudProfile = "CloudProfile"
+	// FilterPluginDisjointNetworks is the name of the filter plugin which only accepts Seeds whose networks do not
+	// overlap with the networks of the Shoot.
+	FilterPluginDisjointNetworks = "DisjointNetworks"
+	// FilterPluginNoMaintenance is the name of the filter plugin which only accepts Seeds which are neither marked to be
+	// under maintenance nor in their maintenance time window.
+	FilterPluginNoMaintenance = "NoMaintenance"
+	// ScorePluginFreeCapacity is the name of the score plugin which prefers the Seeds with the most free resources left
+	// after the control plane of the Shoot has been added.
+	ScorePluginFreeCapacity = "FreeCapacity"
+	// ScorePluginMinimalDistance is the name of the score plugin which prefers the Seeds closest to the region of the
+	// Shoot.
+	ScorePluginMinimalDistance = "MinimalDistance"
+	// ScorePluginSpread is the name of the score plugin which prefers the Seeds with the least number of Shoots of the
+	// same project (or with the same value of a label).
+	ScorePluginSpread = "Spread"
+
+	// MaxScore is the highest normalized score of a candidate Seed. The scores of every score plugin are normalized to
+	// the range from 0 to MaxScore across all candidates before they are multiplied with the weight of the plugin.
+	MaxScore int64 = 100
+)
+
+// Handle provides plugins access to the state of the garden.
+type Handle interface {
+	// NumberOfShoots returns the number of Shoots scheduled to the Seed with the given name.
+	NumberOfShoots(seedName string) (int, error)
+	// Shoots returns the Shoots scheduled to the Seed with the given name.
+	Shoots(seedName string) ([]*garden.Shoot, error)
+	// CloudProfile returns the CloudProfile with the given name.
+	CloudProfile(name string) (*garden.CloudProfile, error)
+}
+
+// FilterPlugin decides whether a Seed is a candidate for a Shoot.
+type FilterPlugin interface {
+	// Name returns the name of the plugin.
+	Name() string
+	// Filter returns nil if the Seed is a candidate for the Shoot, otherwise an error describing why it is not.
+	Filter(handle Handle, shoot *garden.Shoot, seed *garden.Seed) error
+}
+
+// ScorePlugin rates a candidate Seed for a Shoot. Higher scores are better. The scores may have an arbitrary scale as
+// they are normalized across all candidates (see NormalizeScores).
+type ScorePlugin interface {
+	// Name returns the name of the plugin.
+	Name() string
+	// Score returns the score of the Seed for the Shoot.
+	Score(handle Handle, shoot *garden.Shoot, seed *garden.Seed) (int64, error)
+}
+
+// FilterPluginFactory creates a filter plugin with the given arguments from the plugin configuration.
+type FilterPluginFactory func(args json.RawMessage) (FilterPlugin, error)
+
+// ScorePluginFactory creates a score plugin with the given arguments from the plugin configuration.
+type ScorePluginFactory func(args json.RawMessage) (ScorePlugin, error)
+
+var (
+	registryLock sync.RWMutex
+
+	filterPluginFactories = map[string]FilterPluginFactory{
+		FilterPluginCloudProfileAndRegion: func(json.RawMessage) (FilterPlugin, error) { return cloudProfileAndRegion{}, nil },
+		FilterPluginCloudProfile:          func(json.RawMessage) (FilterPlugin, error) { return cloudProfile{}, nil },
+		FilterPluginDisjointNetworks:      func(json.RawMessage) (FilterPlugin, error) { return disjointNetworks{}, nil },
+		FilterPluginNoMaintenance:         func(json.RawMessage) (FilterPlugin, error) { return noMaintenance{}, nil },
+	}
+	scorePluginFactories = map[string]ScorePluginFactory{
+		StrategyMinimalUsage:       func(json.RawMessage) (ScorePlugin, error) { return minimalUsage{}, nil },
+		ScorePluginFreeCapacity:    newFreeCapacity,
+		ScorePluginMinimalDistance: newMinimalDistance,
+		ScorePluginSpread:          newSpread,
+	}
+
+	defaultFilterPlugins = []string{FilterPluginCloudProfileAndRegion, FilterPluginDisjointNetworks}
+	defaultScorePlugins  = []string{StrategyMinimalUsage}
+)
+
+// RegisterFilterPlugin registers a filter plugin under the given name so that it can be used in the configuration of
+// the admission plugin. It must be called before the admission plugin is initialized.
+func RegisterFilterPlugin(name string, factory FilterPluginFactory) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	filterPluginFactories[name] = factory
+}
+
+// RegisterScorePlugin registers a score plugin under the given name so that it can be used in the configuration of
+// the admission plugin. It must be called before the admission plugin is initialized.
+func RegisterScorePlugin(name string, factory ScorePluginFactory) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	scorePluginFactories[name] = factory
+}
+
+// WeightedScorePlugin is a score plugin whose scores are multiplied with a weight.
+type WeightedScorePlugin struct {
+	ScorePlugin
+	// Weight is the factor the scores of the plugin are multiplied with after they have been normalized.
+	Weight int64
+}
+
+// Profile is the set of plugins used to choose a Seed for a Shoot.
+type Profile struct {
+	// Filters are the filter plugins in the order they are executed.
+	Filters []FilterPlugin
+	// Scores are the weighted score plugins.
+	Scores []WeightedScorePlugin
+}
+
+// NewProfile creates the profile of the given configuration. Filter and score plugins which are not configured default
+// to the built-in ones.
+func NewProfile(configuration *Configuration) (*Profile, error) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	filterConfigs := configuration.Filters
+	if len(filterConfigs) == 0 {
+		for _, name := range defaultFilterPlugins {
+			filterConfigs = append(filterConfigs, PluginConfiguration{Name: name})
+		}
+	}
+	scoreConfigs := configuration.Scores
+	if len(scoreConfigs) == 0 {
+		for _, name := range defaultScorePlugins {
+			scoreConfigs = append(scoreConfigs, PluginConfiguration{Name: name})
+		}
+	}
+
+	profile := &Profile{}
+	for _, filterConfig := range filterConfigs {
+		factory, ok := filterPluginFactories[filterConfig.Name]
+		if !ok {
+			return nil, fmt.Errorf("unknown filter plugin %q", filterConfig.Name)
+		}
+		plugin, err := factory(filterConfig.Args)
+		if err != nil {
+			return nil, fmt.Errorf("could not create filter plugin %q: %v", filterConfig.Name, err)
+		}
+		profile.Filters = append(profile.Filters, plugin)
+	}
+	for _, scoreConfig := range scoreConfigs {
+		factory, ok := scorePluginFactories[scoreConfig.Name]
+		if !ok {
+			return nil, fmt.Errorf("unknown score plugin %q", scoreConfig.Name)
+		}
+		plugin, err := factory(scoreConfig.Args)
+		if err != nil {
+			return nil, fmt.Errorf("could not create score plugin %q: %v", scoreConfig.Name, err)
+		}
+
+		weight := int64(1)
+		if scoreConfig.Weight != nil {
+			weight = *scoreConfig.Weight
+		}
+		if weight <= 0 {
+			return nil, fmt.Errorf("weight of score plugin %q must be positive", scoreConfig.Name)
+		}
+		profile.Scores = append(profile.Scores, WeightedScorePlugin{ScorePlugin: plugin, Weight: weight})
+	}
+	return profile, nil
+}
+
+// NormalizeScores maps the given scores linearly to the range from 0 to MaxScore, i.e., the lowest score becomes 0 and
+// the highest one becomes MaxScore. If all scores are equal, they all become MaxScore.
+func NormalizeScores(scores []int64) []int64 {
+	if len(scores) == 0 {
+		return nil
+	}
+
+	min, max := scores[0], scores[0]
+	for _, score := range scores {
+		if score < min {
+			min = score
+		}
+		if score > max {
+			max = score
+		}
+	}
+
+	normalized := make([]int64, len(scores))
+	for i, score := range scores {
+		if max == min {
+			normalized[i] = MaxScore
+			continue
+		}
+		normalized[i] = (score - min) * MaxScore / (max - min)
+	}
+	return normalized
+}
+
+// DefaultProfile returns the profile consisting of the built-in filter and score plugins.
+func DefaultProfile() *Profile {
+	profile, err := NewProfile(&Configuration{})
+	if err != nil {
+		panic(err)
+	}
+	return profile
+}
+
+// Strategy returns the names of the score plugins of the profile, which is recorded as audit annotation.
+func (p *Profile) Strategy() string {
+	names := make([]string, 0, len(p.Scores))
+	for _, score := range p.Scores {
+		names = append(names, score.Name())
+	}
+	return strings.Join(names, ",")
+}
+
+type cloudProfileAndRegion struct{}
+
+func (cloudProfileAndRegion) Name() string {
+	return FilterPluginCloudProfileAndRegion
+}
+
+func (cloudProfileAndRegion) Filter(handle Handle, shoot *garden.Shoot, seed *garden.Seed) error {
+	if err := (cloudProfile{}).Filter(handle, shoot, seed); err != nil {
+		return err
+	}
+	if seed.Spec.Cloud.Region != shoot.Spec.Cloud.Region {
+		return fmt.Errorf("seed is not in region %q", shoot.Spec.Cloud.Region)
+	}
+	return nil
+}
+
+type cloudProfile struct{}
+
+func (cloudProfile) Name() string {
+	return FilterPluginCloudProfile
+}
+
+func (cloudProfile) Filter(handle Handle, shoot *garden.Shoot, seed *garden.Seed) error {
+	switch {
+	case seed.DeletionTimestamp != nil:
+		return fmt.Errorf("seed is marked to be deleted")
+	case seed.Spec.Cloud.Profile != shoot.Spec.Cloud.Profile:
+		return fmt.Errorf("seed does not use cloud profile %q", shoot.Spec.Cloud.Profile)
+	case seed.Spec.Visible == nil || !*seed.Spec.Visible:
+		return fmt.Errorf("seed is not visible")
+	case !verifySeedAvailability(seed):
+		return fmt.Errorf("seed is not available")
+	case isCordoned(seed):
+		return fmt.Errorf("seed is cordoned")
+	}
+
+	if seed.Spec.Cloud.Region == shoot.Spec.Cloud.Region {
+		return nil
+	}
+	cloudProfile, err := handle.CloudProfile(shoot.Spec.Cloud.Profile)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	return checkRegionAffinity(cloudProfile, shoot, seed)
+}
+
+// isCordoned returns whether the Seed is cordoned by an operator or the SeedCordon controller.
+func isCordoned(seed *garden.Seed) bool {
+	value := seed.Annotations[common.SeedCordoned]
+	return value == "true" || value == common.SeedCordonedAutomatically
+}
+
+// checkRegionAffinity returns an error if the region of the Shoot belongs to a region affinity group of the
+// CloudProfile which the region of the Seed does not belong to.
+func checkRegionAffinity(cloudProfile *garden.CloudProfile, shoot *garden.Shoot, seed *garden.Seed) error {
+	for _, group := range cloudProfile.Spec.RegionAffinity {
+		regions := sets.NewString(group.Regions...)
+		if regions.Has(shoot.Spec.Cloud.Region) && !regions.Has(seed.Spec.Cloud.Region) {
+			return fmt.Errorf("seed is not in region affinity group %q of region %q", group.Name, shoot.Spec.Cloud.Region)
+		}
+	}
+	return nil
+}
+
+type disjointNetworks struct{}
+
+func (disjointNetworks) Name() string {
+	return FilterPluginDisjointNetworks
+}
+
+func (disjointNetworks) Filter(_ Handle, shoot *garden.Shoot, seed *garden.Seed) error {
+	if !hasDisjointedNetworks(seed, shoot) {
+		return fmt.Errorf("networks of seed overlap with the networks of the shoot")
+	}
+	return nil
+}
+
+type minimalUsage struct{}
+
+func (minimalUsage) Name() string {
+	return StrategyMinimalUsage
+}
+
+func (minimalUsage) Score(handle Handle, _ *garden.Shoot, seed *garden.Seed) (int64, error) {
+	numberOfShoots, err := handle.NumberOfShoots(seed.Name)
+	if err != nil {
+		return 0, err
+	}
+	return -int64(numberOfShoots), nil
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seedmanager_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/seedmanager"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+// seedLabelScore scores Seeds with the value of the label configured in its arguments.
+type seedLabelScore struct {
+	Label string `json:"label"`
+}
+
+func (seedLabelScore) Name() string {
+	return "SeedLabel"
+}
+
+func (s seedLabelScore) Score(_ Handle, _ *garden.Shoot, seed *garden.Seed) (int64, error) {
+	var value int64
+	_, err := fmt.Sscanf(seed.Labels[s.Label], "%d", &value)
+	return value, err
+}
+
+var _ = Describe("framework", func() {
+	RegisterScorePlugin("SeedLabel", func(args json.RawMessage) (ScorePlugin, error) {
+		plugin := seedLabelScore{}
+		if err := json.Unmarshal(args, &plugin); err != nil {
+			return nil, err
+		}
+		return plugin, nil
+	})
+
+	Describe("#NewProfile", func() {
+		It("should default to the built-in plugins", func() {
+			profile, err := NewProfile(&Configuration{})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.Filters).To(HaveLen(2))
+			Expect(profile.Filters[0].Name()).To(Equal(FilterPluginCloudProfileAndRegion))
+			Expect(profile.Filters[1].Name()).To(Equal(FilterPluginDisjointNetworks))
+			Expect(profile.Strategy()).To(Equal(StrategyMinimalUsage))
+		})
+
+		It("should create the configured plugins with their weights and arguments", func() {
+			weight := int64(3)
+			profile, err := NewProfile(&Configuration{
+				Filters: []PluginConfiguration{{Name: FilterPluginCloudProfileAndRegion}},
+				Scores: []PluginConfiguration{
+					{Name: StrategyMinimalUsage},
+					{Name: "SeedLabel", Weight: &weight, Args: json.RawMessage(`{"label":"capacity"}`)},
+				},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.Filters).To(HaveLen(1))
+			Expect(profile.Scores).To(HaveLen(2))
+			Expect(profile.Scores[0].Weight).To(Equal(int64(1)))
+			Expect(profile.Scores[1].Weight).To(Equal(weight))
+			Expect(profile.Scores[1].ScorePlugin).To(Equal(seedLabelScore{Label: "capacity"}))
+			Expect(profile.Strategy()).To(Equal("MinimalUsage,SeedLabel"))
+		})
+
+		It("should fail for unknown plugins", func() {
+			_, err := NewProfile(&Configuration{Filters: []PluginConfiguration{{Name: "Unknown"}}})
+			Expect(err).To(HaveOccurred())
+
+			_, err = NewProfile(&Configuration{Scores: []PluginConfiguration{{Name: "Unknown"}}})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail for invalid arguments", func() {
+			_, err := NewProfile(&Configuration{Scores: []PluginConfiguration{{Name: "SeedLabel", Args: json.RawMessage(`[]`)}}})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail for non-positive weights", func() {
+			weight := int64(0)
+			_, err := NewProfile(&Configuration{Scores: []PluginConfiguration{{Name: StrategyMinimalUsage, Weight: &weight}}})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	DescribeTable("#NormalizeScores",
+		func(scores, expected []int64) {
+			Expect(NormalizeScores(scores)).To(Equal(expected))
+		},
+		Entry("no scores", nil, nil),
+		Entry("equal scores", []int64{-3, -3}, []int64{100, 100}),
+		Entry("negative scores", []int64{-7000, -1000, -4000}, []int64{0, 100, 50}),
+		Entry("positive scores", []int64{1, 3, 5}, []int64{0, 50, 100}),
+	)
+
+	Describe("#Admit", func() {
+		var (
+			trueVar = true
+			seed    = func(name, capacity string) *garden.Seed {
+				return &garden.Seed{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"capacity": capacity}},
+					Spec: garden.SeedSpec{
+						Cloud:   garden.SeedCloud{Profile: "profile", Region: "region"},
+						Visible: &trueVar,
+					},
+					Status: garden.SeedStatus{
+						Conditions: []garden.Condition{{Type: garden.SeedAvailable, Status: garden.ConditionTrue}},
+					},
+				}
+			}
+			shoot = func(name string, seedName *string) *garden.Shoot {
+				return &garden.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "my-namespace"},
+					Spec: garden.ShootSpec{
+						Cloud: garden.Cloud{Profile: "profile", Region: "region", Seed: seedName},
+					},
+				}
+			}
+
+			// admit places a new Shoot with the given configuration while the small Seed has no Shoots and the large
+			// Seed has five Shoots.
+			admit = func(configuration *Configuration, smallSeed, largeSeed *garden.Seed) (*garden.Shoot, *annotationRecorder) {
+				profile, err := NewProfile(configuration)
+				Expect(err).NotTo(HaveOccurred())
+
+				admissionHandler, _ := New(profile)
+				admissionHandler.AssignReadyFunc(func() bool { return true })
+				gardenInformerFactory := gardeninformers.NewSharedInformerFactory(nil, 0)
+				admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(smallSeed)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(largeSeed)
+				for i := 0; i < 5; i++ {
+					gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(shoot(fmt.Sprintf("shoot-%d", i), &largeSeed.Name))
+				}
+
+				newShoot := shoot("shoot", nil)
+				attrs := &annotationRecorder{Attributes: admission.NewAttributesRecord(newShoot, nil, garden.Kind("Shoot").WithVersion("version"), newShoot.Namespace, newShoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)}
+
+				Expect(admissionHandler.Admit(attrs)).To(Succeed())
+				return newShoot, attrs
+			}
+		)
+
+		It("should choose the Seed with the highest sum of weighted scores", func() {
+			var (
+				weight    = int64(10)
+				smallSeed = seed("seed-1", "1")
+				largeSeed = seed("seed-2", "3")
+			)
+
+			newShoot, attrs := admit(&Configuration{
+				Filters: []PluginConfiguration{{Name: FilterPluginCloudProfileAndRegion}},
+				Scores: []PluginConfiguration{
+					{Name: StrategyMinimalUsage},
+					{Name: "SeedLabel", Weight: &weight, Args: json.RawMessage(`{"label":"capacity"}`)},
+				},
+			}, smallSeed, largeSeed)
+
+			Expect(newShoot.Spec.Cloud.Seed).To(PointTo(Equal(largeSeed.Name)))
+			Expect(attrs.annotations).To(HaveKeyWithValue(AuditAnnotationStrategy, "MinimalUsage,SeedLabel"))
+		})
+
+		It("should normalize the scores of the plugins before applying their weights", func() {
+			var (
+				weight    = int64(2)
+				smallSeed = seed("seed-1", "1")
+				largeSeed = seed("seed-2", "1000")
+			)
+
+			newShoot, _ := admit(&Configuration{
+				Filters: []PluginConfiguration{{Name: FilterPluginCloudProfileAndRegion}},
+				Scores: []PluginConfiguration{
+					{Name: StrategyMinimalUsage, Weight: &weight},
+					{Name: "SeedLabel", Args: json.RawMessage(`{"label":"capacity"}`)},
+				},
+			}, smallSeed, largeSeed)
+
+			Expect(newShoot.Spec.Cloud.Seed).To(PointTo(Equal(smallSeed.Name)))
+		})
+	})
+})
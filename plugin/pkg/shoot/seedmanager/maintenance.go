@@ -0,0 +1,61 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package seedmanager
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// Now returns the current time. It is a variable so that it can be overwritten in tests.
+var Now = time.Now
+
+type noMaintenance struct{}
+
+func (noMaintenance) Name() string {
+	return FilterPluginNoMaintenance
+}
+
+func (noMaintenance) Filter(_ Handle, _ *garden.Shoot, seed *garden.Seed) error {
+	if seed.Annotations[common.SeedMaintenance] == "true" {
+		return fmt.Errorf("seed is under maintenance")
+	}
+
+	value, ok := seed.Annotations[common.SeedMaintenanceTimeWindow]
+	if !ok {
+		return nil
+	}
+	timeWindow, err := parseSeedMaintenanceTimeWindow(value)
+	if err != nil {
+		return fmt.Errorf("seed has an invalid maintenance time window: %v", err)
+	}
+	if timeWindow.Contains(Now()) {
+		return fmt.Errorf("seed is in its maintenance time window (%s)", timeWindow)
+	}
+	return nil
+}
+
+// parseSeedMaintenanceTimeWindow parses the value of the maintenance time window annotation of a Seed.
+func parseSeedMaintenanceTimeWindow(value string) (*utils.MaintenanceTimeWindow, error) {
+	parts := strings.Split(value, ",")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("expected begin and end separated by a comma but got %q", value)
+	}
+	return utils.ParseMaintenanceTimeWindow(strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]))
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package seedmanager_test
+
+import (
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/seedmanager"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("NoMaintenance", func() {
+	var oldNow func() time.Time
+
+	BeforeEach(func() {
+		oldNow = Now
+		Now = func() time.Time { return time.Date(2019, 1, 1, 22, 30, 0, 0, time.UTC) }
+	})
+
+	AfterEach(func() {
+		Now = oldNow
+	})
+
+	DescribeTable("#Filter",
+		func(annotations map[string]string, rejected bool) {
+			profile, err := NewProfile(&Configuration{Filters: []PluginConfiguration{{Name: FilterPluginNoMaintenance}}})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = profile.Filters[0].Filter(fakeHandle{}, &garden.Shoot{}, &garden.Seed{ObjectMeta: metav1.ObjectMeta{Annotations: annotations}})
+
+			if rejected {
+				Expect(err).To(HaveOccurred())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+			}
+		},
+		Entry("seed without annotations", nil, false),
+		Entry("seed under maintenance", map[string]string{common.SeedMaintenance: "true"}, true),
+		Entry("seed not under maintenance", map[string]string{common.SeedMaintenance: "false"}, false),
+		Entry("seed in its maintenance time window", map[string]string{common.SeedMaintenanceTimeWindow: "220000+0000,230000+0000"}, true),
+		Entry("seed in its maintenance time window spanning midnight", map[string]string{common.SeedMaintenanceTimeWindow: "220000+0000,010000+0000"}, true),
+		Entry("seed outside of its maintenance time window", map[string]string{common.SeedMaintenanceTimeWindow: "230000+0000,000000+0000"}, false),
+		Entry("seed with an invalid maintenance time window", map[string]string{common.SeedMaintenanceTimeWindow: "220000+0000"}, true),
+	)
+})
@@ -0,0 +1,105 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seedmanager
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	failureReasonNoSeed         = "NoSeed"
+	failureReasonNoAdequateSeed = "NoAdequateSeed"
+	failureReasonError          = "Error"
+)
+
+var (
+	// placementDuration is a metric which tracks the time it takes to choose a Seed for a Shoot.
+	placementDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "garden_apiserver_seed_placement_duration_seconds",
+		Help:    "Time in seconds it took to choose a Seed for a Shoot, grouped by result.",
+		Buckets: prometheus.ExponentialBuckets(0.0001, 2, 14),
+	}, []string{"result"})
+
+	// placementSeedsConsidered is a metric which tracks the number of Seeds considered for a Shoot.
+	placementSeedsConsidered = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "garden_apiserver_seed_placement_seeds_considered",
+		Help:    "Number of Seeds considered when choosing a Seed for a Shoot.",
+		Buckets: prometheus.ExponentialBuckets(1, 2, 10),
+	})
+
+	// placementRejections is a metric which counts the Seeds rejected by the filter plugins.
+	placementRejections = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "garden_apiserver_seed_placement_rejections_total",
+		Help: "Number of Seeds rejected for Shoots, grouped by filter plugin.",
+	}, []string{"filter"})
+
+	// placementFailures is a metric which counts the Shoots for which no Seed could be chosen.
+	placementFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "garden_apiserver_seed_placement_failures_total",
+		Help: "Number of Shoots for which no Seed could be chosen, grouped by reason.",
+	}, []string{"reason"})
+
+	// unschedulable is a metric which tracks whether the last Shoot in a cloud profile and region could not be placed.
+	unschedulable = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "garden_apiserver_seed_placement_unschedulable",
+		Help: "Whether no Seed could be chosen for the last Shoot in a cloud profile and region (1) or not (0).",
+	}, []string{"cloudprofile", "region"})
+
+	registerMetricsOnce sync.Once
+)
+
+// registerMetrics registers the metrics about the placement of Shoots.
+func registerMetrics() {
+	registerMetricsOnce.Do(func() {
+		prometheus.MustRegister(placementDuration)
+		prometheus.MustRegister(placementSeedsConsidered)
+		prometheus.MustRegister(placementRejections)
+		prometheus.MustRegister(placementFailures)
+		prometheus.MustRegister(unschedulable)
+	})
+}
+
+// recordPlacement records the metrics about a decision of determineSeed.
+func recordPlacement(shoot *garden.Shoot, duration time.Duration, candidates int, rejections []SeedRejection, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	placementDuration.WithLabelValues(result).Observe(duration.Seconds())
+	placementSeedsConsidered.Observe(float64(candidates + len(rejections)))
+
+	for _, rejection := range rejections {
+		placementRejections.WithLabelValues(rejection.Filter).Inc()
+	}
+
+	if err == nil {
+		unschedulable.WithLabelValues(shoot.Spec.Cloud.Profile, shoot.Spec.Cloud.Region).Set(0)
+		return
+	}
+	unschedulable.WithLabelValues(shoot.Spec.Cloud.Profile, shoot.Spec.Cloud.Region).Set(1)
+
+	reason := failureReasonError
+	if _, ok := err.(*NoAdequateSeedError); ok {
+		reason = failureReasonNoAdequateSeed
+	} else if err == errNoSeed {
+		reason = failureReasonNoSeed
+	}
+	placementFailures.WithLabelValues(reason).Inc()
+}
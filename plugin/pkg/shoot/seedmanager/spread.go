@@ -0,0 +1,81 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seedmanager
+
+import (
+	"encoding/json"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+)
+
+// SpreadArgs are the arguments of the Spread score plugin.
+type SpreadArgs struct {
+	// LabelKey is the key of the label whose value groups the Shoots which are spread across the Seeds. If it is not
+	// set, the Shoots of the same project (i.e., namespace) are spread.
+	// +optional
+	LabelKey string `json:"labelKey,omitempty"`
+}
+
+func newSpread(args json.RawMessage) (ScorePlugin, error) {
+	plugin := spread{}
+	if len(args) > 0 {
+		if err := json.Unmarshal(args, &plugin.args); err != nil {
+			return nil, err
+		}
+	}
+	return plugin, nil
+}
+
+// spread scores Seeds by the negative number of Shoots of the same group as the Shoot which are already scheduled to
+// them. Shoots without the label (if configured) score 0 for all Seeds.
+type spread struct {
+	args SpreadArgs
+}
+
+func (spread) Name() string {
+	return ScorePluginSpread
+}
+
+func (s spread) Score(handle Handle, shoot *garden.Shoot, seed *garden.Seed) (int64, error) {
+	group, ok := s.group(shoot)
+	if !ok {
+		return 0, nil
+	}
+
+	shoots, err := handle.Shoots(seed.Name)
+	if err != nil {
+		return 0, err
+	}
+
+	var score int64
+	for _, other := range shoots {
+		if other.Namespace == shoot.Namespace && other.Name == shoot.Name {
+			continue
+		}
+		if otherGroup, ok := s.group(other); ok && otherGroup == group {
+			score--
+		}
+	}
+	return score, nil
+}
+
+// group returns the group of the given Shoot and whether it belongs to one.
+func (s spread) group(shoot *garden.Shoot) (string, bool) {
+	if len(s.args.LabelKey) == 0 {
+		return shoot.Namespace, true
+	}
+	value, ok := shoot.Labels[s.args.LabelKey]
+	return value, ok
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package seedmanager_test
+
+import (
+	"encoding/json"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/seedmanager"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Spread", func() {
+	var (
+		seed   = &garden.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed"}}
+		handle = fakeHandle{"seed": append(shoots(2, "garden-dev"), append(shoots(1, "garden-prod"), &garden.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "labeled", Namespace: "garden-prod", Labels: map[string]string{"ha-group": "a"}},
+		})...)}
+	)
+
+	DescribeTable("#Score",
+		func(args string, shoot *garden.Shoot, expected int64) {
+			profile, err := NewProfile(&Configuration{Scores: []PluginConfiguration{{Name: ScorePluginSpread, Args: json.RawMessage(args)}}})
+			Expect(err).NotTo(HaveOccurred())
+
+			score, err := profile.Scores[0].Score(handle, shoot, seed)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(score).To(Equal(expected))
+		},
+		Entry("shoots of the same project", `{}`,
+			&garden.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "new", Namespace: "garden-dev"}}, int64(-2)),
+		Entry("shoot itself is not counted", `{}`,
+			&garden.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot-0", Namespace: "garden-dev"}}, int64(-1)),
+		Entry("project without shoots on the seed", `{}`,
+			&garden.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "new", Namespace: "garden-test"}}, int64(0)),
+		Entry("shoots with the same label value", `{"labelKey":"ha-group"}`,
+			&garden.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "new", Namespace: "garden-dev", Labels: map[string]string{"ha-group": "a"}}}, int64(-1)),
+		Entry("shoot without the label", `{"labelKey":"ha-group"}`,
+			&garden.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "new", Namespace: "garden-prod"}}, int64(0)),
+	)
+})
@@ -84,6 +84,51 @@ var _ = Describe("utils", func() {
 			}))))
 		})
 
+		It("should fail due to intersecting IPv6 networks", func() {
+			var (
+				seedNetworks = garden.SeedNetworks{
+					Pods:     garden.CIDR("fd00:10:1::/48"),
+					Services: garden.CIDR("fd00:10:2::/48"),
+					Nodes:    garden.CIDR("fd00:10:3::/48"),
+				}
+
+				podsCIDR     = garden.CIDR("fd00:10:1:1::/64")
+				servicesCIDR = garden.CIDR("fd00:20:2::/48")
+				nodesCIDR    = garden.CIDR("fd00:20:3::/48")
+
+				k8sNetworks = garden.K8SNetworks{
+					Pods:     &podsCIDR,
+					Services: &servicesCIDR,
+					Nodes:    &nodesCIDR,
+				}
+			)
+
+			errorList := ValidateNetworkDisjointedness(seedNetworks, k8sNetworks, field.NewPath(""))
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("[].pods"),
+			}))))
+		})
+
+		It("should pass the validation for networks of different IP families", func() {
+			var (
+				podsCIDR     = garden.CIDR("::ffff:10.241.128.0/113")
+				servicesCIDR = garden.CIDR("fd00:10:2::/48")
+				nodesCIDR    = garden.CIDR("fd00:10:3::/48")
+
+				k8sNetworks = garden.K8SNetworks{
+					Pods:     &podsCIDR,
+					Services: &servicesCIDR,
+					Nodes:    &nodesCIDR,
+				}
+			)
+
+			errorList := ValidateNetworkDisjointedness(seedNetworks, k8sNetworks, field.NewPath(""))
+
+			Expect(errorList).To(BeEmpty())
+		})
+
 		It("should fail due to missing fields", func() {
 			var (
 				validK8sNetworks = garden.K8SNetworks{
@@ -15,9 +15,8 @@
 package utils
 
 import (
-	"net"
-
 	"github.com/gardener/gardener/pkg/apis/garden"
+	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -59,7 +58,6 @@ func ValidateNetworkDisjointedness(seedNetworks garden.SeedNetworks, k8sNetworks
 }
 
 func networksIntersect(cidr1, cidr2 garden.CIDR) bool {
-	_, net1, err1 := net.ParseCIDR(string(cidr1))
-	_, net2, err2 := net.ParseCIDR(string(cidr2))
-	return err1 != nil || err2 != nil || net2.Contains(net1.IP) || net1.Contains(net2.IP)
+	c1, c2 := cidrvalidation.NewCIDR(cidr1, nil), cidrvalidation.NewCIDR(cidr2, nil)
+	return !c1.Parse() || !c2.Parse() || cidrvalidation.Overlap(c1, c2)
 }